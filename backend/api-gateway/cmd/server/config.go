@@ -0,0 +1,25 @@
+package main
+
+import (
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/config"
+)
+
+// Loaded by config.Load, see it for the meaning of the tags, the gateway
+// runs no health checker of its own so config.Server is not embedded
+type Config struct {
+	MonitoringPort  string        `env:"MONITORING_PORT" yaml:"monitoring_port" default:"9090"`
+	ShutdownTimeout time.Duration `env:"SHUTDOWN_TIMEOUT" yaml:"shutdown_timeout" default:"10s"`
+
+	HTTPAddr                string   `env:"HTTP_ADDR" yaml:"http_addr" default:":8080"`
+	AuthServiceAddr         string   `env:"AUTH_SERVICE_ADDR" yaml:"auth_service_addr" default:"auth-service:50051"`
+	EssayServiceAddr        string   `env:"ESSAY_SERVICE_ADDR" yaml:"essay_service_addr" default:"essay-service:50052"`
+	ReviewServiceAddr       string   `env:"REVIEW_SERVICE_ADDR" yaml:"review_service_addr" default:"review-service:50053"`
+	NotificationServiceAddr string   `env:"NOTIFICATION_SERVICE_ADDR" yaml:"notification_service_addr" default:"notification-service:50054"`
+	CORSAllowedOrigins      []string `env:"CORS_ALLOWED_ORIGINS" yaml:"cors_allowed_origins" default:"http://localhost"`
+	AccessSecret            string   `env:"JWT_ACCESS_SECRET" yaml:"jwt_access_secret" required:"true" secret:"true"`
+	CookieIsSecure          bool     `env:"JWT_COOKIE_IS_SECURE" yaml:"jwt_cookie_is_secure"`
+
+	TLS config.TLS `yaml:"tls"`
+}
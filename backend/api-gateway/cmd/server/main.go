@@ -1,7 +1,8 @@
 package main
 
 import (
-	"os"
+	"context"
+	"net/http"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -10,63 +11,95 @@ import (
 	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/clients"
 	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/handlers"
 	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/middleware"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/config"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/jwt"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/lifecycle"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/monitoring"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/mtls"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/tracing"
 )
 
 func main() {
 	logger := logging.New("api-gateway")
 	defer logger.Sync()
 
-	authServicePort := os.Getenv("AUTH_SERVICE_GRPC_PORT")
-	essayServicePort := os.Getenv("ESSAY_SERVICE_GRPC_PORT")
-	reviewServicePort := os.Getenv("REVIEW_SERVICE_GRPC_PORT")
-	notificationServicePort := os.Getenv("NOTIFICATIONS_SERVICE_GRPC_PORT")
-	monitoringPort := os.Getenv("MONITORING_PORT")
+	var cfg Config
+	if err := config.Load(&cfg); err != nil {
+		logger.Fatal("Invalid configuration", zap.Error(err))
+	}
+	logger.Info("Starting API gateway")
+	config.Log(logger, cfg)
+
+	monitoring.StartMetricsServer(cfg.MonitoringPort)
+
+	lc := lifecycle.New(logger, cfg.ShutdownTimeout)
+
+	shutdownTracing, err := tracing.Init(context.Background(), "api-gateway")
+	if err != nil {
+		logger.Fatal("Failed to initialize tracing", zap.Error(err))
+	}
 
-	monitoring.StartMetricsServer(monitoringPort)
+	certs, err := mtls.New(cfg.TLS, logger)
+	if err != nil {
+		logger.Fatal("Failed to load TLS certificates", zap.Error(err))
+	}
+	lc.Go(certs.Watch)
 
-	authClient, err := clients.NewAuthClient("auth-service:" + authServicePort)
+	authClient, err := clients.NewAuthClient(cfg.AuthServiceAddr, certs.ClientCredentials(), logger)
 	if err != nil {
 		logger.Fatal("Failed to create auth client", zap.Error(err))
 	}
 	defer authClient.Close()
 
-	essayClient, err := clients.NewEssayClient("essay-service:" + essayServicePort)
+	essayClient, err := clients.NewEssayClient(cfg.EssayServiceAddr, certs.ClientCredentials(), logger)
 	if err != nil {
 		logger.Fatal("Failed to create essay client", zap.Error(err))
 	}
 	defer essayClient.Close()
 
-	reviewClient, err := clients.NewReviewClient("review-service:" + reviewServicePort)
+	reviewClient, err := clients.NewReviewClient(cfg.ReviewServiceAddr, certs.ClientCredentials(), logger)
 	if err != nil {
 		logger.Fatal("Failed to create review client", zap.Error(err))
 	}
 	defer reviewClient.Close()
 
-	notificationClient, err := clients.NewNotificationClient("notification-service:" + notificationServicePort)
+	notificationClient, err := clients.NewNotificationClient(cfg.NotificationServiceAddr, certs.ClientCredentials(), logger)
 	if err != nil {
 		logger.Fatal("Failed to create notification client", zap.Error(err))
 	}
 	defer notificationClient.Close()
 
-	authHandler := handlers.NewAuthHandler(authClient, logger)
+	authHandler := handlers.NewAuthHandler(authClient, cfg.CookieIsSecure, logger)
 	essayHandler := handlers.NewEssayHandler(essayClient, logger)
 	reviewHandler := handlers.NewReviewHandler(reviewClient, logger)
 	notificationHandler := handlers.NewNotificationHandler(notificationClient, logger)
+	healthHandler := handlers.NewHealthHandler(map[string]handlers.HealthChecker{
+		"auth-service":         authClient,
+		"essay-service":        essayClient,
+		"review-service":       reviewClient,
+		"notification-service": notificationClient,
+	}, logger)
+
+	accessSecret := []byte(cfg.AccessSecret)
 
 	router := gin.Default()
 
+	router.Use(middleware.RequestIDMiddleware())
+	router.Use(tracing.GinMiddleware("api-gateway"))
 	router.Use(monitoring.GinMiddleware())
 
 	router.Use(cors.New(cors.Config{
-		AllowOrigins:     []string{"http://localhost"},
+		AllowOrigins:     cfg.CORSAllowedOrigins,
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization"},
-		ExposeHeaders:    []string{"Content-Length"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization", logging.RequestIDHeader},
+		ExposeHeaders:    []string{"Content-Length", logging.RequestIDHeader},
 		AllowCredentials: true,
 	}))
 
+	router.GET("/healthz", healthHandler.Live)
+	router.GET("/readyz", healthHandler.Ready)
+
 	publicApiGroup := router.Group("/api")
 	{
 		authGroup := publicApiGroup.Group("/auth")
@@ -78,42 +111,126 @@ func main() {
 		}
 
 		essayGroup := publicApiGroup.Group("/essays")
+		essayGroup.Use(middleware.OptionalJWTAuthMiddleware(accessSecret))
 		{
 			essayGroup.GET("", essayHandler.GetAllEssays)
 			essayGroup.GET("/:authorname", essayHandler.GetEssay)
+			essayGroup.GET("/by-id/:id", essayHandler.GetEssayByID)
 		}
 
 		reviewGroup := publicApiGroup.Group("/reviews")
+		reviewGroup.Use(middleware.OptionalJWTAuthMiddleware(accessSecret))
 		{
 			reviewGroup.GET("", reviewHandler.GetAllReviews)
 			reviewGroup.GET("/:essayId", reviewHandler.GetByEssayId)
+			reviewGroup.GET("/:essayId/replies", reviewHandler.GetReplies)
+			reviewGroup.GET("/:essayId/stats", reviewHandler.GetEssayStats)
+			reviewGroup.GET("/by-id/:reviewId/history", reviewHandler.GetReviewHistory)
+		}
+
+		rubricGroup := publicApiGroup.Group("/rubrics")
+		{
+			rubricGroup.GET("", reviewHandler.GetAllRubrics)
+			rubricGroup.GET("/:rubricId", reviewHandler.GetRubric)
+		}
+
+		assignmentGroup := publicApiGroup.Group("/assignments")
+		{
+			assignmentGroup.GET("", reviewHandler.GetAllAssignments)
+			assignmentGroup.GET("/:assignmentId", reviewHandler.GetAssignment)
+			assignmentGroup.GET("/:assignmentId/stats", reviewHandler.GetAssignmentStats)
 		}
 	}
 
 	protectedApiGroup := router.Group("/api")
-	protectedApiGroup.Use(middleware.JWTAuthMiddleware())
+	protectedApiGroup.Use(middleware.JWTAuthMiddleware(accessSecret))
 	{
 		essayGroup := protectedApiGroup.Group("/essays")
 		{
 			essayGroup.POST("", essayHandler.CreateEssay)
+			essayGroup.PUT("/:authorname", essayHandler.UpdateEssay)
 			essayGroup.DELETE("/:authorname", essayHandler.RemoveEssay)
 		}
 
 		reviewGroup := protectedApiGroup.Group("/reviews")
 		{
 			reviewGroup.POST("", reviewHandler.CreateReview)
+			reviewGroup.PUT("/:reviewId", reviewHandler.UpdateReview)
 			reviewGroup.DELETE("/:reviewId", reviewHandler.RemoveById)
+			reviewGroup.POST("/:reviewId/replies", reviewHandler.AddReply)
+			reviewGroup.DELETE("/:reviewId/replies/:replyId", reviewHandler.RemoveReply)
+		}
+
+		draftGroup := protectedApiGroup.Group("/drafts")
+		{
+			draftGroup.GET("/:essayId", reviewHandler.GetDraft)
+			draftGroup.PUT("/:essayId", reviewHandler.SaveDraft)
+			draftGroup.POST("/:essayId/submit", reviewHandler.SubmitDraft)
+		}
+
+		rubricGroup := protectedApiGroup.Group("/rubrics")
+		{
+			rubricGroup.POST("", middleware.RequireRole(jwt.RoleTeacher), reviewHandler.CreateRubric)
+		}
+
+		gradeGroup := protectedApiGroup.Group("/grades")
+		{
+			gradeGroup.GET("/:essayId", reviewHandler.GetGrade)
+			gradeGroup.PUT("/:essayId", middleware.RequireRole(jwt.RoleTeacher), reviewHandler.SetGrade)
+		}
+
+		assignmentGroup := protectedApiGroup.Group("/assignments")
+		assignmentGroup.Use(middleware.RequireRole(jwt.RoleTeacher))
+		{
+			assignmentGroup.POST("", reviewHandler.CreateAssignment)
+			assignmentGroup.PUT("/:assignmentId", reviewHandler.UpdateAssignment)
+			assignmentGroup.PUT("/:assignmentId/release", reviewHandler.SetGradesReleased)
+			assignmentGroup.GET("/:assignmentId/gradebook", reviewHandler.ExportGradebook)
+		}
+
+		reliabilityGroup := protectedApiGroup.Group("/reliability")
+		reliabilityGroup.Use(middleware.RequireRole(jwt.RoleTeacher))
+		{
+			reliabilityGroup.GET("", reviewHandler.GetReviewerReliability)
+			reliabilityGroup.POST("/recompute", reviewHandler.RecomputeReliability)
+		}
+
+		calibrationGroup := protectedApiGroup.Group("/calibration")
+		calibrationGroup.Use(middleware.RequireRole(jwt.RoleTeacher))
+		{
+			calibrationGroup.PUT("/:essayId", reviewHandler.SetCalibration)
+			calibrationGroup.DELETE("/:essayId", reviewHandler.RemoveCalibration)
 		}
 
 		notificationGroup := protectedApiGroup.Group("/notifications")
 		{
 			notificationGroup.GET("", notificationHandler.GetUserNotifications)
+			notificationGroup.GET("/unread-count", notificationHandler.UnreadCount)
+			notificationGroup.GET("/preferences", notificationHandler.GetPreferences)
+			notificationGroup.PUT("/preferences", notificationHandler.UpdatePreferences)
 			notificationGroup.POST("/mark-read-all", notificationHandler.MarkAllAsRead)
+			notificationGroup.POST("/archive-all", notificationHandler.ArchiveAll)
+			notificationGroup.DELETE("", notificationHandler.DeleteAll)
 			notificationGroup.POST("/:notificationId/read", notificationHandler.MarkAsRead)
+			notificationGroup.POST("/:notificationId/archive", notificationHandler.Archive)
+			notificationGroup.DELETE("/:notificationId", notificationHandler.Delete)
+		}
+
+		webhookGroup := protectedApiGroup.Group("/webhooks")
+		{
+			webhookGroup.GET("", notificationHandler.ListWebhooks)
+			webhookGroup.POST("", notificationHandler.CreateWebhook)
+			webhookGroup.DELETE("/:webhookId", notificationHandler.DeleteWebhook)
+			webhookGroup.GET("/:webhookId/deliveries", notificationHandler.ListWebhookDeliveries)
 		}
 	}
 
-	if err := router.Run(":8080"); err != nil {
-		logger.Fatal("Failed to start server", zap.Error(err))
+	lc.ServeHTTP(&http.Server{Addr: cfg.HTTPAddr, Handler: router})
+	lc.OnShutdown("tracing", shutdownTracing)
+
+	if err := lc.Wait(); err != nil {
+		logger.Error("API gateway stopped with errors", zap.Error(err))
+		return
 	}
+	logger.Info("API gateway stopped")
 }
@@ -0,0 +1,79 @@
+package apierror
+
+import (
+	"net/http"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/grpcerr"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Codes of errors raised by the gateway itself, backend errors carry
+// the reason the service attached to them
+const (
+	CodeInvalidRequest   = "INVALID_REQUEST"
+	CodeUnauthenticated  = "UNAUTHENTICATED"
+	CodePermissionDenied = "PERMISSION_DENIED"
+	CodeNotFound         = "NOT_FOUND"
+	CodeInternal         = grpcerr.ReasonInternal
+)
+
+// Not in net/http, used by proxies for requests the client gave up on
+const statusClientClosedRequest = 499
+
+var httpStatuses = map[codes.Code]int{
+	codes.OK:                 http.StatusOK,
+	codes.Canceled:           statusClientClosedRequest,
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.OutOfRange:         http.StatusBadRequest,
+	codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.Aborted:            http.StatusConflict,
+	codes.FailedPrecondition: http.StatusConflict,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
+	codes.Unimplemented:      http.StatusNotImplemented,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+}
+
+// Answers with the envelope every endpoint reports errors in
+func Write(c *gin.Context, httpStatus int, code, message string) {
+	c.JSON(httpStatus, gin.H{"error": message, "code": code})
+}
+
+// Same as Write, for middleware that stops the chain
+func Abort(c *gin.Context, httpStatus int, code, message string) {
+	c.AbortWithStatusJSON(httpStatus, gin.H{"error": message, "code": code})
+}
+
+// Translates the error of a backend call and returns the HTTP status sent,
+// server side failures are reported without their details
+func WriteGrpc(c *gin.Context, err error) int {
+	st := status.Convert(err)
+	httpStatus := HTTPStatus(st.Code())
+
+	code, message := grpcerr.Reason(err), st.Message()
+	switch st.Code() {
+	case codes.Unknown, codes.Internal, codes.DataLoss:
+		code, message = CodeInternal, "internal error"
+	case codes.Unavailable:
+		message = "service temporarily unavailable"
+	case codes.DeadlineExceeded:
+		message = "request timed out"
+	case codes.Unimplemented:
+		message = "not implemented"
+	}
+
+	Write(c, httpStatus, code, message)
+	return httpStatus
+}
+
+func HTTPStatus(code codes.Code) int {
+	if httpStatus, ok := httpStatuses[code]; ok {
+		return httpStatus
+	}
+	return http.StatusInternalServerError
+}
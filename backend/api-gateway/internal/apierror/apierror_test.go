@@ -0,0 +1,90 @@
+package apierror_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/apierror"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/grpcerr"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWriteGrpc(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "domain error keeps its reason",
+			err:            grpcerr.New(codes.AlreadyExists, "ESSAY_ALREADY_EXISTS", "essay already exists"),
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error": "essay already exists", "code": "ESSAY_ALREADY_EXISTS"}`,
+		},
+		{
+			name:           "status without details",
+			err:            status.Error(codes.PermissionDenied, "only the review author can edit it"),
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   `{"error": "only the review author can edit it", "code": "PERMISSION_DENIED"}`,
+		},
+		{
+			name:           "outdated essay",
+			err:            status.Error(codes.FailedPrecondition, "essay has changed"),
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"error": "essay has changed", "code": "FAILED_PRECONDITION"}`,
+		},
+		{
+			name:           "internal details are hidden",
+			err:            status.Error(codes.Internal, `pq: relation "essays" does not exist`),
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   `{"error": "internal error", "code": "INTERNAL"}`,
+		},
+		{
+			name:           "plain error is hidden",
+			err:            errors.New("dial tcp 10.0.0.3:50051: connection refused"),
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   `{"error": "internal error", "code": "INTERNAL"}`,
+		},
+		{
+			name:           "unreachable service",
+			err:            status.Error(codes.Unavailable, "connection error: desc = transport: dial tcp 10.0.0.3:50051"),
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedBody:   `{"error": "service temporarily unavailable", "code": "UNAVAILABLE"}`,
+		},
+		{
+			name:           "deadline",
+			err:            status.Error(codes.DeadlineExceeded, "context deadline exceeded"),
+			expectedStatus: http.StatusGatewayTimeout,
+			expectedBody:   `{"error": "request timed out", "code": "DEADLINE_EXCEEDED"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			sent := apierror.WriteGrpc(c, tt.err)
+
+			assert.Equal(t, tt.expectedStatus, sent)
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.JSONEq(t, tt.expectedBody, w.Body.String())
+		})
+	}
+}
+
+func TestHTTPStatus(t *testing.T) {
+	assert.Equal(t, http.StatusBadRequest, apierror.HTTPStatus(codes.InvalidArgument))
+	assert.Equal(t, http.StatusNotFound, apierror.HTTPStatus(codes.NotFound))
+	assert.Equal(t, http.StatusUnauthorized, apierror.HTTPStatus(codes.Unauthenticated))
+	assert.Equal(t, http.StatusTooManyRequests, apierror.HTTPStatus(codes.ResourceExhausted))
+	assert.Equal(t, http.StatusInternalServerError, apierror.HTTPStatus(codes.DataLoss))
+}
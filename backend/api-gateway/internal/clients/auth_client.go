@@ -4,7 +4,11 @@ import (
 	"context"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/grpcclient"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/health"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
 
 	pb "github.com/IAGrig/vt-csa-essays/backend/proto/user"
 )
@@ -14,6 +18,7 @@ type AuthClient interface {
 	Login(context.Context, *pb.UserLoginRequest) (*pb.AuthTokensResponse, error)
 	GetUser(context.Context, *pb.GetByUsernameRequest) (*pb.UserResponse, error)
 	RefreshToken(context.Context, *pb.RefreshTokenRequest) (*pb.AuthTokensResponse, error)
+	HealthCheck(context.Context) error
 	Close() error
 }
 
@@ -22,8 +27,13 @@ type authClient struct {
 	service pb.UserServiceClient
 }
 
-func NewAuthClient(addr string) (AuthClient, error) {
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+func NewAuthClient(addr string, creds credentials.TransportCredentials, logger *logging.Logger) (AuthClient, error) {
+	conn, err := grpcclient.NewClient(addr, pb.UserService_ServiceDesc.ServiceName, logger,
+		grpcclient.WithCredentials(creds),
+		grpcclient.WithRetries(
+			pb.UserService_GetByUsername_FullMethodName,
+		),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -50,6 +60,10 @@ func (c *authClient) RefreshToken(ctx context.Context, req *pb.RefreshTokenReque
 	return c.service.RefreshToken(ctx, req)
 }
 
+func (c *authClient) HealthCheck(ctx context.Context) error {
+	return health.GrpcCheck(c.conn)(ctx)
+}
+
 func (c *authClient) Close() error {
 	return c.conn.Close()
 }
@@ -3,9 +3,14 @@ package clients
 import (
 	"context"
 	"io"
+	"time"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/grpcclient"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/health"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
 
 	pb "github.com/IAGrig/vt-csa-essays/backend/proto/essay"
 )
@@ -13,9 +18,12 @@ import (
 type EssayClient interface {
 	CreateEssay(context.Context, *pb.EssayAddRequest) (*pb.EssayResponse, error)
 	GetEssay(context.Context, *pb.GetByAuthorNameRequest) (*pb.EssayWithReviewsResponse, error)
+	GetEssayByID(context.Context, *pb.GetByIdRequest) (*pb.EssayWithReviewsResponse, error)
 	GetAllEssays(context.Context, *pb.EmptyRequest) ([]*pb.EssayResponse, error)
 	SearchEssays(context.Context, *pb.SearchByContentRequest) ([]*pb.EssayResponse, error)
 	DeleteEssay(context.Context, *pb.RemoveByAuthorNameRequest) (*pb.EssayResponse, error)
+	UpdateEssay(context.Context, *pb.UpdateByAuthorNameRequest) (*pb.EssayResponse, error)
+	HealthCheck(context.Context) error
 	Close() error
 }
 
@@ -24,8 +32,18 @@ type essayClient struct {
 	service pb.EssayServiceClient
 }
 
-func NewEssayClient(addr string) (EssayClient, error) {
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+func NewEssayClient(addr string, creds credentials.TransportCredentials, logger *logging.Logger) (EssayClient, error) {
+	conn, err := grpcclient.NewClient(addr, pb.EssayService_ServiceDesc.ServiceName, logger,
+		grpcclient.WithCredentials(creds),
+		grpcclient.WithRetries(
+			pb.EssayService_GetAllEssays_FullMethodName,
+			pb.EssayService_GetByAuthorName_FullMethodName,
+			pb.EssayService_GetById_FullMethodName,
+			pb.EssayService_SearchByContent_FullMethodName,
+		),
+		grpcclient.WithMethodTimeout(pb.EssayService_GetAllEssays_FullMethodName, 15*time.Second),
+		grpcclient.WithMethodTimeout(pb.EssayService_SearchByContent_FullMethodName, 15*time.Second),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -44,6 +62,10 @@ func (c *essayClient) GetEssay(ctx context.Context, req *pb.GetByAuthorNameReque
 	return c.service.GetByAuthorName(ctx, req)
 }
 
+func (c *essayClient) GetEssayByID(ctx context.Context, req *pb.GetByIdRequest) (*pb.EssayWithReviewsResponse, error) {
+	return c.service.GetById(ctx, req)
+}
+
 func (c *essayClient) GetAllEssays(ctx context.Context, req *pb.EmptyRequest) ([]*pb.EssayResponse, error) {
 	stream, err := c.service.GetAllEssays(ctx, req)
 	if err != nil {
@@ -92,6 +114,14 @@ func (c *essayClient) DeleteEssay(ctx context.Context, req *pb.RemoveByAuthorNam
 	return c.service.RemoveByAuthorName(ctx, req)
 }
 
+func (c *essayClient) UpdateEssay(ctx context.Context, req *pb.UpdateByAuthorNameRequest) (*pb.EssayResponse, error) {
+	return c.service.UpdateByAuthorName(ctx, req)
+}
+
+func (c *essayClient) HealthCheck(ctx context.Context) error {
+	return health.GrpcCheck(c.conn)(ctx)
+}
+
 func (c *essayClient) Close() error {
 	return c.conn.Close()
 }
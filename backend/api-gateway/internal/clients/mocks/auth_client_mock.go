@@ -43,6 +43,11 @@ func (m *MockAuthClient) RefreshToken(ctx context.Context, req *pb.RefreshTokenR
 	return args.Get(0).(*pb.AuthTokensResponse), args.Error(1)
 }
 
+func (m *MockAuthClient) HealthCheck(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
 func (m *MockAuthClient) Close() error {
 	args := m.Called()
 	return args.Error(0)
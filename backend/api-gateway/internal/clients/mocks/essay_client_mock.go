@@ -27,6 +27,14 @@ func (m *MockEssayClient) GetEssay(ctx context.Context, req *pb.GetByAuthorNameR
 	return args.Get(0).(*pb.EssayWithReviewsResponse), args.Error(1)
 }
 
+func (m *MockEssayClient) GetEssayByID(ctx context.Context, req *pb.GetByIdRequest) (*pb.EssayWithReviewsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.EssayWithReviewsResponse), args.Error(1)
+}
+
 func (m *MockEssayClient) GetAllEssays(ctx context.Context, req *pb.EmptyRequest) ([]*pb.EssayResponse, error) {
 	args := m.Called(ctx, req)
 	if args.Get(0) == nil {
@@ -51,6 +59,19 @@ func (m *MockEssayClient) DeleteEssay(ctx context.Context, req *pb.RemoveByAutho
 	return args.Get(0).(*pb.EssayResponse), args.Error(1)
 }
 
+func (m *MockEssayClient) UpdateEssay(ctx context.Context, req *pb.UpdateByAuthorNameRequest) (*pb.EssayResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.EssayResponse), args.Error(1)
+}
+
+func (m *MockEssayClient) HealthCheck(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
 func (m *MockEssayClient) Close() error {
 	args := m.Called()
 	return args.Error(0)
@@ -19,6 +19,14 @@ func (m *MockNotificationClient) GetByUserID(ctx context.Context, req *pb.GetByU
 	return args.Get(0).([]*pb.NotificationResponse), args.Error(1)
 }
 
+func (m *MockNotificationClient) UnreadCount(ctx context.Context, req *pb.UnreadCountRequest) (*pb.UnreadCountResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.UnreadCountResponse), args.Error(1)
+}
+
 func (m *MockNotificationClient) MarkAsRead(ctx context.Context, req *pb.MarkAsReadRequest) (*pb.MarkAsReadResponse, error) {
 	args := m.Called(ctx, req)
 	if args.Get(0) == nil {
@@ -35,6 +43,91 @@ func (m *MockNotificationClient) MarkAllAsRead(ctx context.Context, req *pb.Mark
 	return args.Get(0).(*pb.MarkAllAsReadResponse), args.Error(1)
 }
 
+func (m *MockNotificationClient) Archive(ctx context.Context, req *pb.ArchiveRequest) (*pb.ArchiveResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.ArchiveResponse), args.Error(1)
+}
+
+func (m *MockNotificationClient) ArchiveAll(ctx context.Context, req *pb.ArchiveAllRequest) (*pb.ArchiveAllResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.ArchiveAllResponse), args.Error(1)
+}
+
+func (m *MockNotificationClient) Delete(ctx context.Context, req *pb.DeleteRequest) (*pb.DeleteResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.DeleteResponse), args.Error(1)
+}
+
+func (m *MockNotificationClient) DeleteAll(ctx context.Context, req *pb.DeleteAllRequest) (*pb.DeleteAllResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.DeleteAllResponse), args.Error(1)
+}
+
+func (m *MockNotificationClient) GetPreferences(ctx context.Context, req *pb.GetPreferencesRequest) (*pb.PreferencesResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.PreferencesResponse), args.Error(1)
+}
+
+func (m *MockNotificationClient) UpdatePreferences(ctx context.Context, req *pb.UpdatePreferencesRequest) (*pb.PreferencesResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.PreferencesResponse), args.Error(1)
+}
+
+func (m *MockNotificationClient) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.WebhookResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.WebhookResponse), args.Error(1)
+}
+
+func (m *MockNotificationClient) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) ([]*pb.WebhookResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pb.WebhookResponse), args.Error(1)
+}
+
+func (m *MockNotificationClient) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.DeleteWebhookResponse), args.Error(1)
+}
+
+func (m *MockNotificationClient) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) ([]*pb.WebhookDeliveryResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pb.WebhookDeliveryResponse), args.Error(1)
+}
+
+func (m *MockNotificationClient) HealthCheck(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
 func (m *MockNotificationClient) Close() error {
 	args := m.Called()
 	return args.Error(0)
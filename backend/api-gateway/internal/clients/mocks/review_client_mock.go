@@ -43,6 +43,219 @@ func (m *MockReviewClient) RemoveById(ctx context.Context, req *pb.RemoveByIdReq
 	return args.Get(0).(*pb.ReviewResponse), args.Error(1)
 }
 
+func (m *MockReviewClient) CreateRubric(ctx context.Context, req *pb.CreateRubricRequest) (*pb.RubricResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.RubricResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) GetRubric(ctx context.Context, req *pb.GetRubricRequest) (*pb.RubricResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.RubricResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) GetAllRubrics(ctx context.Context, req *pb.EmptyRequest) ([]*pb.RubricResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pb.RubricResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) AddReply(ctx context.Context, req *pb.AddReplyRequest) (*pb.ReplyResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.ReplyResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) GetReplies(ctx context.Context, req *pb.GetRepliesRequest) ([]*pb.ReplyResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pb.ReplyResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) RemoveReply(ctx context.Context, req *pb.RemoveReplyRequest) (*pb.ReplyResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.ReplyResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) CreateAssignment(ctx context.Context, req *pb.CreateAssignmentRequest) (*pb.AssignmentResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.AssignmentResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) GetAssignment(ctx context.Context, req *pb.GetAssignmentRequest) (*pb.AssignmentResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.AssignmentResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) GetAllAssignments(ctx context.Context, req *pb.EmptyRequest) ([]*pb.AssignmentResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pb.AssignmentResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) UpdateAssignment(ctx context.Context, req *pb.UpdateAssignmentRequest) (*pb.AssignmentResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.AssignmentResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) UpdateReview(ctx context.Context, req *pb.UpdateReviewRequest) (*pb.ReviewResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.ReviewResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) GetReviewHistory(ctx context.Context, req *pb.GetReviewHistoryRequest) ([]*pb.ReviewVersionResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pb.ReviewVersionResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) GetEssayStats(ctx context.Context, req *pb.GetEssayStatsRequest) (*pb.EssayStatsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.EssayStatsResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) GetAssignmentStats(ctx context.Context, req *pb.GetAssignmentStatsRequest) (*pb.AssignmentStatsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.AssignmentStatsResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) GetByAuthor(ctx context.Context, req *pb.GetByAuthorRequest) ([]*pb.ReviewResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pb.ReviewResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) SetGradesReleased(ctx context.Context, req *pb.SetGradesReleasedRequest) (*pb.AssignmentResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.AssignmentResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) SetGrade(ctx context.Context, req *pb.SetGradeRequest) (*pb.GradeResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.GradeResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) GetGrade(ctx context.Context, req *pb.GetGradeRequest) (*pb.GradeResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.GradeResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) GetGradebook(ctx context.Context, req *pb.GetGradebookRequest) ([]*pb.GradebookEntry, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pb.GradebookEntry), args.Error(1)
+}
+
+func (m *MockReviewClient) SetCalibration(ctx context.Context, req *pb.SetCalibrationRequest) (*pb.CalibrationResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.CalibrationResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) RemoveCalibration(ctx context.Context, req *pb.RemoveCalibrationRequest) (*pb.CalibrationResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.CalibrationResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) RecomputeReliability(ctx context.Context, req *pb.EmptyRequest) ([]*pb.ReviewerReliabilityResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pb.ReviewerReliabilityResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) GetReviewerReliability(ctx context.Context, req *pb.EmptyRequest) ([]*pb.ReviewerReliabilityResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pb.ReviewerReliabilityResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) SaveDraft(ctx context.Context, req *pb.SaveDraftRequest) (*pb.DraftResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.DraftResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) GetDraft(ctx context.Context, req *pb.GetDraftRequest) (*pb.DraftResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.DraftResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) SubmitDraft(ctx context.Context, req *pb.SubmitDraftRequest) (*pb.ReviewResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.ReviewResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) HealthCheck(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
 func (m *MockReviewClient) Close() error {
 	args := m.Called()
 	return args.Error(0)
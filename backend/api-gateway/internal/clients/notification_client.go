@@ -5,15 +5,31 @@ import (
 	"io"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/grpcclient"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/health"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
 
 	pb "github.com/IAGrig/vt-csa-essays/backend/proto/notification"
 )
 
 type NotificationClient interface {
 	GetByUserID(context.Context, *pb.GetByUserIDRequest) ([]*pb.NotificationResponse, error)
+	UnreadCount(context.Context, *pb.UnreadCountRequest) (*pb.UnreadCountResponse, error)
 	MarkAsRead(context.Context, *pb.MarkAsReadRequest) (*pb.MarkAsReadResponse, error)
 	MarkAllAsRead(context.Context, *pb.MarkAllAsReadRequest) (*pb.MarkAllAsReadResponse, error)
+	Archive(context.Context, *pb.ArchiveRequest) (*pb.ArchiveResponse, error)
+	ArchiveAll(context.Context, *pb.ArchiveAllRequest) (*pb.ArchiveAllResponse, error)
+	Delete(context.Context, *pb.DeleteRequest) (*pb.DeleteResponse, error)
+	DeleteAll(context.Context, *pb.DeleteAllRequest) (*pb.DeleteAllResponse, error)
+	GetPreferences(context.Context, *pb.GetPreferencesRequest) (*pb.PreferencesResponse, error)
+	UpdatePreferences(context.Context, *pb.UpdatePreferencesRequest) (*pb.PreferencesResponse, error)
+	CreateWebhook(context.Context, *pb.CreateWebhookRequest) (*pb.WebhookResponse, error)
+	ListWebhooks(context.Context, *pb.ListWebhooksRequest) ([]*pb.WebhookResponse, error)
+	DeleteWebhook(context.Context, *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error)
+	ListWebhookDeliveries(context.Context, *pb.ListWebhookDeliveriesRequest) ([]*pb.WebhookDeliveryResponse, error)
+	HealthCheck(context.Context) error
 	Close() error
 }
 
@@ -22,8 +38,17 @@ type notificationClient struct {
 	service pb.NotificationServiceClient
 }
 
-func NewNotificationClient(addr string) (NotificationClient, error) {
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+func NewNotificationClient(addr string, creds credentials.TransportCredentials, logger *logging.Logger) (NotificationClient, error) {
+	conn, err := grpcclient.NewClient(addr, pb.NotificationService_ServiceDesc.ServiceName, logger,
+		grpcclient.WithCredentials(creds),
+		grpcclient.WithRetries(
+			pb.NotificationService_GetByUserID_FullMethodName,
+			pb.NotificationService_UnreadCount_FullMethodName,
+			pb.NotificationService_GetPreferences_FullMethodName,
+			pb.NotificationService_ListWebhooks_FullMethodName,
+			pb.NotificationService_ListWebhookDeliveries_FullMethodName,
+		),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -55,6 +80,10 @@ func (c *notificationClient) GetByUserID(ctx context.Context, req *pb.GetByUserI
 	return notifications, nil
 }
 
+func (c *notificationClient) UnreadCount(ctx context.Context, req *pb.UnreadCountRequest) (*pb.UnreadCountResponse, error) {
+	return c.service.UnreadCount(ctx, req)
+}
+
 func (c *notificationClient) MarkAsRead(ctx context.Context, req *pb.MarkAsReadRequest) (*pb.MarkAsReadResponse, error) {
 	return c.service.MarkAsRead(ctx, req)
 }
@@ -63,6 +92,84 @@ func (c *notificationClient) MarkAllAsRead(ctx context.Context, req *pb.MarkAllA
 	return c.service.MarkAllAsRead(ctx, req)
 }
 
+func (c *notificationClient) Archive(ctx context.Context, req *pb.ArchiveRequest) (*pb.ArchiveResponse, error) {
+	return c.service.Archive(ctx, req)
+}
+
+func (c *notificationClient) ArchiveAll(ctx context.Context, req *pb.ArchiveAllRequest) (*pb.ArchiveAllResponse, error) {
+	return c.service.ArchiveAll(ctx, req)
+}
+
+func (c *notificationClient) Delete(ctx context.Context, req *pb.DeleteRequest) (*pb.DeleteResponse, error) {
+	return c.service.Delete(ctx, req)
+}
+
+func (c *notificationClient) DeleteAll(ctx context.Context, req *pb.DeleteAllRequest) (*pb.DeleteAllResponse, error) {
+	return c.service.DeleteAll(ctx, req)
+}
+
+func (c *notificationClient) GetPreferences(ctx context.Context, req *pb.GetPreferencesRequest) (*pb.PreferencesResponse, error) {
+	return c.service.GetPreferences(ctx, req)
+}
+
+func (c *notificationClient) UpdatePreferences(ctx context.Context, req *pb.UpdatePreferencesRequest) (*pb.PreferencesResponse, error) {
+	return c.service.UpdatePreferences(ctx, req)
+}
+
+func (c *notificationClient) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.WebhookResponse, error) {
+	return c.service.CreateWebhook(ctx, req)
+}
+
+func (c *notificationClient) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) ([]*pb.WebhookResponse, error) {
+	stream, err := c.service.ListWebhooks(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var webhooks []*pb.WebhookResponse
+	for {
+		webhook, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, nil
+}
+
+func (c *notificationClient) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	return c.service.DeleteWebhook(ctx, req)
+}
+
+func (c *notificationClient) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) ([]*pb.WebhookDeliveryResponse, error) {
+	stream, err := c.service.ListWebhookDeliveries(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var deliveries []*pb.WebhookDeliveryResponse
+	for {
+		delivery, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
+
+func (c *notificationClient) HealthCheck(ctx context.Context) error {
+	return health.GrpcCheck(c.conn)(ctx)
+}
+
 func (c *notificationClient) Close() error {
 	return c.conn.Close()
 }
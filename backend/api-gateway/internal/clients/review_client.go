@@ -3,9 +3,14 @@ package clients
 import (
 	"context"
 	"io"
+	"time"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/grpcclient"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/health"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
 
 	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
 )
@@ -15,6 +20,33 @@ type ReviewClient interface {
 	GetAllReviews(context.Context, *pb.EmptyRequest) ([]*pb.ReviewResponse, error)
 	GetByEssayId(context.Context, *pb.GetByEssayIdRequest) ([]*pb.ReviewResponse, error)
 	RemoveById(context.Context, *pb.RemoveByIdRequest) (*pb.ReviewResponse, error)
+	CreateRubric(context.Context, *pb.CreateRubricRequest) (*pb.RubricResponse, error)
+	GetRubric(context.Context, *pb.GetRubricRequest) (*pb.RubricResponse, error)
+	GetAllRubrics(context.Context, *pb.EmptyRequest) ([]*pb.RubricResponse, error)
+	AddReply(context.Context, *pb.AddReplyRequest) (*pb.ReplyResponse, error)
+	GetReplies(context.Context, *pb.GetRepliesRequest) ([]*pb.ReplyResponse, error)
+	RemoveReply(context.Context, *pb.RemoveReplyRequest) (*pb.ReplyResponse, error)
+	CreateAssignment(context.Context, *pb.CreateAssignmentRequest) (*pb.AssignmentResponse, error)
+	GetAssignment(context.Context, *pb.GetAssignmentRequest) (*pb.AssignmentResponse, error)
+	GetAllAssignments(context.Context, *pb.EmptyRequest) ([]*pb.AssignmentResponse, error)
+	UpdateAssignment(context.Context, *pb.UpdateAssignmentRequest) (*pb.AssignmentResponse, error)
+	UpdateReview(context.Context, *pb.UpdateReviewRequest) (*pb.ReviewResponse, error)
+	GetReviewHistory(context.Context, *pb.GetReviewHistoryRequest) ([]*pb.ReviewVersionResponse, error)
+	GetEssayStats(context.Context, *pb.GetEssayStatsRequest) (*pb.EssayStatsResponse, error)
+	GetAssignmentStats(context.Context, *pb.GetAssignmentStatsRequest) (*pb.AssignmentStatsResponse, error)
+	GetByAuthor(context.Context, *pb.GetByAuthorRequest) ([]*pb.ReviewResponse, error)
+	SetGradesReleased(context.Context, *pb.SetGradesReleasedRequest) (*pb.AssignmentResponse, error)
+	SetGrade(context.Context, *pb.SetGradeRequest) (*pb.GradeResponse, error)
+	GetGrade(context.Context, *pb.GetGradeRequest) (*pb.GradeResponse, error)
+	GetGradebook(context.Context, *pb.GetGradebookRequest) ([]*pb.GradebookEntry, error)
+	SetCalibration(context.Context, *pb.SetCalibrationRequest) (*pb.CalibrationResponse, error)
+	RemoveCalibration(context.Context, *pb.RemoveCalibrationRequest) (*pb.CalibrationResponse, error)
+	RecomputeReliability(context.Context, *pb.EmptyRequest) ([]*pb.ReviewerReliabilityResponse, error)
+	GetReviewerReliability(context.Context, *pb.EmptyRequest) ([]*pb.ReviewerReliabilityResponse, error)
+	SaveDraft(context.Context, *pb.SaveDraftRequest) (*pb.DraftResponse, error)
+	GetDraft(context.Context, *pb.GetDraftRequest) (*pb.DraftResponse, error)
+	SubmitDraft(context.Context, *pb.SubmitDraftRequest) (*pb.ReviewResponse, error)
+	HealthCheck(context.Context) error
 	Close() error
 }
 
@@ -23,8 +55,30 @@ type reviewClient struct {
 	service pb.ReviewServiceClient
 }
 
-func NewReviewClient(addr string) (ReviewClient, error) {
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+func NewReviewClient(addr string, creds credentials.TransportCredentials, logger *logging.Logger) (ReviewClient, error) {
+	conn, err := grpcclient.NewClient(addr, pb.ReviewService_ServiceDesc.ServiceName, logger,
+		grpcclient.WithCredentials(creds),
+		grpcclient.WithRetries(
+			pb.ReviewService_GetAllReviews_FullMethodName,
+			pb.ReviewService_GetByEssayId_FullMethodName,
+			pb.ReviewService_GetByAuthor_FullMethodName,
+			pb.ReviewService_GetReviewHistory_FullMethodName,
+			pb.ReviewService_GetEssayStats_FullMethodName,
+			pb.ReviewService_GetEssayStatsBatch_FullMethodName,
+			pb.ReviewService_GetAssignmentStats_FullMethodName,
+			pb.ReviewService_GetRubric_FullMethodName,
+			pb.ReviewService_GetAllRubrics_FullMethodName,
+			pb.ReviewService_GetReplies_FullMethodName,
+			pb.ReviewService_GetAssignment_FullMethodName,
+			pb.ReviewService_GetAllAssignments_FullMethodName,
+			pb.ReviewService_GetGrade_FullMethodName,
+			pb.ReviewService_GetGradebook_FullMethodName,
+			pb.ReviewService_GetReviewerReliability_FullMethodName,
+			pb.ReviewService_GetDraft_FullMethodName,
+		),
+		grpcclient.WithMethodTimeout(pb.ReviewService_GetGradebook_FullMethodName, 15*time.Second),
+		grpcclient.WithMethodTimeout(pb.ReviewService_RecomputeReliability_FullMethodName, 30*time.Second),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -85,6 +139,250 @@ func (c *reviewClient) RemoveById(ctx context.Context, req *pb.RemoveByIdRequest
 	return c.service.RemoveById(ctx, req)
 }
 
+func (c *reviewClient) CreateRubric(ctx context.Context, req *pb.CreateRubricRequest) (*pb.RubricResponse, error) {
+	return c.service.CreateRubric(ctx, req)
+}
+
+func (c *reviewClient) GetRubric(ctx context.Context, req *pb.GetRubricRequest) (*pb.RubricResponse, error) {
+	return c.service.GetRubric(ctx, req)
+}
+
+func (c *reviewClient) GetAllRubrics(ctx context.Context, req *pb.EmptyRequest) ([]*pb.RubricResponse, error) {
+	stream, err := c.service.GetAllRubrics(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var rubrics []*pb.RubricResponse
+	for {
+		rubric, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		rubrics = append(rubrics, rubric)
+	}
+
+	return rubrics, nil
+}
+
+func (c *reviewClient) AddReply(ctx context.Context, req *pb.AddReplyRequest) (*pb.ReplyResponse, error) {
+	return c.service.AddReply(ctx, req)
+}
+
+func (c *reviewClient) GetReplies(ctx context.Context, req *pb.GetRepliesRequest) ([]*pb.ReplyResponse, error) {
+	stream, err := c.service.GetReplies(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var replies []*pb.ReplyResponse
+	for {
+		reply, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		replies = append(replies, reply)
+	}
+
+	return replies, nil
+}
+
+func (c *reviewClient) RemoveReply(ctx context.Context, req *pb.RemoveReplyRequest) (*pb.ReplyResponse, error) {
+	return c.service.RemoveReply(ctx, req)
+}
+
+func (c *reviewClient) CreateAssignment(ctx context.Context, req *pb.CreateAssignmentRequest) (*pb.AssignmentResponse, error) {
+	return c.service.CreateAssignment(ctx, req)
+}
+
+func (c *reviewClient) GetAssignment(ctx context.Context, req *pb.GetAssignmentRequest) (*pb.AssignmentResponse, error) {
+	return c.service.GetAssignment(ctx, req)
+}
+
+func (c *reviewClient) GetAllAssignments(ctx context.Context, req *pb.EmptyRequest) ([]*pb.AssignmentResponse, error) {
+	stream, err := c.service.GetAllAssignments(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var assignments []*pb.AssignmentResponse
+	for {
+		assignment, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, assignment)
+	}
+
+	return assignments, nil
+}
+
+func (c *reviewClient) UpdateAssignment(ctx context.Context, req *pb.UpdateAssignmentRequest) (*pb.AssignmentResponse, error) {
+	return c.service.UpdateAssignment(ctx, req)
+}
+
+func (c *reviewClient) UpdateReview(ctx context.Context, req *pb.UpdateReviewRequest) (*pb.ReviewResponse, error) {
+	return c.service.UpdateReview(ctx, req)
+}
+
+func (c *reviewClient) GetReviewHistory(ctx context.Context, req *pb.GetReviewHistoryRequest) ([]*pb.ReviewVersionResponse, error) {
+	stream, err := c.service.GetReviewHistory(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var reviewVersions []*pb.ReviewVersionResponse
+	for {
+		reviewVersion, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		reviewVersions = append(reviewVersions, reviewVersion)
+	}
+
+	return reviewVersions, nil
+}
+
+func (c *reviewClient) GetEssayStats(ctx context.Context, req *pb.GetEssayStatsRequest) (*pb.EssayStatsResponse, error) {
+	return c.service.GetEssayStats(ctx, req)
+}
+
+func (c *reviewClient) GetAssignmentStats(ctx context.Context, req *pb.GetAssignmentStatsRequest) (*pb.AssignmentStatsResponse, error) {
+	return c.service.GetAssignmentStats(ctx, req)
+}
+
+func (c *reviewClient) GetByAuthor(ctx context.Context, req *pb.GetByAuthorRequest) ([]*pb.ReviewResponse, error) {
+	stream, err := c.service.GetByAuthor(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var reviews []*pb.ReviewResponse
+	for {
+		review, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, review)
+	}
+
+	return reviews, nil
+}
+
+func (c *reviewClient) SetGradesReleased(ctx context.Context, req *pb.SetGradesReleasedRequest) (*pb.AssignmentResponse, error) {
+	return c.service.SetGradesReleased(ctx, req)
+}
+
+func (c *reviewClient) SetGrade(ctx context.Context, req *pb.SetGradeRequest) (*pb.GradeResponse, error) {
+	return c.service.SetGrade(ctx, req)
+}
+
+func (c *reviewClient) GetGrade(ctx context.Context, req *pb.GetGradeRequest) (*pb.GradeResponse, error) {
+	return c.service.GetGrade(ctx, req)
+}
+
+func (c *reviewClient) GetGradebook(ctx context.Context, req *pb.GetGradebookRequest) ([]*pb.GradebookEntry, error) {
+	stream, err := c.service.GetGradebook(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*pb.GradebookEntry
+	for {
+		entry, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (c *reviewClient) SetCalibration(ctx context.Context, req *pb.SetCalibrationRequest) (*pb.CalibrationResponse, error) {
+	return c.service.SetCalibration(ctx, req)
+}
+
+func (c *reviewClient) RemoveCalibration(ctx context.Context, req *pb.RemoveCalibrationRequest) (*pb.CalibrationResponse, error) {
+	return c.service.RemoveCalibration(ctx, req)
+}
+
+func (c *reviewClient) RecomputeReliability(ctx context.Context, req *pb.EmptyRequest) ([]*pb.ReviewerReliabilityResponse, error) {
+	stream, err := c.service.RecomputeReliability(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var reliabilities []*pb.ReviewerReliabilityResponse
+	for {
+		reliability, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		reliabilities = append(reliabilities, reliability)
+	}
+
+	return reliabilities, nil
+}
+
+func (c *reviewClient) GetReviewerReliability(ctx context.Context, req *pb.EmptyRequest) ([]*pb.ReviewerReliabilityResponse, error) {
+	stream, err := c.service.GetReviewerReliability(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var reliabilities []*pb.ReviewerReliabilityResponse
+	for {
+		reliability, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		reliabilities = append(reliabilities, reliability)
+	}
+
+	return reliabilities, nil
+}
+
+func (c *reviewClient) SaveDraft(ctx context.Context, req *pb.SaveDraftRequest) (*pb.DraftResponse, error) {
+	return c.service.SaveDraft(ctx, req)
+}
+
+func (c *reviewClient) GetDraft(ctx context.Context, req *pb.GetDraftRequest) (*pb.DraftResponse, error) {
+	return c.service.GetDraft(ctx, req)
+}
+
+func (c *reviewClient) SubmitDraft(ctx context.Context, req *pb.SubmitDraftRequest) (*pb.ReviewResponse, error) {
+	return c.service.SubmitDraft(ctx, req)
+}
+
+func (c *reviewClient) HealthCheck(ctx context.Context) error {
+	return health.GrpcCheck(c.conn)(ctx)
+}
+
 func (c *reviewClient) Close() error {
 	return c.conn.Close()
 }
@@ -0,0 +1,68 @@
+package converters
+
+import (
+	essayPb "github.com/IAGrig/vt-csa-essays/backend/proto/essay"
+	reviewPb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/jwt"
+)
+
+const defaultAlias = "Anonymous"
+
+// User the response is rendered for, empty for unauthenticated requests
+type Viewer struct {
+	Username string
+	Role     string
+}
+
+// Teachers and the user themselves always see the real name
+func (v Viewer) seesIdentity(anonymous bool, username string) bool {
+	return !anonymous || v.Role == jwt.RoleTeacher || (v.Username != "" && v.Username == username)
+}
+
+// Reports whether the viewer may look up username's double-blind reviews by name
+func (v Viewer) SeesIdentityOf(username string) bool {
+	return v.seesIdentity(true, username)
+}
+
+func aliasOrDefault(alias string) string {
+	if alias == "" {
+		return defaultAlias
+	}
+	return alias
+}
+
+// Replaces the reviewer of a double-blind review with their pseudonym
+func AnonymizeReview(r *reviewPb.ReviewResponse, viewer Viewer) {
+	if r == nil || viewer.seesIdentity(r.Anonymous, r.Author) {
+		return
+	}
+	r.Author = aliasOrDefault(r.AuthorAlias)
+}
+
+func AnonymizeReply(r *reviewPb.ReplyResponse, viewer Viewer) {
+	if r == nil || viewer.seesIdentity(r.Anonymous, r.Author) {
+		return
+	}
+	r.Author = aliasOrDefault(r.AuthorAlias)
+}
+
+func AnonymizeEssay(e *essayPb.EssayResponse, viewer Viewer) {
+	if e == nil || viewer.seesIdentity(e.Anonymous, e.Author) {
+		return
+	}
+	e.Author = aliasOrDefault(e.AuthorAlias)
+}
+
+// Hides the essay author and every reviewer the viewer may not know
+func AnonymizeEssayWithReviews(e *essayPb.EssayWithReviewsResponse, viewer Viewer) {
+	if e == nil {
+		return
+	}
+	for _, review := range e.Reviews {
+		AnonymizeReview(review, viewer)
+	}
+	if viewer.seesIdentity(e.Anonymous, e.Author) {
+		return
+	}
+	e.Author = aliasOrDefault(e.AuthorAlias)
+}
@@ -0,0 +1,93 @@
+package converters
+
+import (
+	"testing"
+
+	essayPb "github.com/IAGrig/vt-csa-essays/backend/proto/essay"
+	reviewPb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/jwt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnonymizeReview(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *reviewPb.ReviewResponse
+		viewer   Viewer
+		expected string
+	}{
+		{
+			name:     "regular review keeps author",
+			input:    &reviewPb.ReviewResponse{Author: "reviewer1"},
+			viewer:   Viewer{},
+			expected: "reviewer1",
+		},
+		{
+			name:     "anonymous review hides author from guests",
+			input:    &reviewPb.ReviewResponse{Author: "reviewer1", Anonymous: true, AuthorAlias: "Reviewer A"},
+			viewer:   Viewer{},
+			expected: "Reviewer A",
+		},
+		{
+			name:     "anonymous review hides author from students",
+			input:    &reviewPb.ReviewResponse{Author: "reviewer1", Anonymous: true, AuthorAlias: "Reviewer A"},
+			viewer:   Viewer{Username: "student", Role: jwt.RoleStudent},
+			expected: "Reviewer A",
+		},
+		{
+			name:     "reviewer sees own name",
+			input:    &reviewPb.ReviewResponse{Author: "reviewer1", Anonymous: true, AuthorAlias: "Reviewer A"},
+			viewer:   Viewer{Username: "reviewer1", Role: jwt.RoleStudent},
+			expected: "reviewer1",
+		},
+		{
+			name:     "teacher sees real name",
+			input:    &reviewPb.ReviewResponse{Author: "reviewer1", Anonymous: true, AuthorAlias: "Reviewer A"},
+			viewer:   Viewer{Username: "teacher", Role: jwt.RoleTeacher},
+			expected: "reviewer1",
+		},
+		{
+			name:     "missing alias falls back to default",
+			input:    &reviewPb.ReviewResponse{Author: "reviewer1", Anonymous: true},
+			viewer:   Viewer{},
+			expected: "Anonymous",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			AnonymizeReview(tt.input, tt.viewer)
+			assert.Equal(t, tt.expected, tt.input.Author)
+		})
+	}
+}
+
+func TestAnonymizeReply(t *testing.T) {
+	reply := &reviewPb.ReplyResponse{Author: "author1", Anonymous: true, AuthorAlias: "Author A"}
+	AnonymizeReply(reply, Viewer{Username: "reviewer1", Role: jwt.RoleStudent})
+	assert.Equal(t, "Author A", reply.Author)
+
+	AnonymizeReply(nil, Viewer{})
+}
+
+func TestAnonymizeEssayWithReviews(t *testing.T) {
+	essay := &essayPb.EssayWithReviewsResponse{
+		Author:      "author1",
+		Anonymous:   true,
+		AuthorAlias: "Author A",
+		Reviews: []*reviewPb.ReviewResponse{
+			{Author: "reviewer1", Anonymous: true, AuthorAlias: "Reviewer A"},
+			{Author: "reviewer2", Anonymous: true, AuthorAlias: "Reviewer B"},
+		},
+	}
+
+	AnonymizeEssayWithReviews(essay, Viewer{Username: "author1", Role: jwt.RoleStudent})
+
+	assert.Equal(t, "author1", essay.Author)
+	assert.Equal(t, "Reviewer A", essay.Reviews[0].Author)
+	assert.Equal(t, "Reviewer B", essay.Reviews[1].Author)
+
+	listed := &essayPb.EssayResponse{Author: "author1", Anonymous: true, AuthorAlias: "Author A"}
+	AnonymizeEssay(listed, Viewer{Username: "reviewer1", Role: jwt.RoleStudent})
+	assert.Equal(t, "Author A", listed.Author)
+}
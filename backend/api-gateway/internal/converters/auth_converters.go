@@ -13,6 +13,7 @@ func MarshalProtoUserResponse(u *pb.UserResponse) gin.H {
 	return gin.H{
 		"id":         u.Id,
 		"username":   u.Username,
+		"role":       u.Role,
 		"created_at": u.CreatedAt,
 	}
 }
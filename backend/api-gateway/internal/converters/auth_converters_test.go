@@ -19,11 +19,13 @@ func TestMarshalProtoUserResponse(t *testing.T) {
 			input: &pb.UserResponse{
 				Id:        1,
 				Username:  "testuser",
+				Role:      "teacher",
 				CreatedAt: 1234567890,
 			},
 			expected: gin.H{
 				"id":         int32(1),
 				"username":   "testuser",
+				"role":       "teacher",
 				"created_at": int64(1234567890),
 			},
 		},
@@ -37,6 +39,7 @@ func TestMarshalProtoUserResponse(t *testing.T) {
 			expected: gin.H{
 				"id":         int32(0),
 				"username":   "",
+				"role":       "",
 				"created_at": int64(0),
 			},
 		},
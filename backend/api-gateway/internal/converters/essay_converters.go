@@ -10,11 +10,19 @@ func MarshalProtoEssayResponse(e *pb.EssayResponse) gin.H {
 	if e == nil {
 		return gin.H{}
 	}
+	// null tells the client the review service did not answer
+	var reviewStats gin.H
+	if e.ReviewStats != nil {
+		reviewStats = MarshalReviewStats(e.ReviewStats)
+	}
 	return gin.H{
-		"id":         e.Id,
-		"content":    e.Content,
-		"author":     e.Author,
-		"created_at": e.CreatedAt,
+		"id":            e.Id,
+		"content":       e.Content,
+		"author":        e.Author,
+		"created_at":    e.CreatedAt,
+		"assignment_id": e.AssignmentId,
+		"anonymous":     e.Anonymous,
+		"review_stats":  reviewStats,
 	}
 }
 
@@ -27,10 +35,14 @@ func MarshalProtoEssayWithReviewsResponse(e *pb.EssayWithReviewsResponse) gin.H
 		reviews = append(reviews, MarshalReviewResponse(review))
 	}
 	return gin.H{
-		"id":         e.Id,
-		"content":    e.Content,
-		"author":     e.Author,
-		"created_at": e.CreatedAt,
-		"reviews":    reviews,
+		"id":                  e.Id,
+		"content":             e.Content,
+		"author":              e.Author,
+		"created_at":          e.CreatedAt,
+		"revision":            e.Revision,
+		"reviews":             reviews,
+		"reviews_unavailable": e.ReviewsUnavailable,
+		"assignment_id":       e.AssignmentId,
+		"anonymous":           e.Anonymous,
 	}
 }
@@ -22,12 +22,30 @@ func TestMarshalProtoEssayResponse(t *testing.T) {
 				Content:   "Test essay content",
 				Author:    "testauthor",
 				CreatedAt: 1234567890,
+				ReviewStats: &reviewPb.ReviewStats{
+					ReviewCount:      2,
+					MeanRank:         2.5,
+					WeightedMeanRank: 2.5,
+					MedianRank:       2.5,
+					RankDistribution: map[int32]int32{2: 1, 3: 1},
+					LastReviewAt:     1234567899,
+				},
 			},
 			expected: gin.H{
-				"id":         int32(1),
-				"content":    "Test essay content",
-				"author":     "testauthor",
-				"created_at": int64(1234567890),
+				"id":            int32(1),
+				"content":       "Test essay content",
+				"author":        "testauthor",
+				"created_at":    int64(1234567890),
+				"assignment_id": int64(0),
+				"anonymous":     false,
+				"review_stats": gin.H{
+					"review_count":       int32(2),
+					"mean_rank":          2.5,
+					"weighted_mean_rank": 2.5,
+					"median_rank":        2.5,
+					"rank_distribution":  map[string]int32{"2": 1, "3": 1},
+					"last_review_at":     int64(1234567899),
+				},
 			},
 		},
 		{
@@ -39,10 +57,13 @@ func TestMarshalProtoEssayResponse(t *testing.T) {
 				CreatedAt: 0,
 			},
 			expected: gin.H{
-				"id":         int32(0),
-				"content":    "",
-				"author":     "",
-				"created_at": int64(0),
+				"id":            int32(0),
+				"content":       "",
+				"author":        "",
+				"created_at":    int64(0),
+				"assignment_id": int64(0),
+				"anonymous":     false,
+				"review_stats":  gin.H(nil),
 			},
 		},
 		{
@@ -93,26 +114,44 @@ func TestMarshalProtoEssayWithReviewsResponse(t *testing.T) {
 				},
 			},
 			expected: gin.H{
-				"id":         int32(1),
-				"content":    "Test essay content",
-				"author":     "testauthor",
-				"created_at": int64(1234567890),
+				"id":                  int32(1),
+				"content":             "Test essay content",
+				"author":              "testauthor",
+				"created_at":          int64(1234567890),
+				"assignment_id":       int64(0),
+				"anonymous":           false,
+				"reviews_unavailable": false,
+				"revision":            int32(0),
 				"reviews": []gin.H{
 					{
-						"id":         int32(1),
-						"essay_id":   int32(1),
-						"rank":       int32(5),
-						"content":    "Great essay!",
-						"author":     "reviewer1",
-						"created_at": int64(1234567891),
+						"id":          int32(1),
+						"essay_id":    int32(1),
+						"rank":        int32(5),
+						"content":     "Great essay!",
+						"author":      "reviewer1",
+						"created_at":  int64(1234567891),
+						"rubric_id":   int64(0),
+						"total_score": float64(0),
+						"anonymous":   false,
+						"edited":      false,
+						"edited_at":   int64(0),
+						"scores":      []gin.H{},
+						"comments":    []gin.H{},
 					},
 					{
-						"id":         int32(2),
-						"essay_id":   int32(1),
-						"rank":       int32(4),
-						"content":    "Good essay",
-						"author":     "reviewer2",
-						"created_at": int64(1234567892),
+						"id":          int32(2),
+						"essay_id":    int32(1),
+						"rank":        int32(4),
+						"content":     "Good essay",
+						"author":      "reviewer2",
+						"created_at":  int64(1234567892),
+						"rubric_id":   int64(0),
+						"total_score": float64(0),
+						"anonymous":   false,
+						"edited":      false,
+						"edited_at":   int64(0),
+						"scores":      []gin.H{},
+						"comments":    []gin.H{},
 					},
 				},
 			},
@@ -127,11 +166,15 @@ func TestMarshalProtoEssayWithReviewsResponse(t *testing.T) {
 				Reviews:   []*reviewPb.ReviewResponse{},
 			},
 			expected: gin.H{
-				"id":         int32(1),
-				"content":    "Test essay content",
-				"author":     "testauthor",
-				"created_at": int64(1234567890),
-				"reviews":    []gin.H{},
+				"id":                  int32(1),
+				"content":             "Test essay content",
+				"author":              "testauthor",
+				"created_at":          int64(1234567890),
+				"assignment_id":       int64(0),
+				"anonymous":           false,
+				"reviews_unavailable": false,
+				"revision":            int32(0),
+				"reviews":             []gin.H{},
 			},
 		},
 		{
@@ -144,11 +187,15 @@ func TestMarshalProtoEssayWithReviewsResponse(t *testing.T) {
 				Reviews:   nil,
 			},
 			expected: gin.H{
-				"id":         int32(1),
-				"content":    "Test essay content",
-				"author":     "testauthor",
-				"created_at": int64(1234567890),
-				"reviews":    []gin.H{},
+				"id":                  int32(1),
+				"content":             "Test essay content",
+				"author":              "testauthor",
+				"created_at":          int64(1234567890),
+				"assignment_id":       int64(0),
+				"anonymous":           false,
+				"reviews_unavailable": false,
+				"revision":            int32(0),
+				"reviews":             []gin.H{},
 			},
 		},
 		{
@@ -171,19 +218,30 @@ func TestMarshalProtoEssayWithReviewsResponse(t *testing.T) {
 				},
 			},
 			expected: gin.H{
-				"id":         int32(1),
-				"content":    "Test essay content",
-				"author":     "testauthor",
-				"created_at": int64(1234567890),
+				"id":                  int32(1),
+				"content":             "Test essay content",
+				"author":              "testauthor",
+				"created_at":          int64(1234567890),
+				"assignment_id":       int64(0),
+				"anonymous":           false,
+				"reviews_unavailable": false,
+				"revision":            int32(0),
 				"reviews": []gin.H{
 					gin.H{},
 					{
-						"id":         int32(1),
-						"essay_id":   int32(1),
-						"rank":       int32(5),
-						"content":    "Great essay!",
-						"author":     "reviewer1",
-						"created_at": int64(1234567891),
+						"id":          int32(1),
+						"essay_id":    int32(1),
+						"rank":        int32(5),
+						"content":     "Great essay!",
+						"author":      "reviewer1",
+						"created_at":  int64(1234567891),
+						"rubric_id":   int64(0),
+						"total_score": float64(0),
+						"anonymous":   false,
+						"edited":      false,
+						"edited_at":   int64(0),
+						"scores":      []gin.H{},
+						"comments":    []gin.H{},
 					},
 				},
 			},
@@ -193,6 +251,26 @@ func TestMarshalProtoEssayWithReviewsResponse(t *testing.T) {
 			input:    nil,
 			expected: gin.H{},
 		},
+		{
+			name: "reviews unavailable",
+			input: &pb.EssayWithReviewsResponse{
+				Id:                 1,
+				Content:            "Test essay content",
+				Author:             "testauthor",
+				ReviewsUnavailable: true,
+			},
+			expected: gin.H{
+				"id":                  int32(1),
+				"content":             "Test essay content",
+				"author":              "testauthor",
+				"created_at":          int64(0),
+				"assignment_id":       int64(0),
+				"anonymous":           false,
+				"revision":            int32(0),
+				"reviews":             []gin.H{},
+				"reviews_unavailable": true,
+			},
+		},
 	}
 
 	for _, tt := range tests {
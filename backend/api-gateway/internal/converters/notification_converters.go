@@ -13,8 +13,80 @@ func MarshalNotificationResponse(n *pb.NotificationResponse) gin.H {
 	return gin.H{
 		"notification_id": n.NotificationId,
 		"user_id":         n.UserId,
+		"type":            n.Type,
+		"actor":           n.Actor,
+		"target":          marshalNotificationTarget(n.Target),
+		"link":            n.Link,
 		"content":         n.Content,
 		"is_read":         n.IsRead,
+		"is_archived":     n.IsArchived,
 		"created_at":      n.CreatedAt,
 	}
 }
+
+func marshalNotificationTarget(t *pb.NotificationTarget) gin.H {
+	if t == nil {
+		return gin.H{}
+	}
+	target := gin.H{}
+	if t.EssayId != 0 {
+		target["essay_id"] = t.EssayId
+	}
+	if t.ReviewId != 0 {
+		target["review_id"] = t.ReviewId
+	}
+	return target
+}
+
+func MarshalPreferencesResponse(p *pb.PreferencesResponse) gin.H {
+	if p == nil {
+		return gin.H{}
+	}
+	channels := gin.H{}
+	for _, preference := range p.Preferences {
+		channels[preference.Type] = preference.Channel
+	}
+	return gin.H{
+		"email":       p.Email,
+		"preferences": channels,
+	}
+}
+
+func MarshalWebhookResponse(w *pb.WebhookResponse) gin.H {
+	if w == nil {
+		return gin.H{}
+	}
+	eventTypes := w.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+	response := gin.H{
+		"webhook_id":  w.WebhookId,
+		"url":         w.Url,
+		"event_types": eventTypes,
+		"created_at":  w.CreatedAt,
+	}
+	if w.Secret != "" {
+		response["secret"] = w.Secret
+	}
+	return response
+}
+
+func MarshalWebhookDeliveryResponse(d *pb.WebhookDeliveryResponse) gin.H {
+	if d == nil {
+		return gin.H{}
+	}
+	return gin.H{
+		"delivery_id":      d.DeliveryId,
+		"webhook_id":       d.WebhookId,
+		"notification_id":  d.NotificationId,
+		"event_type":       d.EventType,
+		"status":           d.Status,
+		"attempts":         d.Attempts,
+		"last_status_code": d.LastStatusCode,
+		"last_error":       d.LastError,
+		"next_attempt_at":  d.NextAttemptAt,
+		"delivered_at":     d.DeliveredAt,
+		"created_at":       d.CreatedAt,
+	}
+}
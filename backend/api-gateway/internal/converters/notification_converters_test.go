@@ -22,12 +22,21 @@ func TestMarshalNotificationResponse(t *testing.T) {
 				Content:        "Your essay has been reviewed!",
 				IsRead:         false,
 				CreatedAt:      1234567890,
+				Type:           "new_review",
+				Actor:          "reviewer1",
+				Target:         &pb.NotificationTarget{EssayId: 5, ReviewId: 9},
+				Link:           "/my-essay#review-9",
 			},
 			expected: gin.H{
 				"notification_id": int64(1),
 				"user_id":         int64(123),
+				"type":            "new_review",
+				"actor":           "reviewer1",
+				"target":          gin.H{"essay_id": int64(5), "review_id": int64(9)},
+				"link":            "/my-essay#review-9",
 				"content":         "Your essay has been reviewed!",
 				"is_read":         false,
+				"is_archived":     false,
 				"created_at":      int64(1234567890),
 			},
 		},
@@ -43,8 +52,13 @@ func TestMarshalNotificationResponse(t *testing.T) {
 			expected: gin.H{
 				"notification_id": int64(0),
 				"user_id":         int64(0),
+				"type":            "",
+				"actor":           "",
+				"target":          gin.H{},
+				"link":            "",
 				"content":         "",
 				"is_read":         false,
+				"is_archived":     false,
 				"created_at":      int64(0),
 			},
 		},
@@ -62,3 +76,19 @@ func TestMarshalNotificationResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestMarshalPreferencesResponse(t *testing.T) {
+	result := MarshalPreferencesResponse(&pb.PreferencesResponse{
+		UserId: 1,
+		Email:  "user@example.com",
+		Preferences: []*pb.Preference{
+			{Type: "new_review", Channel: "email_immediate"},
+		},
+	})
+	assert.Equal(t, gin.H{
+		"email":       "user@example.com",
+		"preferences": gin.H{"new_review": "email_immediate"},
+	}, result)
+
+	assert.Equal(t, gin.H{}, MarshalPreferencesResponse(nil))
+}
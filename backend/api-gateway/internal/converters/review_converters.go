@@ -1,6 +1,8 @@
 package converters
 
 import (
+	"strconv"
+
 	"github.com/gin-gonic/gin"
 
 	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
@@ -10,12 +12,226 @@ func MarshalReviewResponse(r *pb.ReviewResponse) gin.H {
 	if r == nil {
 		return gin.H{}
 	}
+	return gin.H{
+		"id":          r.Id,
+		"essay_id":    r.EssayId,
+		"rank":        r.Rank,
+		"content":     r.Content,
+		"author":      r.Author,
+		"created_at":  r.CreatedAt,
+		"rubric_id":   r.RubricId,
+		"total_score": r.TotalScore,
+		"scores":      marshalCriterionScores(r.Scores),
+		"comments":    marshalInlineComments(r.Comments),
+		"anonymous":   r.Anonymous,
+		"edited":      r.Edited,
+		"edited_at":   r.EditedAt,
+	}
+}
+
+func MarshalReviewVersionResponse(v *pb.ReviewVersionResponse) gin.H {
+	if v == nil {
+		return gin.H{}
+	}
+	return gin.H{
+		"id":          v.Id,
+		"review_id":   v.ReviewId,
+		"rank":        v.Rank,
+		"content":     v.Content,
+		"total_score": v.TotalScore,
+		"written_at":  v.WrittenAt,
+		"replaced_at": v.ReplacedAt,
+	}
+}
+
+func MarshalReviewStats(s *pb.ReviewStats) gin.H {
+	if s == nil {
+		return gin.H{}
+	}
+	distribution := make(map[string]int32, len(s.RankDistribution))
+	for rank, count := range s.RankDistribution {
+		distribution[strconv.Itoa(int(rank))] = count
+	}
+	return gin.H{
+		"review_count":       s.ReviewCount,
+		"mean_rank":          s.MeanRank,
+		"weighted_mean_rank": s.WeightedMeanRank,
+		"median_rank":        s.MedianRank,
+		"rank_distribution":  distribution,
+		"last_review_at":     s.LastReviewAt,
+	}
+}
+
+func MarshalEssayStatsResponse(s *pb.EssayStatsResponse) gin.H {
+	if s == nil {
+		return gin.H{}
+	}
+	return gin.H{
+		"essay_id": s.EssayId,
+		"stats":    MarshalReviewStats(s.Stats),
+	}
+}
+
+func MarshalAssignmentStatsResponse(s *pb.AssignmentStatsResponse) gin.H {
+	if s == nil {
+		return gin.H{}
+	}
+	return gin.H{
+		"assignment_id": s.AssignmentId,
+		"essay_count":   s.EssayCount,
+		"stats":         MarshalReviewStats(s.Stats),
+	}
+}
+
+func marshalInlineComments(comments []*pb.InlineComment) []gin.H {
+	result := make([]gin.H, 0, len(comments))
+	for _, c := range comments {
+		result = append(result, gin.H{
+			"id":             c.Id,
+			"start_offset":   c.StartOffset,
+			"end_offset":     c.EndOffset,
+			"quote":          c.Quote,
+			"content":        c.Content,
+			"essay_revision": c.EssayRevision,
+			"orphaned":       c.Orphaned,
+			"created_at":     c.CreatedAt,
+		})
+	}
+	return result
+}
+
+func marshalCriterionScores(scores []*pb.CriterionScore) []gin.H {
+	result := make([]gin.H, 0, len(scores))
+	for _, s := range scores {
+		result = append(result, gin.H{
+			"criterion_id":   s.CriterionId,
+			"criterion_name": s.CriterionName,
+			"score":          s.Score,
+			"min_score":      s.MinScore,
+			"max_score":      s.MaxScore,
+			"weight":         s.Weight,
+			"comment":        s.Comment,
+		})
+	}
+	return result
+}
+
+func MarshalRubricResponse(r *pb.RubricResponse) gin.H {
+	if r == nil {
+		return gin.H{}
+	}
+
+	criteria := make([]gin.H, 0, len(r.Criteria))
+	for _, c := range r.Criteria {
+		criteria = append(criteria, gin.H{
+			"id":          c.Id,
+			"name":        c.Name,
+			"description": c.Description,
+			"weight":      c.Weight,
+			"min_score":   c.MinScore,
+			"max_score":   c.MaxScore,
+		})
+	}
+
 	return gin.H{
 		"id":         r.Id,
-		"essay_id":   r.EssayId,
-		"rank":       r.Rank,
-		"content":    r.Content,
+		"title":      r.Title,
+		"created_by": r.CreatedBy,
+		"criteria":   criteria,
+		"created_at": r.CreatedAt,
+	}
+}
+
+func MarshalReplyResponse(r *pb.ReplyResponse) gin.H {
+	if r == nil {
+		return gin.H{}
+	}
+
+	return gin.H{
+		"id":         r.Id,
+		"review_id":  r.ReviewId,
+		"parent_id":  r.ParentId,
 		"author":     r.Author,
+		"content":    r.Content,
 		"created_at": r.CreatedAt,
+		"anonymous":  r.Anonymous,
+	}
+}
+
+func MarshalAssignmentResponse(a *pb.AssignmentResponse) gin.H {
+	if a == nil {
+		return gin.H{}
+	}
+
+	return gin.H{
+		"id":              a.Id,
+		"title":           a.Title,
+		"created_by":      a.CreatedBy,
+		"anonymous":       a.Anonymous,
+		"grades_released": a.GradesReleased,
+		"rubric_id":       a.RubricId,
+		"created_at":      a.CreatedAt,
+	}
+}
+
+func MarshalGradeResponse(g *pb.GradeResponse) gin.H {
+	if g == nil {
+		return gin.H{}
+	}
+
+	return gin.H{
+		"essay_id":          g.EssayId,
+		"grade":             g.Grade,
+		"feedback":          g.Feedback,
+		"graded_by":         g.GradedBy,
+		"graded_at":         g.GradedAt,
+		"peer_score":        g.PeerScore,
+		"peer_review_count": g.PeerReviewCount,
+		"released":          g.Released,
+	}
+}
+
+func MarshalCalibrationResponse(c *pb.CalibrationResponse) gin.H {
+	if c == nil {
+		return gin.H{}
+	}
+
+	return gin.H{
+		"essay_id":       c.EssayId,
+		"reference_rank": c.ReferenceRank,
+		"created_by":     c.CreatedBy,
+		"created_at":     c.CreatedAt,
+	}
+}
+
+func MarshalReviewerReliabilityResponse(r *pb.ReviewerReliabilityResponse) gin.H {
+	if r == nil {
+		return gin.H{}
+	}
+
+	return gin.H{
+		"reviewer":            r.Reviewer,
+		"score":               r.Score,
+		"consensus_reviews":   r.ConsensusReviews,
+		"calibration_reviews": r.CalibrationReviews,
+		"computed_at":         r.ComputedAt,
+	}
+}
+
+func MarshalDraftResponse(d *pb.DraftResponse) gin.H {
+	if d == nil {
+		return gin.H{}
+	}
+
+	return gin.H{
+		"essay_id":       d.EssayId,
+		"author":         d.Author,
+		"rank":           d.Rank,
+		"content":        d.Content,
+		"rubric_id":      d.RubricId,
+		"scores":         marshalCriterionScores(d.Scores),
+		"essay_revision": d.EssayRevision,
+		"comments":       marshalInlineComments(d.Comments),
+		"updated_at":     d.UpdatedAt,
 	}
 }
@@ -25,12 +25,60 @@ func TestMarshalReviewResponse(t *testing.T) {
 				CreatedAt: 1234567890,
 			},
 			expected: gin.H{
-				"id":         int32(1),
-				"essay_id":   int32(2),
-				"rank":       int32(5),
-				"content":    "Excellent essay!",
-				"author":     "reviewer1",
-				"created_at": int64(1234567890),
+				"id":          int32(1),
+				"essay_id":    int32(2),
+				"rank":        int32(5),
+				"content":     "Excellent essay!",
+				"author":      "reviewer1",
+				"created_at":  int64(1234567890),
+				"rubric_id":   int64(0),
+				"total_score": float64(0),
+				"anonymous":   false,
+				"edited":      false,
+				"edited_at":   int64(0),
+				"scores":      []gin.H{},
+				"comments":    []gin.H{},
+			},
+		},
+		{
+			name: "success - converts rubric scored review",
+			input: &pb.ReviewResponse{
+				Id:         3,
+				EssayId:    2,
+				Rank:       2,
+				Content:    "Scored",
+				Author:     "reviewer1",
+				CreatedAt:  1234567890,
+				RubricId:   7,
+				TotalScore: 62.5,
+				Scores: []*pb.CriterionScore{
+					{CriterionId: 1, CriterionName: "Thesis", Score: 4, MinScore: 0, MaxScore: 4, Weight: 1, Comment: "clear"},
+				},
+			},
+			expected: gin.H{
+				"id":          int32(3),
+				"essay_id":    int32(2),
+				"rank":        int32(2),
+				"content":     "Scored",
+				"author":      "reviewer1",
+				"created_at":  int64(1234567890),
+				"rubric_id":   int64(7),
+				"total_score": 62.5,
+				"anonymous":   false,
+				"edited":      false,
+				"edited_at":   int64(0),
+				"scores": []gin.H{
+					{
+						"criterion_id":   int64(1),
+						"criterion_name": "Thesis",
+						"score":          int32(4),
+						"min_score":      int32(0),
+						"max_score":      int32(4),
+						"weight":         1.0,
+						"comment":        "clear",
+					},
+				},
+				"comments": []gin.H{},
 			},
 		},
 		{
@@ -44,12 +92,58 @@ func TestMarshalReviewResponse(t *testing.T) {
 				CreatedAt: 0,
 			},
 			expected: gin.H{
-				"id":         int32(0),
-				"essay_id":   int32(0),
-				"rank":       int32(0),
-				"content":    "",
-				"author":     "",
-				"created_at": int64(0),
+				"id":          int32(0),
+				"essay_id":    int32(0),
+				"rank":        int32(0),
+				"content":     "",
+				"author":      "",
+				"created_at":  int64(0),
+				"rubric_id":   int64(0),
+				"total_score": float64(0),
+				"anonymous":   false,
+				"edited":      false,
+				"edited_at":   int64(0),
+				"scores":      []gin.H{},
+				"comments":    []gin.H{},
+			},
+		},
+		{
+			name: "success - converts review with inline comments",
+			input: &pb.ReviewResponse{
+				Id:      4,
+				EssayId: 2,
+				Rank:    1,
+				Content: "See comments",
+				Author:  "reviewer1",
+				Comments: []*pb.InlineComment{
+					{Id: 9, StartOffset: 4, EndOffset: 10, Quote: "thesis", Content: "Unclear", EssayRevision: 2, CreatedAt: 1234567890},
+				},
+			},
+			expected: gin.H{
+				"id":          int32(4),
+				"essay_id":    int32(2),
+				"rank":        int32(1),
+				"content":     "See comments",
+				"author":      "reviewer1",
+				"created_at":  int64(0),
+				"rubric_id":   int64(0),
+				"total_score": float64(0),
+				"anonymous":   false,
+				"edited":      false,
+				"edited_at":   int64(0),
+				"scores":      []gin.H{},
+				"comments": []gin.H{
+					{
+						"id":             int64(9),
+						"start_offset":   int32(4),
+						"end_offset":     int32(10),
+						"quote":          "thesis",
+						"content":        "Unclear",
+						"essay_revision": int32(2),
+						"orphaned":       false,
+						"created_at":     int64(1234567890),
+					},
+				},
 			},
 		},
 		{
@@ -66,3 +160,176 @@ func TestMarshalReviewResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestMarshalRubricResponse(t *testing.T) {
+	result := MarshalRubricResponse(&pb.RubricResponse{
+		Id:        7,
+		Title:     "Essay rubric",
+		CreatedBy: "teacher",
+		Criteria: []*pb.Criterion{
+			{Id: 1, Name: "Thesis", Description: "Clear claim", Weight: 2, MinScore: 0, MaxScore: 4},
+		},
+		CreatedAt: 1234567890,
+	})
+
+	assert.Equal(t, gin.H{
+		"id":         int64(7),
+		"title":      "Essay rubric",
+		"created_by": "teacher",
+		"criteria": []gin.H{
+			{
+				"id":          int64(1),
+				"name":        "Thesis",
+				"description": "Clear claim",
+				"weight":      2.0,
+				"min_score":   int32(0),
+				"max_score":   int32(4),
+			},
+		},
+		"created_at": int64(1234567890),
+	}, result)
+	assert.Equal(t, gin.H{}, MarshalRubricResponse(nil))
+}
+
+func TestMarshalReplyResponse(t *testing.T) {
+	result := MarshalReplyResponse(&pb.ReplyResponse{
+		Id:        8,
+		ReviewId:  3,
+		ParentId:  7,
+		Author:    "reviewer",
+		Content:   "You're welcome",
+		CreatedAt: 1234567890,
+	})
+
+	assert.Equal(t, gin.H{
+		"id":         int64(8),
+		"review_id":  int32(3),
+		"parent_id":  int64(7),
+		"author":     "reviewer",
+		"content":    "You're welcome",
+		"created_at": int64(1234567890),
+		"anonymous":  false,
+	}, result)
+	assert.Equal(t, gin.H{}, MarshalReplyResponse(nil))
+}
+
+func TestMarshalReviewVersionResponse(t *testing.T) {
+	result := MarshalReviewVersionResponse(&pb.ReviewVersionResponse{
+		Id:         2,
+		ReviewId:   3,
+		Rank:       1,
+		Content:    "First take",
+		WrittenAt:  1234567890,
+		ReplacedAt: 1234567990,
+	})
+
+	assert.Equal(t, gin.H{
+		"id":          int64(2),
+		"review_id":   int32(3),
+		"rank":        int32(1),
+		"content":     "First take",
+		"total_score": float64(0),
+		"written_at":  int64(1234567890),
+		"replaced_at": int64(1234567990),
+	}, result)
+	assert.Equal(t, gin.H{}, MarshalReviewVersionResponse(nil))
+}
+
+func TestMarshalAssignmentStatsResponse(t *testing.T) {
+	result := MarshalAssignmentStatsResponse(&pb.AssignmentStatsResponse{
+		AssignmentId: 4,
+		EssayCount:   2,
+		Stats: &pb.ReviewStats{
+			ReviewCount:      3,
+			MeanRank:         2,
+			WeightedMeanRank: 2.25,
+			MedianRank:       2,
+			RankDistribution: map[int32]int32{1: 1, 2: 1, 3: 1},
+			LastReviewAt:     1234567890,
+		},
+	})
+
+	assert.Equal(t, gin.H{
+		"assignment_id": int64(4),
+		"essay_count":   int32(2),
+		"stats": gin.H{
+			"review_count":       int32(3),
+			"mean_rank":          float64(2),
+			"weighted_mean_rank": 2.25,
+			"median_rank":        float64(2),
+			"rank_distribution":  map[string]int32{"1": 1, "2": 1, "3": 1},
+			"last_review_at":     int64(1234567890),
+		},
+	}, result)
+	assert.Equal(t, gin.H{}, MarshalAssignmentStatsResponse(nil))
+	assert.Equal(t, gin.H{}, MarshalEssayStatsResponse(nil))
+}
+
+func TestMarshalGradeResponse(t *testing.T) {
+	result := MarshalGradeResponse(&pb.GradeResponse{
+		EssayId:         1,
+		Grade:           85,
+		Feedback:        "Well argued",
+		GradedBy:        "teacher",
+		GradedAt:        1234567890,
+		PeerScore:       72.5,
+		PeerReviewCount: 2,
+		Released:        true,
+	})
+
+	assert.Equal(t, gin.H{
+		"essay_id":          int32(1),
+		"grade":             float64(85),
+		"feedback":          "Well argued",
+		"graded_by":         "teacher",
+		"graded_at":         int64(1234567890),
+		"peer_score":        72.5,
+		"peer_review_count": int32(2),
+		"released":          true,
+	}, result)
+	assert.Equal(t, gin.H{}, MarshalGradeResponse(nil))
+}
+
+func TestMarshalReviewerReliabilityResponse(t *testing.T) {
+	result := MarshalReviewerReliabilityResponse(&pb.ReviewerReliabilityResponse{
+		Reviewer:           "reviewer1",
+		Score:              0.75,
+		ConsensusReviews:   4,
+		CalibrationReviews: 1,
+		ComputedAt:         1234567890,
+	})
+
+	assert.Equal(t, gin.H{
+		"reviewer":            "reviewer1",
+		"score":               0.75,
+		"consensus_reviews":   int32(4),
+		"calibration_reviews": int32(1),
+		"computed_at":         int64(1234567890),
+	}, result)
+	assert.Equal(t, gin.H{}, MarshalReviewerReliabilityResponse(nil))
+	assert.Equal(t, gin.H{}, MarshalCalibrationResponse(nil))
+}
+
+func TestMarshalDraftResponse(t *testing.T) {
+	result := MarshalDraftResponse(&pb.DraftResponse{
+		EssayId:       1,
+		Author:        "reviewer1",
+		Rank:          2,
+		Content:       "Draft",
+		EssayRevision: 3,
+		UpdatedAt:     1234567890,
+	})
+
+	assert.Equal(t, gin.H{
+		"essay_id":       int32(1),
+		"author":         "reviewer1",
+		"rank":           int32(2),
+		"content":        "Draft",
+		"rubric_id":      int64(0),
+		"scores":         []gin.H{},
+		"essay_revision": int32(3),
+		"comments":       []gin.H{},
+		"updated_at":     int64(1234567890),
+	}, result)
+	assert.Equal(t, gin.H{}, MarshalDraftResponse(nil))
+}
@@ -0,0 +1,152 @@
+package gradebook
+
+import (
+	"encoding/csv"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+
+	sheetName = "Gradebook"
+)
+
+var header = []string{
+	"essay_id",
+	"author",
+	"submitted_at",
+	"peer_reviews",
+	"peer_score",
+	"grade",
+	"feedback",
+	"graded_by",
+	"graded_at",
+}
+
+func ContentType(format string) string {
+	if format == FormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+func WriteCSV(w io.Writer, entries []*pb.GradebookEntry) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := writer.Write(row(entry)); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func WriteXLSX(w io.Writer, entries []*pb.GradebookEntry) error {
+	file := excelize.NewFile()
+	defer file.Close()
+
+	if err := file.SetSheetName(file.GetSheetName(0), sheetName); err != nil {
+		return err
+	}
+	if err := file.SetSheetRow(sheetName, "A1", &header); err != nil {
+		return err
+	}
+	for i, entry := range entries {
+		cell, err := excelize.CoordinatesToCellName(1, i+2)
+		if err != nil {
+			return err
+		}
+		values := xlsxRow(entry)
+		if err := file.SetSheetRow(sheetName, cell, &values); err != nil {
+			return err
+		}
+	}
+
+	_, err := file.WriteTo(w)
+	return err
+}
+
+// Text cells of one essay, grade columns stay empty for ungraded essays
+func row(e *pb.GradebookEntry) []string {
+	result := []string{
+		strconv.Itoa(int(e.EssayId)),
+		escapeFormula(e.EssayAuthor),
+		formatTime(e.SubmittedAt),
+		strconv.Itoa(int(e.PeerReviewCount)),
+		"",
+		"",
+		"",
+		"",
+		"",
+	}
+	if e.PeerReviewCount > 0 {
+		result[4] = formatScore(e.PeerScore)
+	}
+	if e.Graded {
+		result[5] = formatScore(e.Grade)
+		result[6] = escapeFormula(e.Feedback)
+		result[7] = escapeFormula(e.GradedBy)
+		result[8] = formatTime(e.GradedAt)
+	}
+	return result
+}
+
+// Numbers stay numeric in the spreadsheet, text is written as plain strings
+func xlsxRow(e *pb.GradebookEntry) []interface{} {
+	result := []interface{}{
+		e.EssayId,
+		e.EssayAuthor,
+		formatTime(e.SubmittedAt),
+		e.PeerReviewCount,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	}
+	if e.PeerReviewCount > 0 {
+		result[4] = roundScore(e.PeerScore)
+	}
+	if e.Graded {
+		result[5] = roundScore(e.Grade)
+		result[6] = e.Feedback
+		result[7] = e.GradedBy
+		result[8] = formatTime(e.GradedAt)
+	}
+	return result
+}
+
+func formatTime(unix int64) string {
+	if unix == 0 {
+		return ""
+	}
+	return time.Unix(unix, 0).UTC().Format(time.RFC3339)
+}
+
+func roundScore(score float64) float64 {
+	return math.Round(score*10) / 10
+}
+
+func formatScore(score float64) string {
+	return strconv.FormatFloat(score, 'f', 1, 64)
+}
+
+// Spreadsheet apps run cells starting with these characters as formulas
+func escapeFormula(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
@@ -0,0 +1,71 @@
+package gradebook
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+)
+
+var testEntries = []*pb.GradebookEntry{
+	{
+		EssayId:         1,
+		EssayAuthor:     "alice",
+		SubmittedAt:     1740830400,
+		PeerReviewCount: 2,
+		PeerScore:       72.345,
+		Graded:          true,
+		Grade:           85,
+		Feedback:        "=HYPERLINK(\"http://example.com\")",
+		GradedBy:        "teacher",
+		GradedAt:        1740916800,
+	},
+	{
+		EssayId:     2,
+		EssayAuthor: "bob",
+		SubmittedAt: 1740834000,
+	},
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteCSV(&buf, testEntries))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+
+	assert.Equal(t, header, records[0])
+	assert.Equal(t, []string{
+		"1", "alice", "2025-03-01T12:00:00Z", "2", "72.3", "85.0",
+		"'=HYPERLINK(\"http://example.com\")", "teacher", "2025-03-02T12:00:00Z",
+	}, records[1])
+	assert.Equal(t, []string{"2", "bob", "2025-03-01T13:00:00Z", "0", "", "", "", "", ""}, records[2])
+}
+
+func TestWriteXLSX(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteXLSX(&buf, testEntries))
+
+	file, err := excelize.OpenReader(&buf)
+	require.NoError(t, err)
+	defer file.Close()
+
+	rows, err := file.GetRows(sheetName)
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+
+	assert.Equal(t, header, rows[0])
+	assert.Equal(t, []string{"1", "alice", "2025-03-01T12:00:00Z", "2", "72.3", "85"}, rows[1][:6])
+	assert.Equal(t, "=HYPERLINK(\"http://example.com\")", rows[1][6])
+	assert.Equal(t, []string{"2", "bob", "2025-03-01T13:00:00Z", "0"}, rows[2])
+
+	formula, err := file.GetCellFormula(sheetName, "G2")
+	require.NoError(t, err)
+	assert.Empty(t, formula)
+}
@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/apierror"
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/converters"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+)
+
+// POST /api/assignments
+func (h *ReviewHandler) CreateAssignment(c *gin.Context) {
+	logger := requestLogger(c, h.logger).With(zap.String("operation", "create_assignment"))
+
+	var request struct {
+		Title     string `json:"title" binding:"required"`
+		Anonymous bool   `json:"anonymous"`
+		RubricId  int64  `json:"rubric_id"`
+	}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Warn("Invalid create assignment request",
+			zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	username, exists := c.Get("username")
+	if !exists {
+		logger.Warn("Authentication required for assignment creation")
+		apierror.Write(c, http.StatusUnauthorized, apierror.CodeUnauthenticated, "authentication required")
+		return
+	}
+
+	logger = logger.With(zap.String("username", username.(string)))
+	resp, err := h.reviewClient.CreateAssignment(
+		c.Request.Context(),
+		&pb.CreateAssignmentRequest{
+			Title:     request.Title,
+			CreatedBy: username.(string),
+			Anonymous: request.Anonymous,
+			RubricId:  request.RubricId,
+		},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to create assignment", err)
+		return
+	}
+
+	logger.Info("Assignment created successfully",
+		zap.Int64("assignment_id", resp.Id))
+	c.JSON(http.StatusCreated, converters.MarshalAssignmentResponse(resp))
+}
+
+// GET /api/assignments
+func (h *ReviewHandler) GetAllAssignments(c *gin.Context) {
+	logger := requestLogger(c, h.logger).With(zap.String("operation", "get_all_assignments"))
+
+	resp, err := h.reviewClient.GetAllAssignments(c.Request.Context(), &pb.EmptyRequest{})
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to get assignments", err)
+		return
+	}
+
+	assignments := make([]gin.H, 0, len(resp))
+	for _, assignment := range resp {
+		assignments = append(assignments, converters.MarshalAssignmentResponse(assignment))
+	}
+
+	logger.Debug("Retrieved assignments",
+		zap.Int("count", len(assignments)))
+	c.JSON(http.StatusOK, assignments)
+}
+
+// GET /api/assignments/:assignmentId
+func (h *ReviewHandler) GetAssignment(c *gin.Context) {
+	assignmentId, ok := h.parseAssignmentId(c)
+	if !ok {
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "get_assignment"),
+		zap.Int64("assignment_id", assignmentId),
+	)
+
+	resp, err := h.reviewClient.GetAssignment(c.Request.Context(), &pb.GetAssignmentRequest{Id: assignmentId})
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to get assignment", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, converters.MarshalAssignmentResponse(resp))
+}
+
+// PUT /api/assignments/:assignmentId
+func (h *ReviewHandler) UpdateAssignment(c *gin.Context) {
+	assignmentId, ok := h.parseAssignmentId(c)
+	if !ok {
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "update_assignment"),
+		zap.Int64("assignment_id", assignmentId),
+	)
+
+	var request struct {
+		Title     string `json:"title" binding:"required"`
+		Anonymous bool   `json:"anonymous"`
+		RubricId  int64  `json:"rubric_id"`
+	}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Warn("Invalid update assignment request",
+			zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	username, exists := c.Get("username")
+	if !exists {
+		logger.Warn("Authentication required for assignment update")
+		apierror.Write(c, http.StatusUnauthorized, apierror.CodeUnauthenticated, "authentication required")
+		return
+	}
+
+	logger = logger.With(zap.String("username", username.(string)))
+	resp, err := h.reviewClient.UpdateAssignment(
+		c.Request.Context(),
+		&pb.UpdateAssignmentRequest{
+			Id:          assignmentId,
+			Title:       request.Title,
+			Anonymous:   request.Anonymous,
+			RequestedBy: username.(string),
+			RubricId:    request.RubricId,
+		},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to update assignment", err)
+		return
+	}
+
+	logger.Info("Assignment updated successfully")
+	c.JSON(http.StatusOK, converters.MarshalAssignmentResponse(resp))
+}
+
+func (h *ReviewHandler) parseAssignmentId(c *gin.Context) (int64, bool) {
+	assignmentIdStr := c.Param("assignmentId")
+	assignmentId, err := strconv.ParseInt(assignmentIdStr, 10, 64)
+	if err != nil {
+		requestLogger(c, h.logger).Warn("Invalid assignment ID",
+			zap.String("assignment_id", assignmentIdStr),
+			zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid assignment ID")
+		return 0, false
+	}
+	return assignmentId, true
+}
+
+// GET /api/assignments/:assignmentId/stats
+func (h *ReviewHandler) GetAssignmentStats(c *gin.Context) {
+	assignmentId, ok := h.parseAssignmentId(c)
+	if !ok {
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "get_assignment_stats"),
+		zap.Int64("assignment_id", assignmentId),
+	)
+
+	resp, err := h.reviewClient.GetAssignmentStats(
+		c.Request.Context(),
+		&pb.GetAssignmentStatsRequest{AssignmentId: assignmentId},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to get assignment stats", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, converters.MarshalAssignmentStatsResponse(resp))
+}
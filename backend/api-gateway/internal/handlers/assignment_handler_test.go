@@ -0,0 +1,322 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/clients/mocks"
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/handlers"
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/middleware"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/jwt"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+)
+
+func TestReviewHandler_CreateAssignment(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		role           string
+		setupMock      func(*mocks.MockReviewClient)
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:        "teacher creates double-blind assignment",
+			requestBody: `{"title": "Argumentative essay", "anonymous": true, "rubric_id": 7}`,
+			role:        jwt.RoleTeacher,
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("CreateAssignment", mock.Anything, &pb.CreateAssignmentRequest{
+					Title:     "Argumentative essay",
+					CreatedBy: "teacher",
+					Anonymous: true,
+					RubricId:  7,
+				}).Return(&pb.AssignmentResponse{Id: 4, Title: "Argumentative essay", CreatedBy: "teacher", Anonymous: true, RubricId: 7}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: map[string]interface{}{
+				"id":        float64(4),
+				"title":     "Argumentative essay",
+				"anonymous": true,
+				"rubric_id": float64(7),
+			},
+		},
+		{
+			name:           "student is forbidden",
+			requestBody:    `{"title": "Argumentative essay", "anonymous": true}`,
+			role:           jwt.RoleStudent,
+			setupMock:      func(mockClient *mocks.MockReviewClient) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "missing title",
+			requestBody:    `{"anonymous": true}`,
+			role:           jwt.RoleTeacher,
+			setupMock:      func(mockClient *mocks.MockReviewClient) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "rejected by review service",
+			requestBody: `{"title": "   "}`,
+			role:        jwt.RoleTeacher,
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("CreateAssignment", mock.Anything, mock.Anything).
+					Return(nil, status.Error(codes.InvalidArgument, "title is required"))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"error": "title is required",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReviewClient := new(mocks.MockReviewClient)
+			tt.setupMock(mockReviewClient)
+
+			handler := handlers.NewReviewHandler(mockReviewClient, logging.NewEmptyLogger())
+
+			router := gin.New()
+			router.POST("/assignments", func(c *gin.Context) {
+				c.Set("username", "teacher")
+				c.Set("role", tt.role)
+			}, middleware.RequireRole(jwt.RoleTeacher), handler.CreateAssignment)
+
+			req, err := http.NewRequest(http.MethodPost, "/assignments", bytes.NewBufferString(tt.requestBody))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedBody != nil {
+				var response map[string]interface{}
+				err = json.Unmarshal(w.Body.Bytes(), &response)
+				require.NoError(t, err)
+
+				for key, expectedValue := range tt.expectedBody {
+					assert.Equal(t, expectedValue, response[key])
+				}
+			}
+
+			mockReviewClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReviewHandler_UpdateAssignment(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		assignmentId   string
+		setupMock      func(*mocks.MockReviewClient)
+		expectedStatus int
+	}{
+		{
+			name:         "owner updates assignment",
+			assignmentId: "4",
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("UpdateAssignment", mock.Anything, &pb.UpdateAssignmentRequest{
+					Id:          4,
+					Title:       "Renamed",
+					Anonymous:   true,
+					RequestedBy: "teacher",
+				}).Return(&pb.AssignmentResponse{Id: 4, Title: "Renamed", Anonymous: true}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid assignment ID",
+			assignmentId:   "abc",
+			setupMock:      func(mockClient *mocks.MockReviewClient) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "assignment not found",
+			assignmentId: "99",
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("UpdateAssignment", mock.Anything, mock.Anything).
+					Return(nil, status.Error(codes.NotFound, "assignment not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:         "another teacher's assignment",
+			assignmentId: "4",
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("UpdateAssignment", mock.Anything, mock.Anything).
+					Return(nil, status.Error(codes.PermissionDenied, "only the assignment creator can change it"))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReviewClient := new(mocks.MockReviewClient)
+			tt.setupMock(mockReviewClient)
+
+			handler := handlers.NewReviewHandler(mockReviewClient, logging.NewEmptyLogger())
+
+			router := gin.New()
+			router.PUT("/assignments/:assignmentId", func(c *gin.Context) {
+				c.Set("username", "teacher")
+				c.Set("role", jwt.RoleTeacher)
+			}, handler.UpdateAssignment)
+
+			req, err := http.NewRequest(http.MethodPut, "/assignments/"+tt.assignmentId,
+				bytes.NewBufferString(`{"title": "Renamed", "anonymous": true}`))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			mockReviewClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReviewHandler_GetAssignment(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockReviewClient := new(mocks.MockReviewClient)
+	mockReviewClient.On("GetAssignment", mock.Anything, &pb.GetAssignmentRequest{Id: 4}).
+		Return(&pb.AssignmentResponse{Id: 4, Title: "Argumentative essay"}, nil)
+	mockReviewClient.On("GetAssignment", mock.Anything, &pb.GetAssignmentRequest{Id: 5}).
+		Return(nil, status.Error(codes.NotFound, "assignment not found"))
+
+	handler := handlers.NewReviewHandler(mockReviewClient, logging.NewEmptyLogger())
+	router := gin.New()
+	router.GET("/assignments/:assignmentId", handler.GetAssignment)
+
+	for path, expectedStatus := range map[string]int{
+		"/assignments/4":   http.StatusOK,
+		"/assignments/5":   http.StatusNotFound,
+		"/assignments/abc": http.StatusBadRequest,
+	} {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, expectedStatus, w.Code, path)
+	}
+
+	mockReviewClient.AssertExpectations(t)
+}
+
+func TestReviewHandler_GetAssignmentStats(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockReviewClient := new(mocks.MockReviewClient)
+	mockReviewClient.On("GetAssignmentStats", mock.Anything, &pb.GetAssignmentStatsRequest{AssignmentId: 4}).
+		Return(&pb.AssignmentStatsResponse{AssignmentId: 4, EssayCount: 3, Stats: &pb.ReviewStats{ReviewCount: 5}}, nil)
+	mockReviewClient.On("GetAssignmentStats", mock.Anything, &pb.GetAssignmentStatsRequest{AssignmentId: 5}).
+		Return(nil, status.Error(codes.NotFound, "assignment not found"))
+
+	handler := handlers.NewReviewHandler(mockReviewClient, logging.NewEmptyLogger())
+	router := gin.New()
+	router.GET("/assignments/:assignmentId/stats", handler.GetAssignmentStats)
+
+	for path, expectedStatus := range map[string]int{
+		"/assignments/4/stats":   http.StatusOK,
+		"/assignments/5/stats":   http.StatusNotFound,
+		"/assignments/abc/stats": http.StatusBadRequest,
+	} {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, expectedStatus, w.Code, path)
+	}
+
+	mockReviewClient.AssertExpectations(t)
+}
+
+func TestReviewHandler_GetByEssayId_DoubleBlind(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		username       string
+		role           string
+		expectedAuthor string
+	}{
+		{
+			name:           "guest sees alias",
+			expectedAuthor: "Reviewer A",
+		},
+		{
+			name:           "essay author sees alias",
+			username:       "author1",
+			role:           jwt.RoleStudent,
+			expectedAuthor: "Reviewer A",
+		},
+		{
+			name:           "reviewer sees own name",
+			username:       "reviewer1",
+			role:           jwt.RoleStudent,
+			expectedAuthor: "reviewer1",
+		},
+		{
+			name:           "teacher sees real name",
+			username:       "teacher",
+			role:           jwt.RoleTeacher,
+			expectedAuthor: "reviewer1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReviewClient := new(mocks.MockReviewClient)
+			mockReviewClient.On("GetByEssayId", mock.Anything, &pb.GetByEssayIdRequest{EssayId: 1}).
+				Return([]*pb.ReviewResponse{
+					{Id: 1, EssayId: 1, Author: "reviewer1", Anonymous: true, AuthorAlias: "Reviewer A"},
+				}, nil)
+
+			handler := handlers.NewReviewHandler(mockReviewClient, logging.NewEmptyLogger())
+
+			router := gin.New()
+			router.GET("/reviews/:essayId", func(c *gin.Context) {
+				if tt.username != "" {
+					c.Set("username", tt.username)
+					c.Set("role", tt.role)
+				}
+			}, handler.GetByEssayId)
+
+			req, err := http.NewRequest(http.MethodGet, "/reviews/1", nil)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var response []map[string]interface{}
+			err = json.Unmarshal(w.Body.Bytes(), &response)
+			require.NoError(t, err)
+			require.Len(t, response, 1)
+			assert.Equal(t, tt.expectedAuthor, response[0]["author"])
+			assert.Equal(t, true, response[0]["anonymous"])
+		})
+	}
+}
@@ -2,8 +2,8 @@ package handlers
 
 import (
 	"net/http"
-	"os"
 
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/apierror"
 	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/clients"
 	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/converters"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
@@ -14,20 +14,22 @@ import (
 )
 
 type AuthHandler struct {
-	authClient clients.AuthClient
-	logger     *logging.Logger
+	authClient   clients.AuthClient
+	secureCookie bool
+	logger       *logging.Logger
 }
 
-func NewAuthHandler(authClient clients.AuthClient, logger *logging.Logger) *AuthHandler {
+func NewAuthHandler(authClient clients.AuthClient, secureCookie bool, logger *logging.Logger) *AuthHandler {
 	return &AuthHandler{
-		authClient: authClient,
-		logger:     logger,
+		authClient:   authClient,
+		secureCookie: secureCookie,
+		logger:       logger,
 	}
 }
 
 // POST /api/auth/register
 func (h *AuthHandler) Register(c *gin.Context) {
-	logger := h.logger.With(zap.String("operation", "register"))
+	logger := requestLogger(c, h.logger).With(zap.String("operation", "register"))
 
 	var request struct {
 		Username string `json:"username" binding:"required"`
@@ -38,7 +40,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 		logger.Warn("Invalid registration request",
 			zap.Error(err),
 			zap.String("username", request.Username))
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, err.Error())
 		return
 	}
 
@@ -52,10 +54,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 		},
 	)
 	if err != nil {
-		logger.Error("User registration failed",
-			zap.String("username", request.Username),
-			zap.Error(err))
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		writeGrpcError(c, logger.With(zap.String("username", request.Username)), "User registration failed", err)
 		return
 	}
 
@@ -67,7 +66,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 
 // POST /api/auth/login
 func (h *AuthHandler) Login(c *gin.Context) {
-	logger := h.logger.With(zap.String("operation", "login"))
+	logger := requestLogger(c, h.logger).With(zap.String("operation", "login"))
 
 	var request struct {
 		Username string `json:"username"`
@@ -77,7 +76,7 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		logger.Warn("Invalid login request",
 			zap.Error(err),
 			zap.String("username", request.Username))
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, err.Error())
 		return
 	}
 
@@ -91,26 +90,23 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		},
 	)
 	if err != nil {
-		logger.Warn("Login failed - invalid credentials",
-			zap.String("username", request.Username),
-			zap.Error(err))
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid credentials"})
+		writeGrpcError(c, logger.With(zap.String("username", request.Username)), "Login failed", err)
 		return
 	}
 
-	setRefreshCookie(c, resp.RefreshToken)
+	h.setRefreshCookie(c, resp.RefreshToken)
 	logger.Info("Login successful", zap.String("username", request.Username))
 	c.JSON(http.StatusOK, gin.H{"access_token": resp.AccessToken})
 }
 
 // POST /api/auth/refresh
 func (h *AuthHandler) RefreshToken(c *gin.Context) {
-	logger := h.logger.With(zap.String("operation", "refresh_token"))
+	logger := requestLogger(c, h.logger).With(zap.String("operation", "refresh_token"))
 
 	refreshToken, err := c.Cookie("refresh_token")
 	if err != nil {
 		logger.Warn("Refresh token missing from cookie")
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "refresh token required"})
+		apierror.Write(c, http.StatusUnauthorized, apierror.CodeUnauthenticated, "refresh token required")
 		return
 	}
 
@@ -121,14 +117,12 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 		&pb.RefreshTokenRequest{RefreshToken: refreshToken},
 	)
 	if err != nil {
-		logger.Warn("Refresh token failed",
-			zap.Error(err))
-		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		writeGrpcError(c, logger, "Refresh token failed", err)
 		return
 	}
 
 	// Set new refresh token cookie
-	setRefreshCookie(c, resp.RefreshToken)
+	h.setRefreshCookie(c, resp.RefreshToken)
 	logger.Info("Refresh token successful")
 	c.JSON(http.StatusOK, gin.H{"access_token": resp.AccessToken})
 }
@@ -136,7 +130,7 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 // GET /api/user/:username
 func (h *AuthHandler) GetUser(c *gin.Context) {
 	username := c.Param("username")
-	logger := h.logger.With(
+	logger := requestLogger(c, h.logger).With(
 		zap.String("operation", "get_user"),
 		zap.String("username", username),
 	)
@@ -148,8 +142,7 @@ func (h *AuthHandler) GetUser(c *gin.Context) {
 		&pb.GetByUsernameRequest{Username: username},
 	)
 	if err != nil {
-		logger.Warn("User not found", zap.Error(err))
-		c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+		writeGrpcError(c, logger, "Failed to get user", err)
 		return
 	}
 
@@ -158,15 +151,14 @@ func (h *AuthHandler) GetUser(c *gin.Context) {
 }
 
 // Your existing cookie function
-func setRefreshCookie(c *gin.Context, refreshToken string) {
-	isSecure := (os.Getenv("JWT_COOKIE_IS_SECURE") == "true")
+func (h *AuthHandler) setRefreshCookie(c *gin.Context, refreshToken string) {
 	c.SetCookie(
 		"refresh_token",
 		refreshToken,
 		7*24*60*60,          // MaxAge in seconds (7 days)
 		"/api/auth/refresh", // Path
 		"",                  // Current domain
-		isSecure,
+		h.secureCookie,
 		true, // HTTP only, JS can't read token
 	)
 }
@@ -9,11 +9,13 @@ import (
 
 	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/clients/mocks"
 	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/handlers"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/grpcerr"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
 
 	pb "github.com/IAGrig/vt-csa-essays/backend/proto/user"
 )
@@ -73,6 +75,22 @@ func TestAuthHandler_Register(t *testing.T) {
 				"error": "Key: 'Username' Error:Field validation for 'Username' failed on the 'required' tag",
 			},
 		},
+		{
+			name: "username taken",
+			requestBody: map[string]string{
+				"username": "testuser",
+				"password": "password123",
+			},
+			setupMock: func(mockClient *mocks.MockAuthClient) {
+				mockClient.On("Register", mock.Anything, mock.Anything).
+					Return(nil, grpcerr.New(codes.AlreadyExists, "USER_ALREADY_EXISTS", "user already exists"))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody: map[string]interface{}{
+				"error": "user already exists",
+				"code":  "USER_ALREADY_EXISTS",
+			},
+		},
 		{
 			name: "auth service error",
 			requestBody: map[string]string{
@@ -83,9 +101,10 @@ func TestAuthHandler_Register(t *testing.T) {
 				mockClient.On("Register", mock.Anything, mock.Anything).
 					Return(nil, assert.AnError)
 			},
-			expectedStatus: http.StatusBadRequest,
+			expectedStatus: http.StatusInternalServerError,
 			expectedBody: map[string]interface{}{
-				"error": assert.AnError.Error(),
+				"error": "internal error",
+				"code":  "INTERNAL",
 			},
 		},
 	}
@@ -96,7 +115,7 @@ func TestAuthHandler_Register(t *testing.T) {
 			tt.setupMock(mockAuthClient)
 
 			logger := logging.NewEmptyLogger()
-			handler := handlers.NewAuthHandler(mockAuthClient, logger)
+			handler := handlers.NewAuthHandler(mockAuthClient, false, logger)
 
 			router := gin.New()
 			router.POST("/register", handler.Register)
@@ -170,11 +189,12 @@ func TestAuthHandler_Login(t *testing.T) {
 			},
 			setupMock: func(mockClient *mocks.MockAuthClient) {
 				mockClient.On("Login", mock.Anything, mock.Anything).
-					Return(nil, assert.AnError)
+					Return(nil, grpcerr.New(codes.Unauthenticated, "INVALID_CREDENTIALS", "invalid credentials"))
 			},
 			expectedStatus: http.StatusUnauthorized,
 			expectedBody: map[string]interface{}{
 				"error": "invalid credentials",
+				"code":  "INVALID_CREDENTIALS",
 			},
 		},
 	}
@@ -185,7 +205,7 @@ func TestAuthHandler_Login(t *testing.T) {
 			tt.setupMock(mockAuthClient)
 
 			logger := logging.NewEmptyLogger()
-			handler := handlers.NewAuthHandler(mockAuthClient, logger)
+			handler := handlers.NewAuthHandler(mockAuthClient, false, logger)
 
 			router := gin.New()
 			router.POST("/login", handler.Login)
@@ -252,7 +272,7 @@ func TestAuthHandler_RefreshToken(t *testing.T) {
 			refreshToken: "invalid_token",
 			setupMock: func(mockClient *mocks.MockAuthClient) {
 				mockClient.On("RefreshToken", mock.Anything, mock.Anything).
-					Return(nil, assert.AnError)
+					Return(nil, grpcerr.New(codes.Unauthenticated, "INVALID_TOKEN", "invalid or expired refresh token"))
 			},
 			expectedStatus: http.StatusUnauthorized,
 		},
@@ -264,7 +284,7 @@ func TestAuthHandler_RefreshToken(t *testing.T) {
 			tt.setupMock(mockAuthClient)
 
 			logger := logging.NewEmptyLogger()
-			handler := handlers.NewAuthHandler(mockAuthClient, logger)
+			handler := handlers.NewAuthHandler(mockAuthClient, false, logger)
 
 			router := gin.New()
 			router.POST("/refresh", handler.RefreshToken)
@@ -332,11 +352,12 @@ func TestAuthHandler_GetUser(t *testing.T) {
 			username: "nonexistent",
 			setupMock: func(mockClient *mocks.MockAuthClient) {
 				mockClient.On("GetUser", mock.Anything, mock.Anything).
-					Return(nil, assert.AnError)
+					Return(nil, grpcerr.New(codes.NotFound, "USER_NOT_FOUND", "user not found"))
 			},
 			expectedStatus: http.StatusNotFound,
 			expectedBody: map[string]interface{}{
 				"error": "user not found",
+				"code":  "USER_NOT_FOUND",
 			},
 		},
 	}
@@ -347,7 +368,7 @@ func TestAuthHandler_GetUser(t *testing.T) {
 			tt.setupMock(mockAuthClient)
 
 			logger := logging.NewEmptyLogger()
-			handler := handlers.NewAuthHandler(mockAuthClient, logger)
+			handler := handlers.NewAuthHandler(mockAuthClient, false, logger)
 
 			router := gin.New()
 			router.GET("/user/:username", handler.GetUser)
@@ -0,0 +1,174 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/apierror"
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/converters"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+)
+
+// PUT /api/drafts/:essayId
+// Called periodically by the editor, the body replaces the whole draft
+func (h *ReviewHandler) SaveDraft(c *gin.Context) {
+	essayId, ok := h.parseGradeEssayId(c)
+	if !ok {
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "save_draft"),
+		zap.Int("essay_id", essayId),
+	)
+
+	var request struct {
+		Rank     int32  `json:"rank"`
+		Content  string `json:"content"`
+		RubricId int64  `json:"rubric_id"`
+		Scores   []struct {
+			CriterionId int64  `json:"criterion_id"`
+			Score       int32  `json:"score"`
+			Comment     string `json:"comment"`
+		} `json:"scores"`
+		EssayRevision int32 `json:"essay_revision"`
+		Comments      []struct {
+			StartOffset int32  `json:"start_offset"`
+			EndOffset   int32  `json:"end_offset"`
+			Quote       string `json:"quote"`
+			Content     string `json:"content"`
+		} `json:"comments"`
+	}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Warn("Invalid save draft request",
+			zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	username, exists := c.Get("username")
+	if !exists {
+		logger.Warn("Authentication required for saving drafts")
+		apierror.Write(c, http.StatusUnauthorized, apierror.CodeUnauthenticated, "authentication required")
+		return
+	}
+
+	var scores []*pb.CriterionScore
+	for _, score := range request.Scores {
+		scores = append(scores, &pb.CriterionScore{
+			CriterionId: score.CriterionId,
+			Score:       score.Score,
+			Comment:     score.Comment,
+		})
+	}
+	var comments []*pb.InlineComment
+	for _, comment := range request.Comments {
+		comments = append(comments, &pb.InlineComment{
+			StartOffset: comment.StartOffset,
+			EndOffset:   comment.EndOffset,
+			Quote:       comment.Quote,
+			Content:     comment.Content,
+		})
+	}
+
+	resp, err := h.reviewClient.SaveDraft(
+		c.Request.Context(),
+		&pb.SaveDraftRequest{
+			EssayId:       int32(essayId),
+			Author:        username.(string),
+			Rank:          request.Rank,
+			Content:       request.Content,
+			RubricId:      request.RubricId,
+			Scores:        scores,
+			EssayRevision: request.EssayRevision,
+			Comments:      comments,
+		},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to save draft", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, converters.MarshalDraftResponse(resp))
+}
+
+// GET /api/drafts/:essayId
+func (h *ReviewHandler) GetDraft(c *gin.Context) {
+	essayId, ok := h.parseGradeEssayId(c)
+	if !ok {
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "get_draft"),
+		zap.Int("essay_id", essayId),
+	)
+
+	username, exists := c.Get("username")
+	if !exists {
+		logger.Warn("Authentication required to load drafts")
+		apierror.Write(c, http.StatusUnauthorized, apierror.CodeUnauthenticated, "authentication required")
+		return
+	}
+
+	resp, err := h.reviewClient.GetDraft(
+		c.Request.Context(),
+		&pb.GetDraftRequest{EssayId: int32(essayId), Author: username.(string)},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to get draft", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, converters.MarshalDraftResponse(resp))
+}
+
+// POST /api/drafts/:essayId/submit
+func (h *ReviewHandler) SubmitDraft(c *gin.Context) {
+	essayId, ok := h.parseGradeEssayId(c)
+	if !ok {
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "submit_draft"),
+		zap.Int("essay_id", essayId),
+	)
+
+	var request struct {
+		EssayAuthorId int32 `json:"essay_author_id" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Warn("Invalid submit draft request",
+			zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	username, exists := c.Get("username")
+	if !exists {
+		logger.Warn("Authentication required for draft submission")
+		apierror.Write(c, http.StatusUnauthorized, apierror.CodeUnauthenticated, "authentication required")
+		return
+	}
+
+	logger = logger.With(zap.String("username", username.(string)))
+	resp, err := h.reviewClient.SubmitDraft(
+		c.Request.Context(),
+		&pb.SubmitDraftRequest{
+			EssayId:       int32(essayId),
+			EssayAuthorId: request.EssayAuthorId,
+			Author:        username.(string),
+		},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to submit draft", err)
+		return
+	}
+
+	logger.Info("Draft submitted successfully",
+		zap.Int64("review_id", int64(resp.Id)))
+	c.JSON(http.StatusCreated, converters.MarshalReviewResponse(resp))
+}
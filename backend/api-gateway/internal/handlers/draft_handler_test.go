@@ -0,0 +1,134 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/clients/mocks"
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/handlers"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+)
+
+func TestReviewHandler_SaveDraft(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockReviewClient := new(mocks.MockReviewClient)
+	mockReviewClient.On("SaveDraft", mock.Anything, &pb.SaveDraftRequest{
+		EssayId:       1,
+		Author:        "reviewer",
+		Content:       "Half written",
+		EssayRevision: 2,
+		Comments:      []*pb.InlineComment{{StartOffset: 0, EndOffset: 4, Content: "Hm"}},
+	}).Return(&pb.DraftResponse{EssayId: 1, Author: "reviewer", Content: "Half written", UpdatedAt: 1234567890}, nil)
+	mockReviewClient.On("SaveDraft", mock.Anything, &pb.SaveDraftRequest{EssayId: 9, Author: "reviewer"}).
+		Return(nil, status.Error(codes.NotFound, "essay not found"))
+
+	router := newReviewRouter(handlers.NewReviewHandler(mockReviewClient, logging.NewEmptyLogger()), "reviewer")
+
+	for _, tc := range []struct {
+		path           string
+		body           string
+		expectedStatus int
+	}{
+		{"/drafts/1", `{"content": "Half written", "essay_revision": 2, "comments": [{"start_offset": 0, "end_offset": 4, "content": "Hm"}]}`, http.StatusOK},
+		{"/drafts/9", `{}`, http.StatusNotFound},
+		{"/drafts/abc", `{}`, http.StatusBadRequest},
+		{"/drafts/1", `{"rank": "high"}`, http.StatusBadRequest},
+	} {
+		req, err := http.NewRequest(http.MethodPut, tc.path, bytes.NewBufferString(tc.body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, tc.expectedStatus, w.Code, tc.path+" "+tc.body)
+		if tc.expectedStatus == http.StatusOK {
+			var response map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, "Half written", response["content"])
+			assert.Equal(t, float64(1234567890), response["updated_at"])
+		}
+	}
+
+	mockReviewClient.AssertExpectations(t)
+}
+
+func TestReviewHandler_GetDraft(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockReviewClient := new(mocks.MockReviewClient)
+	mockReviewClient.On("GetDraft", mock.Anything, &pb.GetDraftRequest{EssayId: 1, Author: "reviewer"}).
+		Return(&pb.DraftResponse{EssayId: 1, Author: "reviewer", Rank: 2, Content: "Draft"}, nil)
+	mockReviewClient.On("GetDraft", mock.Anything, &pb.GetDraftRequest{EssayId: 2, Author: "reviewer"}).
+		Return(nil, status.Error(codes.NotFound, "draft not found"))
+
+	router := newReviewRouter(handlers.NewReviewHandler(mockReviewClient, logging.NewEmptyLogger()), "reviewer")
+
+	req, err := http.NewRequest(http.MethodGet, "/drafts/1", nil)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var response map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, float64(2), response["rank"])
+	assert.Equal(t, []interface{}{}, response["comments"])
+
+	req, err = http.NewRequest(http.MethodGet, "/drafts/2", nil)
+	require.NoError(t, err)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	mockReviewClient.AssertExpectations(t)
+}
+
+func TestReviewHandler_SubmitDraft(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockReviewClient := new(mocks.MockReviewClient)
+	mockReviewClient.On("SubmitDraft", mock.Anything, &pb.SubmitDraftRequest{EssayId: 1, EssayAuthorId: 5, Author: "reviewer"}).
+		Return(&pb.ReviewResponse{Id: 7, EssayId: 1, Rank: 2, Content: "Done", Author: "reviewer"}, nil)
+	mockReviewClient.On("SubmitDraft", mock.Anything, &pb.SubmitDraftRequest{EssayId: 2, EssayAuthorId: 5, Author: "reviewer"}).
+		Return(nil, status.Error(codes.InvalidArgument, "rank must be between 1 and 3"))
+	mockReviewClient.On("SubmitDraft", mock.Anything, &pb.SubmitDraftRequest{EssayId: 3, EssayAuthorId: 5, Author: "reviewer"}).
+		Return(nil, status.Error(codes.FailedPrecondition, "essay has changed"))
+	mockReviewClient.On("SubmitDraft", mock.Anything, &pb.SubmitDraftRequest{EssayId: 4, EssayAuthorId: 5, Author: "reviewer"}).
+		Return(nil, status.Error(codes.NotFound, "draft not found"))
+
+	router := newReviewRouter(handlers.NewReviewHandler(mockReviewClient, logging.NewEmptyLogger()), "reviewer")
+
+	for _, tc := range []struct {
+		path           string
+		body           string
+		expectedStatus int
+	}{
+		{"/drafts/1/submit", `{"essay_author_id": 5}`, http.StatusCreated},
+		{"/drafts/2/submit", `{"essay_author_id": 5}`, http.StatusBadRequest},
+		{"/drafts/3/submit", `{"essay_author_id": 5}`, http.StatusConflict},
+		{"/drafts/4/submit", `{"essay_author_id": 5}`, http.StatusNotFound},
+		{"/drafts/1/submit", `{}`, http.StatusBadRequest},
+	} {
+		req, err := http.NewRequest(http.MethodPost, tc.path, bytes.NewBufferString(tc.body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, tc.expectedStatus, w.Code, tc.path+" "+tc.body)
+	}
+
+	mockReviewClient.AssertExpectations(t)
+}
@@ -0,0 +1,19 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/apierror"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// Logs a failed backend call and answers with the translated error,
+// client mistakes are only worth a warning
+func writeGrpcError(c *gin.Context, logger *zap.Logger, message string, err error) {
+	if apierror.WriteGrpc(c, err) >= http.StatusInternalServerError {
+		logger.Error(message, zap.Error(err))
+		return
+	}
+	logger.Warn(message, zap.Error(err))
+}
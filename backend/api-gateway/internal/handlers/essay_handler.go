@@ -2,7 +2,10 @@ package handlers
 
 import (
 	"net/http"
+	"sort"
+	"strconv"
 
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/apierror"
 	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/clients"
 	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/converters"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
@@ -26,22 +29,23 @@ func NewEssayHandler(essayClient clients.EssayClient, logger *logging.Logger) *E
 
 // POST /api/essays
 func (h *EssayHandler) CreateEssay(c *gin.Context) {
-	logger := h.logger.With(zap.String("operation", "create_essay"))
+	logger := requestLogger(c, h.logger).With(zap.String("operation", "create_essay"))
 
 	var request struct {
-		Content string `json:"content" binding:"required"`
+		Content      string `json:"content" binding:"required"`
+		AssignmentId int64  `json:"assignment_id"`
 	}
 	if err := c.ShouldBindJSON(&request); err != nil {
 		logger.Warn("Invalid create essay request",
 			zap.Error(err))
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, err.Error())
 		return
 	}
 
 	username, ok := c.Get("username")
 	if !ok {
 		logger.Warn("Authentication required for essay creation")
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierror.Write(c, http.StatusUnauthorized, apierror.CodeUnauthenticated, "authentication required")
 		return
 	}
 
@@ -52,14 +56,13 @@ func (h *EssayHandler) CreateEssay(c *gin.Context) {
 	resp, err := h.essayClient.CreateEssay(
 		c.Request.Context(),
 		&pb.EssayAddRequest{
-			Content: request.Content,
-			Author:  usernameStr,
+			Content:      request.Content,
+			Author:       usernameStr,
+			AssignmentId: request.AssignmentId,
 		},
 	)
 	if err != nil {
-		logger.Error("Failed to create essay",
-			zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeGrpcError(c, logger, "Failed to create essay", err)
 		return
 	}
 
@@ -71,7 +74,7 @@ func (h *EssayHandler) CreateEssay(c *gin.Context) {
 // GET /api/essays/:authorname
 func (h *EssayHandler) GetEssay(c *gin.Context) {
 	authorname := c.Param("authorname")
-	logger := h.logger.With(
+	logger := requestLogger(c, h.logger).With(
 		zap.String("operation", "get_essay"),
 		zap.String("authorname", authorname),
 	)
@@ -82,12 +85,48 @@ func (h *EssayHandler) GetEssay(c *gin.Context) {
 	})
 
 	if err != nil {
-		logger.Warn("Essay not found",
-			zap.Error(err))
-		c.JSON(http.StatusNotFound, gin.H{"error": "essay not found"})
+		writeGrpcError(c, logger, "Failed to get essay", err)
+		return
+	}
+
+	viewer := viewerFrom(c)
+	if resp.Anonymous && !viewer.SeesIdentityOf(authorname) {
+		// answering like a missing essay keeps the name from being tied to the
+		// essay id and the alias of a double-blind assignment
+		logger.Debug("Anonymous essay looked up by author name")
+		apierror.Write(c, http.StatusNotFound, "ESSAY_NOT_FOUND", "essay not found")
+		return
+	}
+	converters.AnonymizeEssayWithReviews(resp, viewer)
+
+	logger.Debug("Essay retrieved successfully")
+	c.JSON(http.StatusOK, converters.MarshalProtoEssayWithReviewsResponse(resp))
+}
+
+// GET /api/essays/by-id/:id
+func (h *EssayHandler) GetEssayByID(c *gin.Context) {
+	idStr := c.Param("id")
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "get_essay_by_id"),
+		zap.String("essay_id", idStr),
+	)
+
+	id, err := strconv.ParseInt(idStr, 10, 32)
+	if err != nil {
+		logger.Warn("Invalid essay ID", zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid essay ID")
+		return
+	}
+
+	logger.Debug("Get essay by ID request")
+	resp, err := h.essayClient.GetEssayByID(c.Request.Context(), &pb.GetByIdRequest{Id: int32(id)})
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to get essay", err)
 		return
 	}
 
+	converters.AnonymizeEssayWithReviews(resp, viewerFrom(c))
+
 	logger.Debug("Essay retrieved successfully")
 	c.JSON(http.StatusOK, converters.MarshalProtoEssayWithReviewsResponse(resp))
 }
@@ -95,53 +134,123 @@ func (h *EssayHandler) GetEssay(c *gin.Context) {
 // GET /api/essays
 func (h *EssayHandler) GetAllEssays(c *gin.Context) {
 	searchContent := c.Query("search")
-	logger := h.logger.With(
+	sortBy := c.Query("sort")
+	logger := requestLogger(c, h.logger).With(
 		zap.String("operation", "get_all_essays"),
 		zap.String("search_content", searchContent),
+		zap.String("sort", sortBy),
 	)
 
 	logger.Debug("Get all essays request")
-	var essays []gin.H
+	if sortBy != "" && sortBy != sortLeastReviewed {
+		logger.Warn("Unsupported essay sort")
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, "unsupported sort, expected "+sortLeastReviewed)
+		return
+	}
 
+	var resp []*pb.EssayResponse
+	var err error
 	if searchContent == "" {
-		resp, err := h.essayClient.GetAllEssays(c.Request.Context(), &pb.EmptyRequest{})
+		resp, err = h.essayClient.GetAllEssays(c.Request.Context(), &pb.EmptyRequest{})
 		if err != nil {
-			logger.Error("Failed to get all essays",
-				zap.Error(err))
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			writeGrpcError(c, logger, "Failed to get all essays", err)
 			return
 		}
-
-		for _, essay := range resp {
-			essays = append(essays, converters.MarshalProtoEssayResponse(essay))
-		}
 		logger.Debug("Retrieved all essays",
-			zap.Int("count", len(essays)))
+			zap.Int("count", len(resp)))
 	} else {
-		resp, err := h.essayClient.SearchEssays(c.Request.Context(), &pb.SearchByContentRequest{
+		resp, err = h.essayClient.SearchEssays(c.Request.Context(), &pb.SearchByContentRequest{
 			Content: searchContent,
 		})
 		if err != nil {
-			logger.Error("Failed to search essays",
-				zap.Error(err))
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			writeGrpcError(c, logger, "Failed to search essays", err)
 			return
 		}
-
-		for _, essay := range resp {
-			essays = append(essays, converters.MarshalProtoEssayResponse(essay))
-		}
 		logger.Debug("Search essays completed",
-			zap.Int("count", len(essays)))
+			zap.Int("count", len(resp)))
+	}
+
+	if sortBy == sortLeastReviewed {
+		sortLeastReviewedFirst(resp)
+	}
+
+	viewer := viewerFrom(c)
+	var essays []gin.H
+	for _, essay := range resp {
+		converters.AnonymizeEssay(essay, viewer)
+		essays = append(essays, converters.MarshalProtoEssayResponse(essay))
 	}
 
 	c.JSON(http.StatusOK, essays)
 }
 
+const sortLeastReviewed = "least_reviewed"
+
+// Orders essays by review count, older essays first on ties and
+// essays without stats last
+func sortLeastReviewedFirst(essays []*pb.EssayResponse) {
+	sort.SliceStable(essays, func(i, j int) bool {
+		a, b := essays[i].ReviewStats, essays[j].ReviewStats
+		if a == nil || b == nil {
+			return a != nil && b == nil
+		}
+		if a.ReviewCount != b.ReviewCount {
+			return a.ReviewCount < b.ReviewCount
+		}
+		return essays[i].CreatedAt < essays[j].CreatedAt
+	})
+}
+
+// PUT /api/essays/:authorname
+func (h *EssayHandler) UpdateEssay(c *gin.Context) {
+	authorname := c.Param("authorname")
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "update_essay"),
+		zap.String("authorname", authorname),
+	)
+
+	var request struct {
+		Content string `json:"content" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Warn("Invalid update essay request",
+			zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	usernameVal, exists := c.Get("username")
+	if !exists {
+		logger.Warn("Authentication required for essay update")
+		apierror.Write(c, http.StatusUnauthorized, apierror.CodeUnauthenticated, "authentication required")
+		return
+	}
+	usernameStr, ok := usernameVal.(string)
+	if !ok || usernameStr != authorname {
+		logger.Warn("Forbidden essay update attempt",
+			zap.String("authenticated_user", usernameStr))
+		apierror.Write(c, http.StatusForbidden, apierror.CodePermissionDenied, "you can update only your own essays")
+		return
+	}
+
+	logger.Info("Updating essay")
+	resp, err := h.essayClient.UpdateEssay(c.Request.Context(), &pb.UpdateByAuthorNameRequest{
+		Authorname: authorname,
+		Content:    request.Content,
+	})
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to update essay", err)
+		return
+	}
+
+	logger.Info("Essay updated successfully")
+	c.JSON(http.StatusOK, converters.MarshalProtoEssayResponse(resp))
+}
+
 // DELETE /api/essays/:authorname
 func (h *EssayHandler) RemoveEssay(c *gin.Context) {
 	authorname := c.Param("authorname")
-	logger := h.logger.With(
+	logger := requestLogger(c, h.logger).With(
 		zap.String("operation", "remove_essay"),
 		zap.String("authorname", authorname),
 	)
@@ -149,14 +258,14 @@ func (h *EssayHandler) RemoveEssay(c *gin.Context) {
 	usernameVal, exists := c.Get("username")
 	if !exists {
 		logger.Warn("Authentication required for essay deletion")
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierror.Write(c, http.StatusUnauthorized, apierror.CodeUnauthenticated, "authentication required")
 		return
 	}
 	usernameStr, ok := usernameVal.(string)
 	if !ok || usernameStr != authorname {
 		logger.Warn("Forbidden essay deletion attempt",
 			zap.String("authenticated_user", usernameStr))
-		c.JSON(http.StatusForbidden, gin.H{"error": "you can delete only your own essays"})
+		apierror.Write(c, http.StatusForbidden, apierror.CodePermissionDenied, "you can delete only your own essays")
 		return
 	}
 
@@ -166,9 +275,7 @@ func (h *EssayHandler) RemoveEssay(c *gin.Context) {
 	})
 
 	if err != nil {
-		logger.Error("Failed to delete essay",
-			zap.Error(err))
-		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		writeGrpcError(c, logger, "Failed to delete essay", err)
 		return
 	}
 
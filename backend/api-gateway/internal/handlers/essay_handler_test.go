@@ -9,11 +9,14 @@ import (
 
 	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/clients/mocks"
 	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/handlers"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/grpcerr"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/IAGrig/vt-csa-essays/backend/proto/essay"
 	reviewPb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
@@ -53,6 +56,25 @@ func TestEssayHandler_CreateEssay(t *testing.T) {
 				"author":  "testuser",
 			},
 		},
+		{
+			name: "essay for unknown assignment",
+			requestBody: []byte(`{
+				"content": "This is a test essay content",
+				"assignment_id": 42
+			}`),
+			username: "testuser",
+			setupMock: func(mockClient *mocks.MockEssayClient) {
+				mockClient.On("CreateEssay", mock.Anything, &pb.EssayAddRequest{
+					Content:      "This is a test essay content",
+					Author:       "testuser",
+					AssignmentId: 42,
+				}).Return(nil, status.Error(codes.InvalidArgument, "assignment not found"))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"error": "assignment not found",
+			},
+		},
 		{
 			name: "missing authentication",
 			requestBody: []byte(`{
@@ -85,6 +107,22 @@ func TestEssayHandler_CreateEssay(t *testing.T) {
 			},
 			expectedStatus: http.StatusBadRequest,
 		},
+		{
+			name: "duplicate essay",
+			requestBody: []byte(`{
+				"content": "This is a test essay content"
+			}`),
+			username: "testuser",
+			setupMock: func(mockClient *mocks.MockEssayClient) {
+				mockClient.On("CreateEssay", mock.Anything, mock.Anything).
+					Return(nil, grpcerr.New(codes.AlreadyExists, "ESSAY_ALREADY_EXISTS", "essay already exists"))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody: map[string]interface{}{
+				"error": "essay already exists",
+				"code":  "ESSAY_ALREADY_EXISTS",
+			},
+		},
 		{
 			name: "essay service error",
 			requestBody: []byte(`{
@@ -97,7 +135,8 @@ func TestEssayHandler_CreateEssay(t *testing.T) {
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedBody: map[string]interface{}{
-				"error": assert.AnError.Error(),
+				"error": "internal error",
+				"code":  "INTERNAL",
 			},
 		},
 	}
@@ -149,6 +188,8 @@ func TestEssayHandler_GetEssay(t *testing.T) {
 	tests := []struct {
 		name           string
 		authorname     string
+		username       string
+		role           string
 		setupMock      func(*mocks.MockEssayClient)
 		expectedStatus int
 		expectedBody   map[string]interface{}
@@ -179,11 +220,81 @@ func TestEssayHandler_GetEssay(t *testing.T) {
 			authorname: "nonexistent",
 			setupMock: func(mockClient *mocks.MockEssayClient) {
 				mockClient.On("GetEssay", mock.Anything, mock.Anything).
-					Return(nil, assert.AnError)
+					Return(nil, grpcerr.New(codes.NotFound, "ESSAY_NOT_FOUND", "essay not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: map[string]interface{}{
+				"error": "essay not found",
+				"code":  "ESSAY_NOT_FOUND",
+			},
+		},
+		{
+			name:       "anonymous essay hidden from another student",
+			authorname: "testauthor",
+			username:   "student",
+			role:       "student",
+			setupMock: func(mockClient *mocks.MockEssayClient) {
+				mockClient.On("GetEssay", mock.Anything, mock.Anything).Return(&pb.EssayWithReviewsResponse{
+					Id:          7,
+					Author:      "testauthor",
+					Anonymous:   true,
+					AuthorAlias: "Author A",
+				}, nil)
 			},
 			expectedStatus: http.StatusNotFound,
 			expectedBody: map[string]interface{}{
 				"error": "essay not found",
+				"code":  "ESSAY_NOT_FOUND",
+				"id":    nil,
+			},
+		},
+		{
+			name:       "anonymous essay shown to its author",
+			authorname: "testauthor",
+			username:   "testauthor",
+			role:       "student",
+			setupMock: func(mockClient *mocks.MockEssayClient) {
+				mockClient.On("GetEssay", mock.Anything, mock.Anything).Return(&pb.EssayWithReviewsResponse{
+					Id:          7,
+					Author:      "testauthor",
+					Anonymous:   true,
+					AuthorAlias: "Author A",
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"id":     float64(7),
+				"author": "testauthor",
+			},
+		},
+		{
+			name:       "anonymous essay shown to a teacher",
+			authorname: "testauthor",
+			username:   "teacher",
+			role:       "teacher",
+			setupMock: func(mockClient *mocks.MockEssayClient) {
+				mockClient.On("GetEssay", mock.Anything, mock.Anything).Return(&pb.EssayWithReviewsResponse{
+					Id:          7,
+					Author:      "testauthor",
+					Anonymous:   true,
+					AuthorAlias: "Author A",
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"author": "testauthor",
+			},
+		},
+		{
+			name:       "reviews unavailable",
+			authorname: "testauthor",
+			setupMock: func(mockClient *mocks.MockEssayClient) {
+				mockClient.On("GetEssay", mock.Anything, mock.Anything).
+					Return(nil, grpcerr.New(codes.Unavailable, "REVIEWS_UNAVAILABLE", "reviews are temporarily unavailable"))
+			},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedBody: map[string]interface{}{
+				"code": "REVIEWS_UNAVAILABLE",
 			},
 		},
 	}
@@ -204,6 +315,10 @@ func TestEssayHandler_GetEssay(t *testing.T) {
 
 			c.Request = req
 			c.Params = gin.Params{gin.Param{Key: "authorname", Value: tt.authorname}}
+			if tt.username != "" {
+				c.Set("username", tt.username)
+				c.Set("role", tt.role)
+			}
 
 			handler.GetEssay(c)
 
@@ -224,6 +339,112 @@ func TestEssayHandler_GetEssay(t *testing.T) {
 	}
 }
 
+func TestEssayHandler_GetEssayByID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	anonymous := func() *pb.EssayWithReviewsResponse {
+		return &pb.EssayWithReviewsResponse{
+			Id:          7,
+			Content:     "Blind essay",
+			Author:      "testauthor",
+			Anonymous:   true,
+			AuthorAlias: "Author A",
+			Reviews:     []*reviewPb.ReviewResponse{},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		id             string
+		username       string
+		role           string
+		setupMock      func(*mocks.MockEssayClient)
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:     "student sees the alias",
+			id:       "7",
+			username: "student",
+			role:     "student",
+			setupMock: func(mockClient *mocks.MockEssayClient) {
+				mockClient.On("GetEssayByID", mock.Anything, &pb.GetByIdRequest{Id: 7}).Return(anonymous(), nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"id":     float64(7),
+				"author": "Author A",
+			},
+		},
+		{
+			name:     "teacher sees the author",
+			id:       "7",
+			username: "teacher",
+			role:     "teacher",
+			setupMock: func(mockClient *mocks.MockEssayClient) {
+				mockClient.On("GetEssayByID", mock.Anything, &pb.GetByIdRequest{Id: 7}).Return(anonymous(), nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"author": "testauthor",
+			},
+		},
+		{
+			name:           "invalid id",
+			id:             "abc",
+			setupMock:      func(mockClient *mocks.MockEssayClient) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "essay not found",
+			id:   "9",
+			setupMock: func(mockClient *mocks.MockEssayClient) {
+				mockClient.On("GetEssayByID", mock.Anything, &pb.GetByIdRequest{Id: 9}).
+					Return(nil, grpcerr.New(codes.NotFound, "ESSAY_NOT_FOUND", "essay not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockEssayClient := new(mocks.MockEssayClient)
+			tt.setupMock(mockEssayClient)
+
+			handler := handlers.NewEssayHandler(mockEssayClient, logging.NewEmptyLogger())
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			req, err := http.NewRequest(http.MethodGet, "/essays/by-id/"+tt.id, nil)
+			require.NoError(t, err)
+
+			c.Request = req
+			c.Params = gin.Params{gin.Param{Key: "id", Value: tt.id}}
+			if tt.username != "" {
+				c.Set("username", tt.username)
+				c.Set("role", tt.role)
+			}
+
+			handler.GetEssayByID(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedBody != nil {
+				var response map[string]interface{}
+				err = json.Unmarshal(w.Body.Bytes(), &response)
+				require.NoError(t, err)
+
+				for key, expectedValue := range tt.expectedBody {
+					assert.Equal(t, expectedValue, response[key])
+				}
+			}
+
+			mockEssayClient.AssertExpectations(t)
+		})
+	}
+}
+
 func TestEssayHandler_GetAllEssays(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -314,6 +535,52 @@ func TestEssayHandler_GetAllEssays(t *testing.T) {
 	}
 }
 
+func TestEssayHandler_GetAllEssays_LeastReviewed(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockEssayClient := new(mocks.MockEssayClient)
+	mockEssayClient.On("GetAllEssays", mock.Anything, &pb.EmptyRequest{}).
+		Return([]*pb.EssayResponse{
+			{Id: 1, Author: "user1", CreatedAt: 100, ReviewStats: &reviewPb.ReviewStats{ReviewCount: 3}},
+			{Id: 2, Author: "user2", CreatedAt: 200},
+			{Id: 3, Author: "user3", CreatedAt: 300, ReviewStats: &reviewPb.ReviewStats{ReviewCount: 0}},
+			{Id: 4, Author: "user4", CreatedAt: 50, ReviewStats: &reviewPb.ReviewStats{ReviewCount: 0}},
+		}, nil)
+
+	handler := handlers.NewEssayHandler(mockEssayClient, logging.NewEmptyLogger())
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	req, err := http.NewRequest(http.MethodGet, "/essays?sort=least_reviewed", nil)
+	require.NoError(t, err)
+	c.Request = req
+
+	handler.GetAllEssays(c)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var response []map[string]interface{}
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+
+	ids := make([]float64, 0, len(response))
+	for _, essay := range response {
+		ids = append(ids, essay["id"].(float64))
+	}
+	assert.Equal(t, []float64{4, 3, 1, 2}, ids)
+	assert.Nil(t, response[3]["review_stats"])
+
+	w = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(w)
+	req, err = http.NewRequest(http.MethodGet, "/essays?sort=newest", nil)
+	require.NoError(t, err)
+	c.Request = req
+
+	handler.GetAllEssays(c)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	mockEssayClient.AssertExpectations(t)
+}
+
 func TestEssayHandler_RemoveEssay(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -375,10 +642,20 @@ func TestEssayHandler_RemoveEssay(t *testing.T) {
 			username:   "testuser",
 			setupMock: func(mockClient *mocks.MockEssayClient) {
 				mockClient.On("DeleteEssay", mock.Anything, mock.Anything).
-					Return(nil, assert.AnError)
+					Return(nil, grpcerr.New(codes.NotFound, "ESSAY_NOT_FOUND", "essay not found"))
 			},
 			expectedStatus: http.StatusNotFound,
 		},
+		{
+			name:       "essay service error",
+			authorname: "testuser",
+			username:   "testuser",
+			setupMock: func(mockClient *mocks.MockEssayClient) {
+				mockClient.On("DeleteEssay", mock.Anything, mock.Anything).
+					Return(nil, assert.AnError)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
 	}
 
 	for _, tt := range tests {
@@ -420,3 +697,111 @@ func TestEssayHandler_RemoveEssay(t *testing.T) {
 		})
 	}
 }
+
+func TestEssayHandler_UpdateEssay(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		authorname     string
+		username       interface{}
+		body           string
+		setupMock      func(*mocks.MockEssayClient)
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:       "successful update - same user",
+			authorname: "testuser",
+			username:   "testuser",
+			body:       `{"content": "Revised content"}`,
+			setupMock: func(mockClient *mocks.MockEssayClient) {
+				mockClient.On("UpdateEssay", mock.Anything, &pb.UpdateByAuthorNameRequest{
+					Authorname: "testuser",
+					Content:    "Revised content",
+				}).Return(&pb.EssayResponse{
+					Id:      1,
+					Content: "Revised content",
+					Author:  "testuser",
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"id":      float64(1),
+				"content": "Revised content",
+			},
+		},
+		{
+			name:       "forbidden - different user",
+			authorname: "otheruser",
+			username:   "testuser",
+			body:       `{"content": "Revised content"}`,
+			setupMock: func(mockClient *mocks.MockEssayClient) {
+				// no call expected
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"error": "you can update only your own essays",
+			},
+		},
+		{
+			name:       "missing content",
+			authorname: "testuser",
+			username:   "testuser",
+			body:       `{}`,
+			setupMock: func(mockClient *mocks.MockEssayClient) {
+				// no call expected
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "essay not found",
+			authorname: "testuser",
+			username:   "testuser",
+			body:       `{"content": "Revised content"}`,
+			setupMock: func(mockClient *mocks.MockEssayClient) {
+				mockClient.On("UpdateEssay", mock.Anything, mock.Anything).
+					Return(nil, grpcerr.New(codes.NotFound, "ESSAY_NOT_FOUND", "essay not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockEssayClient := new(mocks.MockEssayClient)
+			tt.setupMock(mockEssayClient)
+
+			handler := handlers.NewEssayHandler(mockEssayClient, logging.NewEmptyLogger())
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			req, err := http.NewRequest(http.MethodPut, "/essays/"+tt.authorname, bytes.NewBufferString(tt.body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			c.Request = req
+			c.Params = gin.Params{gin.Param{Key: "authorname", Value: tt.authorname}}
+			if tt.username != nil {
+				c.Set("username", tt.username)
+			}
+
+			handler.UpdateEssay(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedBody != nil {
+				var response map[string]interface{}
+				err = json.Unmarshal(w.Body.Bytes(), &response)
+				require.NoError(t, err)
+
+				for key, expectedValue := range tt.expectedBody {
+					assert.Equal(t, expectedValue, response[key])
+				}
+			}
+
+			mockEssayClient.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/apierror"
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/converters"
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/gradebook"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+)
+
+// PUT /api/grades/:essayId
+func (h *ReviewHandler) SetGrade(c *gin.Context) {
+	essayId, ok := h.parseGradeEssayId(c)
+	if !ok {
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "set_grade"),
+		zap.Int("essay_id", essayId),
+	)
+
+	var request struct {
+		Grade         *float64 `json:"grade"`
+		Feedback      string   `json:"feedback"`
+		SeedFromPeers bool     `json:"seed_from_peers"`
+	}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Warn("Invalid set grade request",
+			zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, err.Error())
+		return
+	}
+	if request.Grade == nil && !request.SeedFromPeers {
+		logger.Warn("Grade is missing")
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, "grade is required unless seed_from_peers is set")
+		return
+	}
+
+	username, exists := c.Get("username")
+	if !exists {
+		logger.Warn("Authentication required for grading")
+		apierror.Write(c, http.StatusUnauthorized, apierror.CodeUnauthenticated, "authentication required")
+		return
+	}
+
+	logger = logger.With(zap.String("username", username.(string)))
+	req := &pb.SetGradeRequest{
+		EssayId:       int32(essayId),
+		Feedback:      request.Feedback,
+		GradedBy:      username.(string),
+		SeedFromPeers: request.SeedFromPeers,
+	}
+	if request.Grade != nil {
+		req.Grade = *request.Grade
+	}
+
+	resp, err := h.reviewClient.SetGrade(c.Request.Context(), req)
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to set grade", err)
+		return
+	}
+
+	logger.Info("Essay graded successfully")
+	c.JSON(http.StatusOK, converters.MarshalGradeResponse(resp))
+}
+
+// GET /api/grades/:essayId
+func (h *ReviewHandler) GetGrade(c *gin.Context) {
+	essayId, ok := h.parseGradeEssayId(c)
+	if !ok {
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "get_grade"),
+		zap.Int("essay_id", essayId),
+	)
+
+	username, exists := c.Get("username")
+	if !exists {
+		logger.Warn("Authentication required to see a grade")
+		apierror.Write(c, http.StatusUnauthorized, apierror.CodeUnauthenticated, "authentication required")
+		return
+	}
+
+	resp, err := h.reviewClient.GetGrade(
+		c.Request.Context(),
+		&pb.GetGradeRequest{EssayId: int32(essayId), RequestedBy: username.(string)},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to get grade", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, converters.MarshalGradeResponse(resp))
+}
+
+// PUT /api/assignments/:assignmentId/release
+func (h *ReviewHandler) SetGradesReleased(c *gin.Context) {
+	assignmentId, ok := h.parseAssignmentId(c)
+	if !ok {
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "set_grades_released"),
+		zap.Int64("assignment_id", assignmentId),
+	)
+
+	var request struct {
+		Released *bool `json:"released" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Warn("Invalid grade release request",
+			zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	username, exists := c.Get("username")
+	if !exists {
+		logger.Warn("Authentication required for grade release")
+		apierror.Write(c, http.StatusUnauthorized, apierror.CodeUnauthenticated, "authentication required")
+		return
+	}
+
+	logger = logger.With(zap.String("username", username.(string)))
+	resp, err := h.reviewClient.SetGradesReleased(
+		c.Request.Context(),
+		&pb.SetGradesReleasedRequest{
+			AssignmentId: assignmentId,
+			Released:     *request.Released,
+			RequestedBy:  username.(string),
+		},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to change grade release", err)
+		return
+	}
+
+	logger.Info("Grade release changed successfully",
+		zap.Bool("released", resp.GradesReleased))
+	c.JSON(http.StatusOK, converters.MarshalAssignmentResponse(resp))
+}
+
+// GET /api/assignments/:assignmentId/gradebook?format=csv|xlsx
+func (h *ReviewHandler) ExportGradebook(c *gin.Context) {
+	assignmentId, ok := h.parseAssignmentId(c)
+	if !ok {
+		return
+	}
+
+	format := c.DefaultQuery("format", gradebook.FormatCSV)
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "export_gradebook"),
+		zap.Int64("assignment_id", assignmentId),
+		zap.String("format", format),
+	)
+
+	if format != gradebook.FormatCSV && format != gradebook.FormatXLSX {
+		logger.Warn("Unsupported gradebook format")
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, "format must be csv or xlsx")
+		return
+	}
+
+	username, exists := c.Get("username")
+	if !exists {
+		logger.Warn("Authentication required for gradebook export")
+		apierror.Write(c, http.StatusUnauthorized, apierror.CodeUnauthenticated, "authentication required")
+		return
+	}
+
+	logger = logger.With(zap.String("username", username.(string)))
+	entries, err := h.reviewClient.GetGradebook(
+		c.Request.Context(),
+		&pb.GetGradebookRequest{AssignmentId: assignmentId, RequestedBy: username.(string)},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to get gradebook", err)
+		return
+	}
+
+	// rendered into memory first so a failure still yields a JSON error
+	var buf bytes.Buffer
+	if format == gradebook.FormatXLSX {
+		err = gradebook.WriteXLSX(&buf, entries)
+	} else {
+		err = gradebook.WriteCSV(&buf, entries)
+	}
+	if err != nil {
+		logger.Error("Failed to render gradebook",
+			zap.Error(err))
+		apierror.Write(c, http.StatusInternalServerError, apierror.CodeInternal, "failed to render gradebook")
+		return
+	}
+
+	logger.Info("Gradebook exported",
+		zap.Int("count", len(entries)))
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="gradebook-%d.%s"`, assignmentId, format))
+	c.Data(http.StatusOK, gradebook.ContentType(format), buf.Bytes())
+}
+
+func (h *ReviewHandler) parseGradeEssayId(c *gin.Context) (int, bool) {
+	essayIdStr := c.Param("essayId")
+	essayId, err := strconv.Atoi(essayIdStr)
+	if err != nil {
+		requestLogger(c, h.logger).Warn("Invalid essay ID",
+			zap.String("essay_id", essayIdStr),
+			zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid essay ID")
+		return 0, false
+	}
+	return essayId, true
+}
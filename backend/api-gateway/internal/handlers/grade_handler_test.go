@@ -0,0 +1,256 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/clients/mocks"
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/handlers"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+)
+
+func TestReviewHandler_SetGrade(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		path           string
+		requestBody    string
+		setupMock      func(*mocks.MockReviewClient)
+		expectedStatus int
+	}{
+		{
+			name:        "explicit grade",
+			path:        "/grades/1",
+			requestBody: `{"grade": 85, "feedback": "Well argued"}`,
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("SetGrade", mock.Anything, &pb.SetGradeRequest{
+					EssayId:  1,
+					Grade:    85,
+					Feedback: "Well argued",
+					GradedBy: "teacher",
+				}).Return(&pb.GradeResponse{EssayId: 1, Grade: 85, Feedback: "Well argued", GradedBy: "teacher"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "seeded from peers",
+			path:        "/grades/1",
+			requestBody: `{"seed_from_peers": true}`,
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("SetGrade", mock.Anything, &pb.SetGradeRequest{
+					EssayId:       1,
+					GradedBy:      "teacher",
+					SeedFromPeers: true,
+				}).Return(&pb.GradeResponse{EssayId: 1, Grade: 72.3, PeerScore: 72.345}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "grade missing",
+			path:           "/grades/1",
+			requestBody:    `{"feedback": "No grade"}`,
+			setupMock:      func(*mocks.MockReviewClient) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "nothing to seed from",
+			path:        "/grades/1",
+			requestBody: `{"seed_from_peers": true}`,
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("SetGrade", mock.Anything, mock.Anything).
+					Return(nil, status.Error(codes.FailedPrecondition, "essay has no rubric scored peer reviews to seed the grade from"))
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:        "essay of another teacher",
+			path:        "/grades/1",
+			requestBody: `{"grade": 85}`,
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("SetGrade", mock.Anything, mock.Anything).
+					Return(nil, status.Error(codes.PermissionDenied, "only the assignment creator can grade its essays"))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:        "unknown essay",
+			path:        "/grades/9",
+			requestBody: `{"grade": 85}`,
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("SetGrade", mock.Anything, mock.Anything).
+					Return(nil, status.Error(codes.NotFound, "essay not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "invalid essay ID",
+			path:           "/grades/abc",
+			requestBody:    `{"grade": 85}`,
+			setupMock:      func(*mocks.MockReviewClient) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReviewClient := new(mocks.MockReviewClient)
+			tt.setupMock(mockReviewClient)
+
+			router := newReviewRouter(handlers.NewReviewHandler(mockReviewClient, logging.NewEmptyLogger()), "teacher")
+
+			req, err := http.NewRequest(http.MethodPut, tt.path, bytes.NewBufferString(tt.requestBody))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			mockReviewClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReviewHandler_GetGrade(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockReviewClient := new(mocks.MockReviewClient)
+	mockReviewClient.On("GetGrade", mock.Anything, &pb.GetGradeRequest{EssayId: 1, RequestedBy: "student"}).
+		Return(&pb.GradeResponse{EssayId: 1, Grade: 85, Feedback: "Well argued", Released: true}, nil)
+	mockReviewClient.On("GetGrade", mock.Anything, &pb.GetGradeRequest{EssayId: 2, RequestedBy: "student"}).
+		Return(nil, status.Error(codes.NotFound, "grade not found"))
+	mockReviewClient.On("GetGrade", mock.Anything, &pb.GetGradeRequest{EssayId: 3, RequestedBy: "student"}).
+		Return(nil, status.Error(codes.PermissionDenied, "only the essay author and the assignment creator can see the grade"))
+
+	router := newReviewRouter(handlers.NewReviewHandler(mockReviewClient, logging.NewEmptyLogger()), "student")
+
+	req, err := http.NewRequest(http.MethodGet, "/grades/1", nil)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var response map[string]interface{}
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, float64(85), response["grade"])
+	assert.Equal(t, "Well argued", response["feedback"])
+
+	for path, expectedStatus := range map[string]int{
+		"/grades/2": http.StatusNotFound,
+		"/grades/3": http.StatusForbidden,
+	} {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, expectedStatus, w.Code, path)
+	}
+
+	mockReviewClient.AssertExpectations(t)
+}
+
+func TestReviewHandler_SetGradesReleased(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockReviewClient := new(mocks.MockReviewClient)
+	mockReviewClient.On("SetGradesReleased", mock.Anything, &pb.SetGradesReleasedRequest{
+		AssignmentId: 4,
+		Released:     true,
+		RequestedBy:  "teacher",
+	}).Return(&pb.AssignmentResponse{Id: 4, CreatedBy: "teacher", GradesReleased: true}, nil)
+	mockReviewClient.On("SetGradesReleased", mock.Anything, &pb.SetGradesReleasedRequest{
+		AssignmentId: 5,
+		RequestedBy:  "teacher",
+	}).Return(nil, status.Error(codes.PermissionDenied, "only the assignment creator can manage its grades"))
+
+	router := newReviewRouter(handlers.NewReviewHandler(mockReviewClient, logging.NewEmptyLogger()), "teacher")
+
+	for _, tc := range []struct {
+		path           string
+		body           string
+		expectedStatus int
+	}{
+		{"/assignments/4/release", `{"released": true}`, http.StatusOK},
+		{"/assignments/5/release", `{"released": false}`, http.StatusForbidden},
+		{"/assignments/4/release", `{}`, http.StatusBadRequest},
+	} {
+		req, err := http.NewRequest(http.MethodPut, tc.path, bytes.NewBufferString(tc.body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, tc.expectedStatus, w.Code, tc.path+" "+tc.body)
+		if tc.expectedStatus == http.StatusOK {
+			var response map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, true, response["grades_released"])
+		}
+	}
+
+	mockReviewClient.AssertExpectations(t)
+}
+
+func TestReviewHandler_ExportGradebook(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	entries := []*pb.GradebookEntry{
+		{EssayId: 1, EssayAuthor: "alice", PeerReviewCount: 2, PeerScore: 66.5, Graded: true, Grade: 70, GradedBy: "teacher"},
+		{EssayId: 2, EssayAuthor: "bob"},
+	}
+	mockReviewClient := new(mocks.MockReviewClient)
+	mockReviewClient.On("GetGradebook", mock.Anything, &pb.GetGradebookRequest{AssignmentId: 4, RequestedBy: "teacher"}).
+		Return(entries, nil)
+	mockReviewClient.On("GetGradebook", mock.Anything, &pb.GetGradebookRequest{AssignmentId: 5, RequestedBy: "teacher"}).
+		Return(nil, status.Error(codes.NotFound, "assignment not found"))
+
+	router := newReviewRouter(handlers.NewReviewHandler(mockReviewClient, logging.NewEmptyLogger()), "teacher")
+
+	req, err := http.NewRequest(http.MethodGet, "/assignments/4/gradebook", nil)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="gradebook-4.csv"`, w.Header().Get("Content-Disposition"))
+	lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.True(t, strings.HasPrefix(lines[1], "1,alice,"))
+
+	req, err = http.NewRequest(http.MethodGet, "/assignments/4/gradebook?format=xlsx", nil)
+	require.NoError(t, err)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", w.Header().Get("Content-Type"))
+	assert.True(t, bytes.HasPrefix(w.Body.Bytes(), []byte("PK")))
+
+	for path, expectedStatus := range map[string]int{
+		"/assignments/5/gradebook":            http.StatusNotFound,
+		"/assignments/4/gradebook?format=pdf": http.StatusBadRequest,
+	} {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, expectedStatus, w.Code, path)
+	}
+
+	mockReviewClient.AssertExpectations(t)
+}
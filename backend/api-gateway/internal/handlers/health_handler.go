@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/health"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const healthCheckTimeout = 2 * time.Second
+
+// Implemented by the clients of every backend service
+type HealthChecker interface {
+	HealthCheck(context.Context) error
+}
+
+type HealthHandler struct {
+	services map[string]HealthChecker
+	logger   *logging.Logger
+}
+
+func NewHealthHandler(services map[string]HealthChecker, logger *logging.Logger) *HealthHandler {
+	return &HealthHandler{
+		services: services,
+		logger:   logger,
+	}
+}
+
+// GET /healthz
+func (h *HealthHandler) Live(c *gin.Context) {
+	health.Live(c.Writer, c.Request)
+}
+
+// GET /readyz
+func (h *HealthHandler) Ready(c *gin.Context) {
+	logger := requestLogger(c, h.logger).With(zap.String("operation", "readiness"))
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), healthCheckTimeout)
+	defer cancel()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	checks := make(map[string]string, len(h.services))
+	ready := true
+	for name, service := range h.services {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := service.HealthCheck(ctx)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				logger.Warn("Backend service is not ready",
+					zap.String("service", name),
+					zap.Error(err))
+				checks[name] = "unavailable"
+				ready = false
+				return
+			}
+			checks[name] = "ok"
+		}()
+	}
+	wg.Wait()
+
+	health.WriteJSON(c.Writer, ready, checks)
+}
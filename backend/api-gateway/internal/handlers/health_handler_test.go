@@ -0,0 +1,90 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/clients/mocks"
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/handlers"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHealthHandler_Ready(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		essayErr       error
+		reviewErr      error
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "all services serving",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"status": "ok", "checks": {"essay-service": "ok", "review-service": "ok"}}`,
+		},
+		{
+			name:           "one service down",
+			reviewErr:      errors.New("connection refused"),
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedBody:   `{"status": "unavailable", "checks": {"essay-service": "ok", "review-service": "unavailable"}}`,
+		},
+		{
+			name:           "all services down",
+			essayErr:       errors.New("service is NOT_SERVING"),
+			reviewErr:      errors.New("connection refused"),
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedBody:   `{"status": "unavailable", "checks": {"essay-service": "unavailable", "review-service": "unavailable"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			essayClient := new(mocks.MockEssayClient)
+			essayClient.On("HealthCheck", mock.Anything).Return(tt.essayErr)
+			reviewClient := new(mocks.MockReviewClient)
+			reviewClient.On("HealthCheck", mock.Anything).Return(tt.reviewErr)
+
+			handler := handlers.NewHealthHandler(map[string]handlers.HealthChecker{
+				"essay-service":  essayClient,
+				"review-service": reviewClient,
+			}, logging.NewEmptyLogger())
+
+			router := gin.New()
+			router.GET("/readyz", handler.Ready)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.JSONEq(t, tt.expectedBody, w.Body.String())
+			essayClient.AssertExpectations(t)
+			reviewClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHealthHandler_Live(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	essayClient := new(mocks.MockEssayClient)
+	handler := handlers.NewHealthHandler(map[string]handlers.HealthChecker{
+		"essay-service": essayClient,
+	}, logging.NewEmptyLogger())
+
+	router := gin.New()
+	router.GET("/healthz", handler.Live)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"status": "ok"}`, w.Body.String())
+	essayClient.AssertNotCalled(t, "HealthCheck", mock.Anything)
+}
@@ -0,0 +1,11 @@
+package handlers
+
+import (
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/gin-gonic/gin"
+)
+
+// Scopes the handler logger to the request so its lines carry the request id
+func requestLogger(c *gin.Context, logger *logging.Logger) *logging.Logger {
+	return logger.WithContext(c.Request.Context())
+}
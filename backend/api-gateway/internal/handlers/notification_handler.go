@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/apierror"
 	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/clients"
 	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/converters"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
@@ -25,35 +26,48 @@ func NewNotificationHandler(notificationClient clients.NotificationClient, logge
 	}
 }
 
-// GET /api/notifications
+// GET /api/notifications?unread=true&type=new_review&archived=true
 func (h *NotificationHandler) GetUserNotifications(c *gin.Context) {
-	userID, exists := c.Get("userId")
-	if !exists {
-		h.logger.Warn("Authentication required for getting notifications")
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+	userIDInt, ok := h.requireUserID(c)
+	if !ok {
 		return
 	}
 
-	userIDInt, ok := userID.(int64)
-	if !ok {
-		h.logger.Warn("Wrong userId type in authorization header")
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required: wrong userId type"})
+	unreadOnly, err := parseBoolQuery(c, "unread")
+	if err != nil {
+		requestLogger(c, h.logger).Warn("Invalid unread filter", zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid unread filter")
 		return
 	}
-	logger := h.logger.With(
+
+	archived, err := parseBoolQuery(c, "archived")
+	if err != nil {
+		requestLogger(c, h.logger).Warn("Invalid archived filter", zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid archived filter")
+		return
+	}
+
+	notificationType := c.Query("type")
+	logger := requestLogger(c, h.logger).With(
 		zap.String("operation", "get_user_notifications"),
 		zap.Int64("user_id", userIDInt),
+		zap.Bool("unread_only", unreadOnly),
+		zap.String("type", notificationType),
+		zap.Bool("archived", archived),
 	)
 
 	logger.Debug("Get user notifications request")
 	resp, err := h.notificationClient.GetByUserID(
 		c.Request.Context(),
-		&pb.GetByUserIDRequest{UserId: userIDInt},
+		&pb.GetByUserIDRequest{
+			UserId:     userIDInt,
+			UnreadOnly: unreadOnly,
+			Type:       notificationType,
+			Archived:   archived,
+		},
 	)
 	if err != nil {
-		logger.Error("Failed to get user notifications",
-			zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeGrpcError(c, logger, "Failed to get user notifications", err)
 		return
 	}
 
@@ -67,38 +81,62 @@ func (h *NotificationHandler) GetUserNotifications(c *gin.Context) {
 	c.JSON(http.StatusOK, notifications)
 }
 
+// GET /api/notifications/unread-count
+func (h *NotificationHandler) UnreadCount(c *gin.Context) {
+	userIDInt, ok := h.requireUserID(c)
+	if !ok {
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "count_unread_notifications"),
+		zap.Int64("user_id", userIDInt),
+	)
+
+	logger.Debug("Unread notifications count request")
+	resp, err := h.notificationClient.UnreadCount(
+		c.Request.Context(),
+		&pb.UnreadCountRequest{UserId: userIDInt},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to count unread notifications", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"count": resp.Count})
+}
+
 // POST /api/notifications/:notificationId/read
 func (h *NotificationHandler) MarkAsRead(c *gin.Context) {
-	notificationIdStr := c.Param("notificationId")
-	notificationId, err := strconv.ParseInt(notificationIdStr, 10, 64)
-	if err != nil {
-		h.logger.Warn("Invalid notification ID",
-			zap.String("notification_id", notificationIdStr),
-			zap.Error(err))
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid notification ID"})
+	notificationId, ok := h.notificationIDParam(c)
+	if !ok {
 		return
 	}
 
-	logger := h.logger.With(
+	userIDInt, ok := h.requireUserID(c)
+	if !ok {
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
 		zap.String("operation", "mark_notification_as_read"),
 		zap.Int64("notification_id", notificationId),
+		zap.Int64("user_id", userIDInt),
 	)
 
 	logger.Debug("Mark notification as read request")
 	resp, err := h.notificationClient.MarkAsRead(
 		c.Request.Context(),
-		&pb.MarkAsReadRequest{NotificationId: notificationId},
+		&pb.MarkAsReadRequest{NotificationId: notificationId, UserId: userIDInt},
 	)
 	if err != nil {
-		logger.Error("Failed to mark notification as read",
-			zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeGrpcError(c, logger, "Notification request failed", err)
 		return
 	}
 
 	if !resp.Success {
 		logger.Warn("Notification not found for marking as read")
-		c.JSON(http.StatusNotFound, gin.H{"error": "notification not found"})
+		apierror.Write(c, http.StatusNotFound, apierror.CodeNotFound, "notification not found")
 		return
 	}
 
@@ -110,13 +148,13 @@ func (h *NotificationHandler) MarkAsRead(c *gin.Context) {
 func (h *NotificationHandler) MarkAllAsRead(c *gin.Context) {
 	userID, exists := c.Get("user_id")
 	if !exists {
-		h.logger.Warn("Authentication required for marking all notifications as read")
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		requestLogger(c, h.logger).Warn("Authentication required for marking all notifications as read")
+		apierror.Write(c, http.StatusUnauthorized, apierror.CodeUnauthenticated, "authentication required")
 		return
 	}
 
 	userIDInt := userID.(int64)
-	logger := h.logger.With(
+	logger := requestLogger(c, h.logger).With(
 		zap.String("operation", "mark_all_notifications_as_read"),
 		zap.Int64("user_id", userIDInt),
 	)
@@ -127,18 +165,253 @@ func (h *NotificationHandler) MarkAllAsRead(c *gin.Context) {
 		&pb.MarkAllAsReadRequest{UserId: userIDInt},
 	)
 	if err != nil {
-		logger.Error("Failed to mark all notifications as read",
-			zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeGrpcError(c, logger, "Failed to mark all notifications as read", err)
 		return
 	}
 
 	if !resp.Success {
 		logger.Error("Failed to mark all notifications as read - service returned failure")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to mark notifications as read"})
+		apierror.Write(c, http.StatusInternalServerError, apierror.CodeInternal, "failed to mark notifications as read")
 		return
 	}
 
 	logger.Debug("All notifications marked as read successfully")
 	c.JSON(http.StatusOK, gin.H{"success": true})
 }
+
+// POST /api/notifications/:notificationId/archive
+func (h *NotificationHandler) Archive(c *gin.Context) {
+	notificationId, ok := h.notificationIDParam(c)
+	if !ok {
+		return
+	}
+
+	userIDInt, ok := h.requireUserID(c)
+	if !ok {
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "archive_notification"),
+		zap.Int64("notification_id", notificationId),
+		zap.Int64("user_id", userIDInt),
+	)
+
+	logger.Debug("Archive notification request")
+	_, err := h.notificationClient.Archive(
+		c.Request.Context(),
+		&pb.ArchiveRequest{NotificationId: notificationId, UserId: userIDInt},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Notification request failed", err)
+		return
+	}
+
+	logger.Debug("Notification archived successfully")
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+// POST /api/notifications/archive-all?read_only=true
+func (h *NotificationHandler) ArchiveAll(c *gin.Context) {
+	userIDInt, ok := h.requireUserID(c)
+	if !ok {
+		return
+	}
+
+	readOnly, err := parseBoolQuery(c, "read_only")
+	if err != nil {
+		requestLogger(c, h.logger).Warn("Invalid read_only flag", zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid read_only flag")
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "archive_all_notifications"),
+		zap.Int64("user_id", userIDInt),
+		zap.Bool("read_only", readOnly),
+	)
+
+	logger.Debug("Archive all notifications request")
+	resp, err := h.notificationClient.ArchiveAll(
+		c.Request.Context(),
+		&pb.ArchiveAllRequest{UserId: userIDInt, ReadOnly: readOnly},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to archive notifications", err)
+		return
+	}
+
+	logger.Debug("Notifications archived successfully",
+		zap.Int64("affected", resp.Affected))
+	c.JSON(http.StatusOK, gin.H{"success": true, "affected": resp.Affected})
+}
+
+// DELETE /api/notifications/:notificationId
+func (h *NotificationHandler) Delete(c *gin.Context) {
+	notificationId, ok := h.notificationIDParam(c)
+	if !ok {
+		return
+	}
+
+	userIDInt, ok := h.requireUserID(c)
+	if !ok {
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "delete_notification"),
+		zap.Int64("notification_id", notificationId),
+		zap.Int64("user_id", userIDInt),
+	)
+
+	logger.Debug("Delete notification request")
+	_, err := h.notificationClient.Delete(
+		c.Request.Context(),
+		&pb.DeleteRequest{NotificationId: notificationId, UserId: userIDInt},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Notification request failed", err)
+		return
+	}
+
+	logger.Debug("Notification deleted successfully")
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+// DELETE /api/notifications?read_only=true
+func (h *NotificationHandler) DeleteAll(c *gin.Context) {
+	userIDInt, ok := h.requireUserID(c)
+	if !ok {
+		return
+	}
+
+	readOnly, err := parseBoolQuery(c, "read_only")
+	if err != nil {
+		requestLogger(c, h.logger).Warn("Invalid read_only flag", zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid read_only flag")
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "delete_all_notifications"),
+		zap.Int64("user_id", userIDInt),
+		zap.Bool("read_only", readOnly),
+	)
+
+	logger.Debug("Delete all notifications request")
+	resp, err := h.notificationClient.DeleteAll(
+		c.Request.Context(),
+		&pb.DeleteAllRequest{UserId: userIDInt, ReadOnly: readOnly},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to delete notifications", err)
+		return
+	}
+
+	logger.Debug("Notifications deleted successfully",
+		zap.Int64("affected", resp.Affected))
+	c.JSON(http.StatusOK, gin.H{"success": true, "affected": resp.Affected})
+}
+
+// GET /api/notifications/preferences
+func (h *NotificationHandler) GetPreferences(c *gin.Context) {
+	userIDInt, ok := h.requireUserID(c)
+	if !ok {
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "get_notification_preferences"),
+		zap.Int64("user_id", userIDInt),
+	)
+
+	logger.Debug("Get notification preferences request")
+	resp, err := h.notificationClient.GetPreferences(
+		c.Request.Context(),
+		&pb.GetPreferencesRequest{UserId: userIDInt},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to get notification preferences", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, converters.MarshalPreferencesResponse(resp))
+}
+
+// PUT /api/notifications/preferences
+func (h *NotificationHandler) UpdatePreferences(c *gin.Context) {
+	userIDInt, ok := h.requireUserID(c)
+	if !ok {
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "update_notification_preferences"),
+		zap.Int64("user_id", userIDInt),
+	)
+
+	var request struct {
+		Email       string            `json:"email"`
+		Preferences map[string]string `json:"preferences"`
+	}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Warn("Invalid update preferences request",
+			zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	req := &pb.UpdatePreferencesRequest{UserId: userIDInt, Email: request.Email}
+	for notificationType, channel := range request.Preferences {
+		req.Preferences = append(req.Preferences, &pb.Preference{Type: notificationType, Channel: channel})
+	}
+
+	logger.Debug("Update notification preferences request")
+	resp, err := h.notificationClient.UpdatePreferences(c.Request.Context(), req)
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to update notification preferences", err)
+		return
+	}
+
+	logger.Info("Notification preferences updated")
+	c.JSON(http.StatusOK, converters.MarshalPreferencesResponse(resp))
+}
+
+func (h *NotificationHandler) requireUserID(c *gin.Context) (int64, bool) {
+	userID, exists := c.Get("userId")
+	if !exists {
+		requestLogger(c, h.logger).Warn("Authentication required for notifications")
+		apierror.Write(c, http.StatusUnauthorized, apierror.CodeUnauthenticated, "authentication required")
+		return 0, false
+	}
+
+	userIDInt, ok := userID.(int64)
+	if !ok {
+		requestLogger(c, h.logger).Warn("Wrong userId type in authorization header")
+		apierror.Write(c, http.StatusUnauthorized, apierror.CodeUnauthenticated, "authentication required: wrong userId type")
+		return 0, false
+	}
+
+	return userIDInt, true
+}
+
+func (h *NotificationHandler) notificationIDParam(c *gin.Context) (int64, bool) {
+	notificationIdStr := c.Param("notificationId")
+	notificationId, err := strconv.ParseInt(notificationIdStr, 10, 64)
+	if err != nil {
+		requestLogger(c, h.logger).Warn("Invalid notification ID",
+			zap.String("notification_id", notificationIdStr),
+			zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid notification ID")
+		return 0, false
+	}
+	return notificationId, true
+}
+
+func parseBoolQuery(c *gin.Context, key string) (bool, error) {
+	value := c.Query(key)
+	if value == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(value)
+}
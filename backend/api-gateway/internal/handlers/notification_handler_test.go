@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/clients/mocks"
@@ -13,6 +14,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/IAGrig/vt-csa-essays/backend/proto/notification"
 )
@@ -92,7 +95,8 @@ func TestNotificationHandler_GetUserNotifications(t *testing.T) {
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedBody: map[string]interface{}{
-				"error": assert.AnError.Error(),
+				"error": "internal error",
+				"code":  "INTERNAL",
 			},
 		},
 	}
@@ -144,6 +148,7 @@ func TestNotificationHandler_MarkAsRead(t *testing.T) {
 	tests := []struct {
 		name           string
 		notificationId string
+		userID         interface{}
 		setupMock      func(*mocks.MockNotificationClient)
 		expectedStatus int
 		expectedBody   map[string]interface{}
@@ -151,9 +156,11 @@ func TestNotificationHandler_MarkAsRead(t *testing.T) {
 		{
 			name:           "successful mark as read",
 			notificationId: "1",
+			userID:         int64(123),
 			setupMock: func(mockClient *mocks.MockNotificationClient) {
 				mockClient.On("MarkAsRead", mock.Anything, &pb.MarkAsReadRequest{
 					NotificationId: 1,
+					UserId:         123,
 				}).Return(&pb.MarkAsReadResponse{
 					Success: true,
 				}, nil)
@@ -166,18 +173,61 @@ func TestNotificationHandler_MarkAsRead(t *testing.T) {
 		{
 			name:           "invalid notification ID",
 			notificationId: "invalid",
+			userID:         int64(123),
 			setupMock:      func(mockClient *mocks.MockNotificationClient) {},
 			expectedStatus: http.StatusBadRequest,
 			expectedBody: map[string]interface{}{
 				"error": "invalid notification ID",
 			},
 		},
+		{
+			name:           "missing authentication",
+			notificationId: "1",
+			userID:         nil,
+			setupMock:      func(mockClient *mocks.MockNotificationClient) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: map[string]interface{}{
+				"error": "authentication required",
+			},
+		},
 		{
 			name:           "notification not found",
 			notificationId: "999",
+			userID:         int64(123),
+			setupMock: func(mockClient *mocks.MockNotificationClient) {
+				mockClient.On("MarkAsRead", mock.Anything, &pb.MarkAsReadRequest{
+					NotificationId: 999,
+					UserId:         123,
+				}).Return(nil, status.Error(codes.NotFound, "notification not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: map[string]interface{}{
+				"error": "notification not found",
+			},
+		},
+		{
+			name:           "notification belongs to another user",
+			notificationId: "1",
+			userID:         int64(456),
+			setupMock: func(mockClient *mocks.MockNotificationClient) {
+				mockClient.On("MarkAsRead", mock.Anything, &pb.MarkAsReadRequest{
+					NotificationId: 1,
+					UserId:         456,
+				}).Return(nil, status.Error(codes.PermissionDenied, "notification belongs to another user"))
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"error": "notification belongs to another user",
+			},
+		},
+		{
+			name:           "service reports failure",
+			notificationId: "999",
+			userID:         int64(123),
 			setupMock: func(mockClient *mocks.MockNotificationClient) {
 				mockClient.On("MarkAsRead", mock.Anything, &pb.MarkAsReadRequest{
 					NotificationId: 999,
+					UserId:         123,
 				}).Return(&pb.MarkAsReadResponse{
 					Success: false,
 				}, nil)
@@ -190,13 +240,15 @@ func TestNotificationHandler_MarkAsRead(t *testing.T) {
 		{
 			name:           "service error",
 			notificationId: "1",
+			userID:         int64(123),
 			setupMock: func(mockClient *mocks.MockNotificationClient) {
 				mockClient.On("MarkAsRead", mock.Anything, mock.Anything).
 					Return(nil, assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedBody: map[string]interface{}{
-				"error": assert.AnError.Error(),
+				"error": "internal error",
+				"code":  "INTERNAL",
 			},
 		},
 	}
@@ -218,6 +270,10 @@ func TestNotificationHandler_MarkAsRead(t *testing.T) {
 			c.Request = req
 			c.Params = gin.Params{gin.Param{Key: "notificationId", Value: tt.notificationId}}
 
+			if tt.userID != nil {
+				c.Set("userId", tt.userID)
+			}
+
 			handler.MarkAsRead(c)
 
 			assert.Equal(t, tt.expectedStatus, w.Code)
@@ -295,7 +351,8 @@ func TestNotificationHandler_MarkAllAsRead(t *testing.T) {
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedBody: map[string]interface{}{
-				"error": assert.AnError.Error(),
+				"error": "internal error",
+				"code":  "INTERNAL",
 			},
 		},
 	}
@@ -338,3 +395,369 @@ func TestNotificationHandler_MarkAllAsRead(t *testing.T) {
 		})
 	}
 }
+
+func TestNotificationHandler_GetUserNotifications_Filters(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMock      func(*mocks.MockNotificationClient)
+		expectedStatus int
+	}{
+		{
+			name:  "passes unread and type filters",
+			query: "?unread=true&type=new_review",
+			setupMock: func(mockClient *mocks.MockNotificationClient) {
+				mockClient.On("GetByUserID", mock.Anything, &pb.GetByUserIDRequest{
+					UserId:     123,
+					UnreadOnly: true,
+					Type:       "new_review",
+				}).Return([]*pb.NotificationResponse{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "lists archived notifications",
+			query: "?archived=true",
+			setupMock: func(mockClient *mocks.MockNotificationClient) {
+				mockClient.On("GetByUserID", mock.Anything, &pb.GetByUserIDRequest{
+					UserId:   123,
+					Archived: true,
+				}).Return([]*pb.NotificationResponse{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid unread filter",
+			query:          "?unread=maybe",
+			setupMock:      func(mockClient *mocks.MockNotificationClient) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockNotificationClient := new(mocks.MockNotificationClient)
+			tt.setupMock(mockNotificationClient)
+
+			logger := logging.NewEmptyLogger()
+			handler := handlers.NewNotificationHandler(mockNotificationClient, logger)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			req, err := http.NewRequest(http.MethodGet, "/notifications"+tt.query, nil)
+			require.NoError(t, err)
+
+			c.Request = req
+			c.Set("userId", int64(123))
+
+			handler.GetUserNotifications(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			mockNotificationClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNotificationHandler_UnreadCount(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		userID         interface{}
+		setupMock      func(*mocks.MockNotificationClient)
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:   "returns unread count",
+			userID: int64(123),
+			setupMock: func(mockClient *mocks.MockNotificationClient) {
+				mockClient.On("UnreadCount", mock.Anything, &pb.UnreadCountRequest{
+					UserId: 123,
+				}).Return(&pb.UnreadCountResponse{Count: 7}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"count": float64(7),
+			},
+		},
+		{
+			name:           "missing authentication",
+			userID:         nil,
+			setupMock:      func(mockClient *mocks.MockNotificationClient) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: map[string]interface{}{
+				"error": "authentication required",
+			},
+		},
+		{
+			name:   "service error",
+			userID: int64(123),
+			setupMock: func(mockClient *mocks.MockNotificationClient) {
+				mockClient.On("UnreadCount", mock.Anything, mock.Anything).
+					Return(nil, assert.AnError)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: map[string]interface{}{
+				"error": "internal error",
+				"code":  "INTERNAL",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockNotificationClient := new(mocks.MockNotificationClient)
+			tt.setupMock(mockNotificationClient)
+
+			logger := logging.NewEmptyLogger()
+			handler := handlers.NewNotificationHandler(mockNotificationClient, logger)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			req, err := http.NewRequest(http.MethodGet, "/notifications/unread-count", nil)
+			require.NoError(t, err)
+
+			c.Request = req
+
+			if tt.userID != nil {
+				c.Set("userId", tt.userID)
+			}
+
+			handler.UnreadCount(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response map[string]interface{}
+			err = json.Unmarshal(w.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedBody {
+				assert.Equal(t, expectedValue, response[key])
+			}
+
+			mockNotificationClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNotificationHandler_ArchiveAndDelete(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		action         func(*handlers.NotificationHandler, *gin.Context)
+		notificationId string
+		setupMock      func(*mocks.MockNotificationClient)
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:           "archive owned notification",
+			action:         (*handlers.NotificationHandler).Archive,
+			notificationId: "1",
+			setupMock: func(mockClient *mocks.MockNotificationClient) {
+				mockClient.On("Archive", mock.Anything, &pb.ArchiveRequest{
+					NotificationId: 1,
+					UserId:         123,
+				}).Return(&pb.ArchiveResponse{Success: true}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"success": true,
+			},
+		},
+		{
+			name:           "archive another user's notification",
+			action:         (*handlers.NotificationHandler).Archive,
+			notificationId: "1",
+			setupMock: func(mockClient *mocks.MockNotificationClient) {
+				mockClient.On("Archive", mock.Anything, mock.Anything).
+					Return(nil, status.Error(codes.PermissionDenied, "notification belongs to another user"))
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"error": "notification belongs to another user",
+			},
+		},
+		{
+			name:           "delete owned notification",
+			action:         (*handlers.NotificationHandler).Delete,
+			notificationId: "2",
+			setupMock: func(mockClient *mocks.MockNotificationClient) {
+				mockClient.On("Delete", mock.Anything, &pb.DeleteRequest{
+					NotificationId: 2,
+					UserId:         123,
+				}).Return(&pb.DeleteResponse{Success: true}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"success": true,
+			},
+		},
+		{
+			name:           "delete missing notification",
+			action:         (*handlers.NotificationHandler).Delete,
+			notificationId: "999",
+			setupMock: func(mockClient *mocks.MockNotificationClient) {
+				mockClient.On("Delete", mock.Anything, mock.Anything).
+					Return(nil, status.Error(codes.NotFound, "notification not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: map[string]interface{}{
+				"error": "notification not found",
+			},
+		},
+		{
+			name:           "delete with invalid notification ID",
+			action:         (*handlers.NotificationHandler).Delete,
+			notificationId: "invalid",
+			setupMock:      func(mockClient *mocks.MockNotificationClient) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"error": "invalid notification ID",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockNotificationClient := new(mocks.MockNotificationClient)
+			tt.setupMock(mockNotificationClient)
+
+			logger := logging.NewEmptyLogger()
+			handler := handlers.NewNotificationHandler(mockNotificationClient, logger)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			req, err := http.NewRequest(http.MethodPost, "/notifications/"+tt.notificationId, nil)
+			require.NoError(t, err)
+
+			c.Request = req
+			c.Params = gin.Params{gin.Param{Key: "notificationId", Value: tt.notificationId}}
+			c.Set("userId", int64(123))
+
+			tt.action(handler, c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response map[string]interface{}
+			err = json.Unmarshal(w.Body.Bytes(), &response)
+			require.NoError(t, err)
+
+			for key, expectedValue := range tt.expectedBody {
+				assert.Equal(t, expectedValue, response[key])
+			}
+
+			mockNotificationClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNotificationHandler_BulkArchiveAndDelete(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockNotificationClient := new(mocks.MockNotificationClient)
+	mockNotificationClient.On("ArchiveAll", mock.Anything, &pb.ArchiveAllRequest{
+		UserId:   123,
+		ReadOnly: true,
+	}).Return(&pb.ArchiveAllResponse{Success: true, Affected: 3}, nil)
+	mockNotificationClient.On("DeleteAll", mock.Anything, &pb.DeleteAllRequest{
+		UserId: 123,
+	}).Return(&pb.DeleteAllResponse{Success: true, Affected: 5}, nil)
+
+	logger := logging.NewEmptyLogger()
+	handler := handlers.NewNotificationHandler(mockNotificationClient, logger)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodPost, "/notifications/archive-all?read_only=true", nil)
+	c.Set("userId", int64(123))
+
+	handler.ArchiveAll(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"success": true, "affected": 3}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodDelete, "/notifications", nil)
+	c.Set("userId", int64(123))
+
+	handler.DeleteAll(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"success": true, "affected": 5}`, w.Body.String())
+
+	mockNotificationClient.AssertExpectations(t)
+}
+
+func TestNotificationHandler_Preferences(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	stored := &pb.PreferencesResponse{
+		UserId: 123,
+		Email:  "user@example.com",
+		Preferences: []*pb.Preference{
+			{Type: "new_review", Channel: "email_digest"},
+		},
+	}
+
+	mockNotificationClient := new(mocks.MockNotificationClient)
+	mockNotificationClient.On("GetPreferences", mock.Anything, &pb.GetPreferencesRequest{UserId: 123}).
+		Return(stored, nil)
+	mockNotificationClient.On("UpdatePreferences", mock.Anything, &pb.UpdatePreferencesRequest{
+		UserId:      123,
+		Email:       "user@example.com",
+		Preferences: []*pb.Preference{{Type: "new_review", Channel: "email_digest"}},
+	}).Return(stored, nil)
+	mockNotificationClient.On("UpdatePreferences", mock.Anything, &pb.UpdatePreferencesRequest{
+		UserId:      123,
+		Preferences: []*pb.Preference{{Type: "new_review", Channel: "sms"}},
+	}).Return(nil, status.Error(codes.InvalidArgument, `unknown channel "sms"`))
+
+	logger := logging.NewEmptyLogger()
+	handler := handlers.NewNotificationHandler(mockNotificationClient, logger)
+	expected := `{"email": "user@example.com", "preferences": {"new_review": "email_digest"}}`
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/notifications/preferences", nil)
+	c.Set("userId", int64(123))
+
+	handler.GetPreferences(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, expected, w.Body.String())
+
+	w = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodPut, "/notifications/preferences",
+		strings.NewReader(`{"email": "user@example.com", "preferences": {"new_review": "email_digest"}}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Set("userId", int64(123))
+
+	handler.UpdatePreferences(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, expected, w.Body.String())
+
+	w = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodPut, "/notifications/preferences",
+		strings.NewReader(`{"preferences": {"new_review": "sms"}}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Set("userId", int64(123))
+
+	handler.UpdatePreferences(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.JSONEq(t, `{"error": "unknown channel \"sms\"", "code": "INVALID_ARGUMENT"}`, w.Body.String())
+
+	mockNotificationClient.AssertExpectations(t)
+}
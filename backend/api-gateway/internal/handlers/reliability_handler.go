@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/apierror"
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/converters"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+)
+
+// GET /api/reliability
+func (h *ReviewHandler) GetReviewerReliability(c *gin.Context) {
+	logger := requestLogger(c, h.logger).With(zap.String("operation", "get_reviewer_reliability"))
+
+	reliability, err := h.reviewClient.GetReviewerReliability(c.Request.Context(), &pb.EmptyRequest{})
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to get reviewer reliability", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, marshalReviewerReliability(reliability))
+}
+
+// POST /api/reliability/recompute
+func (h *ReviewHandler) RecomputeReliability(c *gin.Context) {
+	logger := requestLogger(c, h.logger).With(zap.String("operation", "recompute_reliability"))
+
+	reliability, err := h.reviewClient.RecomputeReliability(c.Request.Context(), &pb.EmptyRequest{})
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to recompute reviewer reliability", err)
+		return
+	}
+
+	logger.Info("Reviewer reliability recomputed",
+		zap.Int("count", len(reliability)))
+	c.JSON(http.StatusOK, marshalReviewerReliability(reliability))
+}
+
+// PUT /api/calibration/:essayId
+func (h *ReviewHandler) SetCalibration(c *gin.Context) {
+	essayId, ok := h.parseGradeEssayId(c)
+	if !ok {
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "set_calibration"),
+		zap.Int("essay_id", essayId),
+	)
+
+	var request struct {
+		ReferenceRank int32 `json:"reference_rank" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Warn("Invalid calibration request",
+			zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	username, exists := c.Get("username")
+	if !exists {
+		logger.Warn("Authentication required for calibration")
+		apierror.Write(c, http.StatusUnauthorized, apierror.CodeUnauthenticated, "authentication required")
+		return
+	}
+
+	logger = logger.With(zap.String("username", username.(string)))
+	resp, err := h.reviewClient.SetCalibration(
+		c.Request.Context(),
+		&pb.SetCalibrationRequest{
+			EssayId:       int32(essayId),
+			ReferenceRank: request.ReferenceRank,
+			RequestedBy:   username.(string),
+		},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to set calibration reference", err)
+		return
+	}
+
+	logger.Info("Calibration reference set successfully")
+	c.JSON(http.StatusOK, converters.MarshalCalibrationResponse(resp))
+}
+
+// DELETE /api/calibration/:essayId
+func (h *ReviewHandler) RemoveCalibration(c *gin.Context) {
+	essayId, ok := h.parseGradeEssayId(c)
+	if !ok {
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "remove_calibration"),
+		zap.Int("essay_id", essayId),
+	)
+
+	username, exists := c.Get("username")
+	if !exists {
+		logger.Warn("Authentication required for calibration removal")
+		apierror.Write(c, http.StatusUnauthorized, apierror.CodeUnauthenticated, "authentication required")
+		return
+	}
+
+	logger = logger.With(zap.String("username", username.(string)))
+	resp, err := h.reviewClient.RemoveCalibration(
+		c.Request.Context(),
+		&pb.RemoveCalibrationRequest{EssayId: int32(essayId), RequestedBy: username.(string)},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to remove calibration reference", err)
+		return
+	}
+
+	logger.Info("Calibration reference removed successfully")
+	c.JSON(http.StatusOK, converters.MarshalCalibrationResponse(resp))
+}
+
+func marshalReviewerReliability(reliability []*pb.ReviewerReliabilityResponse) []gin.H {
+	result := make([]gin.H, 0, len(reliability))
+	for _, r := range reliability {
+		result = append(result, converters.MarshalReviewerReliabilityResponse(r))
+	}
+	return result
+}
@@ -0,0 +1,105 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/clients/mocks"
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/handlers"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+)
+
+func TestReviewHandler_ReviewerReliability(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	scores := []*pb.ReviewerReliabilityResponse{
+		{Reviewer: "harsh", Score: 0.1, ConsensusReviews: 4},
+		{Reviewer: "agreeing", Score: 0.9, ConsensusReviews: 5, CalibrationReviews: 2},
+	}
+	mockReviewClient := new(mocks.MockReviewClient)
+	mockReviewClient.On("GetReviewerReliability", mock.Anything, &pb.EmptyRequest{}).Return(scores, nil)
+	mockReviewClient.On("RecomputeReliability", mock.Anything, &pb.EmptyRequest{}).
+		Return(nil, errors.New("database unavailable"))
+
+	router := newReviewRouter(handlers.NewReviewHandler(mockReviewClient, logging.NewEmptyLogger()), "teacher")
+
+	req, err := http.NewRequest(http.MethodGet, "/reliability", nil)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var response []map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Len(t, response, 2)
+	assert.Equal(t, "harsh", response[0]["reviewer"])
+	assert.Equal(t, 0.9, response[1]["score"])
+
+	req, err = http.NewRequest(http.MethodPost, "/reliability/recompute", nil)
+	require.NoError(t, err)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+	mockReviewClient.AssertExpectations(t)
+}
+
+func TestReviewHandler_Calibration(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockReviewClient := new(mocks.MockReviewClient)
+	mockReviewClient.On("SetCalibration", mock.Anything, &pb.SetCalibrationRequest{EssayId: 1, ReferenceRank: 2, RequestedBy: "teacher"}).
+		Return(&pb.CalibrationResponse{EssayId: 1, ReferenceRank: 2, CreatedBy: "teacher"}, nil)
+	mockReviewClient.On("SetCalibration", mock.Anything, &pb.SetCalibrationRequest{EssayId: 1, ReferenceRank: 5, RequestedBy: "teacher"}).
+		Return(nil, status.Error(codes.InvalidArgument, "reference rank must be between 1 and 3"))
+	mockReviewClient.On("SetCalibration", mock.Anything, &pb.SetCalibrationRequest{EssayId: 2, ReferenceRank: 2, RequestedBy: "teacher"}).
+		Return(nil, status.Error(codes.PermissionDenied, "only the assignment creator can calibrate its essays"))
+	mockReviewClient.On("RemoveCalibration", mock.Anything, &pb.RemoveCalibrationRequest{EssayId: 1, RequestedBy: "teacher"}).
+		Return(&pb.CalibrationResponse{EssayId: 1, ReferenceRank: 2, CreatedBy: "teacher"}, nil)
+	mockReviewClient.On("RemoveCalibration", mock.Anything, &pb.RemoveCalibrationRequest{EssayId: 3, RequestedBy: "teacher"}).
+		Return(nil, status.Error(codes.NotFound, "calibration essay not found"))
+
+	router := newReviewRouter(handlers.NewReviewHandler(mockReviewClient, logging.NewEmptyLogger()), "teacher")
+
+	for _, tc := range []struct {
+		method         string
+		path           string
+		body           string
+		expectedStatus int
+	}{
+		{http.MethodPut, "/calibration/1", `{"reference_rank": 2}`, http.StatusOK},
+		{http.MethodPut, "/calibration/1", `{"reference_rank": 5}`, http.StatusBadRequest},
+		{http.MethodPut, "/calibration/2", `{"reference_rank": 2}`, http.StatusForbidden},
+		{http.MethodPut, "/calibration/1", `{}`, http.StatusBadRequest},
+		{http.MethodPut, "/calibration/abc", `{"reference_rank": 2}`, http.StatusBadRequest},
+		{http.MethodDelete, "/calibration/1", "", http.StatusOK},
+		{http.MethodDelete, "/calibration/3", "", http.StatusNotFound},
+	} {
+		req, err := http.NewRequest(tc.method, tc.path, bytes.NewBufferString(tc.body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, tc.expectedStatus, w.Code, tc.method+" "+tc.path+" "+tc.body)
+		if tc.expectedStatus == http.StatusOK {
+			var response map[string]interface{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, float64(2), response["reference_rank"])
+		}
+	}
+
+	mockReviewClient.AssertExpectations(t)
+}
@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/apierror"
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/converters"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+)
+
+// POST /api/reviews/:reviewId/replies
+func (h *ReviewHandler) AddReply(c *gin.Context) {
+	reviewIdStr := c.Param("reviewId")
+	reviewId, err := strconv.Atoi(reviewIdStr)
+	if err != nil {
+		requestLogger(c, h.logger).Warn("Invalid review ID",
+			zap.String("review_id", reviewIdStr),
+			zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid review ID")
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "add_reply"),
+		zap.Int("review_id", reviewId),
+	)
+
+	var request struct {
+		Content  string `json:"content" binding:"required"`
+		ParentId int64  `json:"parent_id"`
+	}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Warn("Invalid add reply request",
+			zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	username, exists := c.Get("username")
+	if !exists {
+		logger.Warn("Authentication required for reply creation")
+		apierror.Write(c, http.StatusUnauthorized, apierror.CodeUnauthenticated, "authentication required")
+		return
+	}
+
+	logger = logger.With(zap.String("username", username.(string)))
+	resp, err := h.reviewClient.AddReply(
+		c.Request.Context(),
+		&pb.AddReplyRequest{
+			ReviewId: int32(reviewId),
+			ParentId: request.ParentId,
+			Author:   username.(string),
+			Content:  request.Content,
+		},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to add reply", err)
+		return
+	}
+
+	logger.Info("Reply added successfully",
+		zap.Int64("reply_id", resp.Id))
+	c.JSON(http.StatusCreated, converters.MarshalReplyResponse(resp))
+}
+
+// GET /api/reviews/:reviewId/replies
+func (h *ReviewHandler) GetReplies(c *gin.Context) {
+	// gin wildcards at the same position must share a name, so this route
+	// reuses the :essayId segment of GET /api/reviews/:essayId
+	reviewIdStr := c.Param("essayId")
+	reviewId, err := strconv.Atoi(reviewIdStr)
+	if err != nil {
+		requestLogger(c, h.logger).Warn("Invalid review ID",
+			zap.String("review_id", reviewIdStr),
+			zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid review ID")
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "get_replies"),
+		zap.Int("review_id", reviewId),
+	)
+
+	resp, err := h.reviewClient.GetReplies(
+		c.Request.Context(),
+		&pb.GetRepliesRequest{ReviewId: int32(reviewId)},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to get replies", err)
+		return
+	}
+
+	viewer := viewerFrom(c)
+	replies := make([]gin.H, 0, len(resp))
+	for _, reply := range resp {
+		converters.AnonymizeReply(reply, viewer)
+		replies = append(replies, converters.MarshalReplyResponse(reply))
+	}
+
+	logger.Debug("Retrieved replies",
+		zap.Int("count", len(replies)))
+	c.JSON(http.StatusOK, replies)
+}
+
+// DELETE /api/reviews/:reviewId/replies/:replyId
+func (h *ReviewHandler) RemoveReply(c *gin.Context) {
+	replyIdStr := c.Param("replyId")
+	replyId, err := strconv.ParseInt(replyIdStr, 10, 64)
+	if err != nil {
+		requestLogger(c, h.logger).Warn("Invalid reply ID",
+			zap.String("reply_id", replyIdStr),
+			zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid reply ID")
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "remove_reply"),
+		zap.Int64("reply_id", replyId),
+	)
+
+	username, exists := c.Get("username")
+	if !exists {
+		logger.Warn("Authentication required for reply removal")
+		apierror.Write(c, http.StatusUnauthorized, apierror.CodeUnauthenticated, "authentication required")
+		return
+	}
+
+	resp, err := h.reviewClient.RemoveReply(
+		c.Request.Context(),
+		&pb.RemoveReplyRequest{Id: replyId, Author: username.(string)},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to remove reply", err)
+		return
+	}
+
+	logger.Info("Reply removed successfully")
+	c.JSON(http.StatusOK, converters.MarshalReplyResponse(resp))
+}
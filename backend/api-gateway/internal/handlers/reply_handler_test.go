@@ -0,0 +1,236 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/clients/mocks"
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/handlers"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+)
+
+func TestReviewHandler_AddReply(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		reviewId       string
+		requestBody    string
+		setupMock      func(*mocks.MockReviewClient)
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:        "essay author replies",
+			reviewId:    "3",
+			requestBody: `{"content": "Thanks!", "parent_id": 7}`,
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("AddReply", mock.Anything, &pb.AddReplyRequest{
+					ReviewId: 3,
+					ParentId: 7,
+					Author:   "author",
+					Content:  "Thanks!",
+				}).Return(&pb.ReplyResponse{Id: 8, ReviewId: 3, ParentId: 7, Author: "author", Content: "Thanks!"}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: map[string]interface{}{
+				"id":        float64(8),
+				"parent_id": float64(7),
+				"content":   "Thanks!",
+			},
+		},
+		{
+			name:           "missing content",
+			reviewId:       "3",
+			requestBody:    `{}`,
+			setupMock:      func(mockClient *mocks.MockReviewClient) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid review ID",
+			reviewId:       "abc",
+			requestBody:    `{"content": "Thanks!"}`,
+			setupMock:      func(mockClient *mocks.MockReviewClient) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "outsider is forbidden",
+			reviewId:    "3",
+			requestBody: `{"content": "Hi"}`,
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("AddReply", mock.Anything, mock.Anything).
+					Return(nil, status.Error(codes.PermissionDenied, "only the essay author and the reviewer can reply"))
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"error": "only the essay author and the reviewer can reply",
+			},
+		},
+		{
+			name:        "review not found",
+			reviewId:    "5",
+			requestBody: `{"content": "Hi"}`,
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("AddReply", mock.Anything, mock.Anything).
+					Return(nil, status.Error(codes.NotFound, "review not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:        "nesting too deep",
+			reviewId:    "3",
+			requestBody: `{"content": "Hi", "parent_id": 8}`,
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("AddReply", mock.Anything, mock.Anything).
+					Return(nil, status.Error(codes.InvalidArgument, "replies can be nested only one level deep"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReviewClient := new(mocks.MockReviewClient)
+			tt.setupMock(mockReviewClient)
+
+			handler := handlers.NewReviewHandler(mockReviewClient, logging.NewEmptyLogger())
+
+			router := gin.New()
+			router.POST("/reviews/:reviewId/replies", func(c *gin.Context) {
+				c.Set("username", "author")
+			}, handler.AddReply)
+
+			req, err := http.NewRequest(http.MethodPost, "/reviews/"+tt.reviewId+"/replies", bytes.NewBufferString(tt.requestBody))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedBody != nil {
+				var response map[string]interface{}
+				err = json.Unmarshal(w.Body.Bytes(), &response)
+				require.NoError(t, err)
+
+				for key, expectedValue := range tt.expectedBody {
+					assert.Equal(t, expectedValue, response[key])
+				}
+			}
+
+			mockReviewClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReviewHandler_GetReplies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockReviewClient := new(mocks.MockReviewClient)
+	mockReviewClient.On("GetReplies", mock.Anything, &pb.GetRepliesRequest{ReviewId: 3}).
+		Return([]*pb.ReplyResponse{
+			{Id: 7, ReviewId: 3, Author: "author", Content: "Thanks!"},
+			{Id: 8, ReviewId: 3, ParentId: 7, Author: "reviewer", Content: "You're welcome"},
+		}, nil)
+
+	handler := handlers.NewReviewHandler(mockReviewClient, logging.NewEmptyLogger())
+
+	router := gin.New()
+	router.GET("/reviews/:essayId", handler.GetByEssayId)
+	router.GET("/reviews/:essayId/replies", handler.GetReplies)
+
+	req, err := http.NewRequest(http.MethodGet, "/reviews/3/replies", nil)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response []map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Len(t, response, 2)
+	assert.Equal(t, float64(7), response[1]["parent_id"])
+
+	mockReviewClient.AssertExpectations(t)
+}
+
+func TestReviewHandler_RemoveReply(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		replyId        string
+		setupMock      func(*mocks.MockReviewClient)
+		expectedStatus int
+	}{
+		{
+			name:    "author removes own reply",
+			replyId: "7",
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("RemoveReply", mock.Anything, &pb.RemoveReplyRequest{Id: 7, Author: "author"}).
+					Return(&pb.ReplyResponse{Id: 7, ReviewId: 3, Author: "author"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:    "someone else's reply",
+			replyId: "8",
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("RemoveReply", mock.Anything, &pb.RemoveReplyRequest{Id: 8, Author: "author"}).
+					Return(nil, status.Error(codes.PermissionDenied, "you can delete only your own replies"))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:    "reply not found",
+			replyId: "9",
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("RemoveReply", mock.Anything, &pb.RemoveReplyRequest{Id: 9, Author: "author"}).
+					Return(nil, status.Error(codes.NotFound, "reply not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "invalid reply ID",
+			replyId:        "abc",
+			setupMock:      func(mockClient *mocks.MockReviewClient) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReviewClient := new(mocks.MockReviewClient)
+			tt.setupMock(mockReviewClient)
+
+			handler := handlers.NewReviewHandler(mockReviewClient, logging.NewEmptyLogger())
+
+			router := gin.New()
+			router.DELETE("/reviews/:reviewId/replies/:replyId", func(c *gin.Context) {
+				c.Set("username", "author")
+			}, handler.RemoveReply)
+
+			req, err := http.NewRequest(http.MethodDelete, "/reviews/3/replies/"+tt.replyId, nil)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			mockReviewClient.AssertExpectations(t)
+		})
+	}
+}
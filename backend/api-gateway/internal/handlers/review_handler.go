@@ -1,9 +1,12 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/apierror"
 	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/clients"
 	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/converters"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
@@ -27,25 +30,53 @@ func NewReviewHandler(reviewClient clients.ReviewClient, logger *logging.Logger)
 
 // POST /api/reviews
 func (h *ReviewHandler) CreateReview(c *gin.Context) {
-	logger := h.logger.With(zap.String("operation", "create_review"))
+	logger := requestLogger(c, h.logger).With(zap.String("operation", "create_review"))
 
 	var request struct {
 		EssayId       int32  `json:"essay_id" binding:"required"`
 		EssayAuthorId int32  `json:"essay_author_id" binding:"required"`
-		Rank          int32  `json:"rank" binding:"required"`
+		Rank          int32  `json:"rank"`
 		Content       string `json:"content" binding:"required"`
+		RubricId      int64  `json:"rubric_id"`
+		Scores        []struct {
+			CriterionId int64  `json:"criterion_id" binding:"required"`
+			Score       int32  `json:"score"`
+			Comment     string `json:"comment"`
+		} `json:"scores" binding:"dive"`
+		EssayRevision int32 `json:"essay_revision"`
+		Comments      []struct {
+			StartOffset int32  `json:"start_offset"`
+			EndOffset   int32  `json:"end_offset" binding:"required"`
+			Quote       string `json:"quote"`
+			Content     string `json:"content" binding:"required"`
+		} `json:"comments" binding:"dive"`
 	}
 	if err := c.ShouldBindJSON(&request); err != nil {
 		logger.Warn("Invalid create review request",
 			zap.Error(err))
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, err.Error())
 		return
 	}
+	// scores without a rubric_id are for the rubric of the essay's assignment
+	if request.RubricId == 0 && request.Rank == 0 && len(request.Scores) == 0 {
+		logger.Warn("Review without rank and rubric")
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, "rank is required")
+		return
+	}
+
+	var scores []*pb.CriterionScore
+	for _, score := range request.Scores {
+		scores = append(scores, &pb.CriterionScore{
+			CriterionId: score.CriterionId,
+			Score:       score.Score,
+			Comment:     score.Comment,
+		})
+	}
 
 	username, exists := c.Get("username")
 	if !exists {
 		logger.Warn("Authentication required for review creation")
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		apierror.Write(c, http.StatusUnauthorized, apierror.CodeUnauthenticated, "authentication required")
 		return
 	}
 
@@ -55,6 +86,16 @@ func (h *ReviewHandler) CreateReview(c *gin.Context) {
 		zap.Int32("essay_id", request.EssayId),
 	)
 
+	var comments []*pb.InlineComment
+	for _, comment := range request.Comments {
+		comments = append(comments, &pb.InlineComment{
+			StartOffset: comment.StartOffset,
+			EndOffset:   comment.EndOffset,
+			Quote:       comment.Quote,
+			Content:     comment.Content,
+		})
+	}
+
 	logger.Info("Creating review")
 	resp, err := h.reviewClient.CreateReview(
 		c.Request.Context(),
@@ -64,12 +105,14 @@ func (h *ReviewHandler) CreateReview(c *gin.Context) {
 			Rank:          request.Rank,
 			Content:       request.Content,
 			Author:        usernameStr,
+			RubricId:      request.RubricId,
+			Scores:        scores,
+			EssayRevision: request.EssayRevision,
+			Comments:      comments,
 		},
 	)
 	if err != nil {
-		logger.Error("Failed to create review",
-			zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeGrpcError(c, logger, "Failed to create review", err)
 		return
 	}
 
@@ -80,19 +123,44 @@ func (h *ReviewHandler) CreateReview(c *gin.Context) {
 
 // GET /api/reviews
 func (h *ReviewHandler) GetAllReviews(c *gin.Context) {
-	logger := h.logger.With(zap.String("operation", "get_all_reviews"))
+	logger := requestLogger(c, h.logger).With(zap.String("operation", "get_all_reviews"))
 
-	logger.Debug("Get all reviews request")
-	resp, err := h.reviewClient.GetAllReviews(c.Request.Context(), &pb.EmptyRequest{})
+	query, err := parseReviewQuery(c)
 	if err != nil {
-		logger.Error("Failed to get all reviews",
+		logger.Warn("Invalid review filters",
 			zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, err.Error())
+		return
+	}
+	logger = logger.With(
+		zap.String("author", query.Author),
+		zap.Int32("essay_id", query.EssayId),
+		zap.Int32("min_rank", query.MinRank),
+	)
+
+	logger.Debug("Get all reviews request")
+	viewer := viewerFrom(c)
+	var resp []*pb.ReviewResponse
+	switch {
+	case query.Author != "":
+		query.IncludeAnonymous = viewer.SeesIdentityOf(query.Author)
+		resp, err = h.reviewClient.GetByAuthor(c.Request.Context(), query)
+	case query.EssayId != 0:
+		resp, err = h.reviewClient.GetByEssayId(c.Request.Context(), &pb.GetByEssayIdRequest{EssayId: query.EssayId})
+	default:
+		resp, err = h.reviewClient.GetAllReviews(c.Request.Context(), &pb.EmptyRequest{})
+	}
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to get all reviews", err)
 		return
 	}
 
 	var reviews []gin.H
 	for _, review := range resp {
+		if review.Rank < query.MinRank {
+			continue
+		}
+		converters.AnonymizeReview(review, viewer)
 		reviews = append(reviews, converters.MarshalReviewResponse(review))
 	}
 
@@ -101,19 +169,49 @@ func (h *ReviewHandler) GetAllReviews(c *gin.Context) {
 	c.JSON(http.StatusOK, reviews)
 }
 
+// Filters of the review listing, pagination is only supported together with author
+func parseReviewQuery(c *gin.Context) (*pb.GetByAuthorRequest, error) {
+	query := &pb.GetByAuthorRequest{Author: c.Query("author")}
+	for _, param := range []struct {
+		name  string
+		value *int32
+		min   int
+	}{
+		{"essay_id", &query.EssayId, 1},
+		{"min_rank", &query.MinRank, 1},
+		{"limit", &query.Limit, 1},
+		{"offset", &query.Offset, 0},
+	} {
+		raw, ok := c.GetQuery(param.name)
+		if !ok {
+			continue
+		}
+		value, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil || int(value) < param.min {
+			return nil, fmt.Errorf("invalid %s", param.name)
+		}
+		*param.value = int32(value)
+	}
+
+	if query.Author == "" && (query.Limit != 0 || query.Offset != 0) {
+		return nil, errors.New("limit and offset require author")
+	}
+	return query, nil
+}
+
 // GET /api/reviews/:essayId
 func (h *ReviewHandler) GetByEssayId(c *gin.Context) {
 	essayIdStr := c.Param("essayId")
 	essayId, err := strconv.Atoi(essayIdStr)
 	if err != nil {
-		h.logger.Warn("Invalid essay ID",
+		requestLogger(c, h.logger).Warn("Invalid essay ID",
 			zap.String("essay_id", essayIdStr),
 			zap.Error(err))
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid essay ID"})
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid essay ID")
 		return
 	}
 
-	logger := h.logger.With(
+	logger := requestLogger(c, h.logger).With(
 		zap.String("operation", "get_reviews_by_essay_id"),
 		zap.Int("essay_id", essayId),
 	)
@@ -124,14 +222,14 @@ func (h *ReviewHandler) GetByEssayId(c *gin.Context) {
 		&pb.GetByEssayIdRequest{EssayId: int32(essayId)},
 	)
 	if err != nil {
-		logger.Warn("Reviews not found for essay",
-			zap.Error(err))
-		c.JSON(http.StatusNotFound, gin.H{"error": "reviews not found"})
+		writeGrpcError(c, logger, "Failed to get reviews for essay", err)
 		return
 	}
 
+	viewer := viewerFrom(c)
 	var reviews []gin.H
 	for _, review := range resp {
+		converters.AnonymizeReview(review, viewer)
 		reviews = append(reviews, converters.MarshalReviewResponse(review))
 	}
 
@@ -145,14 +243,14 @@ func (h *ReviewHandler) RemoveById(c *gin.Context) {
 	reviewIdStr := c.Param("reviewId")
 	reviewId, err := strconv.Atoi(reviewIdStr)
 	if err != nil {
-		h.logger.Warn("Invalid review ID",
+		requestLogger(c, h.logger).Warn("Invalid review ID",
 			zap.String("review_id", reviewIdStr),
 			zap.Error(err))
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid review ID"})
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid review ID")
 		return
 	}
 
-	logger := h.logger.With(
+	logger := requestLogger(c, h.logger).With(
 		zap.String("operation", "remove_review_by_id"),
 		zap.Int("review_id", reviewId),
 	)
@@ -163,12 +261,144 @@ func (h *ReviewHandler) RemoveById(c *gin.Context) {
 		&pb.RemoveByIdRequest{Id: int32(reviewId)},
 	)
 	if err != nil {
-		logger.Error("Failed to delete review",
-			zap.Error(err))
-		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		writeGrpcError(c, logger, "Failed to delete review", err)
 		return
 	}
 
 	logger.Info("Review deleted successfully")
 	c.JSON(http.StatusOK, converters.MarshalReviewResponse(resp))
 }
+
+// PUT /api/reviews/:reviewId
+func (h *ReviewHandler) UpdateReview(c *gin.Context) {
+	reviewIdStr := c.Param("reviewId")
+	reviewId, err := strconv.Atoi(reviewIdStr)
+	if err != nil {
+		requestLogger(c, h.logger).Warn("Invalid review ID",
+			zap.String("review_id", reviewIdStr),
+			zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid review ID")
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "update_review"),
+		zap.Int("review_id", reviewId),
+	)
+
+	var request struct {
+		Rank    int32  `json:"rank"`
+		Content string `json:"content" binding:"required"`
+		Scores  []struct {
+			CriterionId int64  `json:"criterion_id" binding:"required"`
+			Score       int32  `json:"score"`
+			Comment     string `json:"comment"`
+		} `json:"scores" binding:"dive"`
+	}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Warn("Invalid update review request",
+			zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	var scores []*pb.CriterionScore
+	for _, score := range request.Scores {
+		scores = append(scores, &pb.CriterionScore{
+			CriterionId: score.CriterionId,
+			Score:       score.Score,
+			Comment:     score.Comment,
+		})
+	}
+
+	username, exists := c.Get("username")
+	if !exists {
+		logger.Warn("Authentication required for review update")
+		apierror.Write(c, http.StatusUnauthorized, apierror.CodeUnauthenticated, "authentication required")
+		return
+	}
+
+	logger = logger.With(zap.String("username", username.(string)))
+	resp, err := h.reviewClient.UpdateReview(
+		c.Request.Context(),
+		&pb.UpdateReviewRequest{
+			Id:      int32(reviewId),
+			Author:  username.(string),
+			Rank:    request.Rank,
+			Content: request.Content,
+			Scores:  scores,
+		},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to update review", err)
+		return
+	}
+
+	logger.Info("Review updated successfully")
+	c.JSON(http.StatusOK, converters.MarshalReviewResponse(resp))
+}
+
+// GET /api/reviews/by-id/:reviewId/history
+func (h *ReviewHandler) GetReviewHistory(c *gin.Context) {
+	reviewIdStr := c.Param("reviewId")
+	reviewId, err := strconv.Atoi(reviewIdStr)
+	if err != nil {
+		requestLogger(c, h.logger).Warn("Invalid review ID",
+			zap.String("review_id", reviewIdStr),
+			zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid review ID")
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "get_review_history"),
+		zap.Int("review_id", reviewId),
+	)
+
+	resp, err := h.reviewClient.GetReviewHistory(
+		c.Request.Context(),
+		&pb.GetReviewHistoryRequest{ReviewId: int32(reviewId)},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to get review history", err)
+		return
+	}
+
+	versions := make([]gin.H, 0, len(resp))
+	for _, version := range resp {
+		versions = append(versions, converters.MarshalReviewVersionResponse(version))
+	}
+
+	logger.Debug("Retrieved review history",
+		zap.Int("count", len(versions)))
+	c.JSON(http.StatusOK, versions)
+}
+
+// GET /api/reviews/:essayId/stats
+func (h *ReviewHandler) GetEssayStats(c *gin.Context) {
+	essayIdStr := c.Param("essayId")
+	essayId, err := strconv.Atoi(essayIdStr)
+	if err != nil {
+		requestLogger(c, h.logger).Warn("Invalid essay ID",
+			zap.String("essay_id", essayIdStr),
+			zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid essay ID")
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "get_essay_stats"),
+		zap.Int("essay_id", essayId),
+	)
+
+	resp, err := h.reviewClient.GetEssayStats(
+		c.Request.Context(),
+		&pb.GetEssayStatsRequest{EssayId: int32(essayId)},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to get essay stats", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, converters.MarshalEssayStatsResponse(resp))
+}
@@ -9,15 +9,41 @@ import (
 
 	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/clients/mocks"
 	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/handlers"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/grpcerr"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/jwt"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
 )
 
+// Routes of the review handler the way main registers them, every request
+// is made by username
+func newReviewRouter(handler *handlers.ReviewHandler, username string) *gin.Engine {
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("username", username)
+		c.Next()
+	})
+	router.GET("/drafts/:essayId", handler.GetDraft)
+	router.PUT("/drafts/:essayId", handler.SaveDraft)
+	router.POST("/drafts/:essayId/submit", handler.SubmitDraft)
+	router.PUT("/grades/:essayId", handler.SetGrade)
+	router.GET("/grades/:essayId", handler.GetGrade)
+	router.PUT("/assignments/:assignmentId/release", handler.SetGradesReleased)
+	router.GET("/assignments/:assignmentId/gradebook", handler.ExportGradebook)
+	router.GET("/reliability", handler.GetReviewerReliability)
+	router.POST("/reliability/recompute", handler.RecomputeReliability)
+	router.PUT("/calibration/:essayId", handler.SetCalibration)
+	router.DELETE("/calibration/:essayId", handler.RemoveCalibration)
+	return router
+}
+
 func TestReviewHandler_CreateReview(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -100,6 +126,173 @@ func TestReviewHandler_CreateReview(t *testing.T) {
 			},
 			expectedStatus: http.StatusBadRequest,
 		},
+		{
+			name: "missing rank without rubric",
+			requestBody: []byte(`{
+				"essay_id": 123,
+				"essay_author_id": 1,
+				"content": "Great essay!"
+			}`),
+			username: "reviewer1",
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				// no call expected
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"error": "rank is required",
+			},
+		},
+		{
+			name: "rubric scored review",
+			requestBody: []byte(`{
+				"essay_id": 123,
+				"essay_author_id": 1,
+				"content": "Great essay!",
+				"rubric_id": 7,
+				"scores": [{"criterion_id": 1, "score": 4, "comment": "clear"}]
+			}`),
+			username: "reviewer1",
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("CreateReview", mock.Anything, &pb.ReviewAddRequest{
+					EssayId:       123,
+					EssayAuthorId: 1,
+					Content:       "Great essay!",
+					Author:        "reviewer1",
+					RubricId:      7,
+					Scores: []*pb.CriterionScore{
+						{CriterionId: 1, Score: 4, Comment: "clear"},
+					},
+				}).Return(&pb.ReviewResponse{
+					Id:         1,
+					EssayId:    123,
+					Rank:       3,
+					Content:    "Great essay!",
+					Author:     "reviewer1",
+					RubricId:   7,
+					TotalScore: 100,
+					Scores: []*pb.CriterionScore{
+						{CriterionId: 1, CriterionName: "Thesis", Score: 4, MaxScore: 4, Weight: 1, Comment: "clear"},
+					},
+				}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: map[string]interface{}{
+				"rank":        float64(3),
+				"rubric_id":   float64(7),
+				"total_score": float64(100),
+			},
+		},
+		{
+			name: "scores for the rubric of the assignment",
+			requestBody: []byte(`{
+				"essay_id": 123,
+				"essay_author_id": 1,
+				"content": "Great essay!",
+				"scores": [{"criterion_id": 1, "score": 4}]
+			}`),
+			username: "reviewer1",
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("CreateReview", mock.Anything, &pb.ReviewAddRequest{
+					EssayId:       123,
+					EssayAuthorId: 1,
+					Content:       "Great essay!",
+					Author:        "reviewer1",
+					Scores:        []*pb.CriterionScore{{CriterionId: 1, Score: 4}},
+				}).Return(&pb.ReviewResponse{Id: 1, EssayId: 123, Rank: 3, Author: "reviewer1", RubricId: 7, TotalScore: 100}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: map[string]interface{}{
+				"rubric_id": float64(7),
+			},
+		},
+		{
+			name: "review with inline comments",
+			requestBody: []byte(`{
+				"essay_id": 123,
+				"essay_author_id": 1,
+				"rank": 2,
+				"content": "Great essay!",
+				"essay_revision": 3,
+				"comments": [{"start_offset": 4, "end_offset": 10, "content": "Which thesis?"}]
+			}`),
+			username: "reviewer1",
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("CreateReview", mock.Anything, &pb.ReviewAddRequest{
+					EssayId:       123,
+					EssayAuthorId: 1,
+					Rank:          2,
+					Content:       "Great essay!",
+					Author:        "reviewer1",
+					EssayRevision: 3,
+					Comments: []*pb.InlineComment{
+						{StartOffset: 4, EndOffset: 10, Content: "Which thesis?"},
+					},
+				}).Return(&pb.ReviewResponse{
+					Id:      1,
+					EssayId: 123,
+					Rank:    2,
+					Content: "Great essay!",
+					Author:  "reviewer1",
+					Comments: []*pb.InlineComment{
+						{Id: 5, StartOffset: 4, EndOffset: 10, Quote: "thesis", Content: "Which thesis?", EssayRevision: 3},
+					},
+				}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: map[string]interface{}{
+				"comments": []interface{}{
+					map[string]interface{}{
+						"id":             float64(5),
+						"start_offset":   float64(4),
+						"end_offset":     float64(10),
+						"quote":          "thesis",
+						"content":        "Which thesis?",
+						"essay_revision": float64(3),
+						"orphaned":       false,
+						"created_at":     float64(0),
+					},
+				},
+			},
+		},
+		{
+			name: "comments on outdated essay revision",
+			requestBody: []byte(`{
+				"essay_id": 123,
+				"essay_author_id": 1,
+				"rank": 2,
+				"content": "Great essay!",
+				"essay_revision": 2,
+				"comments": [{"start_offset": 4, "end_offset": 10, "content": "Which thesis?"}]
+			}`),
+			username: "reviewer1",
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("CreateReview", mock.Anything, mock.Anything).
+					Return(nil, status.Error(codes.FailedPrecondition, "essay has changed"))
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody: map[string]interface{}{
+				"error": "essay has changed",
+			},
+		},
+		{
+			name: "invalid rubric scores",
+			requestBody: []byte(`{
+				"essay_id": 123,
+				"essay_author_id": 1,
+				"content": "Great essay!",
+				"rubric_id": 7,
+				"scores": [{"criterion_id": 1, "score": 9}]
+			}`),
+			username: "reviewer1",
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("CreateReview", mock.Anything, mock.Anything).
+					Return(nil, status.Error(codes.InvalidArgument, `score for criterion "Thesis" must be between 0 and 4`))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"error": `score for criterion "Thesis" must be between 0 and 4`,
+			},
+		},
 		{
 			name: "review service error",
 			requestBody: []byte(`{
@@ -115,7 +308,8 @@ func TestReviewHandler_CreateReview(t *testing.T) {
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedBody: map[string]interface{}{
-				"error": assert.AnError.Error(),
+				"error": "internal error",
+				"code":  "INTERNAL",
 			},
 		},
 	}
@@ -245,6 +439,140 @@ func TestReviewHandler_GetAllReviews(t *testing.T) {
 	}
 }
 
+func TestReviewHandler_GetAllReviews_Filters(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		queryParams    string
+		username       string
+		role           string
+		setupMock      func(*mocks.MockReviewClient)
+		expectedStatus int
+		expectedIds    []float64
+	}{
+		{
+			name:        "author with pagination",
+			queryParams: "?author=reviewer1&min_rank=2&limit=10&offset=20",
+			username:    "reviewer1",
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("GetByAuthor", mock.Anything, &pb.GetByAuthorRequest{
+					Author:           "reviewer1",
+					MinRank:          2,
+					Limit:            10,
+					Offset:           20,
+					IncludeAnonymous: true,
+				}).Return([]*pb.ReviewResponse{
+					{Id: 2, EssayId: 5, Rank: 3, Author: "reviewer1"},
+					{Id: 1, EssayId: 4, Rank: 2, Author: "reviewer1", Anonymous: true, AuthorAlias: "Reviewer A"},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedIds:    []float64{2, 1},
+		},
+		{
+			name:        "other viewers do not get double-blind reviews of the author",
+			queryParams: "?author=reviewer1&limit=1",
+			username:    "someone",
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("GetByAuthor", mock.Anything, &pb.GetByAuthorRequest{Author: "reviewer1", Limit: 1}).
+					Return([]*pb.ReviewResponse{
+						{Id: 2, EssayId: 5, Rank: 3, Author: "reviewer1"},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedIds:    []float64{2},
+		},
+		{
+			name:        "teachers get double-blind reviews of the author",
+			queryParams: "?author=reviewer1",
+			username:    "teacher",
+			role:        jwt.RoleTeacher,
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("GetByAuthor", mock.Anything, &pb.GetByAuthorRequest{Author: "reviewer1", IncludeAnonymous: true}).
+					Return([]*pb.ReviewResponse{
+						{Id: 1, EssayId: 4, Rank: 2, Author: "reviewer1", Anonymous: true, AuthorAlias: "Reviewer A"},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedIds:    []float64{1},
+		},
+		{
+			name:        "essay and min rank without author",
+			queryParams: "?essay_id=4&min_rank=2",
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("GetByEssayId", mock.Anything, &pb.GetByEssayIdRequest{EssayId: 4}).
+					Return([]*pb.ReviewResponse{
+						{Id: 1, EssayId: 4, Rank: 1, Author: "reviewer1"},
+						{Id: 2, EssayId: 4, Rank: 3, Author: "reviewer2"},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedIds:    []float64{2},
+		},
+		{
+			name:        "page size rejected by the service",
+			queryParams: "?author=reviewer1&limit=1000",
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("GetByAuthor", mock.Anything, mock.Anything).
+					Return(nil, status.Error(codes.InvalidArgument, "limit must not exceed 100"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid min rank",
+			queryParams:    "?author=reviewer1&min_rank=abc",
+			setupMock:      func(*mocks.MockReviewClient) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "pagination without author",
+			queryParams:    "?limit=10",
+			setupMock:      func(*mocks.MockReviewClient) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReviewClient := new(mocks.MockReviewClient)
+			tt.setupMock(mockReviewClient)
+
+			handler := handlers.NewReviewHandler(mockReviewClient, logging.NewEmptyLogger())
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			if tt.username != "" {
+				c.Set("username", tt.username)
+			}
+			if tt.role != "" {
+				c.Set("role", tt.role)
+			}
+
+			req, err := http.NewRequest(http.MethodGet, "/reviews"+tt.queryParams, nil)
+			require.NoError(t, err)
+			c.Request = req
+
+			handler.GetAllReviews(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var response []map[string]interface{}
+				err = json.Unmarshal(w.Body.Bytes(), &response)
+				require.NoError(t, err)
+
+				ids := make([]float64, 0, len(response))
+				for _, review := range response {
+					ids = append(ids, review["id"].(float64))
+				}
+				assert.Equal(t, tt.expectedIds, ids)
+			}
+
+			mockReviewClient.AssertExpectations(t)
+		})
+	}
+}
+
 func TestReviewHandler_GetByEssayId(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -305,15 +633,16 @@ func TestReviewHandler_GetByEssayId(t *testing.T) {
 			},
 		},
 		{
-			name:    "reviews not found",
+			name:    "review service error",
 			essayId: "999",
 			setupMock: func(mockClient *mocks.MockReviewClient) {
 				mockClient.On("GetByEssayId", mock.Anything, mock.Anything).
 					Return(nil, assert.AnError)
 			},
-			expectedStatus: http.StatusNotFound,
+			expectedStatus: http.StatusInternalServerError,
 			expectedBody: map[string]interface{}{
-				"error": "reviews not found",
+				"error": "internal error",
+				"code":  "INTERNAL",
 			},
 		},
 	}
@@ -406,7 +735,7 @@ func TestReviewHandler_RemoveById(t *testing.T) {
 			setupMock: func(mockClient *mocks.MockReviewClient) {
 				mockClient.On("RemoveById", mock.Anything, &pb.RemoveByIdRequest{
 					Id: 999,
-				}).Return(nil, assert.AnError)
+				}).Return(nil, grpcerr.New(codes.NotFound, "REVIEW_NOT_FOUND", "review not found"))
 			},
 			expectedStatus: http.StatusNotFound,
 		},
@@ -447,3 +776,220 @@ func TestReviewHandler_RemoveById(t *testing.T) {
 		})
 	}
 }
+
+func TestReviewHandler_UpdateReview(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		reviewId       string
+		requestBody    string
+		setupMock      func(*mocks.MockReviewClient)
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:        "author edits review",
+			reviewId:    "3",
+			requestBody: `{"rank": 3, "content": "Great essay"}`,
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("UpdateReview", mock.Anything, &pb.UpdateReviewRequest{
+					Id:      3,
+					Author:  "reviewer",
+					Rank:    3,
+					Content: "Great essay",
+				}).Return(&pb.ReviewResponse{Id: 3, Rank: 3, Content: "Great essay", Author: "reviewer", Edited: true, EditedAt: 1234567890}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"edited":    true,
+				"edited_at": float64(1234567890),
+			},
+		},
+		{
+			name:        "rubric review is rescored",
+			reviewId:    "3",
+			requestBody: `{"content": "Rescored", "scores": [{"criterion_id": 1, "score": 4}]}`,
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("UpdateReview", mock.Anything, &pb.UpdateReviewRequest{
+					Id:      3,
+					Author:  "reviewer",
+					Content: "Rescored",
+					Scores:  []*pb.CriterionScore{{CriterionId: 1, Score: 4}},
+				}).Return(&pb.ReviewResponse{Id: 3, Edited: true}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "missing content",
+			reviewId:       "3",
+			requestBody:    `{"rank": 3}`,
+			setupMock:      func(mockClient *mocks.MockReviewClient) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid review ID",
+			reviewId:       "abc",
+			requestBody:    `{"rank": 3, "content": "Great essay"}`,
+			setupMock:      func(mockClient *mocks.MockReviewClient) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "not the review author",
+			reviewId:    "3",
+			requestBody: `{"rank": 3, "content": "Great essay"}`,
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("UpdateReview", mock.Anything, mock.Anything).
+					Return(nil, status.Error(codes.PermissionDenied, "only the review author can edit it"))
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"error": "only the review author can edit it",
+			},
+		},
+		{
+			name:        "review not found",
+			reviewId:    "99",
+			requestBody: `{"rank": 3, "content": "Great essay"}`,
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("UpdateReview", mock.Anything, mock.Anything).
+					Return(nil, status.Error(codes.NotFound, "review not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:        "rank rejected by review service",
+			reviewId:    "3",
+			requestBody: `{"rank": 7, "content": "Great essay"}`,
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("UpdateReview", mock.Anything, mock.Anything).
+					Return(nil, status.Error(codes.InvalidArgument, "rank must be between 1 and 3"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReviewClient := new(mocks.MockReviewClient)
+			tt.setupMock(mockReviewClient)
+
+			handler := handlers.NewReviewHandler(mockReviewClient, logging.NewEmptyLogger())
+
+			router := gin.New()
+			router.PUT("/reviews/:reviewId", func(c *gin.Context) {
+				c.Set("username", "reviewer")
+			}, handler.UpdateReview)
+
+			req, err := http.NewRequest(http.MethodPut, "/reviews/"+tt.reviewId, bytes.NewBufferString(tt.requestBody))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedBody != nil {
+				var response map[string]interface{}
+				err = json.Unmarshal(w.Body.Bytes(), &response)
+				require.NoError(t, err)
+
+				for key, expectedValue := range tt.expectedBody {
+					assert.Equal(t, expectedValue, response[key])
+				}
+			}
+
+			mockReviewClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReviewHandler_GetReviewHistory(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockReviewClient := new(mocks.MockReviewClient)
+	mockReviewClient.On("GetReviewHistory", mock.Anything, &pb.GetReviewHistoryRequest{ReviewId: 3}).
+		Return([]*pb.ReviewVersionResponse{
+			{Id: 1, ReviewId: 3, Rank: 1, Content: "First take"},
+			{Id: 2, ReviewId: 3, Rank: 2, Content: "Second take"},
+		}, nil)
+	mockReviewClient.On("GetReviewHistory", mock.Anything, &pb.GetReviewHistoryRequest{ReviewId: 4}).
+		Return(nil, status.Error(codes.NotFound, "review not found"))
+
+	handler := handlers.NewReviewHandler(mockReviewClient, logging.NewEmptyLogger())
+	router := gin.New()
+	// registered next to GET /reviews/:essayId like in the server
+	router.GET("/reviews/:essayId", handler.GetByEssayId)
+	router.GET("/reviews/by-id/:reviewId/history", handler.GetReviewHistory)
+
+	req, err := http.NewRequest(http.MethodGet, "/reviews/by-id/3/history", nil)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var response []map[string]interface{}
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Len(t, response, 2)
+	assert.Equal(t, "First take", response[0]["content"])
+
+	for path, expectedStatus := range map[string]int{
+		"/reviews/by-id/4/history":   http.StatusNotFound,
+		"/reviews/by-id/abc/history": http.StatusBadRequest,
+	} {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, expectedStatus, w.Code, path)
+	}
+
+	mockReviewClient.AssertExpectations(t)
+}
+
+func TestReviewHandler_GetEssayStats(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockReviewClient := new(mocks.MockReviewClient)
+	mockReviewClient.On("GetEssayStats", mock.Anything, &pb.GetEssayStatsRequest{EssayId: 1}).
+		Return(&pb.EssayStatsResponse{EssayId: 1, Stats: &pb.ReviewStats{
+			ReviewCount:      2,
+			MeanRank:         1.5,
+			MedianRank:       1.5,
+			RankDistribution: map[int32]int32{1: 1, 2: 1},
+		}}, nil)
+	mockReviewClient.On("GetEssayStats", mock.Anything, &pb.GetEssayStatsRequest{EssayId: 2}).
+		Return(nil, status.Error(codes.NotFound, "essay not found"))
+
+	handler := handlers.NewReviewHandler(mockReviewClient, logging.NewEmptyLogger())
+	router := gin.New()
+	router.GET("/reviews/:essayId/stats", handler.GetEssayStats)
+
+	req, err := http.NewRequest(http.MethodGet, "/reviews/1/stats", nil)
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var response map[string]interface{}
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	stats := response["stats"].(map[string]interface{})
+	assert.Equal(t, float64(2), stats["review_count"])
+	assert.Equal(t, map[string]interface{}{"1": float64(1), "2": float64(1)}, stats["rank_distribution"])
+
+	for path, expectedStatus := range map[string]int{
+		"/reviews/2/stats":   http.StatusNotFound,
+		"/reviews/abc/stats": http.StatusBadRequest,
+	} {
+		req, err := http.NewRequest(http.MethodGet, path, nil)
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, expectedStatus, w.Code, path)
+	}
+
+	mockReviewClient.AssertExpectations(t)
+}
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/apierror"
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/converters"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+)
+
+// POST /api/rubrics
+func (h *ReviewHandler) CreateRubric(c *gin.Context) {
+	logger := requestLogger(c, h.logger).With(zap.String("operation", "create_rubric"))
+
+	var request struct {
+		Title    string `json:"title" binding:"required"`
+		Criteria []struct {
+			Name        string  `json:"name" binding:"required"`
+			Description string  `json:"description"`
+			Weight      float64 `json:"weight" binding:"required"`
+			MinScore    int32   `json:"min_score"`
+			MaxScore    int32   `json:"max_score" binding:"required"`
+		} `json:"criteria" binding:"required,min=1,dive"`
+	}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Warn("Invalid create rubric request",
+			zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	username, exists := c.Get("username")
+	if !exists {
+		logger.Warn("Authentication required for rubric creation")
+		apierror.Write(c, http.StatusUnauthorized, apierror.CodeUnauthenticated, "authentication required")
+		return
+	}
+
+	criteria := make([]*pb.Criterion, 0, len(request.Criteria))
+	for _, criterion := range request.Criteria {
+		criteria = append(criteria, &pb.Criterion{
+			Name:        criterion.Name,
+			Description: criterion.Description,
+			Weight:      criterion.Weight,
+			MinScore:    criterion.MinScore,
+			MaxScore:    criterion.MaxScore,
+		})
+	}
+
+	logger = logger.With(zap.String("username", username.(string)))
+	resp, err := h.reviewClient.CreateRubric(
+		c.Request.Context(),
+		&pb.CreateRubricRequest{
+			Title:     request.Title,
+			CreatedBy: username.(string),
+			Criteria:  criteria,
+		},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to create rubric", err)
+		return
+	}
+
+	logger.Info("Rubric created successfully",
+		zap.Int64("rubric_id", resp.Id))
+	c.JSON(http.StatusCreated, converters.MarshalRubricResponse(resp))
+}
+
+// GET /api/rubrics
+func (h *ReviewHandler) GetAllRubrics(c *gin.Context) {
+	logger := requestLogger(c, h.logger).With(zap.String("operation", "get_all_rubrics"))
+
+	resp, err := h.reviewClient.GetAllRubrics(c.Request.Context(), &pb.EmptyRequest{})
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to get rubrics", err)
+		return
+	}
+
+	rubrics := make([]gin.H, 0, len(resp))
+	for _, rubric := range resp {
+		rubrics = append(rubrics, converters.MarshalRubricResponse(rubric))
+	}
+
+	logger.Debug("Retrieved rubrics",
+		zap.Int("count", len(rubrics)))
+	c.JSON(http.StatusOK, rubrics)
+}
+
+// GET /api/rubrics/:rubricId
+func (h *ReviewHandler) GetRubric(c *gin.Context) {
+	rubricIdStr := c.Param("rubricId")
+	rubricId, err := strconv.ParseInt(rubricIdStr, 10, 64)
+	if err != nil {
+		requestLogger(c, h.logger).Warn("Invalid rubric ID",
+			zap.String("rubric_id", rubricIdStr),
+			zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid rubric ID")
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "get_rubric"),
+		zap.Int64("rubric_id", rubricId),
+	)
+
+	resp, err := h.reviewClient.GetRubric(c.Request.Context(), &pb.GetRubricRequest{Id: rubricId})
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to get rubric", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, converters.MarshalRubricResponse(resp))
+}
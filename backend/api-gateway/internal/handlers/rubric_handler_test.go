@@ -0,0 +1,208 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/clients/mocks"
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/handlers"
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/middleware"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/jwt"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+)
+
+func TestReviewHandler_CreateRubric(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		role           string
+		setupMock      func(*mocks.MockReviewClient)
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "teacher creates rubric",
+			requestBody: `{
+				"title": "Essay rubric",
+				"criteria": [{"name": "Thesis", "description": "Clear claim", "weight": 2, "min_score": 0, "max_score": 4}]
+			}`,
+			role: jwt.RoleTeacher,
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("CreateRubric", mock.Anything, &pb.CreateRubricRequest{
+					Title:     "Essay rubric",
+					CreatedBy: "teacher",
+					Criteria: []*pb.Criterion{
+						{Name: "Thesis", Description: "Clear claim", Weight: 2, MinScore: 0, MaxScore: 4},
+					},
+				}).Return(&pb.RubricResponse{Id: 7, Title: "Essay rubric", CreatedBy: "teacher"}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: map[string]interface{}{
+				"id":         float64(7),
+				"title":      "Essay rubric",
+				"created_by": "teacher",
+			},
+		},
+		{
+			name:           "student is forbidden",
+			requestBody:    `{"title": "Essay rubric", "criteria": [{"name": "Thesis", "weight": 1, "max_score": 4}]}`,
+			role:           jwt.RoleStudent,
+			setupMock:      func(mockClient *mocks.MockReviewClient) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "missing criteria",
+			requestBody:    `{"title": "Essay rubric", "criteria": []}`,
+			role:           jwt.RoleTeacher,
+			setupMock:      func(mockClient *mocks.MockReviewClient) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "rejected by review service",
+			requestBody: `{"title": "Essay rubric", "criteria": [{"name": "Thesis", "weight": 1, "min_score": 4, "max_score": 4}]}`,
+			role:        jwt.RoleTeacher,
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("CreateRubric", mock.Anything, mock.Anything).
+					Return(nil, status.Error(codes.InvalidArgument, `criterion "Thesis": max_score must be greater than min_score`))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"error": `criterion "Thesis": max_score must be greater than min_score`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReviewClient := new(mocks.MockReviewClient)
+			tt.setupMock(mockReviewClient)
+
+			handler := handlers.NewReviewHandler(mockReviewClient, logging.NewEmptyLogger())
+
+			router := gin.New()
+			router.POST("/rubrics", func(c *gin.Context) {
+				c.Set("username", "teacher")
+				c.Set("role", tt.role)
+			}, middleware.RequireRole(jwt.RoleTeacher), handler.CreateRubric)
+
+			req, err := http.NewRequest(http.MethodPost, "/rubrics", bytes.NewBufferString(tt.requestBody))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedBody != nil {
+				var response map[string]interface{}
+				err = json.Unmarshal(w.Body.Bytes(), &response)
+				require.NoError(t, err)
+
+				for key, expectedValue := range tt.expectedBody {
+					assert.Equal(t, expectedValue, response[key])
+				}
+			}
+
+			mockReviewClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReviewHandler_GetRubric(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		rubricId       string
+		setupMock      func(*mocks.MockReviewClient)
+		expectedStatus int
+	}{
+		{
+			name:     "rubric found",
+			rubricId: "7",
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("GetRubric", mock.Anything, &pb.GetRubricRequest{Id: 7}).
+					Return(&pb.RubricResponse{Id: 7, Title: "Essay rubric"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:     "rubric not found",
+			rubricId: "8",
+			setupMock: func(mockClient *mocks.MockReviewClient) {
+				mockClient.On("GetRubric", mock.Anything, &pb.GetRubricRequest{Id: 8}).
+					Return(nil, status.Error(codes.NotFound, "rubric not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "invalid rubric ID",
+			rubricId:       "abc",
+			setupMock:      func(mockClient *mocks.MockReviewClient) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReviewClient := new(mocks.MockReviewClient)
+			tt.setupMock(mockReviewClient)
+
+			handler := handlers.NewReviewHandler(mockReviewClient, logging.NewEmptyLogger())
+
+			router := gin.New()
+			router.GET("/rubrics/:rubricId", handler.GetRubric)
+
+			req, err := http.NewRequest(http.MethodGet, "/rubrics/"+tt.rubricId, nil)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			mockReviewClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReviewHandler_GetAllRubrics(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockReviewClient := new(mocks.MockReviewClient)
+	mockReviewClient.On("GetAllRubrics", mock.Anything, &pb.EmptyRequest{}).
+		Return([]*pb.RubricResponse{{Id: 1, Title: "First"}, {Id: 2, Title: "Second"}}, nil)
+
+	handler := handlers.NewReviewHandler(mockReviewClient, logging.NewEmptyLogger())
+
+	router := gin.New()
+	router.GET("/rubrics", handler.GetAllRubrics)
+
+	req, err := http.NewRequest(http.MethodGet, "/rubrics", nil)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response []map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Len(t, response, 2)
+	assert.Equal(t, "Second", response[1]["title"])
+
+	mockReviewClient.AssertExpectations(t)
+}
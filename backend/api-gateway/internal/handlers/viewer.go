@@ -0,0 +1,14 @@
+package handlers
+
+import (
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/converters"
+	"github.com/gin-gonic/gin"
+)
+
+// Identity of the requester set by the auth middleware, empty for anonymous requests
+func viewerFrom(c *gin.Context) converters.Viewer {
+	return converters.Viewer{
+		Username: c.GetString("username"),
+		Role:     c.GetString("role"),
+	}
+}
@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/apierror"
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/converters"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/notification"
+)
+
+// GET /api/webhooks
+func (h *NotificationHandler) ListWebhooks(c *gin.Context) {
+	userIDInt, ok := h.requireUserID(c)
+	if !ok {
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "list_webhooks"),
+		zap.Int64("user_id", userIDInt),
+	)
+
+	logger.Debug("List webhooks request")
+	webhooks, err := h.notificationClient.ListWebhooks(
+		c.Request.Context(),
+		&pb.ListWebhooksRequest{UserId: userIDInt},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to list webhooks", err)
+		return
+	}
+
+	result := make([]gin.H, 0, len(webhooks))
+	for _, w := range webhooks {
+		result = append(result, converters.MarshalWebhookResponse(w))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// POST /api/webhooks
+func (h *NotificationHandler) CreateWebhook(c *gin.Context) {
+	userIDInt, ok := h.requireUserID(c)
+	if !ok {
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "create_webhook"),
+		zap.Int64("user_id", userIDInt),
+	)
+
+	var request struct {
+		URL        string   `json:"url" binding:"required"`
+		EventTypes []string `json:"event_types"`
+	}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.Warn("Invalid create webhook request",
+			zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, err.Error())
+		return
+	}
+
+	logger.Debug("Create webhook request")
+	resp, err := h.notificationClient.CreateWebhook(
+		c.Request.Context(),
+		&pb.CreateWebhookRequest{UserId: userIDInt, Url: request.URL, EventTypes: request.EventTypes},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Failed to create webhook", err)
+		return
+	}
+
+	logger.Info("Webhook created", zap.Int64("webhook_id", resp.WebhookId))
+	c.JSON(http.StatusCreated, converters.MarshalWebhookResponse(resp))
+}
+
+// DELETE /api/webhooks/:webhookId
+func (h *NotificationHandler) DeleteWebhook(c *gin.Context) {
+	webhookId, ok := h.webhookIDParam(c)
+	if !ok {
+		return
+	}
+
+	userIDInt, ok := h.requireUserID(c)
+	if !ok {
+		return
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "delete_webhook"),
+		zap.Int64("webhook_id", webhookId),
+		zap.Int64("user_id", userIDInt),
+	)
+
+	logger.Debug("Delete webhook request")
+	_, err := h.notificationClient.DeleteWebhook(
+		c.Request.Context(),
+		&pb.DeleteWebhookRequest{WebhookId: webhookId, UserId: userIDInt},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Webhook request failed", err)
+		return
+	}
+
+	logger.Info("Webhook deleted")
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+// GET /api/webhooks/:webhookId/deliveries?limit=20
+func (h *NotificationHandler) ListWebhookDeliveries(c *gin.Context) {
+	webhookId, ok := h.webhookIDParam(c)
+	if !ok {
+		return
+	}
+
+	userIDInt, ok := h.requireUserID(c)
+	if !ok {
+		return
+	}
+
+	var limit int64
+	if limitStr := c.Query("limit"); limitStr != "" {
+		var err error
+		limit, err = strconv.ParseInt(limitStr, 10, 32)
+		if err != nil || limit < 0 {
+			requestLogger(c, h.logger).Warn("Invalid deliveries limit", zap.String("limit", limitStr))
+			apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid limit")
+			return
+		}
+	}
+
+	logger := requestLogger(c, h.logger).With(
+		zap.String("operation", "list_webhook_deliveries"),
+		zap.Int64("webhook_id", webhookId),
+		zap.Int64("user_id", userIDInt),
+	)
+
+	logger.Debug("List webhook deliveries request")
+	deliveries, err := h.notificationClient.ListWebhookDeliveries(
+		c.Request.Context(),
+		&pb.ListWebhookDeliveriesRequest{WebhookId: webhookId, UserId: userIDInt, Limit: int32(limit)},
+	)
+	if err != nil {
+		writeGrpcError(c, logger, "Webhook request failed", err)
+		return
+	}
+
+	result := make([]gin.H, 0, len(deliveries))
+	for _, d := range deliveries {
+		result = append(result, converters.MarshalWebhookDeliveryResponse(d))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *NotificationHandler) webhookIDParam(c *gin.Context) (int64, bool) {
+	webhookIdStr := c.Param("webhookId")
+	webhookId, err := strconv.ParseInt(webhookIdStr, 10, 64)
+	if err != nil {
+		requestLogger(c, h.logger).Warn("Invalid webhook ID",
+			zap.String("webhook_id", webhookIdStr),
+			zap.Error(err))
+		apierror.Write(c, http.StatusBadRequest, apierror.CodeInvalidRequest, "invalid webhook ID")
+		return 0, false
+	}
+	return webhookId, true
+}
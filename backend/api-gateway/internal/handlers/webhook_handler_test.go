@@ -0,0 +1,215 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/clients/mocks"
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/handlers"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/notification"
+)
+
+func TestNotificationHandler_CreateWebhook(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		setupMock      func(*mocks.MockNotificationClient)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "creates webhook and returns secret once",
+			body: `{"url": "https://chat.example.com/hook", "event_types": ["new_review"]}`,
+			setupMock: func(m *mocks.MockNotificationClient) {
+				m.On("CreateWebhook", mock.Anything, &pb.CreateWebhookRequest{
+					UserId:     123,
+					Url:        "https://chat.example.com/hook",
+					EventTypes: []string{"new_review"},
+				}).Return(&pb.WebhookResponse{
+					WebhookId:  1,
+					UserId:     123,
+					Url:        "https://chat.example.com/hook",
+					EventTypes: []string{"new_review"},
+					Secret:     "s3cret",
+					CreatedAt:  1700000000,
+				}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: `{"webhook_id": 1, "url": "https://chat.example.com/hook", "event_types": ["new_review"],
+				"secret": "s3cret", "created_at": 1700000000}`,
+		},
+		{
+			name:           "rejects missing url",
+			body:           `{"event_types": ["new_review"]}`,
+			setupMock:      func(m *mocks.MockNotificationClient) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "maps invalid argument to bad request",
+			body: `{"url": "ftp://example.com"}`,
+			setupMock: func(m *mocks.MockNotificationClient) {
+				m.On("CreateWebhook", mock.Anything, mock.Anything).
+					Return(nil, status.Error(codes.InvalidArgument, `invalid webhook url "ftp://example.com"`))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error": "invalid webhook url \"ftp://example.com\"", "code": "INVALID_ARGUMENT"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+
+			mockNotificationClient := new(mocks.MockNotificationClient)
+			tt.setupMock(mockNotificationClient)
+			handler := handlers.NewNotificationHandler(mockNotificationClient, logging.NewEmptyLogger())
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(tt.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Set("userId", int64(123))
+
+			handler.CreateWebhook(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, w.Body.String())
+			}
+			mockNotificationClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNotificationHandler_ListWebhooks(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockNotificationClient := new(mocks.MockNotificationClient)
+	mockNotificationClient.On("ListWebhooks", mock.Anything, &pb.ListWebhooksRequest{UserId: 123}).
+		Return([]*pb.WebhookResponse{{WebhookId: 1, Url: "https://example.com", CreatedAt: 10}}, nil)
+	handler := handlers.NewNotificationHandler(mockNotificationClient, logging.NewEmptyLogger())
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/webhooks", nil)
+	c.Set("userId", int64(123))
+
+	handler.ListWebhooks(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `[{"webhook_id": 1, "url": "https://example.com", "event_types": [], "created_at": 10}]`, w.Body.String())
+	mockNotificationClient.AssertExpectations(t)
+}
+
+func TestNotificationHandler_DeleteWebhook(t *testing.T) {
+	tests := []struct {
+		name           string
+		webhookID      string
+		setupMock      func(*mocks.MockNotificationClient)
+		expectedStatus int
+	}{
+		{
+			name:      "deletes own webhook",
+			webhookID: "1",
+			setupMock: func(m *mocks.MockNotificationClient) {
+				m.On("DeleteWebhook", mock.Anything, &pb.DeleteWebhookRequest{WebhookId: 1, UserId: 123}).
+					Return(&pb.DeleteWebhookResponse{Success: true}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:      "forbids foreign webhook",
+			webhookID: "2",
+			setupMock: func(m *mocks.MockNotificationClient) {
+				m.On("DeleteWebhook", mock.Anything, &pb.DeleteWebhookRequest{WebhookId: 2, UserId: 123}).
+					Return(nil, status.Error(codes.PermissionDenied, "webhook belongs to another user"))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:      "returns not found",
+			webhookID: "3",
+			setupMock: func(m *mocks.MockNotificationClient) {
+				m.On("DeleteWebhook", mock.Anything, &pb.DeleteWebhookRequest{WebhookId: 3, UserId: 123}).
+					Return(nil, status.Error(codes.NotFound, "webhook not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "rejects invalid id",
+			webhookID:      "abc",
+			setupMock:      func(m *mocks.MockNotificationClient) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+
+			mockNotificationClient := new(mocks.MockNotificationClient)
+			tt.setupMock(mockNotificationClient)
+			handler := handlers.NewNotificationHandler(mockNotificationClient, logging.NewEmptyLogger())
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodDelete, "/webhooks/"+tt.webhookID, nil)
+			c.Params = gin.Params{{Key: "webhookId", Value: tt.webhookID}}
+			c.Set("userId", int64(123))
+
+			handler.DeleteWebhook(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			mockNotificationClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNotificationHandler_ListWebhookDeliveries(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockNotificationClient := new(mocks.MockNotificationClient)
+	mockNotificationClient.On("ListWebhookDeliveries", mock.Anything, &pb.ListWebhookDeliveriesRequest{
+		WebhookId: 1,
+		UserId:    123,
+		Limit:     5,
+	}).Return([]*pb.WebhookDeliveryResponse{{
+		DeliveryId:     9,
+		WebhookId:      1,
+		NotificationId: 4,
+		EventType:      "new_review",
+		Status:         "failed",
+		Attempts:       6,
+		LastStatusCode: 500,
+		LastError:      "receiver responded with status 500",
+		NextAttemptAt:  20,
+		CreatedAt:      10,
+	}}, nil)
+	handler := handlers.NewNotificationHandler(mockNotificationClient, logging.NewEmptyLogger())
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/webhooks/1/deliveries?limit=5", nil)
+	c.Params = gin.Params{{Key: "webhookId", Value: "1"}}
+	c.Set("userId", int64(123))
+
+	handler.ListWebhookDeliveries(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `[{
+		"delivery_id": 9, "webhook_id": 1, "notification_id": 4, "event_type": "new_review",
+		"status": "failed", "attempts": 6, "last_status_code": 500,
+		"last_error": "receiver responded with status 500",
+		"next_attempt_at": 20, "delivered_at": 0, "created_at": 10
+	}]`, w.Body.String())
+	mockNotificationClient.AssertExpectations(t)
+}
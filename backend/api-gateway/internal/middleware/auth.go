@@ -4,51 +4,85 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"os"
 	"strings"
 
+	"github.com/IAGrig/vt-csa-essays/backend/api-gateway/internal/apierror"
+	sharedjwt "github.com/IAGrig/vt-csa-essays/backend/shared/jwt"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 )
 
 // Extracts the access token from the Authorization header and validate it
-func JWTAuthMiddleware() gin.HandlerFunc {
+func JWTAuthMiddleware(secret []byte) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		tokenString, err := extractToken(c)
-		if err != nil {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "authorization required"})
+		if message := authenticate(c, secret); message != "" {
+			apierror.Abort(c, http.StatusUnauthorized, apierror.CodeUnauthenticated, message)
 			return
 		}
+		c.Next()
+	}
+}
 
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-			}
-			return []byte(os.Getenv("JWT_ACCESS_SECRET")), nil
-		})
-		if err != nil {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid token"})
-			return
+// Identifies the user when a valid token is sent, anonymous requests pass through
+func OptionalJWTAuthMiddleware(secret []byte) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, err := extractToken(c); err == nil {
+			authenticate(c, secret)
 		}
+		c.Next()
+	}
+}
 
-		if !token.Valid {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid or expired token"})
-			return
-		}
+// Validates the token and stores the user claims in the context,
+// returns the error message for the client when authentication fails
+func authenticate(c *gin.Context, secret []byte) string {
+	tokenString, err := extractToken(c)
+	if err != nil {
+		return "authorization required"
+	}
 
-		claims, ok := token.Claims.(jwt.MapClaims)
-		if !ok {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid token claims"})
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
+		return secret, nil
+	})
+	if err != nil {
+		return "invalid token"
+	}
 
-		userID, ok := claims["userId"].(float64)
-		if !ok {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid userId type"})
+	if !token.Valid {
+		return "invalid or expired token"
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return "invalid token claims"
+	}
+
+	userID, ok := claims["userId"].(float64)
+	if !ok {
+		return "invalid userId type"
+	}
+	c.Set("userId", int64(userID))
+
+	c.Set("username", claims["sub"])
+
+	role, ok := claims["role"].(string)
+	if !ok || role == "" {
+		role = sharedjwt.RoleStudent
+	}
+	c.Set("role", role)
+	return ""
+}
+
+// Lets the request through only for users with the given role, must run after JWTAuthMiddleware
+func RequireRole(role string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString("role") != role {
+			apierror.Abort(c, http.StatusForbidden, apierror.CodePermissionDenied, "insufficient permissions")
 			return
 		}
-		c.Set("userId", int64(userID))
-
-		c.Set("username", claims["sub"])
 		c.Next()
 	}
 }
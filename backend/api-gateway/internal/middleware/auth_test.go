@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sharedjwt "github.com/IAGrig/vt-csa-essays/backend/shared/jwt"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSecret = []byte("test-secret")
+
+func TestRequireRole(t *testing.T) {
+	tests := []struct {
+		name           string
+		role           string
+		expectedStatus int
+	}{
+		{name: "allows teacher", role: sharedjwt.RoleTeacher, expectedStatus: http.StatusOK},
+		{name: "forbids student", role: sharedjwt.RoleStudent, expectedStatus: http.StatusForbidden},
+		{name: "forbids missing role", role: "", expectedStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+
+			router := gin.New()
+			router.GET("/", func(c *gin.Context) {
+				if tt.role != "" {
+					c.Set("role", tt.role)
+				}
+				c.Next()
+			}, RequireRole(sharedjwt.RoleTeacher), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/", nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestOptionalJWTAuthMiddleware(t *testing.T) {
+	validToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":    "teacher1",
+		"userId": 7,
+		"role":   sharedjwt.RoleTeacher,
+	}).SignedString(testSecret)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		authorization    string
+		expectedUsername string
+		expectedRole     string
+	}{
+		{name: "valid token identifies the user", authorization: "Bearer " + validToken, expectedUsername: "teacher1", expectedRole: sharedjwt.RoleTeacher},
+		{name: "no token stays anonymous", authorization: ""},
+		{name: "invalid token stays anonymous", authorization: "Bearer garbage"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+
+			router := gin.New()
+			router.GET("/", OptionalJWTAuthMiddleware(testSecret), func(c *gin.Context) {
+				assert.Equal(t, tt.expectedUsername, c.GetString("username"))
+				assert.Equal(t, tt.expectedRole, c.GetString("role"))
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestIDMiddleware(t *testing.T) {
+	tests := []struct {
+		name       string
+		header     string
+		expectKept bool
+	}{
+		{name: "keeps client id", header: "3f0c9a52-e1b4-4c57-9d0a-6d2c1f0e8b77", expectKept: true},
+		{name: "generates missing id", header: ""},
+		{name: "replaces id with spaces", header: "bad id"},
+		{name: "replaces too long id", header: strings.Repeat("a", maxRequestIDLength+1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+
+			var seen string
+			router := gin.New()
+			router.Use(RequestIDMiddleware())
+			router.GET("/", func(c *gin.Context) {
+				seen = logging.RequestID(c.Request.Context())
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set(logging.RequestIDHeader, tt.header)
+			}
+			router.ServeHTTP(w, req)
+
+			returned := w.Header().Get(logging.RequestIDHeader)
+			assert.Equal(t, seen, returned)
+			if tt.expectKept {
+				assert.Equal(t, tt.header, returned)
+			} else {
+				assert.NoError(t, uuid.Validate(returned))
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package main
+
+import (
+	"github.com/IAGrig/vt-csa-essays/backend/shared/config"
+)
+
+// Loaded by config.Load, see it for the meaning of the tags
+type Config struct {
+	config.Server `yaml:",inline"`
+
+	GRPCPort      string          `env:"AUTH_SERVICE_GRPC_PORT" yaml:"grpc_port" required:"true"`
+	AccessSecret  string          `env:"JWT_ACCESS_SECRET" yaml:"jwt_access_secret" required:"true" secret:"true"`
+	RefreshSecret string          `env:"JWT_REFRESH_SECRET" yaml:"jwt_refresh_secret" required:"true" secret:"true"`
+	Postgres      config.Postgres `yaml:"postgres"`
+	TLS           config.TLS      `yaml:"tls"`
+}
@@ -1,40 +1,60 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
-	"os"
+	"net/http"
 
 	"google.golang.org/grpc"
 
 	"github.com/IAGrig/vt-csa-essays/backend/auth-service/internal/repository"
 	"github.com/IAGrig/vt-csa-essays/backend/auth-service/internal/service"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/config"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/grpcerr"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/grpcmw"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/health"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/jwt"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/lifecycle"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/monitoring"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/mtls"
+	pgutil "github.com/IAGrig/vt-csa-essays/backend/shared/pg_util"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/tracing"
 	"go.uber.org/zap"
 
 	pb "github.com/IAGrig/vt-csa-essays/backend/proto/user"
 )
 
 func main() {
-	port := os.Getenv("AUTH_SERVICE_GRPC_PORT")
-	accessSecret := []byte(os.Getenv("JWT_ACCESS_SECRET"))
-	refreshSecret := []byte(os.Getenv("JWT_REFRESH_SECRET"))
-	monitoringPort := os.Getenv("MONITORING_PORT")
-
 	logger := logging.New("auth-service")
 	defer logger.Sync()
 
-	logger.Info("Starting auth service",
-		zap.String("port", port),
-		zap.String("monitoring_port", monitoringPort))
+	var cfg Config
+	if err := config.Load(&cfg); err != nil {
+		logger.Fatal("Invalid configuration", zap.Error(err))
+	}
+	logger.Info("Starting auth service")
+	config.Log(logger, cfg)
 
-	monitoring.StartMetricsServer(monitoringPort)
+	monitoring.StartMetricsServer(cfg.MonitoringPort)
 
-	jwtGenerator := jwt.NewGenerator(accessSecret, refreshSecret)
-	jwtParser := jwt.NewParser(accessSecret, refreshSecret)
+	lc := lifecycle.New(logger, cfg.ShutdownTimeout)
+
+	certs, err := mtls.New(cfg.TLS, logger)
+	if err != nil {
+		logger.Fatal("Failed to load TLS certificates", zap.Error(err))
+	}
+	lc.Go(certs.Watch)
+
+	shutdownTracing, err := tracing.Init(context.Background(), "auth-service")
+	if err != nil {
+		logger.Fatal("Failed to initialize tracing", zap.Error(err))
+	}
+
+	jwtGenerator := jwt.NewGenerator([]byte(cfg.AccessSecret), []byte(cfg.RefreshSecret))
+	jwtParser := jwt.NewParser([]byte(cfg.AccessSecret), []byte(cfg.RefreshSecret))
 
 	repo, err := repository.NewUserPgRepository(logger)
 	if err != nil {
@@ -44,17 +64,42 @@ func main() {
 
 	userService := service.New(repo, jwtGenerator, jwtParser, logger)
 
-	var opts []grpc.ServerOption
+	opts := []grpc.ServerOption{
+		certs.ServerOption(),
+		tracing.ServerOption(),
+		grpc.ChainUnaryInterceptor(
+			grpcmw.UnaryServerInterceptor("auth", logger),
+			grpcerr.UnaryServerInterceptor(service.ErrorMappings, logger),
+		),
+		grpc.ChainStreamInterceptor(
+			grpcmw.StreamServerInterceptor("auth", logger),
+			grpcerr.StreamServerInterceptor(service.ErrorMappings, logger),
+		),
+	}
 
 	grpcServer := grpc.NewServer(opts...)
 	pb.RegisterUserServiceServer(grpcServer, userService)
 
-	lis, err := net.Listen("tcp", "0.0.0.0:"+port)
+	checker := health.New(pb.UserService_ServiceDesc.ServiceName, logger)
+	checker.Add("postgres", health.PingCheck(repo.(*repository.UserPgRepository).DB()))
+	checker.Register(grpcServer)
+	checker.RegisterHTTP(http.DefaultServeMux)
+	lc.Go(func(ctx context.Context) { checker.Start(ctx, cfg.HealthCheckInterval) })
+
+	lis, err := net.Listen("tcp", "0.0.0.0:"+cfg.GRPCPort)
 	if err != nil {
 		logger.Error("Failed to listen", zap.Error(err))
 		log.Fatalf("failed to listen: %v", err)
 	}
 
 	logger.Info("Auth service started successfully", zap.String("address", lis.Addr().String()))
-	grpcServer.Serve(lis)
+	lc.ServeGRPC(grpcServer, lis)
+	lc.OnShutdown("postgres", pgutil.ClosePools)
+	lc.OnShutdown("tracing", shutdownTracing)
+
+	if err := lc.Wait(); err != nil {
+		logger.Error("Auth service stopped with errors", zap.Error(err))
+		return
+	}
+	logger.Info("Auth service stopped")
 }
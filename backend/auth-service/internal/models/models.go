@@ -7,6 +7,7 @@ type User struct {
 	ID           int
 	Username     string
 	PasswordHash string
+	Role         string
 	CreatedAt    time.Time
 }
 
@@ -20,5 +21,6 @@ type UserLoginRequest struct {
 type UserResponse struct {
 	ID        int       `json:"id"`
 	Username  string    `json:"username"`
+	Role      string    `json:"role"`
 	CreatedAt time.Time `json:"created_at"`
 }
@@ -1,6 +1,8 @@
 package mocks
 
 import (
+	"context"
+
 	"github.com/IAGrig/vt-csa-essays/backend/auth-service/internal/models"
 	"github.com/stretchr/testify/mock"
 )
@@ -9,17 +11,17 @@ type MockUserRepository struct {
 	mock.Mock
 }
 
-func (m *MockUserRepository) Add(user models.UserLoginRequest) (models.User, error) {
-	args := m.Called(user)
+func (m *MockUserRepository) Add(ctx context.Context, user models.UserLoginRequest) (models.User, error) {
+	args := m.Called(ctx, user)
 	return args.Get(0).(models.User), args.Error(1)
 }
 
-func (m *MockUserRepository) Auth(request models.UserLoginRequest) (models.User, error) {
-	args := m.Called(request)
+func (m *MockUserRepository) Auth(ctx context.Context, request models.UserLoginRequest) (models.User, error) {
+	args := m.Called(ctx, request)
 	return args.Get(0).(models.User), args.Error(1)
 }
 
-func (m *MockUserRepository) GetByUsername(username string) (models.User, error) {
-	args := m.Called(username)
+func (m *MockUserRepository) GetByUsername(ctx context.Context, username string) (models.User, error) {
+	args := m.Called(ctx, username)
 	return args.Get(0).(models.User), args.Error(1)
 }
@@ -30,7 +30,10 @@ func NewUserPgRepository(logger *logging.Logger) (UserRepository, error) {
 	return &UserPgRepository{db: pool, logger: logger}, nil
 }
 
-func (repository *UserPgRepository) Add(request models.UserLoginRequest) (models.User, error) {
+func (repository *UserPgRepository) Add(ctx context.Context, request models.UserLoginRequest) (models.User, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
 	logger := repository.logger.With(
 		zap.String("operation", "add_user"),
 		zap.String("username", request.Username),
@@ -45,11 +48,11 @@ func (repository *UserPgRepository) Add(request models.UserLoginRequest) (models
 	}
 
 	var user models.User
-	err = repository.db.QueryRow(context.Background(),
+	err = repository.db.QueryRow(ctx,
 		`INSERT INTO users (username, password_hash)
 		VALUES ($1, $2)
-		RETURNING user_id, username, created_at;`,
-		request.Username, passwordHash).Scan(&user.ID, &user.Username, &user.CreatedAt)
+		RETURNING user_id, username, role, created_at;`,
+		request.Username, passwordHash).Scan(&user.ID, &user.Username, &user.Role, &user.CreatedAt)
 
 	if err != nil {
 		var pgErr *pgconn.PgError
@@ -65,7 +68,10 @@ func (repository *UserPgRepository) Add(request models.UserLoginRequest) (models
 	return user, nil
 }
 
-func (repository *UserPgRepository) Auth(request models.UserLoginRequest) (models.User, error) {
+func (repository *UserPgRepository) Auth(ctx context.Context, request models.UserLoginRequest) (models.User, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
 	logger := repository.logger.With(
 		zap.String("operation", "auth_user"),
 		zap.String("username", request.Username),
@@ -76,12 +82,12 @@ func (repository *UserPgRepository) Auth(request models.UserLoginRequest) (model
 	var user models.User
 
 	err := repository.db.QueryRow(
-		context.Background(),
-		`SELECT user_id, username, password_hash, created_at
+		ctx,
+		`SELECT user_id, username, password_hash, role, created_at
 		FROM users
 		WHERE username = $1;`,
 		request.Username,
-	).Scan(&user.ID, &user.Username, &user.PasswordHash, &user.CreatedAt)
+	).Scan(&user.ID, &user.Username, &user.PasswordHash, &user.Role, &user.CreatedAt)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -97,12 +103,15 @@ func (repository *UserPgRepository) Auth(request models.UserLoginRequest) (model
 		return models.User{}, AuthErr
 	}
 
-	response := models.User{ID: user.ID, Username: user.Username, CreatedAt: user.CreatedAt}
+	response := models.User{ID: user.ID, Username: user.Username, Role: user.Role, CreatedAt: user.CreatedAt}
 	logger.Debug("User authenticated successfully", zap.Int64("user_id", int64(user.ID)))
 	return response, nil
 }
 
-func (repository *UserPgRepository) GetByUsername(username string) (models.User, error) {
+func (repository *UserPgRepository) GetByUsername(ctx context.Context, username string) (models.User, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
 	logger := repository.logger.With(
 		zap.String("operation", "get_user_by_username"),
 		zap.String("username", username),
@@ -112,11 +121,11 @@ func (repository *UserPgRepository) GetByUsername(username string) (models.User,
 
 	var user models.User
 
-	err := repository.db.QueryRow(context.Background(),
-		`SELECT user_id, username, created_at
+	err := repository.db.QueryRow(ctx,
+		`SELECT user_id, username, role, created_at
 		FROM users
 		WHERE username = $1;`,
-		username).Scan(&user.ID, &user.Username, &user.CreatedAt)
+		username).Scan(&user.ID, &user.Username, &user.Role, &user.CreatedAt)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
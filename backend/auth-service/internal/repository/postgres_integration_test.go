@@ -157,7 +157,7 @@ func TestIntegrationUserRepository_Add(t *testing.T) {
 		Password: "testpassword123",
 	}
 
-	user, err := testRepo.Add(userReq)
+	user, err := testRepo.Add(context.Background(), userReq)
 	require.NoError(t, err)
 	assert.NotZero(t, user.ID)
 	assert.Equal(t, userReq.Username, user.Username)
@@ -176,10 +176,10 @@ func TestIntegrationUserRepository_Add_Duplicate(t *testing.T) {
 		Password: "testpassword123",
 	}
 
-	_, err := testRepo.Add(userReq)
+	_, err := testRepo.Add(context.Background(), userReq)
 	require.NoError(t, err)
 
-	_, err = testRepo.Add(userReq)
+	_, err = testRepo.Add(context.Background(), userReq)
 	assert.ErrorIs(t, err, repository.DuplicateErr)
 }
 
@@ -195,10 +195,10 @@ func TestIntegrationUserRepository_Auth(t *testing.T) {
 		Password: "testpassword123",
 	}
 
-	_, err := testRepo.Add(userReq)
+	_, err := testRepo.Add(context.Background(), userReq)
 	require.NoError(t, err)
 
-	user, err := testRepo.Auth(userReq)
+	user, err := testRepo.Auth(context.Background(), userReq)
 	require.NoError(t, err)
 	assert.Equal(t, userReq.Username, user.Username)
 	assert.NotZero(t, user.ID)
@@ -217,7 +217,7 @@ func TestIntegrationUserRepository_Auth_WrongPassword(t *testing.T) {
 		Password: "testpassword123",
 	}
 
-	_, err := testRepo.Add(userReq)
+	_, err := testRepo.Add(context.Background(), userReq)
 	require.NoError(t, err)
 
 	wrongReq := models.UserLoginRequest{
@@ -225,7 +225,7 @@ func TestIntegrationUserRepository_Auth_WrongPassword(t *testing.T) {
 		Password: "wrongpassword",
 	}
 
-	_, err = testRepo.Auth(wrongReq)
+	_, err = testRepo.Auth(context.Background(), wrongReq)
 	assert.ErrorIs(t, err, repository.AuthErr)
 }
 
@@ -241,7 +241,7 @@ func TestIntegrationUserRepository_Auth_UserNotFound(t *testing.T) {
 		Password: "password",
 	}
 
-	_, err := testRepo.Auth(userReq)
+	_, err := testRepo.Auth(context.Background(), userReq)
 	assert.ErrorIs(t, err, repository.NotFoundErr)
 }
 
@@ -257,14 +257,15 @@ func TestIntegrationUserRepository_GetByUsername(t *testing.T) {
 		Password: "testpassword123",
 	}
 
-	addedUser, err := testRepo.Add(userReq)
+	addedUser, err := testRepo.Add(context.Background(), userReq)
 	require.NoError(t, err)
 
-	user, err := testRepo.GetByUsername(userReq.Username)
+	user, err := testRepo.GetByUsername(context.Background(), userReq.Username)
 	require.NoError(t, err)
 	assert.Equal(t, addedUser.ID, user.ID)
 	assert.Equal(t, addedUser.Username, user.Username)
 	assert.Equal(t, addedUser.CreatedAt, user.CreatedAt)
+	assert.Equal(t, "student", user.Role)
 }
 
 func TestIntegrationUserRepository_GetByUsername_NotFound(t *testing.T) {
@@ -274,7 +275,7 @@ func TestIntegrationUserRepository_GetByUsername_NotFound(t *testing.T) {
 
 	cleanupTables(t)
 
-	_, err := testRepo.GetByUsername("nonexistent")
+	_, err := testRepo.GetByUsername(context.Background(), "nonexistent")
 	assert.ErrorIs(t, err, repository.NotFoundErr)
 }
 
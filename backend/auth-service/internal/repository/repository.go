@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"errors"
 	"github.com/IAGrig/vt-csa-essays/backend/auth-service/internal/models"
 )
@@ -12,7 +13,7 @@ var (
 )
 
 type UserRepository interface {
-	Add(user models.UserLoginRequest) (models.User, error)
-	Auth(request models.UserLoginRequest) (models.User, error)
-	GetByUsername(username string) (models.User, error)
+	Add(ctx context.Context, user models.UserLoginRequest) (models.User, error)
+	Auth(ctx context.Context, request models.UserLoginRequest) (models.User, error)
+	GetByUsername(ctx context.Context, username string) (models.User, error)
 }
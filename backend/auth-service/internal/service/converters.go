@@ -10,6 +10,7 @@ func toProtoUserResponse(u models.User) *pb.UserResponse {
 	return &pb.UserResponse{
 		Id:        int32(u.ID),
 		Username:  u.Username,
+		Role:      u.Role,
 		CreatedAt: u.CreatedAt.Unix(),
 	}
 }
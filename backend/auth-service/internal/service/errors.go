@@ -0,0 +1,27 @@
+package service
+
+import (
+	"github.com/IAGrig/vt-csa-essays/backend/auth-service/internal/repository"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/grpcerr"
+	"google.golang.org/grpc/codes"
+)
+
+// Domain errors and the statuses clients see them as
+var ErrorMappings = grpcerr.Mappings{
+	{Err: repository.DuplicateErr, Code: codes.AlreadyExists, Reason: "USER_ALREADY_EXISTS"},
+	{Err: repository.NotFoundErr, Code: codes.NotFound, Reason: "USER_NOT_FOUND"},
+	{Err: repository.AuthErr, Code: codes.Unauthenticated, Reason: "INVALID_CREDENTIALS"},
+}
+
+func statusError(err error) error {
+	return ErrorMappings.Status(err)
+}
+
+func invalidCredentials() error {
+	return grpcerr.New(codes.Unauthenticated, "INVALID_CREDENTIALS", "invalid credentials")
+}
+
+// Refresh tokens are rejected the same way whatever is wrong with them
+func invalidToken() error {
+	return grpcerr.New(codes.Unauthenticated, "INVALID_TOKEN", "invalid or expired refresh token")
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	"github.com/IAGrig/vt-csa-essays/backend/auth-service/internal/models"
 	"github.com/IAGrig/vt-csa-essays/backend/auth-service/internal/repository"
@@ -35,15 +36,19 @@ func New(
 }
 
 func (s *authService) Register(ctx context.Context, in *pb.UserRegisterRequest) (*pb.UserResponse, error) {
-	logger := s.logger.With(zap.String("operation", "register"), zap.String("username", in.Username))
+	logger := logging.FromContext(ctx, s.logger).With(zap.String("operation", "register"), zap.String("username", in.Username))
 
 	logger.Info("User registration request")
 
 	req := models.UserLoginRequest{Username: in.Username, Password: in.Password}
-	user, err := s.repository.Add(req)
+	user, err := s.repository.Add(ctx, req)
 	if err != nil {
-		logger.Error("User registration failed", zap.Error(err))
-		return nil, err
+		if errors.Is(err, repository.DuplicateErr) {
+			logger.Warn("Username already taken")
+		} else {
+			logger.Error("User registration failed", zap.Error(err))
+		}
+		return nil, statusError(err)
 	}
 
 	logger.Info("User registered successfully", zap.Int64("user_id", int64(user.ID)))
@@ -51,29 +56,34 @@ func (s *authService) Register(ctx context.Context, in *pb.UserRegisterRequest)
 }
 
 func (s *authService) Auth(ctx context.Context, in *pb.UserLoginRequest) (*pb.AuthTokensResponse, error) {
-	logger := s.logger.With(zap.String("operation", "auth"), zap.String("username", in.Username))
+	logger := logging.FromContext(ctx, s.logger).With(zap.String("operation", "auth"), zap.String("username", in.Username))
 
 	logger.Debug("User authentication request")
 
 	req := models.UserLoginRequest{Username: in.Username, Password: in.Password}
 
-	user, err := s.repository.Auth(req)
+	user, err := s.repository.Auth(ctx, req)
 	if err != nil {
-		logger.Warn("User authentication failed", zap.Error(err))
-		return nil, err
+		// an unknown user looks the same as a wrong password
+		if errors.Is(err, repository.AuthErr) || errors.Is(err, repository.NotFoundErr) {
+			logger.Warn("User authentication failed", zap.Error(err))
+			return nil, invalidCredentials()
+		}
+		logger.Error("Failed to authenticate user", zap.Error(err))
+		return nil, statusError(err)
 	}
 
-	userInfo := jwt.UserInfo{UserId: user.ID, Username: user.Username}
+	userInfo := jwt.UserInfo{UserId: user.ID, Username: user.Username, Role: user.Role}
 	accessToken, err := s.jwtGenerator.GenerateAccessToken(userInfo)
 	if err != nil {
 		logger.Error("Failed to generate access token", zap.Error(err))
-		return nil, err
+		return nil, statusError(err)
 	}
 
 	refreshToken, err := s.jwtGenerator.GenerateRefreshToken(userInfo)
 	if err != nil {
 		logger.Error("Failed to generate refresh token", zap.Error(err))
-		return nil, err
+		return nil, statusError(err)
 	}
 
 	logger.Info("User authenticated successfully", zap.Int64("user_id", int64(user.ID)))
@@ -83,14 +93,18 @@ func (s *authService) Auth(ctx context.Context, in *pb.UserLoginRequest) (*pb.Au
 }
 
 func (s *authService) GetByUsername(ctx context.Context, in *pb.GetByUsernameRequest) (*pb.UserResponse, error) {
-	logger := s.logger.With(zap.String("operation", "get_by_username"), zap.String("username", in.Username))
+	logger := logging.FromContext(ctx, s.logger).With(zap.String("operation", "get_by_username"), zap.String("username", in.Username))
 
 	logger.Debug("Get user by username request")
 
-	user, err := s.repository.GetByUsername(in.Username)
+	user, err := s.repository.GetByUsername(ctx, in.Username)
 	if err != nil {
-		logger.Warn("User not found", zap.Error(err))
-		return nil, err
+		if errors.Is(err, repository.NotFoundErr) {
+			logger.Debug("User not found")
+		} else {
+			logger.Error("Failed to get user", zap.Error(err))
+		}
+		return nil, statusError(err)
 	}
 
 	logger.Debug("User retrieved successfully", zap.Int64("user_id", int64(user.ID)))
@@ -98,29 +112,33 @@ func (s *authService) GetByUsername(ctx context.Context, in *pb.GetByUsernameReq
 }
 
 func (s *authService) RefreshToken(ctx context.Context, in *pb.RefreshTokenRequest) (*pb.AuthTokensResponse, error) {
-	logger := s.logger.With(zap.String("operation", "refresh_token"))
+	logger := logging.FromContext(ctx, s.logger).With(zap.String("operation", "refresh_token"))
 
 	logger.Debug("Refresh token request")
 
 	username, err := s.jwtParser.GetUsername(in.RefreshToken, "refresh")
 	if err != nil {
 		logger.Warn("Invalid refresh token", zap.Error(err))
-		return nil, err
+		return nil, invalidToken()
 	}
 
 	logger = logger.With(zap.String("username", username))
 
-	user, err := s.repository.GetByUsername(username)
+	user, err := s.repository.GetByUsername(ctx, username)
 	if err != nil {
-		logger.Warn("User not found for refresh token", zap.Error(err))
-		return nil, err
+		if errors.Is(err, repository.NotFoundErr) {
+			logger.Warn("User not found for refresh token")
+			return nil, invalidToken()
+		}
+		logger.Error("Failed to get user for refresh token", zap.Error(err))
+		return nil, statusError(err)
 	}
 
-	userInfo := jwt.UserInfo{UserId: user.ID, Username: user.Username}
+	userInfo := jwt.UserInfo{UserId: user.ID, Username: user.Username, Role: user.Role}
 	newAccessToken, err := s.jwtGenerator.GenerateAccessToken(userInfo)
 	if err != nil {
 		logger.Error("Failed to generate new access token", zap.Error(err))
-		return nil, err
+		return nil, statusError(err)
 	}
 
 	logger.Info("Token refreshed successfully")
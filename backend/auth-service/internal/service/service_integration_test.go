@@ -18,6 +18,8 @@ import (
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
 	"github.com/testcontainers/testcontainers-go/wait"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/pressly/goose/v3"
@@ -197,7 +199,7 @@ func TestIntegrationAuthService_Register_Duplicate(t *testing.T) {
 
 	_, err = testService.Register(ctx, req)
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), repository.DuplicateErr.Error())
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
 }
 
 func TestIntegrationAuthService_Auth(t *testing.T) {
@@ -250,7 +252,7 @@ func TestIntegrationAuthService_Auth_WrongPassword(t *testing.T) {
 
 	_, err = testService.Auth(ctx, authReq)
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), repository.AuthErr.Error())
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
 }
 
 func TestIntegrationAuthService_GetByUsername(t *testing.T) {
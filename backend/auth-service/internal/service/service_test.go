@@ -12,6 +12,9 @@ import (
 	jwtMocks "github.com/IAGrig/vt-csa-essays/backend/shared/jwt/mocks"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestAuthService_Register(t *testing.T) {
@@ -20,7 +23,7 @@ func TestAuthService_Register(t *testing.T) {
 		input          *pb.UserRegisterRequest
 		setupMock      func(*mocks.MockUserRepository)
 		expectedResult *pb.UserResponse
-		expectedError  error
+		expectedCode   codes.Code
 	}{
 		{
 			name: "success - registers user successfully",
@@ -37,13 +40,13 @@ func TestAuthService_Register(t *testing.T) {
 					ID:       1,
 					Username: "testuser",
 				}
-				mockRepo.On("Add", expectedRequest).Return(expectedUser, nil)
+				mockRepo.On("Add", mock.Anything, expectedRequest).Return(expectedUser, nil)
 			},
 			expectedResult: &pb.UserResponse{
 				Id:       1,
 				Username: "testuser",
 			},
-			expectedError: nil,
+			expectedCode: codes.OK,
 		},
 		{
 			name: "error - duplicate user",
@@ -56,10 +59,10 @@ func TestAuthService_Register(t *testing.T) {
 					Username: "existinguser",
 					Password: "password123",
 				}
-				mockRepo.On("Add", expectedRequest).Return(models.User{}, repository.DuplicateErr)
+				mockRepo.On("Add", mock.Anything, expectedRequest).Return(models.User{}, repository.DuplicateErr)
 			},
 			expectedResult: nil,
-			expectedError:  repository.DuplicateErr,
+			expectedCode:   codes.AlreadyExists,
 		},
 		{
 			name: "error - repository error",
@@ -72,10 +75,10 @@ func TestAuthService_Register(t *testing.T) {
 					Username: "testuser",
 					Password: "password123",
 				}
-				mockRepo.On("Add", expectedRequest).Return(models.User{}, assert.AnError)
+				mockRepo.On("Add", mock.Anything, expectedRequest).Return(models.User{}, assert.AnError)
 			},
 			expectedResult: nil,
-			expectedError:  assert.AnError,
+			expectedCode:   codes.Internal,
 		},
 	}
 
@@ -91,9 +94,9 @@ func TestAuthService_Register(t *testing.T) {
 			service := New(mockRepo, mockGenerator, mockParser, logger)
 			result, err := service.Register(context.Background(), tt.input)
 
-			if tt.expectedError != nil {
+			if tt.expectedCode != codes.OK {
 				assert.Error(t, err)
-				assert.Equal(t, tt.expectedError, err)
+				assert.Equal(t, tt.expectedCode, status.Code(err))
 				assert.Nil(t, result)
 			} else {
 				assert.NoError(t, err)
@@ -112,7 +115,7 @@ func TestAuthService_Auth(t *testing.T) {
 		input          *pb.UserLoginRequest
 		setupMock      func(*mocks.MockUserRepository, *jwtMocks.MockTokenGenerator)
 		expectedResult *pb.AuthTokensResponse
-		expectedError  error
+		expectedCode   codes.Code
 	}{
 		{
 			name: "success - authenticates user and returns tokens",
@@ -133,7 +136,7 @@ func TestAuthService_Auth(t *testing.T) {
 					UserId:   1,
 					Username: "testuser",
 				}
-				mockRepo.On("Auth", expectedRequest).Return(expectedUser, nil)
+				mockRepo.On("Auth", mock.Anything, expectedRequest).Return(expectedUser, nil)
 				mockGenerator.On("GenerateAccessToken", expectedUserInfo).Return("access_token_123", nil)
 				mockGenerator.On("GenerateRefreshToken", expectedUserInfo).Return("refresh_token_456", nil)
 			},
@@ -141,7 +144,7 @@ func TestAuthService_Auth(t *testing.T) {
 				AccessToken:  "access_token_123",
 				RefreshToken: "refresh_token_456",
 			},
-			expectedError: nil,
+			expectedCode: codes.OK,
 		},
 		{
 			name: "error - authentication failed",
@@ -154,10 +157,26 @@ func TestAuthService_Auth(t *testing.T) {
 					Username: "testuser",
 					Password: "wrongpassword",
 				}
-				mockRepo.On("Auth", expectedRequest).Return(models.User{}, repository.AuthErr)
+				mockRepo.On("Auth", mock.Anything, expectedRequest).Return(models.User{}, repository.AuthErr)
 			},
 			expectedResult: nil,
-			expectedError:  repository.AuthErr,
+			expectedCode:   codes.Unauthenticated,
+		},
+		{
+			name: "error - unknown user",
+			input: &pb.UserLoginRequest{
+				Username: "nobody",
+				Password: "password123",
+			},
+			setupMock: func(mockRepo *mocks.MockUserRepository, mockGenerator *jwtMocks.MockTokenGenerator) {
+				expectedRequest := models.UserLoginRequest{
+					Username: "nobody",
+					Password: "password123",
+				}
+				mockRepo.On("Auth", mock.Anything, expectedRequest).Return(models.User{}, repository.NotFoundErr)
+			},
+			expectedResult: nil,
+			expectedCode:   codes.Unauthenticated,
 		},
 		{
 			name: "error - token generation fails",
@@ -178,11 +197,11 @@ func TestAuthService_Auth(t *testing.T) {
 					UserId:   1,
 					Username: "testuser",
 				}
-				mockRepo.On("Auth", expectedRequest).Return(expectedUser, nil)
+				mockRepo.On("Auth", mock.Anything, expectedRequest).Return(expectedUser, nil)
 				mockGenerator.On("GenerateAccessToken", expectedUserInfo).Return("", assert.AnError)
 			},
 			expectedResult: nil,
-			expectedError:  assert.AnError,
+			expectedCode:   codes.Internal,
 		},
 	}
 
@@ -198,9 +217,9 @@ func TestAuthService_Auth(t *testing.T) {
 			service := New(mockRepo, mockGenerator, mockParser, logger)
 			result, err := service.Auth(context.Background(), tt.input)
 
-			if tt.expectedError != nil {
+			if tt.expectedCode != codes.OK {
 				assert.Error(t, err)
-				assert.Equal(t, tt.expectedError, err)
+				assert.Equal(t, tt.expectedCode, status.Code(err))
 				assert.Nil(t, result)
 			} else {
 				assert.NoError(t, err)
@@ -220,7 +239,7 @@ func TestAuthService_GetByUsername(t *testing.T) {
 		input          *pb.GetByUsernameRequest
 		setupMock      func(*mocks.MockUserRepository)
 		expectedResult *pb.UserResponse
-		expectedError  error
+		expectedCode   codes.Code
 	}{
 		{
 			name: "success - returns user by username",
@@ -232,13 +251,13 @@ func TestAuthService_GetByUsername(t *testing.T) {
 					ID:       1,
 					Username: "testuser",
 				}
-				mockRepo.On("GetByUsername", "testuser").Return(expectedUser, nil)
+				mockRepo.On("GetByUsername", mock.Anything, "testuser").Return(expectedUser, nil)
 			},
 			expectedResult: &pb.UserResponse{
 				Id:       1,
 				Username: "testuser",
 			},
-			expectedError: nil,
+			expectedCode: codes.OK,
 		},
 		{
 			name: "error - user not found",
@@ -246,10 +265,10 @@ func TestAuthService_GetByUsername(t *testing.T) {
 				Username: "nonexistent",
 			},
 			setupMock: func(mockRepo *mocks.MockUserRepository) {
-				mockRepo.On("GetByUsername", "nonexistent").Return(models.User{}, repository.NotFoundErr)
+				mockRepo.On("GetByUsername", mock.Anything, "nonexistent").Return(models.User{}, repository.NotFoundErr)
 			},
 			expectedResult: nil,
-			expectedError:  repository.NotFoundErr,
+			expectedCode:   codes.NotFound,
 		},
 		{
 			name: "error - repository error",
@@ -257,10 +276,10 @@ func TestAuthService_GetByUsername(t *testing.T) {
 				Username: "testuser",
 			},
 			setupMock: func(mockRepo *mocks.MockUserRepository) {
-				mockRepo.On("GetByUsername", "testuser").Return(models.User{}, assert.AnError)
+				mockRepo.On("GetByUsername", mock.Anything, "testuser").Return(models.User{}, assert.AnError)
 			},
 			expectedResult: nil,
-			expectedError:  assert.AnError,
+			expectedCode:   codes.Internal,
 		},
 	}
 
@@ -276,9 +295,9 @@ func TestAuthService_GetByUsername(t *testing.T) {
 			service := New(mockRepo, mockGenerator, mockParser, logger)
 			result, err := service.GetByUsername(context.Background(), tt.input)
 
-			if tt.expectedError != nil {
+			if tt.expectedCode != codes.OK {
 				assert.Error(t, err)
-				assert.Equal(t, tt.expectedError, err)
+				assert.Equal(t, tt.expectedCode, status.Code(err))
 				assert.Nil(t, result)
 			} else {
 				assert.NoError(t, err)
@@ -297,7 +316,7 @@ func TestAuthService_RefreshToken(t *testing.T) {
 		input          *pb.RefreshTokenRequest
 		setupMock      func(*mocks.MockUserRepository, *jwtMocks.MockTokenParser, *jwtMocks.MockTokenGenerator)
 		expectedResult *pb.AuthTokensResponse
-		expectedError  error
+		expectedCode   codes.Code
 	}{
 		{
 			name: "success - refreshes access token",
@@ -315,7 +334,7 @@ func TestAuthService_RefreshToken(t *testing.T) {
 					UserId:   1,
 					Username: "testuser",
 				}
-				mockRepo.On("GetByUsername", "testuser").Return(expectedUser, nil)
+				mockRepo.On("GetByUsername", mock.Anything, "testuser").Return(expectedUser, nil)
 
 				mockGenerator.On("GenerateAccessToken", expectedUserInfo).Return("new_access_token", nil)
 			},
@@ -323,7 +342,7 @@ func TestAuthService_RefreshToken(t *testing.T) {
 				AccessToken:  "new_access_token",
 				RefreshToken: "",
 			},
-			expectedError: nil,
+			expectedCode: codes.OK,
 		},
 		{
 			name: "error - invalid refresh token",
@@ -334,7 +353,7 @@ func TestAuthService_RefreshToken(t *testing.T) {
 				mockParser.On("GetUsername", "invalid_token", "refresh").Return("", assert.AnError)
 			},
 			expectedResult: nil,
-			expectedError:  assert.AnError,
+			expectedCode:   codes.Unauthenticated,
 		},
 		{
 			name: "error - user not found",
@@ -343,10 +362,10 @@ func TestAuthService_RefreshToken(t *testing.T) {
 			},
 			setupMock: func(mockRepo *mocks.MockUserRepository, mockParser *jwtMocks.MockTokenParser, mockGenerator *jwtMocks.MockTokenGenerator) {
 				mockParser.On("GetUsername", "valid_refresh_token", "refresh").Return("deleteduser", nil)
-				mockRepo.On("GetByUsername", "deleteduser").Return(models.User{}, repository.NotFoundErr)
+				mockRepo.On("GetByUsername", mock.Anything, "deleteduser").Return(models.User{}, repository.NotFoundErr)
 			},
 			expectedResult: nil,
-			expectedError:  repository.NotFoundErr,
+			expectedCode:   codes.Unauthenticated,
 		},
 		{
 			name: "error - token generation fails",
@@ -364,12 +383,12 @@ func TestAuthService_RefreshToken(t *testing.T) {
 					UserId:   1,
 					Username: "testuser",
 				}
-				mockRepo.On("GetByUsername", "testuser").Return(expectedUser, nil)
+				mockRepo.On("GetByUsername", mock.Anything, "testuser").Return(expectedUser, nil)
 
 				mockGenerator.On("GenerateAccessToken", expectedUserInfo).Return("", assert.AnError)
 			},
 			expectedResult: nil,
-			expectedError:  assert.AnError,
+			expectedCode:   codes.Internal,
 		},
 	}
 
@@ -385,9 +404,9 @@ func TestAuthService_RefreshToken(t *testing.T) {
 			service := New(mockRepo, mockGenerator, mockParser, logger)
 			result, err := service.RefreshToken(context.Background(), tt.input)
 
-			if tt.expectedError != nil {
+			if tt.expectedCode != codes.OK {
 				assert.Error(t, err)
-				assert.Equal(t, tt.expectedError, err)
+				assert.Equal(t, tt.expectedCode, status.Code(err))
 				assert.Nil(t, result)
 			} else {
 				assert.NoError(t, err)
@@ -419,6 +438,19 @@ func TestToProtoUserResponse(t *testing.T) {
 				Username: "testuser",
 			},
 		},
+		{
+			name: "keeps user role",
+			input: models.User{
+				ID:       2,
+				Username: "teacher",
+				Role:     "teacher",
+			},
+			expected: &pb.UserResponse{
+				Id:       2,
+				Username: "teacher",
+				Role:     "teacher",
+			},
+		},
 		{
 			name: "handles zero values",
 			input: models.User{
@@ -437,6 +469,7 @@ func TestToProtoUserResponse(t *testing.T) {
 			result := toProtoUserResponse(tt.input)
 			assert.Equal(t, tt.expected.Id, result.Id)
 			assert.Equal(t, tt.expected.Username, result.Username)
+			assert.Equal(t, tt.expected.Role, result.Role)
 		})
 	}
 }
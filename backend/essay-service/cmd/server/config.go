@@ -0,0 +1,18 @@
+package main
+
+import (
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/config"
+)
+
+// Loaded by config.Load, see it for the meaning of the tags
+type Config struct {
+	config.Server `yaml:",inline"`
+
+	GRPCPort          string          `env:"ESSAY_SERVICE_GRPC_PORT" yaml:"grpc_port" required:"true"`
+	ReviewServiceAddr string          `env:"REVIEW_SERVICE_ADDR" yaml:"review_service_addr" default:"review-service:50053"`
+	ReviewTimeout     time.Duration   `env:"REVIEW_SERVICE_TIMEOUT" yaml:"review_service_timeout" default:"2s"`
+	Postgres          config.Postgres `yaml:"postgres"`
+	TLS               config.TLS      `yaml:"tls"`
+}
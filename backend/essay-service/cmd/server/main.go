@@ -1,18 +1,27 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
-	"os"
+	"net/http"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/IAGrig/vt-csa-essays/backend/essay-service/internal/repository"
 	"github.com/IAGrig/vt-csa-essays/backend/essay-service/internal/service"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/config"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/grpcclient"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/grpcerr"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/grpcmw"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/health"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/lifecycle"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/monitoring"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/mtls"
+	pgutil "github.com/IAGrig/vt-csa-essays/backend/shared/pg_util"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/tracing"
 	"go.uber.org/zap"
 
 	pb "github.com/IAGrig/vt-csa-essays/backend/proto/essay"
@@ -20,19 +29,30 @@ import (
 )
 
 func main() {
-	port := os.Getenv("ESSAY_SERVICE_GRPC_PORT")
-	reviewServicePort := os.Getenv("REVIEW_SERVICE_GRPC_PORT")
-	monitoringPort := os.Getenv("MONITORING_PORT")
-
 	logger := logging.New("essay-service")
 	defer logger.Sync()
 
-	logger.Info("Starting essay service",
-		zap.String("port", port),
-		zap.String("review_service_port", reviewServicePort),
-		zap.String("monitoring_port", monitoringPort))
+	var cfg Config
+	if err := config.Load(&cfg); err != nil {
+		logger.Fatal("Invalid configuration", zap.Error(err))
+	}
+	logger.Info("Starting essay service")
+	config.Log(logger, cfg)
+
+	monitoring.StartMetricsServer(cfg.MonitoringPort)
 
-	monitoring.StartMetricsServer(monitoringPort)
+	lc := lifecycle.New(logger, cfg.ShutdownTimeout)
+
+	certs, err := mtls.New(cfg.TLS, logger)
+	if err != nil {
+		logger.Fatal("Failed to load TLS certificates", zap.Error(err))
+	}
+	lc.Go(certs.Watch)
+
+	shutdownTracing, err := tracing.Init(context.Background(), "essay-service")
+	if err != nil {
+		logger.Fatal("Failed to initialize tracing", zap.Error(err))
+	}
 
 	repo, err := repository.NewEssayPgRepository(logger)
 	if err != nil {
@@ -40,9 +60,17 @@ func main() {
 		panic(fmt.Errorf("failed to create essay repository: %w", err))
 	}
 
-	reviewConn, err := grpc.NewClient(
-		"review-service:"+reviewServicePort,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	// reviews are left out of the essay rather than failing it, so they get
+	// a tighter deadline than the gateway gives the whole request
+	reviewConn, err := grpcclient.NewClient(cfg.ReviewServiceAddr, reviewPb.ReviewService_ServiceDesc.ServiceName, logger,
+		grpcclient.WithCredentials(certs.ClientCredentials()),
+		grpcclient.WithTimeout(cfg.ReviewTimeout),
+		grpcclient.WithRetries(
+			reviewPb.ReviewService_GetByEssayId_FullMethodName,
+			reviewPb.ReviewService_GetEssayStatsBatch_FullMethodName,
+			// comments already on the current revision are skipped, so a retry is harmless
+			reviewPb.ReviewService_ReanchorComments_FullMethodName,
+		),
 	)
 	if err != nil {
 		logger.Error("Failed to connect to review service", zap.Error(err))
@@ -54,16 +82,42 @@ func main() {
 
 	essayService := service.New(repo, reviewClient, logger)
 
-	var opts []grpc.ServerOption
+	opts := []grpc.ServerOption{
+		certs.ServerOption(),
+		tracing.ServerOption(),
+		grpc.ChainUnaryInterceptor(
+			grpcmw.UnaryServerInterceptor("essay", logger),
+			grpcerr.UnaryServerInterceptor(service.ErrorMappings, logger),
+		),
+		grpc.ChainStreamInterceptor(
+			grpcmw.StreamServerInterceptor("essay", logger),
+			grpcerr.StreamServerInterceptor(service.ErrorMappings, logger),
+		),
+	}
 	grpcServer := grpc.NewServer(opts...)
 	pb.RegisterEssayServiceServer(grpcServer, essayService)
 
-	lis, err := net.Listen("tcp", "0.0.0.0:"+port)
+	checker := health.New(pb.EssayService_ServiceDesc.ServiceName, logger)
+	checker.Add("postgres", health.PingCheck(repo.DB()))
+	checker.Add("review-service", health.GrpcCheck(reviewConn))
+	checker.Register(grpcServer)
+	checker.RegisterHTTP(http.DefaultServeMux)
+	lc.Go(func(ctx context.Context) { checker.Start(ctx, cfg.HealthCheckInterval) })
+
+	lis, err := net.Listen("tcp", "0.0.0.0:"+cfg.GRPCPort)
 	if err != nil {
 		logger.Error("Failed to listen", zap.Error(err))
 		log.Fatalf("failed to listen: %v", err)
 	}
 
 	logger.Info("Essay service started successfully", zap.String("address", lis.Addr().String()))
-	grpcServer.Serve(lis)
+	lc.ServeGRPC(grpcServer, lis)
+	lc.OnShutdown("postgres", pgutil.ClosePools)
+	lc.OnShutdown("tracing", shutdownTracing)
+
+	if err := lc.Wait(); err != nil {
+		logger.Error("Essay service stopped with errors", zap.Error(err))
+		return
+	}
+	logger.Info("Essay service stopped")
 }
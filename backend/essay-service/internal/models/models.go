@@ -12,7 +12,12 @@ type Essay struct {
 	Content   string
 	Author    string
 	AuthorId  int
+	Revision  int
 	CreatedAt time.Time
+	// Pseudonym shown instead of Author when the assignment is anonymous
+	AssignmentID int64
+	Anonymous    bool
+	AuthorAlias  string
 }
 
 // Short get response
@@ -34,6 +39,7 @@ type EssayWithReviewsResponse struct {
 
 // Add/update request DTO
 type EssayRequest struct {
-	Content string `json:"content" binding:"required"`
-	Author  string `json:"author" binding:"required"`
+	Content      string `json:"content" binding:"required"`
+	Author       string `json:"author" binding:"required"`
+	AssignmentID int64  `json:"assignment_id"`
 }
@@ -1,6 +1,8 @@
 package mocks
 
 import (
+	"context"
+
 	"github.com/IAGrig/vt-csa-essays/backend/essay-service/internal/models"
 	"github.com/stretchr/testify/mock"
 )
@@ -9,27 +11,37 @@ type MockEssayRepository struct {
 	mock.Mock
 }
 
-func (m *MockEssayRepository) Add(essay models.EssayRequest) (models.Essay, error) {
-	args := m.Called(essay)
+func (m *MockEssayRepository) Add(ctx context.Context, essay models.EssayRequest) (models.Essay, error) {
+	args := m.Called(ctx, essay)
 	return args.Get(0).(models.Essay), args.Error(1)
 }
 
-func (m *MockEssayRepository) GetAllEssays() ([]models.Essay, error) {
-	args := m.Called()
+func (m *MockEssayRepository) GetAllEssays(ctx context.Context) ([]models.Essay, error) {
+	args := m.Called(ctx)
 	return args.Get(0).([]models.Essay), args.Error(1)
 }
 
-func (m *MockEssayRepository) GetByAuthorName(username string) (models.Essay, error) {
-	args := m.Called(username)
+func (m *MockEssayRepository) GetByAuthorName(ctx context.Context, username string) (models.Essay, error) {
+	args := m.Called(ctx, username)
+	return args.Get(0).(models.Essay), args.Error(1)
+}
+
+func (m *MockEssayRepository) GetByID(ctx context.Context, essayID int) (models.Essay, error) {
+	args := m.Called(ctx, essayID)
+	return args.Get(0).(models.Essay), args.Error(1)
+}
+
+func (m *MockEssayRepository) RemoveByAuthorName(ctx context.Context, username string) (models.Essay, error) {
+	args := m.Called(ctx, username)
 	return args.Get(0).(models.Essay), args.Error(1)
 }
 
-func (m *MockEssayRepository) RemoveByAuthorName(username string) (models.Essay, error) {
-	args := m.Called(username)
+func (m *MockEssayRepository) UpdateByAuthorName(ctx context.Context, username, content string) (models.Essay, error) {
+	args := m.Called(ctx, username, content)
 	return args.Get(0).(models.Essay), args.Error(1)
 }
 
-func (m *MockEssayRepository) SearchByContent(query string) ([]models.Essay, error) {
-	args := m.Called(query)
+func (m *MockEssayRepository) SearchByContent(ctx context.Context, query string) ([]models.Essay, error) {
+	args := m.Called(ctx, query)
 	return args.Get(0).([]models.Essay), args.Error(1)
 }
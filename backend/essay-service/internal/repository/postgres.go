@@ -29,7 +29,10 @@ func NewEssayPgRepository(logger *logging.Logger) (*EssayPgRepository, error) {
 	return &EssayPgRepository{db: pool, logger: logger}, nil
 }
 
-func (repository *EssayPgRepository) Add(request models.EssayRequest) (models.Essay, error) {
+func (repository *EssayPgRepository) Add(ctx context.Context, request models.EssayRequest) (models.Essay, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
 	logger := repository.logger.With(
 		zap.String("operation", "add_essay"),
 		zap.String("author", request.Author),
@@ -37,21 +40,23 @@ func (repository *EssayPgRepository) Add(request models.EssayRequest) (models.Es
 
 	logger.Debug("Creating new essay")
 
-	e, err := repository.GetByAuthorName(request.Author)
+	e, err := repository.GetByAuthorName(ctx, request.Author)
 	if err == nil { // if essay found successfully
 		logger.Warn("Duplicate essay creation attempt")
 		return e, DuplicateErr
 	}
 
-	err = repository.db.QueryRow(context.Background(),
-		`INSERT INTO essays (content, author)
-		VALUES ($1, $2)
+	err = repository.db.QueryRow(ctx,
+		`INSERT INTO essays (content, author, assignment_id)
+		VALUES ($1, $2, NULLIF($3, 0))
 		RETURNING essay_id, content, author,
 				(SELECT user_id FROM users WHERE username = $2) AS author_id,
+				COALESCE(assignment_id, 0),
 				created_at;`,
 		request.Content,
 		request.Author,
-	).Scan(&e.ID, &e.Content, &e.Author, &e.AuthorId, &e.CreatedAt)
+		request.AssignmentID,
+	).Scan(&e.ID, &e.Content, &e.Author, &e.AuthorId, &e.AssignmentID, &e.CreatedAt)
 
 	if err != nil {
 		var pgErr *pgconn.PgError
@@ -59,6 +64,10 @@ func (repository *EssayPgRepository) Add(request models.EssayRequest) (models.Es
 			logger.Warn("Database constraint violation - duplicate essay")
 			return models.Essay{}, DuplicateErr
 		}
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" && pgErr.ConstraintName == "essays_assignment_id_fkey" {
+			logger.Warn("Essay submitted to unknown assignment", zap.Int64("assignment_id", request.AssignmentID))
+			return models.Essay{}, AssignmentNotFoundErr
+		}
 		logger.Error("Failed to create essay in database", zap.Error(err))
 		return models.Essay{}, fmt.Errorf("failed to create essay: %w", err)
 	}
@@ -67,16 +76,23 @@ func (repository *EssayPgRepository) Add(request models.EssayRequest) (models.Es
 	return e, nil
 }
 
-func (repository *EssayPgRepository) GetAllEssays() ([]models.Essay, error) {
+func (repository *EssayPgRepository) GetAllEssays(ctx context.Context) ([]models.Essay, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
 	logger := repository.logger.With(zap.String("operation", "get_all_essays"))
 
 	logger.Debug("Getting all essays")
 
-	rows, err := repository.db.Query(context.Background(),
-		`SELECT e.essay_id, e.content, e.author, u.user_id AS author_id, e.created_at
+	rows, err := repository.db.Query(ctx,
+		`SELECT e.essay_id, e.content, e.author, u.user_id AS author_id,
+			COALESCE(e.assignment_id, 0), COALESCE(a.anonymous, FALSE), COALESCE(al.alias, ''), e.created_at
 		FROM essays e
 		JOIN users u ON e.author = u.username
-		ORDER BY created_at DESC;`,
+		LEFT JOIN assignments a ON a.assignment_id = e.assignment_id
+		LEFT JOIN assignment_aliases al ON a.anonymous AND al.assignment_id = a.assignment_id
+			AND al.kind = 'author' AND al.username = e.author
+		ORDER BY e.created_at DESC;`,
 	)
 	if err != nil {
 		logger.Error("Failed to get essays from database", zap.Error(err))
@@ -92,6 +108,9 @@ func (repository *EssayPgRepository) GetAllEssays() ([]models.Essay, error) {
 			&e.Content,
 			&e.Author,
 			&e.AuthorId,
+			&e.AssignmentID,
+			&e.Anonymous,
+			&e.AuthorAlias,
 			&e.CreatedAt,
 		)
 		essays = append(essays, e)
@@ -101,22 +120,43 @@ func (repository *EssayPgRepository) GetAllEssays() ([]models.Essay, error) {
 	return essays, nil
 }
 
-func (repository *EssayPgRepository) GetByAuthorName(username string) (models.Essay, error) {
+func (repository *EssayPgRepository) GetByAuthorName(ctx context.Context, username string) (models.Essay, error) {
 	logger := repository.logger.With(
 		zap.String("operation", "get_essay_by_author"),
 		zap.String("author", username),
 	)
 
 	logger.Debug("Getting essay by author name")
+	return repository.getEssay(ctx, logger, "e.author = $1", username)
+}
+
+func (repository *EssayPgRepository) GetByID(ctx context.Context, essayID int) (models.Essay, error) {
+	logger := repository.logger.With(
+		zap.String("operation", "get_essay_by_id"),
+		zap.Int("essay_id", essayID),
+	)
+
+	logger.Debug("Getting essay by ID")
+	return repository.getEssay(ctx, logger, "e.essay_id = $1", essayID)
+}
+
+// Loads the single essay matching condition together with its anonymity details
+func (repository *EssayPgRepository) getEssay(ctx context.Context, logger *zap.Logger, condition string, arg any) (models.Essay, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
 
 	var e models.Essay
-	err := repository.db.QueryRow(context.Background(),
-		`SELECT e.essay_id, e.content, e.author, u.user_id AS author_id, e.created_at
+	err := repository.db.QueryRow(ctx,
+		`SELECT e.essay_id, e.content, e.author, u.user_id AS author_id, e.revision,
+			COALESCE(e.assignment_id, 0), COALESCE(a.anonymous, FALSE), COALESCE(al.alias, ''), e.created_at
 		FROM essays e
 		JOIN users u ON e.author = u.username
-		WHERE author = $1;`,
-		username,
-	).Scan(&e.ID, &e.Content, &e.Author, &e.AuthorId, &e.CreatedAt)
+		LEFT JOIN assignments a ON a.assignment_id = e.assignment_id
+		LEFT JOIN assignment_aliases al ON a.anonymous AND al.assignment_id = a.assignment_id
+			AND al.kind = 'author' AND al.username = e.author
+		WHERE `+condition+`;`,
+		arg,
+	).Scan(&e.ID, &e.Content, &e.Author, &e.AuthorId, &e.Revision, &e.AssignmentID, &e.Anonymous, &e.AuthorAlias, &e.CreatedAt)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -131,7 +171,10 @@ func (repository *EssayPgRepository) GetByAuthorName(username string) (models.Es
 	return e, nil
 }
 
-func (repository *EssayPgRepository) RemoveByAuthorName(username string) (models.Essay, error) {
+func (repository *EssayPgRepository) RemoveByAuthorName(ctx context.Context, username string) (models.Essay, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
 	logger := repository.logger.With(
 		zap.String("operation", "remove_essay_by_author"),
 		zap.String("author", username),
@@ -139,15 +182,15 @@ func (repository *EssayPgRepository) RemoveByAuthorName(username string) (models
 
 	logger.Info("Removing essay by author name")
 
-	tx, err := repository.db.Begin(context.Background())
+	tx, err := repository.db.Begin(ctx)
 	if err != nil {
 		logger.Error("Failed to begin transaction", zap.Error(err))
 		return models.Essay{}, fmt.Errorf("failed to begin transaction: %w", err)
 	}
-	defer tx.Rollback(context.Background())
+	defer tx.Rollback(ctx)
 
 	var e models.Essay
-	err = tx.QueryRow(context.Background(),
+	err = tx.QueryRow(ctx,
 		`DELETE FROM essays
 		WHERE author = $1
 		RETURNING essay_id, content, author,
@@ -165,7 +208,7 @@ func (repository *EssayPgRepository) RemoveByAuthorName(username string) (models
 		return models.Essay{}, fmt.Errorf("failed to delete essay: %w", err)
 	}
 
-	if err := tx.Commit(context.Background()); err != nil {
+	if err := tx.Commit(ctx); err != nil {
 		logger.Error("Failed to commit transaction", zap.Error(err))
 		return models.Essay{}, fmt.Errorf("failed to commit transaction: %w", err)
 	}
@@ -174,7 +217,49 @@ func (repository *EssayPgRepository) RemoveByAuthorName(username string) (models
 	return e, nil
 }
 
-func (repository *EssayPgRepository) SearchByContent(content string) ([]models.Essay, error) {
+// Replaces the essay text, the revision is bumped by a trigger when the text differs
+func (repository *EssayPgRepository) UpdateByAuthorName(ctx context.Context, username, content string) (models.Essay, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "update_essay_by_author"),
+		zap.String("author", username),
+	)
+
+	logger.Debug("Updating essay by author name")
+
+	var e models.Essay
+	err := repository.db.QueryRow(ctx,
+		`UPDATE essays
+		SET content = $2
+		WHERE author = $1
+		RETURNING essay_id, content, author,
+				(SELECT user_id FROM users WHERE username = $1) AS author_id,
+				revision, COALESCE(assignment_id, 0), created_at;`,
+		username,
+		content,
+	).Scan(&e.ID, &e.Content, &e.Author, &e.AuthorId, &e.Revision, &e.AssignmentID, &e.CreatedAt)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Debug("Essay to update not found")
+			return models.Essay{}, EssayNotFoundErr
+		}
+		logger.Error("Failed to update essay in database", zap.Error(err))
+		return models.Essay{}, fmt.Errorf("failed to update essay: %w", err)
+	}
+
+	logger.Info("Essay updated successfully",
+		zap.Int64("essay_id", int64(e.ID)),
+		zap.Int("revision", e.Revision))
+	return e, nil
+}
+
+func (repository *EssayPgRepository) SearchByContent(ctx context.Context, content string) ([]models.Essay, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
 	logger := repository.logger.With(
 		zap.String("operation", "search_essays_by_content"),
 		zap.String("search_term", content),
@@ -182,10 +267,14 @@ func (repository *EssayPgRepository) SearchByContent(content string) ([]models.E
 
 	logger.Debug("Searching essays by content")
 
-	rows, err := repository.db.Query(context.Background(),
-		`SELECT e.essay_id, e.content, e.author, u.user_id AS author_id, e.created_at, similarity(lower(content), lower($1)) as siml
+	rows, err := repository.db.Query(ctx,
+		`SELECT e.essay_id, e.content, e.author, u.user_id AS author_id,
+			COALESCE(e.assignment_id, 0), COALESCE(a.anonymous, FALSE), COALESCE(al.alias, ''), e.created_at, similarity(lower(e.content), lower($1)) as siml
 		FROM essays e
 		JOIN users u ON e.author = u.username
+		LEFT JOIN assignments a ON a.assignment_id = e.assignment_id
+		LEFT JOIN assignment_aliases al ON a.anonymous AND al.assignment_id = a.assignment_id
+			AND al.kind = 'author' AND al.username = e.author
 		ORDER BY siml DESC
 		LIMIT 20;`,
 		content)
@@ -203,6 +292,9 @@ func (repository *EssayPgRepository) SearchByContent(content string) ([]models.E
 			&e.Content,
 			&e.Author,
 			&e.AuthorId,
+			&e.AssignmentID,
+			&e.Anonymous,
+			&e.AuthorAlias,
 			&e.CreatedAt,
 			nil,
 		)
@@ -158,7 +158,7 @@ func TestIntegrationEssayRepository_Add(t *testing.T) {
 		Author:  "test-author",
 	}
 
-	essay, err := testRepo.Add(essayReq)
+	essay, err := testRepo.Add(context.Background(), essayReq)
 	require.NoError(t, err)
 	assert.NotZero(t, essay.ID)
 	assert.Equal(t, essayReq.Content, essay.Content)
@@ -180,10 +180,10 @@ func TestIntegrationEssayRepository_Add_Duplicate(t *testing.T) {
 		Author:  "test-author",
 	}
 
-	_, err := testRepo.Add(essayReq)
+	_, err := testRepo.Add(context.Background(), essayReq)
 	require.NoError(t, err)
 
-	_, err = testRepo.Add(essayReq)
+	_, err = testRepo.Add(context.Background(), essayReq)
 	assert.ErrorIs(t, err, repository.DuplicateErr)
 }
 
@@ -199,12 +199,12 @@ func TestIntegrationEssayRepository_GetAllEssays(t *testing.T) {
 	essay1 := models.EssayRequest{Content: "Essay 1 content", Author: "author1"}
 	essay2 := models.EssayRequest{Content: "Essay 2 content", Author: "author2"}
 
-	_, err := testRepo.Add(essay1)
+	_, err := testRepo.Add(context.Background(), essay1)
 	require.NoError(t, err)
-	_, err = testRepo.Add(essay2)
+	_, err = testRepo.Add(context.Background(), essay2)
 	require.NoError(t, err)
 
-	essays, err := testRepo.GetAllEssays()
+	essays, err := testRepo.GetAllEssays(context.Background())
 	require.NoError(t, err)
 	assert.Len(t, essays, 2)
 }
@@ -222,15 +222,37 @@ func TestIntegrationEssayRepository_GetByAuthorName(t *testing.T) {
 		Author:  "test-author",
 	}
 
-	addedEssay, err := testRepo.Add(essayReq)
+	addedEssay, err := testRepo.Add(context.Background(), essayReq)
 	require.NoError(t, err)
 
-	essay, err := testRepo.GetByAuthorName("test-author")
+	essay, err := testRepo.GetByAuthorName(context.Background(), "test-author")
 	require.NoError(t, err)
 	assert.Equal(t, addedEssay.ID, essay.ID)
 	assert.Equal(t, addedEssay.Content, essay.Content)
 	assert.Equal(t, addedEssay.Author, essay.Author)
 	assert.NotZero(t, essay.AuthorId)
+	assert.Equal(t, 1, essay.Revision)
+}
+
+func TestIntegrationEssayRepository_GetByID(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	cleanupTables(t)
+	insertTestUser(t, "test-author")
+
+	addedEssay, err := testRepo.Add(context.Background(), models.EssayRequest{Content: "Essay by id", Author: "test-author"})
+	require.NoError(t, err)
+
+	essay, err := testRepo.GetByID(context.Background(), addedEssay.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "test-author", essay.Author)
+	assert.Equal(t, "Essay by id", essay.Content)
+	assert.Equal(t, 1, essay.Revision)
+
+	_, err = testRepo.GetByID(context.Background(), addedEssay.ID+1000)
+	assert.ErrorIs(t, err, repository.EssayNotFoundErr)
 }
 
 func TestIntegrationEssayRepository_GetByAuthorName_NotFound(t *testing.T) {
@@ -240,7 +262,7 @@ func TestIntegrationEssayRepository_GetByAuthorName_NotFound(t *testing.T) {
 
 	cleanupTables(t)
 
-	_, err := testRepo.GetByAuthorName("nonexistent")
+	_, err := testRepo.GetByAuthorName(context.Background(), "nonexistent")
 	assert.ErrorIs(t, err, repository.EssayNotFoundErr)
 }
 
@@ -257,17 +279,17 @@ func TestIntegrationEssayRepository_RemoveByAuthorName(t *testing.T) {
 		Author:  "test-author",
 	}
 
-	addedEssay, err := testRepo.Add(essayReq)
+	addedEssay, err := testRepo.Add(context.Background(), essayReq)
 	require.NoError(t, err)
 
-	removedEssay, err := testRepo.RemoveByAuthorName("test-author")
+	removedEssay, err := testRepo.RemoveByAuthorName(context.Background(), "test-author")
 	require.NoError(t, err)
 	assert.Equal(t, addedEssay.ID, removedEssay.ID)
 	assert.Equal(t, addedEssay.Content, removedEssay.Content)
 	assert.Equal(t, addedEssay.Author, removedEssay.Author)
 	assert.Equal(t, addedEssay.AuthorId, addedEssay.AuthorId)
 
-	_, err = testRepo.GetByAuthorName("test-author")
+	_, err = testRepo.GetByAuthorName(context.Background(), "test-author")
 	assert.ErrorIs(t, err, repository.EssayNotFoundErr)
 }
 
@@ -278,7 +300,32 @@ func TestEssayIntegrationRepository_RemoveByAuthorName_NotFound(t *testing.T) {
 
 	cleanupTables(t)
 
-	_, err := testRepo.RemoveByAuthorName("nonexistent")
+	_, err := testRepo.RemoveByAuthorName(context.Background(), "nonexistent")
+	assert.ErrorIs(t, err, repository.EssayNotFoundErr)
+}
+
+func TestIntegrationEssayRepository_UpdateByAuthorName(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	cleanupTables(t)
+	insertTestUser(t, "test-author")
+
+	added, err := testRepo.Add(context.Background(), models.EssayRequest{Content: "First draft", Author: "test-author"})
+	require.NoError(t, err)
+
+	updated, err := testRepo.UpdateByAuthorName(context.Background(), "test-author", "Second draft")
+	require.NoError(t, err)
+	assert.Equal(t, added.ID, updated.ID)
+	assert.Equal(t, "Second draft", updated.Content)
+	assert.Equal(t, 2, updated.Revision)
+
+	unchanged, err := testRepo.UpdateByAuthorName(context.Background(), "test-author", "Second draft")
+	require.NoError(t, err)
+	assert.Equal(t, 2, unchanged.Revision)
+
+	_, err = testRepo.UpdateByAuthorName(context.Background(), "nonexistent", "Text")
 	assert.ErrorIs(t, err, repository.EssayNotFoundErr)
 }
 
@@ -294,12 +341,12 @@ func TestIntegrationEssayRepository_SearchByContent(t *testing.T) {
 	essay1 := models.EssayRequest{Content: "This essay talks about artificial intelligence", Author: "author1"}
 	essay2 := models.EssayRequest{Content: "This essay discusses machine learning algorithms", Author: "author2"}
 
-	_, err := testRepo.Add(essay1)
+	_, err := testRepo.Add(context.Background(), essay1)
 	require.NoError(t, err)
-	_, err = testRepo.Add(essay2)
+	_, err = testRepo.Add(context.Background(), essay2)
 	require.NoError(t, err)
 
-	essays, err := testRepo.SearchByContent("artificial intelligence")
+	essays, err := testRepo.SearchByContent(context.Background(), "artificial intelligence")
 	require.NoError(t, err)
 	assert.GreaterOrEqual(t, len(essays), 1)
 
@@ -313,6 +360,45 @@ func TestIntegrationEssayRepository_SearchByContent(t *testing.T) {
 	assert.True(t, found, "Should find essay with matching content")
 }
 
+func TestIntegrationEssayRepository_AnonymousAssignment(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	cleanupTables(t)
+	insertTestUser(t, "teacher")
+	insertTestUser(t, "first-author")
+	insertTestUser(t, "second-author")
+
+	var assignmentID int64
+	err := testRepo.DB().QueryRow(context.Background(),
+		"INSERT INTO assignments (title, created_by, anonymous) VALUES ('Blind week', 'teacher', TRUE) RETURNING assignment_id",
+	).Scan(&assignmentID)
+	require.NoError(t, err)
+
+	essay, err := testRepo.Add(context.Background(), models.EssayRequest{Content: "First essay", Author: "first-author", AssignmentID: assignmentID})
+	require.NoError(t, err)
+	assert.Equal(t, assignmentID, essay.AssignmentID)
+	_, err = testRepo.Add(context.Background(), models.EssayRequest{Content: "Second essay", Author: "second-author", AssignmentID: assignmentID})
+	require.NoError(t, err)
+
+	first, err := testRepo.GetByAuthorName(context.Background(), "first-author")
+	require.NoError(t, err)
+	assert.True(t, first.Anonymous)
+	assert.Equal(t, "Author A", first.AuthorAlias)
+
+	essays, err := testRepo.GetAllEssays(context.Background())
+	require.NoError(t, err)
+	aliases := map[string]string{}
+	for _, e := range essays {
+		aliases[e.Author] = e.AuthorAlias
+	}
+	assert.Equal(t, map[string]string{"first-author": "Author A", "second-author": "Author B"}, aliases)
+
+	_, err = testRepo.Add(context.Background(), models.EssayRequest{Content: "Lost essay", Author: "teacher", AssignmentID: assignmentID + 100})
+	assert.ErrorIs(t, err, repository.AssignmentNotFoundErr)
+}
+
 func cleanupTables(t *testing.T) {
 	t.Helper()
 	_, err := testRepo.DB().Exec(context.Background(), `
@@ -1,20 +1,24 @@
 package repository
 
 import (
+	"context"
 	"errors"
 
 	"github.com/IAGrig/vt-csa-essays/backend/essay-service/internal/models"
 )
 
 var (
-	DuplicateErr     = errors.New("essay already exists")
-	EssayNotFoundErr = errors.New("essay not found")
+	DuplicateErr          = errors.New("essay already exists")
+	EssayNotFoundErr      = errors.New("essay not found")
+	AssignmentNotFoundErr = errors.New("assignment not found")
 )
 
 type EssayRepository interface {
-	Add(essay models.EssayRequest) (models.Essay, error)
-	GetAllEssays() ([]models.Essay, error)
-	GetByAuthorName(username string) (models.Essay, error)
-	RemoveByAuthorName(username string) (models.Essay, error)
-	SearchByContent(string) ([]models.Essay, error)
+	Add(ctx context.Context, essay models.EssayRequest) (models.Essay, error)
+	GetAllEssays(ctx context.Context) ([]models.Essay, error)
+	GetByAuthorName(ctx context.Context, username string) (models.Essay, error)
+	GetByID(ctx context.Context, essayID int) (models.Essay, error)
+	RemoveByAuthorName(ctx context.Context, username string) (models.Essay, error)
+	UpdateByAuthorName(ctx context.Context, username, content string) (models.Essay, error)
+	SearchByContent(ctx context.Context, query string) ([]models.Essay, error)
 }
@@ -9,20 +9,27 @@ import (
 
 func toProtoEssayResponse(e models.Essay) *pb.EssayResponse {
 	return &pb.EssayResponse{
-		Id:        int32(e.ID),
-		Content:   e.Content,
-		Author:    e.Author,
-		CreatedAt: e.CreatedAt.Unix(),
+		Id:           int32(e.ID),
+		Content:      e.Content,
+		Author:       e.Author,
+		CreatedAt:    e.CreatedAt.Unix(),
+		AssignmentId: e.AssignmentID,
+		Anonymous:    e.Anonymous,
+		AuthorAlias:  e.AuthorAlias,
 	}
 }
 
 func toProtoEssayWithReviewsResponse(e models.Essay, reviews []*reviewPb.ReviewResponse) *pb.EssayWithReviewsResponse {
 	return &pb.EssayWithReviewsResponse{
-		Id:        int32(e.ID),
-		Content:   e.Content,
-		Author:    e.Author,
-		AuthorId:  int32(e.AuthorId),
-		CreatedAt: e.CreatedAt.Unix(),
-		Reviews:   reviews,
+		Id:           int32(e.ID),
+		Content:      e.Content,
+		Author:       e.Author,
+		AuthorId:     int32(e.AuthorId),
+		CreatedAt:    e.CreatedAt.Unix(),
+		Reviews:      reviews,
+		Revision:     int32(e.Revision),
+		AssignmentId: e.AssignmentID,
+		Anonymous:    e.Anonymous,
+		AuthorAlias:  e.AuthorAlias,
 	}
 }
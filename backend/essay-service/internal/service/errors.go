@@ -0,0 +1,19 @@
+package service
+
+import (
+	"github.com/IAGrig/vt-csa-essays/backend/essay-service/internal/repository"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/grpcerr"
+	"google.golang.org/grpc/codes"
+)
+
+// Domain errors and the statuses clients see them as
+var ErrorMappings = grpcerr.Mappings{
+	{Err: repository.DuplicateErr, Code: codes.AlreadyExists, Reason: "ESSAY_ALREADY_EXISTS"},
+	{Err: repository.EssayNotFoundErr, Code: codes.NotFound, Reason: "ESSAY_NOT_FOUND"},
+	// only ever referenced by the request, so a missing one is the caller's mistake
+	{Err: repository.AssignmentNotFoundErr, Code: codes.InvalidArgument, Reason: "ASSIGNMENT_NOT_FOUND"},
+}
+
+func statusError(err error) error {
+	return ErrorMappings.Status(err)
+}
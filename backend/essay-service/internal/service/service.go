@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 
@@ -15,6 +16,9 @@ import (
 	reviewPb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
 )
 
+// Largest number of essay ids sent in one review stats request
+const statsBatchSize = 500
+
 type essayService struct {
 	pb.UnimplementedEssayServiceServer
 	essayRepository repository.EssayRepository
@@ -31,18 +35,25 @@ func New(essayRepository repository.EssayRepository, reviewClient reviewPb.Revie
 }
 
 func (s *essayService) Add(ctx context.Context, in *pb.EssayAddRequest) (*pb.EssayResponse, error) {
-	logger := s.logger.With(
+	logger := logging.FromContext(ctx, s.logger).With(
 		zap.String("operation", "add_essay"),
 		zap.String("author", in.Author),
 	)
 
 	logger.Info("Adding new essay")
 
-	req := models.EssayRequest{Content: in.Content, Author: in.Author}
-	essay, err := s.essayRepository.Add(req)
+	req := models.EssayRequest{Content: in.Content, Author: in.Author, AssignmentID: in.AssignmentId}
+	essay, err := s.essayRepository.Add(ctx, req)
 	if err != nil {
-		logger.Error("Failed to add essay", zap.Error(err))
-		return nil, err
+		switch {
+		case errors.Is(err, repository.AssignmentNotFoundErr):
+			logger.Warn("Essay submitted to unknown assignment", zap.Int64("assignment_id", in.AssignmentId))
+		case errors.Is(err, repository.DuplicateErr):
+			logger.Warn("Author already has an essay")
+		default:
+			logger.Error("Failed to add essay", zap.Error(err))
+		}
+		return nil, statusError(err)
 	}
 
 	logger.Info("Essay added successfully", zap.Int64("essay_id", int64(essay.ID)))
@@ -50,18 +61,24 @@ func (s *essayService) Add(ctx context.Context, in *pb.EssayAddRequest) (*pb.Ess
 }
 
 func (s *essayService) GetAllEssays(in *pb.EmptyRequest, stream grpc.ServerStreamingServer[pb.EssayResponse]) error {
-	logger := s.logger.With(zap.String("operation", "get_all_essays"))
+	logger := logging.FromContext(stream.Context(), s.logger).With(zap.String("operation", "get_all_essays"))
 
 	logger.Debug("Getting all essays")
 
-	essays, err := s.essayRepository.GetAllEssays()
+	essays, err := s.essayRepository.GetAllEssays(stream.Context())
 	if err != nil {
 		logger.Error("Failed to get all essays", zap.Error(err))
-		return err
+		return statusError(err)
 	}
 
+	responses := make([]*pb.EssayResponse, 0, len(essays))
 	for _, essay := range essays {
-		if err := stream.Send(toProtoEssayResponse(essay)); err != nil {
+		responses = append(responses, toProtoEssayResponse(essay))
+	}
+	s.attachReviewStats(stream.Context(), logger, responses)
+
+	for _, essay := range responses {
+		if err := stream.Send(essay); err != nil {
 			logger.Error("Failed to send essay in stream", zap.Error(err))
 			return err
 		}
@@ -72,79 +89,160 @@ func (s *essayService) GetAllEssays(in *pb.EmptyRequest, stream grpc.ServerStrea
 }
 
 func (s *essayService) GetByAuthorName(ctx context.Context, in *pb.GetByAuthorNameRequest) (*pb.EssayWithReviewsResponse, error) {
-	logger := s.logger.With(
+	logger := logging.FromContext(ctx, s.logger).With(
 		zap.String("operation", "get_essay_by_author"),
 		zap.String("author", in.Authorname),
 	)
 
 	logger.Debug("Getting essay by author name with reviews")
 
-	essay, err := s.essayRepository.GetByAuthorName(in.Authorname)
+	essay, err := s.essayRepository.GetByAuthorName(ctx, in.Authorname)
 	if err != nil {
-		logger.Warn("Essay not found", zap.Error(err))
-		return nil, err
+		if errors.Is(err, repository.EssayNotFoundErr) {
+			logger.Debug("Essay not found")
+		} else {
+			logger.Error("Failed to get essay", zap.Error(err))
+		}
+		return nil, statusError(err)
 	}
 
+	return s.withReviews(ctx, logger, essay)
+}
+
+func (s *essayService) GetById(ctx context.Context, in *pb.GetByIdRequest) (*pb.EssayWithReviewsResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "get_essay_by_id"),
+		zap.Int32("essay_id", in.Id),
+	)
+
+	logger.Debug("Getting essay by ID with reviews")
+
+	essay, err := s.essayRepository.GetByID(ctx, int(in.Id))
+	if err != nil {
+		if errors.Is(err, repository.EssayNotFoundErr) {
+			logger.Debug("Essay not found")
+		} else {
+			logger.Error("Failed to get essay", zap.Error(err))
+		}
+		return nil, statusError(err)
+	}
+
+	return s.withReviews(ctx, logger, essay)
+}
+
+// Attaches the reviews of the essay, serving it without them when the review service is down
+func (s *essayService) withReviews(ctx context.Context, logger *zap.Logger, essay models.Essay) (*pb.EssayWithReviewsResponse, error) {
 	logger = logger.With(zap.Int64("essay_id", int64(essay.ID)))
 
-	reviewStream, err := s.reviewClient.GetByEssayId(ctx, &reviewPb.GetByEssayIdRequest{EssayId: int32(essay.ID)})
+	reviews, err := s.getReviews(ctx, essay.ID)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, statusError(ctx.Err())
+		}
+		logger.Warn("Review service unavailable, serving essay without reviews", zap.Error(err))
+		response := toProtoEssayWithReviewsResponse(essay, nil)
+		response.ReviewsUnavailable = true
+		return response, nil
+	}
+
+	logger.Debug("Retrieved essay with reviews", zap.Int("reviews_count", len(reviews)))
+	return toProtoEssayWithReviewsResponse(essay, reviews), nil
+}
+
+func (s *essayService) getReviews(ctx context.Context, essayID int) ([]*reviewPb.ReviewResponse, error) {
+	reviewStream, err := s.reviewClient.GetByEssayId(ctx, &reviewPb.GetByEssayIdRequest{EssayId: int32(essayID)})
 	if err != nil {
-		logger.Error("Failed to get reviews stream from review service", zap.Error(err))
-		return nil, fmt.Errorf("failed to get reviews stream from review service: %w", err)
+		return nil, fmt.Errorf("failed to get reviews stream: %w", err)
 	}
 
 	var reviews []*reviewPb.ReviewResponse
 	for {
 		review, err := reviewStream.Recv()
 		if err == io.EOF {
-			break
+			return reviews, nil
 		}
 		if err != nil {
-			logger.Error("Failed to receive review from stream", zap.Error(err))
-			return nil, err
+			return nil, fmt.Errorf("failed to receive review: %w", err)
 		}
 
 		reviews = append(reviews, review)
 	}
-
-	logger.Debug("Retrieved essay with reviews", zap.Int("reviews_count", len(reviews)))
-	return toProtoEssayWithReviewsResponse(essay, reviews), nil
 }
 
 func (s *essayService) RemoveByAuthorName(ctx context.Context, in *pb.RemoveByAuthorNameRequest) (*pb.EssayResponse, error) {
-	logger := s.logger.With(
+	logger := logging.FromContext(ctx, s.logger).With(
 		zap.String("operation", "remove_essay_by_author"),
 		zap.String("author", in.Authorname),
 	)
 
 	logger.Info("Removing essay by author name")
 
-	essay, err := s.essayRepository.RemoveByAuthorName(in.Authorname)
+	essay, err := s.essayRepository.RemoveByAuthorName(ctx, in.Authorname)
 	if err != nil {
-		logger.Error("Failed to remove essay", zap.Error(err))
-		return nil, err
+		if errors.Is(err, repository.EssayNotFoundErr) {
+			logger.Debug("Essay to remove not found")
+		} else {
+			logger.Error("Failed to remove essay", zap.Error(err))
+		}
+		return nil, statusError(err)
 	}
 
 	logger.Info("Essay removed successfully", zap.Int64("essay_id", int64(essay.ID)))
 	return toProtoEssayResponse(essay), nil
 }
 
+// Replaces the essay text and has review comments moved onto the new revision,
+// comments keep their old anchors when the review service cannot be reached
+func (s *essayService) UpdateByAuthorName(ctx context.Context, in *pb.UpdateByAuthorNameRequest) (*pb.EssayResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "update_essay_by_author"),
+		zap.String("author", in.Authorname),
+	)
+
+	logger.Info("Updating essay by author name")
+
+	essay, err := s.essayRepository.UpdateByAuthorName(ctx, in.Authorname, in.Content)
+	if err != nil {
+		if errors.Is(err, repository.EssayNotFoundErr) {
+			logger.Debug("Essay to update not found")
+		} else {
+			logger.Error("Failed to update essay", zap.Error(err))
+		}
+		return nil, statusError(err)
+	}
+
+	logger = logger.With(zap.Int64("essay_id", int64(essay.ID)))
+
+	if _, err := s.reviewClient.ReanchorComments(ctx, &reviewPb.ReanchorCommentsRequest{EssayId: int32(essay.ID)}); err != nil {
+		logger.Warn("Failed to re-anchor review comments", zap.Error(err))
+	}
+
+	logger.Info("Essay updated successfully", zap.Int("revision", essay.Revision))
+	return toProtoEssayResponse(essay), nil
+}
+
 func (s *essayService) SearchByContent(in *pb.SearchByContentRequest, stream grpc.ServerStreamingServer[pb.EssayResponse]) error {
-	logger := s.logger.With(
+	logger := logging.FromContext(stream.Context(), s.logger).With(
 		zap.String("operation", "search_essays_by_content"),
 		zap.String("search_term", in.Content),
 	)
 
 	logger.Debug("Searching essays by content")
 
-	essays, err := s.essayRepository.SearchByContent(in.Content)
+	essays, err := s.essayRepository.SearchByContent(stream.Context(), in.Content)
 	if err != nil {
 		logger.Error("Failed to search essays", zap.Error(err))
-		return err
+		return statusError(err)
 	}
 
+	responses := make([]*pb.EssayResponse, 0, len(essays))
 	for _, essay := range essays {
-		if err := stream.Send(toProtoEssayResponse(essay)); err != nil {
+		responses = append(responses, toProtoEssayResponse(essay))
+	}
+	s.attachReviewStats(stream.Context(), logger, responses)
+
+	for _, essay := range responses {
+		if err := stream.Send(essay); err != nil {
 			logger.Error("Failed to send essay in search stream", zap.Error(err))
 			return err
 		}
@@ -153,3 +251,38 @@ func (s *essayService) SearchByContent(in *pb.SearchByContentRequest, stream grp
 	logger.Debug("Search completed and results sent", zap.Int("results_count", len(essays)))
 	return nil
 }
+
+// Adds review aggregates to listed essays, the listing is still served without them
+func (s *essayService) attachReviewStats(ctx context.Context, logger *zap.Logger, essays []*pb.EssayResponse) {
+	byEssay := make(map[int32]*reviewPb.ReviewStats, len(essays))
+	for start := 0; start < len(essays); start += statsBatchSize {
+		end := min(start+statsBatchSize, len(essays))
+
+		ids := make([]int32, 0, end-start)
+		for _, essay := range essays[start:end] {
+			ids = append(ids, essay.Id)
+		}
+
+		statsStream, err := s.reviewClient.GetEssayStatsBatch(ctx, &reviewPb.GetEssayStatsBatchRequest{EssayIds: ids})
+		if err != nil {
+			logger.Warn("Failed to get review stats from review service", zap.Error(err))
+			return
+		}
+
+		for {
+			stats, err := statsStream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				logger.Warn("Failed to receive review stats from stream", zap.Error(err))
+				return
+			}
+			byEssay[stats.EssayId] = stats.Stats
+		}
+	}
+
+	for _, essay := range essays {
+		essay.ReviewStats = byEssay[essay.Id]
+	}
+}
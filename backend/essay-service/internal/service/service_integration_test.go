@@ -57,6 +57,118 @@ func (m *mockReviewClient) RemoveById(ctx context.Context, in *reviewPb.RemoveBy
 	return nil, fmt.Errorf("not implemented")
 }
 
+func (m *mockReviewClient) CreateRubric(ctx context.Context, in *reviewPb.CreateRubricRequest, opts ...grpc.CallOption) (*reviewPb.RubricResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) GetRubric(ctx context.Context, in *reviewPb.GetRubricRequest, opts ...grpc.CallOption) (*reviewPb.RubricResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) GetAllRubrics(ctx context.Context, in *reviewPb.EmptyRequest, opts ...grpc.CallOption) (reviewPb.ReviewService_GetAllRubricsClient, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) AddReply(ctx context.Context, in *reviewPb.AddReplyRequest, opts ...grpc.CallOption) (*reviewPb.ReplyResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) GetReplies(ctx context.Context, in *reviewPb.GetRepliesRequest, opts ...grpc.CallOption) (reviewPb.ReviewService_GetRepliesClient, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) RemoveReply(ctx context.Context, in *reviewPb.RemoveReplyRequest, opts ...grpc.CallOption) (*reviewPb.ReplyResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) CreateAssignment(ctx context.Context, in *reviewPb.CreateAssignmentRequest, opts ...grpc.CallOption) (*reviewPb.AssignmentResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) GetAssignment(ctx context.Context, in *reviewPb.GetAssignmentRequest, opts ...grpc.CallOption) (*reviewPb.AssignmentResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) GetAllAssignments(ctx context.Context, in *reviewPb.EmptyRequest, opts ...grpc.CallOption) (reviewPb.ReviewService_GetAllAssignmentsClient, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) UpdateAssignment(ctx context.Context, in *reviewPb.UpdateAssignmentRequest, opts ...grpc.CallOption) (*reviewPb.AssignmentResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) UpdateReview(ctx context.Context, in *reviewPb.UpdateReviewRequest, opts ...grpc.CallOption) (*reviewPb.ReviewResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) GetReviewHistory(ctx context.Context, in *reviewPb.GetReviewHistoryRequest, opts ...grpc.CallOption) (reviewPb.ReviewService_GetReviewHistoryClient, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) GetEssayStats(ctx context.Context, in *reviewPb.GetEssayStatsRequest, opts ...grpc.CallOption) (*reviewPb.EssayStatsResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) GetEssayStatsBatch(ctx context.Context, in *reviewPb.GetEssayStatsBatchRequest, opts ...grpc.CallOption) (reviewPb.ReviewService_GetEssayStatsBatchClient, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) GetAssignmentStats(ctx context.Context, in *reviewPb.GetAssignmentStatsRequest, opts ...grpc.CallOption) (*reviewPb.AssignmentStatsResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) GetByAuthor(ctx context.Context, in *reviewPb.GetByAuthorRequest, opts ...grpc.CallOption) (reviewPb.ReviewService_GetByAuthorClient, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) SetGradesReleased(ctx context.Context, in *reviewPb.SetGradesReleasedRequest, opts ...grpc.CallOption) (*reviewPb.AssignmentResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) SetGrade(ctx context.Context, in *reviewPb.SetGradeRequest, opts ...grpc.CallOption) (*reviewPb.GradeResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) GetGrade(ctx context.Context, in *reviewPb.GetGradeRequest, opts ...grpc.CallOption) (*reviewPb.GradeResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) GetGradebook(ctx context.Context, in *reviewPb.GetGradebookRequest, opts ...grpc.CallOption) (reviewPb.ReviewService_GetGradebookClient, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) SetCalibration(ctx context.Context, in *reviewPb.SetCalibrationRequest, opts ...grpc.CallOption) (*reviewPb.CalibrationResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) RemoveCalibration(ctx context.Context, in *reviewPb.RemoveCalibrationRequest, opts ...grpc.CallOption) (*reviewPb.CalibrationResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) RecomputeReliability(ctx context.Context, in *reviewPb.EmptyRequest, opts ...grpc.CallOption) (reviewPb.ReviewService_RecomputeReliabilityClient, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) GetReviewerReliability(ctx context.Context, in *reviewPb.EmptyRequest, opts ...grpc.CallOption) (reviewPb.ReviewService_GetReviewerReliabilityClient, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) SaveDraft(ctx context.Context, in *reviewPb.SaveDraftRequest, opts ...grpc.CallOption) (*reviewPb.DraftResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) GetDraft(ctx context.Context, in *reviewPb.GetDraftRequest, opts ...grpc.CallOption) (*reviewPb.DraftResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) SubmitDraft(ctx context.Context, in *reviewPb.SubmitDraftRequest, opts ...grpc.CallOption) (*reviewPb.ReviewResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (m *mockReviewClient) ReanchorComments(ctx context.Context, in *reviewPb.ReanchorCommentsRequest, opts ...grpc.CallOption) (*reviewPb.ReanchorCommentsResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
 type mockReviewStream struct {
 	reviews []*reviewPb.ReviewResponse
 	index   int
@@ -274,9 +386,9 @@ func TestIntegrationEssayService_GetAllEssays(t *testing.T) {
 	essay1 := models.EssayRequest{Content: "Essay 1 content", Author: "author1"}
 	essay2 := models.EssayRequest{Content: "Essay 2 content", Author: "author2"}
 
-	_, err := testRepo.Add(essay1)
+	_, err := testRepo.Add(context.Background(), essay1)
 	require.NoError(t, err)
-	_, err = testRepo.Add(essay2)
+	_, err = testRepo.Add(context.Background(), essay2)
 	require.NoError(t, err)
 
 	req := &pb.EmptyRequest{}
@@ -296,7 +408,7 @@ func TestIntegrationEssayService_RemoveByAuthorName(t *testing.T) {
 	insertTestUser(t, "test-author")
 
 	essayReq := models.EssayRequest{Content: "Test essay content", Author: "test-author"}
-	addedEssay, err := testRepo.Add(essayReq)
+	addedEssay, err := testRepo.Add(context.Background(), essayReq)
 	require.NoError(t, err)
 
 	req := &pb.RemoveByAuthorNameRequest{
@@ -311,7 +423,7 @@ func TestIntegrationEssayService_RemoveByAuthorName(t *testing.T) {
 	assert.Equal(t, essayReq.Content, resp.Content)
 	assert.Equal(t, essayReq.Author, resp.Author)
 
-	_, err = testRepo.GetByAuthorName("test-author")
+	_, err = testRepo.GetByAuthorName(context.Background(), "test-author")
 	assert.ErrorIs(t, err, repository.EssayNotFoundErr)
 }
 
@@ -327,9 +439,9 @@ func TestIntegrationEssayService_SearchByContent(t *testing.T) {
 	essay1 := models.EssayRequest{Content: "This essay talks about artificial intelligence", Author: "author1"}
 	essay2 := models.EssayRequest{Content: "This essay discusses machine learning", Author: "author2"}
 
-	_, err := testRepo.Add(essay1)
+	_, err := testRepo.Add(context.Background(), essay1)
 	require.NoError(t, err)
-	_, err = testRepo.Add(essay2)
+	_, err = testRepo.Add(context.Background(), essay2)
 	require.NoError(t, err)
 
 	req := &pb.SearchByContentRequest{
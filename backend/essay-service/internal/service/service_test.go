@@ -14,8 +14,11 @@ import (
 	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 type MockReviewClient struct {
@@ -42,6 +45,149 @@ func (m *MockReviewClient) RemoveById(ctx context.Context, in *reviewPb.RemoveBy
 	return args.Get(0).(*reviewPb.ReviewResponse), args.Error(1)
 }
 
+func (m *MockReviewClient) CreateRubric(ctx context.Context, in *reviewPb.CreateRubricRequest, opts ...grpc.CallOption) (*reviewPb.RubricResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*reviewPb.RubricResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) GetRubric(ctx context.Context, in *reviewPb.GetRubricRequest, opts ...grpc.CallOption) (*reviewPb.RubricResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*reviewPb.RubricResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) GetAllRubrics(ctx context.Context, in *reviewPb.EmptyRequest, opts ...grpc.CallOption) (reviewPb.ReviewService_GetAllRubricsClient, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(reviewPb.ReviewService_GetAllRubricsClient), args.Error(1)
+}
+
+func (m *MockReviewClient) AddReply(ctx context.Context, in *reviewPb.AddReplyRequest, opts ...grpc.CallOption) (*reviewPb.ReplyResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*reviewPb.ReplyResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) GetReplies(ctx context.Context, in *reviewPb.GetRepliesRequest, opts ...grpc.CallOption) (reviewPb.ReviewService_GetRepliesClient, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(reviewPb.ReviewService_GetRepliesClient), args.Error(1)
+}
+
+func (m *MockReviewClient) RemoveReply(ctx context.Context, in *reviewPb.RemoveReplyRequest, opts ...grpc.CallOption) (*reviewPb.ReplyResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*reviewPb.ReplyResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) CreateAssignment(ctx context.Context, in *reviewPb.CreateAssignmentRequest, opts ...grpc.CallOption) (*reviewPb.AssignmentResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*reviewPb.AssignmentResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) GetAssignment(ctx context.Context, in *reviewPb.GetAssignmentRequest, opts ...grpc.CallOption) (*reviewPb.AssignmentResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*reviewPb.AssignmentResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) GetAllAssignments(ctx context.Context, in *reviewPb.EmptyRequest, opts ...grpc.CallOption) (reviewPb.ReviewService_GetAllAssignmentsClient, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(reviewPb.ReviewService_GetAllAssignmentsClient), args.Error(1)
+}
+
+func (m *MockReviewClient) UpdateAssignment(ctx context.Context, in *reviewPb.UpdateAssignmentRequest, opts ...grpc.CallOption) (*reviewPb.AssignmentResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*reviewPb.AssignmentResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) UpdateReview(ctx context.Context, in *reviewPb.UpdateReviewRequest, opts ...grpc.CallOption) (*reviewPb.ReviewResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*reviewPb.ReviewResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) GetReviewHistory(ctx context.Context, in *reviewPb.GetReviewHistoryRequest, opts ...grpc.CallOption) (reviewPb.ReviewService_GetReviewHistoryClient, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(reviewPb.ReviewService_GetReviewHistoryClient), args.Error(1)
+}
+
+func (m *MockReviewClient) GetEssayStats(ctx context.Context, in *reviewPb.GetEssayStatsRequest, opts ...grpc.CallOption) (*reviewPb.EssayStatsResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*reviewPb.EssayStatsResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) GetEssayStatsBatch(ctx context.Context, in *reviewPb.GetEssayStatsBatchRequest, opts ...grpc.CallOption) (reviewPb.ReviewService_GetEssayStatsBatchClient, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(reviewPb.ReviewService_GetEssayStatsBatchClient), args.Error(1)
+}
+
+func (m *MockReviewClient) GetAssignmentStats(ctx context.Context, in *reviewPb.GetAssignmentStatsRequest, opts ...grpc.CallOption) (*reviewPb.AssignmentStatsResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*reviewPb.AssignmentStatsResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) GetByAuthor(ctx context.Context, in *reviewPb.GetByAuthorRequest, opts ...grpc.CallOption) (reviewPb.ReviewService_GetByAuthorClient, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(reviewPb.ReviewService_GetByAuthorClient), args.Error(1)
+}
+
+func (m *MockReviewClient) SetGradesReleased(ctx context.Context, in *reviewPb.SetGradesReleasedRequest, opts ...grpc.CallOption) (*reviewPb.AssignmentResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*reviewPb.AssignmentResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) SetGrade(ctx context.Context, in *reviewPb.SetGradeRequest, opts ...grpc.CallOption) (*reviewPb.GradeResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*reviewPb.GradeResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) GetGrade(ctx context.Context, in *reviewPb.GetGradeRequest, opts ...grpc.CallOption) (*reviewPb.GradeResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*reviewPb.GradeResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) GetGradebook(ctx context.Context, in *reviewPb.GetGradebookRequest, opts ...grpc.CallOption) (reviewPb.ReviewService_GetGradebookClient, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(reviewPb.ReviewService_GetGradebookClient), args.Error(1)
+}
+
+func (m *MockReviewClient) SetCalibration(ctx context.Context, in *reviewPb.SetCalibrationRequest, opts ...grpc.CallOption) (*reviewPb.CalibrationResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*reviewPb.CalibrationResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) RemoveCalibration(ctx context.Context, in *reviewPb.RemoveCalibrationRequest, opts ...grpc.CallOption) (*reviewPb.CalibrationResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*reviewPb.CalibrationResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) RecomputeReliability(ctx context.Context, in *reviewPb.EmptyRequest, opts ...grpc.CallOption) (reviewPb.ReviewService_RecomputeReliabilityClient, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(reviewPb.ReviewService_RecomputeReliabilityClient), args.Error(1)
+}
+
+func (m *MockReviewClient) GetReviewerReliability(ctx context.Context, in *reviewPb.EmptyRequest, opts ...grpc.CallOption) (reviewPb.ReviewService_GetReviewerReliabilityClient, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(reviewPb.ReviewService_GetReviewerReliabilityClient), args.Error(1)
+}
+
+func (m *MockReviewClient) SaveDraft(ctx context.Context, in *reviewPb.SaveDraftRequest, opts ...grpc.CallOption) (*reviewPb.DraftResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*reviewPb.DraftResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) GetDraft(ctx context.Context, in *reviewPb.GetDraftRequest, opts ...grpc.CallOption) (*reviewPb.DraftResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*reviewPb.DraftResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) SubmitDraft(ctx context.Context, in *reviewPb.SubmitDraftRequest, opts ...grpc.CallOption) (*reviewPb.ReviewResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*reviewPb.ReviewResponse), args.Error(1)
+}
+
+func (m *MockReviewClient) ReanchorComments(ctx context.Context, in *reviewPb.ReanchorCommentsRequest, opts ...grpc.CallOption) (*reviewPb.ReanchorCommentsResponse, error) {
+	args := m.Called(ctx, in, opts)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*reviewPb.ReanchorCommentsResponse), args.Error(1)
+}
+
 type MockReviewStream struct {
 	mock.Mock
 	reviews []*reviewPb.ReviewResponse
@@ -90,6 +236,24 @@ func (m *MockReviewStream) RecvMsg(msg interface{}) error {
 	return args.Error(0)
 }
 
+type essayStatsStream struct {
+	grpc.ClientStream
+	stats []*reviewPb.EssayStatsResponse
+	err   error
+}
+
+func (m *essayStatsStream) Recv() (*reviewPb.EssayStatsResponse, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	if len(m.stats) == 0 {
+		return nil, io.EOF
+	}
+	next := m.stats[0]
+	m.stats = m.stats[1:]
+	return next, nil
+}
+
 type MinimalServerStream struct {
 	ctx          context.Context
 	sentMessages []*pb.EssayResponse
@@ -120,7 +284,7 @@ func TestEssayService_Add(t *testing.T) {
 		input          *pb.EssayAddRequest
 		setupMock      func(*mocks.MockEssayRepository)
 		expectedResult *pb.EssayResponse
-		expectedError  error
+		expectedCode   codes.Code
 	}{
 		{
 			name: "success - adds essay successfully",
@@ -138,14 +302,14 @@ func TestEssayService_Add(t *testing.T) {
 					Content: "Test essay content",
 					Author:  "testuser",
 				}
-				mockRepo.On("Add", expectedRequest).Return(expectedEssay, nil)
+				mockRepo.On("Add", mock.Anything, expectedRequest).Return(expectedEssay, nil)
 			},
 			expectedResult: &pb.EssayResponse{
 				Id:      1,
 				Content: "Test essay content",
 				Author:  "testuser",
 			},
-			expectedError: nil,
+			expectedCode: codes.OK,
 		},
 		{
 			name: "error - duplicate essay",
@@ -158,10 +322,10 @@ func TestEssayService_Add(t *testing.T) {
 					Content: "Duplicate content",
 					Author:  "testuser",
 				}
-				mockRepo.On("Add", expectedRequest).Return(models.Essay{}, repository.DuplicateErr)
+				mockRepo.On("Add", mock.Anything, expectedRequest).Return(models.Essay{}, repository.DuplicateErr)
 			},
 			expectedResult: nil,
-			expectedError:  repository.DuplicateErr,
+			expectedCode:   codes.AlreadyExists,
 		},
 		{
 			name: "error - repository error",
@@ -174,10 +338,10 @@ func TestEssayService_Add(t *testing.T) {
 					Content: "Test content",
 					Author:  "testuser",
 				}
-				mockRepo.On("Add", expectedRequest).Return(models.Essay{}, assert.AnError)
+				mockRepo.On("Add", mock.Anything, expectedRequest).Return(models.Essay{}, assert.AnError)
 			},
 			expectedResult: nil,
-			expectedError:  assert.AnError,
+			expectedCode:   codes.Internal,
 		},
 	}
 
@@ -191,9 +355,9 @@ func TestEssayService_Add(t *testing.T) {
 			service := New(mockRepo, mockReviewClient, logger)
 			result, err := service.Add(context.Background(), tt.input)
 
-			if tt.expectedError != nil {
+			if tt.expectedCode != codes.OK {
 				assert.Error(t, err)
-				assert.Equal(t, tt.expectedError, err)
+				assert.Equal(t, tt.expectedCode, status.Code(err))
 				assert.Nil(t, result)
 			} else {
 				assert.NoError(t, err)
@@ -207,6 +371,25 @@ func TestEssayService_Add(t *testing.T) {
 	}
 }
 
+func TestEssayService_AddToAssignment(t *testing.T) {
+	mockRepo := new(mocks.MockEssayRepository)
+	mockRepo.On("Add", mock.Anything, models.EssayRequest{Content: "Blind essay", Author: "testuser", AssignmentID: 3}).
+		Return(models.Essay{ID: 1, Content: "Blind essay", Author: "testuser", AssignmentID: 3}, nil)
+	mockRepo.On("Add", mock.Anything, models.EssayRequest{Content: "Blind essay", Author: "testuser", AssignmentID: 4}).
+		Return(models.Essay{}, repository.AssignmentNotFoundErr)
+
+	service := New(mockRepo, new(MockReviewClient), logging.NewEmptyLogger())
+
+	result, err := service.Add(context.Background(), &pb.EssayAddRequest{Content: "Blind essay", Author: "testuser", AssignmentId: 3})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), result.AssignmentId)
+
+	_, err = service.Add(context.Background(), &pb.EssayAddRequest{Content: "Blind essay", Author: "testuser", AssignmentId: 4})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	mockRepo.AssertExpectations(t)
+}
+
 func TestEssayService_GetAllEssays(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -222,7 +405,7 @@ func TestEssayService_GetAllEssays(t *testing.T) {
 					{ID: 1, Content: "Essay 1", Author: "user1"},
 					{ID: 2, Content: "Essay 2", Author: "user2"},
 				}
-				mockRepo.On("GetAllEssays").Return(essays, nil)
+				mockRepo.On("GetAllEssays", mock.Anything, mock.Anything).Return(essays, nil)
 			},
 			expectedCount: 2,
 			expectedError: false,
@@ -230,7 +413,7 @@ func TestEssayService_GetAllEssays(t *testing.T) {
 		{
 			name: "success - streams empty list when no essays",
 			setupMock: func(mockRepo *mocks.MockEssayRepository) {
-				mockRepo.On("GetAllEssays").Return([]models.Essay{}, nil)
+				mockRepo.On("GetAllEssays", mock.Anything, mock.Anything).Return([]models.Essay{}, nil)
 			},
 			expectedCount: 0,
 			expectedError: false,
@@ -238,7 +421,7 @@ func TestEssayService_GetAllEssays(t *testing.T) {
 		{
 			name: "error - repository returns error",
 			setupMock: func(mockRepo *mocks.MockEssayRepository) {
-				mockRepo.On("GetAllEssays").Return([]models.Essay{}, assert.AnError)
+				mockRepo.On("GetAllEssays", mock.Anything, mock.Anything).Return([]models.Essay{}, assert.AnError)
 			},
 			expectedCount: 0,
 			expectedError: true,
@@ -249,7 +432,7 @@ func TestEssayService_GetAllEssays(t *testing.T) {
 				essays := []models.Essay{
 					{ID: 1, Content: "Essay 1", Author: "user1"},
 				}
-				mockRepo.On("GetAllEssays").Return(essays, nil)
+				mockRepo.On("GetAllEssays", mock.Anything, mock.Anything).Return(essays, nil)
 			},
 			sendError:     assert.AnError,
 			expectedCount: 0,
@@ -261,6 +444,8 @@ func TestEssayService_GetAllEssays(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo := new(mocks.MockEssayRepository)
 			mockReviewClient := new(MockReviewClient)
+			mockReviewClient.On("GetEssayStatsBatch", mock.Anything, mock.Anything, mock.Anything).
+				Return(&essayStatsStream{}, nil).Maybe()
 			tt.setupMock(mockRepo)
 
 			stream := &MinimalServerStream{
@@ -284,13 +469,75 @@ func TestEssayService_GetAllEssays(t *testing.T) {
 	}
 }
 
+func TestEssayService_GetAllEssaysWithReviewStats(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMock     func(*MockReviewClient)
+		expectedStats []*reviewPb.ReviewStats
+	}{
+		{
+			name: "attaches stats to every essay",
+			setupMock: func(mockReviewClient *MockReviewClient) {
+				mockReviewClient.On("GetEssayStatsBatch", mock.Anything, &reviewPb.GetEssayStatsBatchRequest{EssayIds: []int32{1, 2}}, mock.Anything).
+					Return(&essayStatsStream{stats: []*reviewPb.EssayStatsResponse{
+						{EssayId: 1, Stats: &reviewPb.ReviewStats{ReviewCount: 2, MeanRank: 2.5}},
+						{EssayId: 2, Stats: &reviewPb.ReviewStats{}},
+					}}, nil)
+			},
+			expectedStats: []*reviewPb.ReviewStats{
+				{ReviewCount: 2, MeanRank: 2.5},
+				{},
+			},
+		},
+		{
+			name: "lists essays when review service is unavailable",
+			setupMock: func(mockReviewClient *MockReviewClient) {
+				mockReviewClient.On("GetEssayStatsBatch", mock.Anything, mock.Anything, mock.Anything).
+					Return((*essayStatsStream)(nil), errors.New("review service unavailable"))
+			},
+			expectedStats: []*reviewPb.ReviewStats{nil, nil},
+		},
+		{
+			name: "lists essays when stats stream fails",
+			setupMock: func(mockReviewClient *MockReviewClient) {
+				mockReviewClient.On("GetEssayStatsBatch", mock.Anything, mock.Anything, mock.Anything).
+					Return(&essayStatsStream{err: assert.AnError}, nil)
+			},
+			expectedStats: []*reviewPb.ReviewStats{nil, nil},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mocks.MockEssayRepository)
+			mockRepo.On("GetAllEssays", mock.Anything, mock.Anything).Return([]models.Essay{
+				{ID: 1, Content: "Essay 1", Author: "user1"},
+				{ID: 2, Content: "Essay 2", Author: "user2"},
+			}, nil)
+			mockReviewClient := new(MockReviewClient)
+			tt.setupMock(mockReviewClient)
+
+			stream := &MinimalServerStream{ctx: context.Background()}
+			service := New(mockRepo, mockReviewClient, logging.NewEmptyLogger())
+			err := service.GetAllEssays(&pb.EmptyRequest{}, stream)
+			require.NoError(t, err)
+
+			require.Len(t, stream.sentMessages, len(tt.expectedStats))
+			for i, expected := range tt.expectedStats {
+				assert.Equal(t, expected, stream.sentMessages[i].ReviewStats)
+			}
+			mockReviewClient.AssertExpectations(t)
+		})
+	}
+}
+
 func TestEssayService_GetByAuthorName(t *testing.T) {
 	tests := []struct {
 		name           string
 		input          *pb.GetByAuthorNameRequest
 		setupMock      func(*mocks.MockEssayRepository, *MockReviewClient, *MockReviewStream)
 		expectedResult *pb.EssayWithReviewsResponse
-		expectedError  error
+		expectedCode   codes.Code
 	}{
 		{
 			name:  "success - returns essay with reviews",
@@ -301,8 +548,9 @@ func TestEssayService_GetByAuthorName(t *testing.T) {
 					Content:  "Test essay",
 					Author:   "testuser",
 					AuthorId: 1,
+					Revision: 2,
 				}
-				mockRepo.On("GetByAuthorName", "testuser").Return(expectedEssay, nil)
+				mockRepo.On("GetByAuthorName", mock.Anything, "testuser").Return(expectedEssay, nil)
 
 				reviewRequest := &reviewPb.GetByEssayIdRequest{EssayId: 1}
 				mockReviewClient.On("GetByEssayId", mock.Anything, reviewRequest, mock.Anything).Return(mockStream, nil)
@@ -322,24 +570,25 @@ func TestEssayService_GetByAuthorName(t *testing.T) {
 				Content:  "Test essay",
 				Author:   "testuser",
 				AuthorId: 1,
+				Revision: 2,
 				Reviews: []*reviewPb.ReviewResponse{
 					{Id: 1, EssayId: 1, Rank: 5, Content: "Great essay", Author: "reviewer1"},
 					{Id: 2, EssayId: 1, Rank: 4, Content: "Good essay", Author: "reviewer2"},
 				},
 			},
-			expectedError: nil,
+			expectedCode: codes.OK,
 		},
 		{
 			name:  "error - essay not found",
 			input: &pb.GetByAuthorNameRequest{Authorname: "nonexistent"},
 			setupMock: func(mockRepo *mocks.MockEssayRepository, mockReviewClient *MockReviewClient, mockStream *MockReviewStream) {
-				mockRepo.On("GetByAuthorName", "nonexistent").Return(models.Essay{}, repository.EssayNotFoundErr)
+				mockRepo.On("GetByAuthorName", mock.Anything, "nonexistent").Return(models.Essay{}, repository.EssayNotFoundErr)
 			},
 			expectedResult: nil,
-			expectedError:  repository.EssayNotFoundErr,
+			expectedCode:   codes.NotFound,
 		},
 		{
-			name:  "error - review client fails",
+			name:  "degraded - review client fails",
 			input: &pb.GetByAuthorNameRequest{Authorname: "testuser"},
 			setupMock: func(mockRepo *mocks.MockEssayRepository, mockReviewClient *MockReviewClient, mockStream *MockReviewStream) {
 				expectedEssay := models.Essay{
@@ -347,16 +596,21 @@ func TestEssayService_GetByAuthorName(t *testing.T) {
 					Content: "Test essay",
 					Author:  "testuser",
 				}
-				mockRepo.On("GetByAuthorName", "testuser").Return(expectedEssay, nil)
+				mockRepo.On("GetByAuthorName", mock.Anything, "testuser").Return(expectedEssay, nil)
 
 				reviewRequest := &reviewPb.GetByEssayIdRequest{EssayId: 1}
 				mockReviewClient.On("GetByEssayId", mock.Anything, reviewRequest, mock.Anything).Return((*MockReviewStream)(nil), errors.New("review service unavailable"))
 			},
-			expectedResult: nil,
-			expectedError:  errors.New("failed to get reviews stream from review service: review service unavailable"),
+			expectedResult: &pb.EssayWithReviewsResponse{
+				Id:                 1,
+				Content:            "Test essay",
+				Author:             "testuser",
+				ReviewsUnavailable: true,
+			},
+			expectedCode: codes.OK,
 		},
 		{
-			name:  "error - review stream fails",
+			name:  "degraded - review stream fails",
 			input: &pb.GetByAuthorNameRequest{Authorname: "testuser"},
 			setupMock: func(mockRepo *mocks.MockEssayRepository, mockReviewClient *MockReviewClient, mockStream *MockReviewStream) {
 				expectedEssay := models.Essay{
@@ -364,15 +618,20 @@ func TestEssayService_GetByAuthorName(t *testing.T) {
 					Content: "Test essay",
 					Author:  "testuser",
 				}
-				mockRepo.On("GetByAuthorName", "testuser").Return(expectedEssay, nil)
+				mockRepo.On("GetByAuthorName", mock.Anything, "testuser").Return(expectedEssay, nil)
 
 				reviewRequest := &reviewPb.GetByEssayIdRequest{EssayId: 1}
 				mockReviewClient.On("GetByEssayId", mock.Anything, reviewRequest, mock.Anything).Return(mockStream, nil)
 
 				mockStream.On("Recv").Return(assert.AnError).Once()
 			},
-			expectedResult: nil,
-			expectedError:  assert.AnError,
+			expectedResult: &pb.EssayWithReviewsResponse{
+				Id:                 1,
+				Content:            "Test essay",
+				Author:             "testuser",
+				ReviewsUnavailable: true,
+			},
+			expectedCode: codes.OK,
 		},
 		{
 			name:  "success - essay with no reviews",
@@ -384,7 +643,7 @@ func TestEssayService_GetByAuthorName(t *testing.T) {
 					Author:   "testuser",
 					AuthorId: 1,
 				}
-				mockRepo.On("GetByAuthorName", "testuser").Return(expectedEssay, nil)
+				mockRepo.On("GetByAuthorName", mock.Anything, "testuser").Return(expectedEssay, nil)
 
 				reviewRequest := &reviewPb.GetByEssayIdRequest{EssayId: 1}
 				mockReviewClient.On("GetByEssayId", mock.Anything, reviewRequest, mock.Anything).Return(mockStream, nil)
@@ -398,7 +657,7 @@ func TestEssayService_GetByAuthorName(t *testing.T) {
 				AuthorId: 1,
 				Reviews:  []*reviewPb.ReviewResponse{},
 			},
-			expectedError: nil,
+			expectedCode: codes.OK,
 		},
 	}
 
@@ -413,9 +672,9 @@ func TestEssayService_GetByAuthorName(t *testing.T) {
 			service := New(mockRepo, mockReviewClient, logger)
 			result, err := service.GetByAuthorName(context.Background(), tt.input)
 
-			if tt.expectedError != nil {
+			if tt.expectedCode != codes.OK {
 				assert.Error(t, err)
-				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Equal(t, tt.expectedCode, status.Code(err))
 				assert.Nil(t, result)
 			} else {
 				assert.NoError(t, err)
@@ -423,6 +682,8 @@ func TestEssayService_GetByAuthorName(t *testing.T) {
 				assert.Equal(t, tt.expectedResult.Content, result.Content)
 				assert.Equal(t, tt.expectedResult.Author, result.Author)
 				assert.Equal(t, tt.expectedResult.AuthorId, result.AuthorId)
+				assert.Equal(t, tt.expectedResult.Revision, result.Revision)
+				assert.Equal(t, tt.expectedResult.ReviewsUnavailable, result.ReviewsUnavailable)
 				assert.Len(t, result.Reviews, len(tt.expectedResult.Reviews))
 
 				for i, expectedReview := range tt.expectedResult.Reviews {
@@ -439,13 +700,58 @@ func TestEssayService_GetByAuthorName(t *testing.T) {
 	}
 }
 
+func TestEssayService_GetById(t *testing.T) {
+	t.Run("success - returns anonymous essay with reviews", func(t *testing.T) {
+		mockRepo := new(mocks.MockEssayRepository)
+		mockReviewClient := new(MockReviewClient)
+		mockStream := new(MockReviewStream)
+
+		mockRepo.On("GetByID", mock.Anything, 7).Return(models.Essay{
+			ID:          7,
+			Content:     "Anonymous essay",
+			Author:      "testuser",
+			Anonymous:   true,
+			AuthorAlias: "Author A",
+		}, nil)
+		mockReviewClient.On("GetByEssayId", mock.Anything, &reviewPb.GetByEssayIdRequest{EssayId: 7}, mock.Anything).Return(mockStream, nil)
+		mockStream.reviews = []*reviewPb.ReviewResponse{{Id: 1, EssayId: 7, Rank: 2, Content: "Fine", Author: "reviewer1"}}
+		mockStream.On("Recv").Return(nil).Once()
+		mockStream.On("Recv").Return(io.EOF).Once()
+
+		service := New(mockRepo, mockReviewClient, logging.NewEmptyLogger())
+		result, err := service.GetById(context.Background(), &pb.GetByIdRequest{Id: 7})
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(7), result.Id)
+		assert.True(t, result.Anonymous)
+		assert.Equal(t, "Author A", result.AuthorAlias)
+		assert.Len(t, result.Reviews, 1)
+
+		mockRepo.AssertExpectations(t)
+		mockReviewClient.AssertExpectations(t)
+		mockStream.AssertExpectations(t)
+	})
+
+	t.Run("error - essay not found", func(t *testing.T) {
+		mockRepo := new(mocks.MockEssayRepository)
+		mockRepo.On("GetByID", mock.Anything, 9).Return(models.Essay{}, repository.EssayNotFoundErr)
+
+		service := New(mockRepo, new(MockReviewClient), logging.NewEmptyLogger())
+		result, err := service.GetById(context.Background(), &pb.GetByIdRequest{Id: 9})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Nil(t, result)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
 func TestEssayService_RemoveByAuthorName(t *testing.T) {
 	tests := []struct {
 		name           string
 		input          *pb.RemoveByAuthorNameRequest
 		setupMock      func(*mocks.MockEssayRepository)
 		expectedResult *pb.EssayResponse
-		expectedError  error
+		expectedCode   codes.Code
 	}{
 		{
 			name:  "success - removes essay by author name",
@@ -456,32 +762,32 @@ func TestEssayService_RemoveByAuthorName(t *testing.T) {
 					Content: "Deleted essay",
 					Author:  "testuser",
 				}
-				mockRepo.On("RemoveByAuthorName", "testuser").Return(expectedEssay, nil)
+				mockRepo.On("RemoveByAuthorName", mock.Anything, "testuser").Return(expectedEssay, nil)
 			},
 			expectedResult: &pb.EssayResponse{
 				Id:      1,
 				Content: "Deleted essay",
 				Author:  "testuser",
 			},
-			expectedError: nil,
+			expectedCode: codes.OK,
 		},
 		{
 			name:  "error - essay not found",
 			input: &pb.RemoveByAuthorNameRequest{Authorname: "nonexistent"},
 			setupMock: func(mockRepo *mocks.MockEssayRepository) {
-				mockRepo.On("RemoveByAuthorName", "nonexistent").Return(models.Essay{}, repository.EssayNotFoundErr)
+				mockRepo.On("RemoveByAuthorName", mock.Anything, "nonexistent").Return(models.Essay{}, repository.EssayNotFoundErr)
 			},
 			expectedResult: nil,
-			expectedError:  repository.EssayNotFoundErr,
+			expectedCode:   codes.NotFound,
 		},
 		{
 			name:  "error - repository error",
 			input: &pb.RemoveByAuthorNameRequest{Authorname: "testuser"},
 			setupMock: func(mockRepo *mocks.MockEssayRepository) {
-				mockRepo.On("RemoveByAuthorName", "testuser").Return(models.Essay{}, assert.AnError)
+				mockRepo.On("RemoveByAuthorName", mock.Anything, "testuser").Return(models.Essay{}, assert.AnError)
 			},
 			expectedResult: nil,
-			expectedError:  assert.AnError,
+			expectedCode:   codes.Internal,
 		},
 	}
 
@@ -495,9 +801,9 @@ func TestEssayService_RemoveByAuthorName(t *testing.T) {
 			service := New(mockRepo, mockReviewClient, logger)
 			result, err := service.RemoveByAuthorName(context.Background(), tt.input)
 
-			if tt.expectedError != nil {
+			if tt.expectedCode != codes.OK {
 				assert.Error(t, err)
-				assert.Equal(t, tt.expectedError, err)
+				assert.Equal(t, tt.expectedCode, status.Code(err))
 				assert.Nil(t, result)
 			} else {
 				assert.NoError(t, err)
@@ -511,6 +817,82 @@ func TestEssayService_RemoveByAuthorName(t *testing.T) {
 	}
 }
 
+func TestEssayService_UpdateByAuthorName(t *testing.T) {
+	updated := models.Essay{ID: 1, Content: "Revised essay", Author: "testuser", Revision: 2}
+
+	tests := []struct {
+		name         string
+		setupMock    func(*mocks.MockEssayRepository, *MockReviewClient)
+		expectedCode codes.Code
+	}{
+		{
+			name: "success - re-anchors review comments",
+			setupMock: func(mockRepo *mocks.MockEssayRepository, mockReviewClient *MockReviewClient) {
+				mockRepo.On("UpdateByAuthorName", mock.Anything, "testuser", "Revised essay").Return(updated, nil)
+				mockReviewClient.On("ReanchorComments", mock.Anything, &reviewPb.ReanchorCommentsRequest{EssayId: 1}, mock.Anything).
+					Return(&reviewPb.ReanchorCommentsResponse{EssayRevision: 2, Reanchored: 3}, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "success - review service unavailable",
+			setupMock: func(mockRepo *mocks.MockEssayRepository, mockReviewClient *MockReviewClient) {
+				mockRepo.On("UpdateByAuthorName", mock.Anything, "testuser", "Revised essay").Return(updated, nil)
+				mockReviewClient.On("ReanchorComments", mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, status.Error(codes.Unavailable, "review service down"))
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "error - essay not found",
+			setupMock: func(mockRepo *mocks.MockEssayRepository, mockReviewClient *MockReviewClient) {
+				mockRepo.On("UpdateByAuthorName", mock.Anything, "testuser", "Revised essay").Return(models.Essay{}, repository.EssayNotFoundErr)
+			},
+			expectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mocks.MockEssayRepository)
+			mockReviewClient := new(MockReviewClient)
+			tt.setupMock(mockRepo, mockReviewClient)
+
+			service := New(mockRepo, mockReviewClient, logging.NewEmptyLogger())
+			result, err := service.UpdateByAuthorName(context.Background(), &pb.UpdateByAuthorNameRequest{
+				Authorname: "testuser",
+				Content:    "Revised essay",
+			})
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.Equal(t, int32(1), result.Id)
+				assert.Equal(t, "Revised essay", result.Content)
+			} else {
+				assert.Nil(t, result)
+				mockReviewClient.AssertNotCalled(t, "ReanchorComments", mock.Anything, mock.Anything, mock.Anything)
+			}
+
+			mockRepo.AssertExpectations(t)
+			mockReviewClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestEssayService_PassesRequestContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	mockRepo := new(mocks.MockEssayRepository)
+	mockRepo.On("RemoveByAuthorName", ctx, "testuser").Return(models.Essay{}, context.Canceled)
+
+	service := New(mockRepo, new(MockReviewClient), logging.NewEmptyLogger())
+	_, err := service.RemoveByAuthorName(ctx, &pb.RemoveByAuthorNameRequest{Authorname: "testuser"})
+
+	assert.Equal(t, codes.Canceled, status.Code(err))
+	mockRepo.AssertExpectations(t)
+}
+
 func TestEssayService_SearchByContent(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -528,7 +910,7 @@ func TestEssayService_SearchByContent(t *testing.T) {
 					{ID: 1, Content: "Essay with search term", Author: "user1"},
 					{ID: 2, Content: "Another essay with search term", Author: "user2"},
 				}
-				mockRepo.On("SearchByContent", "search term").Return(essays, nil)
+				mockRepo.On("SearchByContent", mock.Anything, "search term").Return(essays, nil)
 			},
 			expectedCount: 2,
 			expectedError: false,
@@ -537,7 +919,7 @@ func TestEssayService_SearchByContent(t *testing.T) {
 			name:  "success - no search results",
 			input: &pb.SearchByContentRequest{Content: "nonexistent"},
 			setupMock: func(mockRepo *mocks.MockEssayRepository) {
-				mockRepo.On("SearchByContent", "nonexistent").Return([]models.Essay{}, nil)
+				mockRepo.On("SearchByContent", mock.Anything, "nonexistent").Return([]models.Essay{}, nil)
 			},
 			expectedCount: 0,
 			expectedError: false,
@@ -546,7 +928,7 @@ func TestEssayService_SearchByContent(t *testing.T) {
 			name:  "error - repository returns error",
 			input: &pb.SearchByContentRequest{Content: "search term"},
 			setupMock: func(mockRepo *mocks.MockEssayRepository) {
-				mockRepo.On("SearchByContent", "search term").Return([]models.Essay{}, assert.AnError)
+				mockRepo.On("SearchByContent", mock.Anything, "search term").Return([]models.Essay{}, assert.AnError)
 			},
 			expectedCount: 0,
 			expectedError: true,
@@ -558,7 +940,7 @@ func TestEssayService_SearchByContent(t *testing.T) {
 				essays := []models.Essay{
 					{ID: 1, Content: "Essay with search term", Author: "user1"},
 				}
-				mockRepo.On("SearchByContent", "search term").Return(essays, nil)
+				mockRepo.On("SearchByContent", mock.Anything, "search term").Return(essays, nil)
 			},
 			sendError:     assert.AnError,
 			expectedCount: 0,
@@ -570,6 +952,8 @@ func TestEssayService_SearchByContent(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo := new(mocks.MockEssayRepository)
 			mockReviewClient := new(MockReviewClient)
+			mockReviewClient.On("GetEssayStatsBatch", mock.Anything, mock.Anything, mock.Anything).
+				Return(&essayStatsStream{}, nil).Maybe()
 			tt.setupMock(mockRepo)
 
 			stream := &MinimalServerStream{
@@ -622,4 +1006,9 @@ func TestConverters(t *testing.T) {
 			assert.Equal(t, tt.expected.Author, result.Author)
 		})
 	}
+
+	anonymous := toProtoEssayResponse(models.Essay{ID: 2, Author: "test author", AssignmentID: 3, Anonymous: true, AuthorAlias: "Author A"})
+	assert.Equal(t, int64(3), anonymous.AssignmentId)
+	assert.True(t, anonymous.Anonymous)
+	assert.Equal(t, "Author A", anonymous.AuthorAlias)
 }
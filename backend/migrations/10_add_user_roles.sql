@@ -0,0 +1,6 @@
+-- +goose Up
+ALTER TABLE users ADD COLUMN role VARCHAR(20) NOT NULL DEFAULT 'student'
+    CHECK (role IN ('student', 'teacher'));
+
+-- +goose Down
+ALTER TABLE users DROP COLUMN IF EXISTS role;
@@ -0,0 +1,38 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS rubrics (
+    rubric_id BIGSERIAL PRIMARY KEY,
+    title VARCHAR(200) NOT NULL CHECK (LENGTH(title) > 0),
+    created_by VARCHAR(50) NOT NULL REFERENCES users(username) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS rubric_criteria (
+    criterion_id BIGSERIAL PRIMARY KEY,
+    rubric_id BIGINT NOT NULL REFERENCES rubrics(rubric_id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    name VARCHAR(200) NOT NULL CHECK (LENGTH(name) > 0),
+    description TEXT NOT NULL DEFAULT '',
+    weight DOUBLE PRECISION NOT NULL CHECK (weight > 0),
+    min_score INTEGER NOT NULL,
+    max_score INTEGER NOT NULL,
+    CHECK (max_score > min_score),
+    UNIQUE (rubric_id, position)
+);
+
+ALTER TABLE reviews ADD COLUMN rubric_id BIGINT REFERENCES rubrics(rubric_id) ON DELETE RESTRICT;
+ALTER TABLE reviews ADD COLUMN total_score DOUBLE PRECISION;
+
+CREATE TABLE IF NOT EXISTS review_scores (
+    review_id BIGINT NOT NULL REFERENCES reviews(review_id) ON DELETE CASCADE,
+    criterion_id BIGINT NOT NULL REFERENCES rubric_criteria(criterion_id) ON DELETE RESTRICT,
+    score INTEGER NOT NULL,
+    comment TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (review_id, criterion_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS review_scores;
+ALTER TABLE reviews DROP COLUMN IF EXISTS total_score;
+ALTER TABLE reviews DROP COLUMN IF EXISTS rubric_id;
+DROP TABLE IF EXISTS rubric_criteria;
+DROP TABLE IF EXISTS rubrics;
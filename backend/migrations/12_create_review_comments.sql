@@ -0,0 +1,38 @@
+-- +goose Up
+ALTER TABLE essays ADD COLUMN revision INTEGER NOT NULL DEFAULT 1;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION bump_essay_revision() RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.content IS DISTINCT FROM OLD.content THEN
+        NEW.revision := OLD.revision + 1;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER essays_bump_revision
+    BEFORE UPDATE OF content ON essays
+    FOR EACH ROW EXECUTE FUNCTION bump_essay_revision();
+
+CREATE TABLE IF NOT EXISTS review_comments (
+    comment_id BIGSERIAL PRIMARY KEY,
+    review_id BIGINT NOT NULL REFERENCES reviews(review_id) ON DELETE CASCADE,
+    essay_revision INTEGER NOT NULL,
+    start_offset INTEGER NOT NULL CHECK (start_offset >= 0),
+    end_offset INTEGER NOT NULL,
+    quote TEXT NOT NULL,
+    content TEXT NOT NULL CHECK (LENGTH(content) > 0),
+    orphaned BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (end_offset > start_offset)
+);
+
+CREATE INDEX IF NOT EXISTS review_comments_review_id_idx ON review_comments (review_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS review_comments;
+DROP TRIGGER IF EXISTS essays_bump_revision ON essays;
+DROP FUNCTION IF EXISTS bump_essay_revision();
+ALTER TABLE essays DROP COLUMN IF EXISTS revision;
@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS review_replies (
+    reply_id BIGSERIAL PRIMARY KEY,
+    review_id BIGINT NOT NULL REFERENCES reviews(review_id) ON DELETE CASCADE,
+    parent_id BIGINT REFERENCES review_replies(reply_id) ON DELETE CASCADE,
+    author VARCHAR(50) NOT NULL REFERENCES users(username) ON DELETE CASCADE,
+    content TEXT NOT NULL CHECK (LENGTH(content) > 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS review_replies_review_id_idx ON review_replies (review_id, created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS review_replies;
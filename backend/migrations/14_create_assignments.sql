@@ -0,0 +1,106 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS assignments (
+    assignment_id BIGSERIAL PRIMARY KEY,
+    title VARCHAR(200) NOT NULL CHECK (LENGTH(title) > 0),
+    created_by VARCHAR(50) NOT NULL REFERENCES users(username) ON DELETE CASCADE,
+    anonymous BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE essays ADD COLUMN assignment_id BIGINT REFERENCES assignments(assignment_id) ON DELETE SET NULL;
+
+-- Pseudonyms stay the same for a user within an assignment, e.g. "Reviewer A"
+CREATE TABLE IF NOT EXISTS assignment_aliases (
+    assignment_id BIGINT NOT NULL REFERENCES assignments(assignment_id) ON DELETE CASCADE,
+    kind VARCHAR(10) NOT NULL CHECK (kind IN ('author', 'reviewer')),
+    username VARCHAR(50) NOT NULL REFERENCES users(username) ON DELETE CASCADE,
+    alias_index INTEGER NOT NULL,
+    alias VARCHAR(20) NOT NULL,
+    PRIMARY KEY (assignment_id, kind, username),
+    UNIQUE (assignment_id, kind, alias_index)
+);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION alias_letters(n INTEGER) RETURNS TEXT AS $$
+DECLARE
+    result TEXT := '';
+BEGIN
+    WHILE n > 0 LOOP
+        n := n - 1;
+        result := chr(65 + n % 26) || result;
+        n := n / 26;
+    END LOOP;
+    RETURN result;
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION assign_alias(p_assignment_id BIGINT, p_kind VARCHAR, p_username VARCHAR) RETURNS VOID AS $$
+DECLARE
+    next_index INTEGER;
+BEGIN
+    IF p_assignment_id IS NULL THEN
+        RETURN;
+    END IF;
+
+    -- serializes index allocation within the assignment
+    PERFORM pg_advisory_xact_lock(p_assignment_id);
+
+    IF EXISTS (
+        SELECT 1 FROM assignment_aliases
+        WHERE assignment_id = p_assignment_id AND kind = p_kind AND username = p_username
+    ) THEN
+        RETURN;
+    END IF;
+
+    SELECT COALESCE(MAX(alias_index), 0) + 1 INTO next_index
+    FROM assignment_aliases
+    WHERE assignment_id = p_assignment_id AND kind = p_kind;
+
+    INSERT INTO assignment_aliases (assignment_id, kind, username, alias_index, alias)
+    VALUES (p_assignment_id, p_kind, p_username, next_index, initcap(p_kind) || ' ' || alias_letters(next_index));
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION assign_essay_author_alias() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM assign_alias(NEW.assignment_id, 'author', NEW.author);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION assign_reviewer_alias() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM assign_alias(
+        (SELECT assignment_id FROM essays WHERE essay_id = NEW.essay_id),
+        'reviewer',
+        NEW.author
+    );
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER essays_assign_author_alias
+    AFTER INSERT OR UPDATE OF assignment_id ON essays
+    FOR EACH ROW EXECUTE FUNCTION assign_essay_author_alias();
+
+CREATE TRIGGER reviews_assign_reviewer_alias
+    AFTER INSERT ON reviews
+    FOR EACH ROW EXECUTE FUNCTION assign_reviewer_alias();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS reviews_assign_reviewer_alias ON reviews;
+DROP TRIGGER IF EXISTS essays_assign_author_alias ON essays;
+DROP FUNCTION IF EXISTS assign_reviewer_alias();
+DROP FUNCTION IF EXISTS assign_essay_author_alias();
+DROP FUNCTION IF EXISTS assign_alias(BIGINT, VARCHAR, VARCHAR);
+DROP FUNCTION IF EXISTS alias_letters(INTEGER);
+DROP TABLE IF EXISTS assignment_aliases;
+ALTER TABLE essays DROP COLUMN IF EXISTS assignment_id;
+DROP TABLE IF EXISTS assignments;
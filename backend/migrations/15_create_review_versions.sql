@@ -0,0 +1,19 @@
+-- +goose Up
+ALTER TABLE reviews ADD COLUMN edited_at TIMESTAMP WITH TIME ZONE;
+
+-- Previous versions of edited reviews, written_at is when the version was authored
+CREATE TABLE IF NOT EXISTS review_versions (
+    version_id BIGSERIAL PRIMARY KEY,
+    review_id BIGINT NOT NULL REFERENCES reviews(review_id) ON DELETE CASCADE,
+    rank INTEGER NOT NULL,
+    content TEXT NOT NULL,
+    total_score DOUBLE PRECISION,
+    written_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    replaced_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS review_versions_review_id_idx ON review_versions (review_id, version_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS review_versions;
+ALTER TABLE reviews DROP COLUMN IF EXISTS edited_at;
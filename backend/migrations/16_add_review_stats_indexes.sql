@@ -0,0 +1,8 @@
+-- +goose Up
+-- Review aggregates are grouped by essay and by assignment
+CREATE INDEX IF NOT EXISTS reviews_essay_id_rank_idx ON reviews (essay_id, rank);
+CREATE INDEX IF NOT EXISTS essays_assignment_id_idx ON essays (assignment_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS essays_assignment_id_idx;
+DROP INDEX IF EXISTS reviews_essay_id_rank_idx;
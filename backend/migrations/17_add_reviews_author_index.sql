@@ -0,0 +1,6 @@
+-- +goose Up
+-- Backs the paginated per-author review listing
+CREATE INDEX IF NOT EXISTS reviews_author_created_at_idx ON reviews (author, created_at DESC, review_id DESC);
+
+-- +goose Down
+DROP INDEX IF EXISTS reviews_author_created_at_idx;
@@ -0,0 +1,16 @@
+-- +goose Up
+-- Students only see grades once the teacher releases them for the assignment
+ALTER TABLE assignments ADD COLUMN grades_released BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Final teacher grade per essay, a percentage like the rubric totals
+CREATE TABLE IF NOT EXISTS grades (
+    essay_id BIGINT PRIMARY KEY REFERENCES essays(essay_id) ON DELETE CASCADE,
+    grade DOUBLE PRECISION NOT NULL CHECK (grade BETWEEN 0 AND 100),
+    feedback TEXT NOT NULL DEFAULT '',
+    graded_by VARCHAR(50) NOT NULL REFERENCES users(username) ON DELETE CASCADE,
+    graded_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS grades;
+ALTER TABLE assignments DROP COLUMN IF EXISTS grades_released;
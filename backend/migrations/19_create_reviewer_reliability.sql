@@ -0,0 +1,21 @@
+-- +goose Up
+-- Teacher reference rank for essays used to calibrate reviewers
+CREATE TABLE IF NOT EXISTS calibration_essays (
+    essay_id BIGINT PRIMARY KEY REFERENCES essays(essay_id) ON DELETE CASCADE,
+    reference_rank INTEGER NOT NULL CHECK (reference_rank BETWEEN 1 AND 3),
+    created_by VARCHAR(50) NOT NULL REFERENCES users(username) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Snapshot of the latest reliability computation, 1 means full agreement
+CREATE TABLE IF NOT EXISTS reviewer_reliability (
+    username VARCHAR(50) PRIMARY KEY REFERENCES users(username) ON DELETE CASCADE,
+    score DOUBLE PRECISION NOT NULL CHECK (score BETWEEN 0 AND 1),
+    consensus_reviews INTEGER NOT NULL,
+    calibration_reviews INTEGER NOT NULL,
+    computed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS reviewer_reliability;
+DROP TABLE IF EXISTS calibration_essays;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- Unsubmitted review of an essay, at most one per reviewer
+CREATE TABLE IF NOT EXISTS review_drafts (
+    essay_id BIGINT NOT NULL REFERENCES essays(essay_id) ON DELETE CASCADE,
+    author VARCHAR(50) NOT NULL REFERENCES users(username) ON DELETE CASCADE,
+    rank INTEGER NOT NULL DEFAULT 0,
+    content TEXT NOT NULL DEFAULT '',
+    rubric_id BIGINT NOT NULL DEFAULT 0,
+    scores JSONB NOT NULL DEFAULT '[]',
+    essay_revision INTEGER NOT NULL DEFAULT 0,
+    comments JSONB NOT NULL DEFAULT '[]',
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (essay_id, author)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS review_drafts;
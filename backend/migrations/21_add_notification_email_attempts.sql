@@ -0,0 +1,8 @@
+-- +goose Up
+-- Failed emails are retried with backoff until the worker gives up on them
+ALTER TABLE notifications ADD COLUMN email_attempts INT NOT NULL DEFAULT 0;
+ALTER TABLE notifications ADD COLUMN email_next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+-- +goose Down
+ALTER TABLE notifications DROP COLUMN IF EXISTS email_next_attempt_at;
+ALTER TABLE notifications DROP COLUMN IF EXISTS email_attempts;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Reviews of the assignment's essays are scored with this rubric
+ALTER TABLE assignments ADD COLUMN rubric_id BIGINT REFERENCES rubrics(rubric_id) ON DELETE RESTRICT;
+
+-- +goose Down
+ALTER TABLE assignments DROP COLUMN IF EXISTS rubric_id;
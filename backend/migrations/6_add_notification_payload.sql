@@ -0,0 +1,16 @@
+-- +goose Up
+ALTER TABLE notifications
+    ADD COLUMN type VARCHAR(50) NOT NULL DEFAULT '',
+    ADD COLUMN actor VARCHAR(50) NOT NULL DEFAULT '',
+    ADD COLUMN payload JSONB NOT NULL DEFAULT '{}'::jsonb,
+    DROP CONSTRAINT IF EXISTS notifications_content_check,
+    ALTER COLUMN content SET DEFAULT '';
+
+-- +goose Down
+DELETE FROM notifications WHERE LENGTH(content) = 0;
+ALTER TABLE notifications
+    DROP COLUMN IF EXISTS payload,
+    DROP COLUMN IF EXISTS actor,
+    DROP COLUMN IF EXISTS type,
+    ALTER COLUMN content DROP DEFAULT,
+    ADD CONSTRAINT notifications_content_check CHECK (LENGTH(content) > 0);
@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE notifications ADD COLUMN archived_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user_read_created
+    ON notifications (user_id, is_read, created_at DESC);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_notifications_user_read_created;
+ALTER TABLE notifications DROP COLUMN IF EXISTS archived_at;
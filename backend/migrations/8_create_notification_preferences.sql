@@ -0,0 +1,25 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS notification_settings (
+    user_id BIGINT PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    email VARCHAR(254) NOT NULL DEFAULT '',
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id BIGINT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    type VARCHAR(50) NOT NULL CHECK (LENGTH(type) > 0),
+    channel VARCHAR(20) NOT NULL CHECK (channel IN ('in_app', 'email_immediate', 'email_digest', 'off')),
+    PRIMARY KEY (user_id, type)
+);
+
+ALTER TABLE notifications ADD COLUMN email_status VARCHAR(20) NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_notifications_pending_email
+    ON notifications (email_status, created_at)
+    WHERE email_status IN ('pending', 'digest');
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_notifications_pending_email;
+ALTER TABLE notifications DROP COLUMN IF EXISTS email_status;
+DROP TABLE IF EXISTS notification_preferences;
+DROP TABLE IF EXISTS notification_settings;
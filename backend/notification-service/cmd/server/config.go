@@ -0,0 +1,31 @@
+package main
+
+import (
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/config"
+)
+
+// Loaded by config.Load, see it for the meaning of the tags
+type Config struct {
+	config.Server `yaml:",inline"`
+
+	GRPCPort            string          `env:"NOTIFICATIONS_SERVICE_GRPC_PORT" yaml:"grpc_port" required:"true"`
+	FrontendBaseURL     string          `env:"FRONTEND_BASE_URL" yaml:"frontend_base_url" default:"http://localhost"`
+	WebhookPollInterval time.Duration   `env:"WEBHOOK_POLL_INTERVAL" yaml:"webhook_poll_interval" default:"5s"`
+	SMTP                SMTP            `yaml:"smtp"`
+	Kafka               config.Kafka    `yaml:"kafka"`
+	Postgres            config.Postgres `yaml:"postgres"`
+	TLS                 config.TLS      `yaml:"tls"`
+}
+
+// Email delivery is disabled while Host is empty
+type SMTP struct {
+	Host           string        `env:"SMTP_HOST" yaml:"host"`
+	Port           string        `env:"SMTP_PORT" yaml:"port" default:"25"`
+	Username       string        `env:"SMTP_USERNAME" yaml:"username"`
+	Password       string        `env:"SMTP_PASSWORD" yaml:"password" secret:"true"`
+	From           string        `env:"SMTP_FROM" yaml:"from" default:"noreply@essays.local"`
+	PollInterval   time.Duration `env:"EMAIL_POLL_INTERVAL" yaml:"poll_interval" default:"30s"`
+	DigestInterval time.Duration `env:"EMAIL_DIGEST_INTERVAL" yaml:"digest_interval" default:"24h"`
+}
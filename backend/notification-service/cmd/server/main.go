@@ -3,16 +3,23 @@ package main
 import (
 	"context"
 	"net"
-	"os"
-	"os/signal"
-	"strings"
-	"syscall"
+	"net/http"
 
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/email"
 	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/kafka"
 	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/repository"
 	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/service"
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/webhook"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/config"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/grpcerr"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/grpcmw"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/health"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/lifecycle"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/monitoring"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/mtls"
+	pgutil "github.com/IAGrig/vt-csa-essays/backend/shared/pg_util"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/tracing"
 	"go.uber.org/zap"
 
 	"google.golang.org/grpc"
@@ -24,58 +31,108 @@ func main() {
 	logger := logging.New("notification-service")
 	defer logger.Sync()
 
-	port := os.Getenv("NOTIFICATIONS_SERVICE_GRPC_PORT")
-	kafkaBrokers := os.Getenv("KAFKA_BROKERS")
-	monitoringPort := os.Getenv("MONITORING_PORT")
+	var cfg Config
+	if err := config.Load(&cfg); err != nil {
+		logger.Fatal("Invalid configuration", zap.Error(err))
+	}
+	logger.Info("Starting notification service")
+	config.Log(logger, cfg)
 
-	logger.Info("Starting notification service",
-		zap.String("port", port),
-		zap.String("kafka_brokers", kafkaBrokers),
-		zap.String("monitoring_port", monitoringPort))
+	monitoring.StartMetricsServer(cfg.MonitoringPort)
 
-	monitoring.StartMetricsServer(monitoringPort)
+	lc := lifecycle.New(logger, cfg.ShutdownTimeout)
 
-	repo, err := repository.NewNotificationPgRepository(logger)
+	certs, err := mtls.New(cfg.TLS, logger)
 	if err != nil {
-		logger.Fatal("Failed to create notification repository",
-			zap.Error(err))
+		logger.Fatal("Failed to load TLS certificates", zap.Error(err))
 	}
+	lc.Go(certs.Watch)
 
-	brokers := strings.Split(kafkaBrokers, ",")
-	consumer := kafka.NewConsumer(brokers, "notifications", "notification-service", repo, logger)
+	shutdownTracing, err := tracing.Init(context.Background(), "notification-service")
+	if err != nil {
+		logger.Fatal("Failed to initialize tracing", zap.Error(err))
+	}
 
-	notificationService := service.New(repo, logger)
+	pool, err := pgutil.GetPgxPool()
+	if err != nil {
+		logger.Fatal("Failed to connect to database",
+			zap.Error(err))
+	}
 
-	var opts []grpc.ServerOption
+	repo := repository.NewNotificationPgRepository(pool, logger)
+	preferenceRepo := repository.NewPreferencePgRepository(pool, logger)
+	webhookRepo := repository.NewWebhookPgRepository(pool, logger)
+
+	consumer := kafka.NewConsumer(cfg.Kafka.Brokers, cfg.Kafka.Topic, "notification-service", repo, preferenceRepo, webhookRepo, cfg.FrontendBaseURL, logger)
+
+	notificationService := service.New(repo, preferenceRepo, webhookRepo, logger)
+
+	opts := []grpc.ServerOption{
+		certs.ServerOption(),
+		tracing.ServerOption(),
+		grpc.ChainUnaryInterceptor(
+			grpcmw.UnaryServerInterceptor("notification", logger),
+			grpcerr.UnaryServerInterceptor(service.ErrorMappings, logger),
+		),
+		grpc.ChainStreamInterceptor(
+			grpcmw.StreamServerInterceptor("notification", logger),
+			grpcerr.StreamServerInterceptor(service.ErrorMappings, logger),
+		),
+	}
 
 	grpcServer := grpc.NewServer(opts...)
 	pb.RegisterNotificationServiceServer(grpcServer, notificationService)
 
-	lis, err := net.Listen("tcp", "0.0.0.0:"+port)
+	checker := health.New(pb.NotificationService_ServiceDesc.ServiceName, logger)
+	checker.Add("postgres", health.PingCheck(pool))
+	checker.Add("kafka", health.KafkaCheck(cfg.Kafka.Brokers, cfg.Kafka.Topic))
+	checker.Register(grpcServer)
+	checker.RegisterHTTP(http.DefaultServeMux)
+
+	lis, err := net.Listen("tcp", "0.0.0.0:"+cfg.GRPCPort)
 	if err != nil {
 		logger.Fatal("Failed to listen",
 			zap.Error(err),
-			zap.String("port", port))
+			zap.String("port", cfg.GRPCPort))
 	}
 
 	logger.Info("Notification service starting",
-		zap.String("port", port))
-
-	go func() {
-		if err := grpcServer.Serve(lis); err != nil {
-			logger.Fatal("Failed to serve", zap.Error(err))
-		}
-	}()
-
-	ctx, cancel := context.WithCancel(context.Background())
-	go consumer.Start(ctx)
+		zap.String("port", cfg.GRPCPort))
+
+	lc.Go(consumer.Start)
+	lc.Go(func(ctx context.Context) { checker.Start(ctx, cfg.HealthCheckInterval) })
+
+	dispatcher := webhook.NewDispatcher(webhookRepo, logger, cfg.WebhookPollInterval)
+	lc.Go(dispatcher.Start)
+
+	if cfg.SMTP.Host != "" {
+		sender := email.NewSMTPSender(
+			cfg.SMTP.Host,
+			cfg.SMTP.Port,
+			cfg.SMTP.Username,
+			cfg.SMTP.Password,
+			cfg.SMTP.From,
+		)
+		worker := email.NewWorker(
+			sender,
+			repo,
+			logger,
+			cfg.FrontendBaseURL,
+			cfg.SMTP.PollInterval,
+			cfg.SMTP.DigestInterval,
+		)
+		lc.Go(worker.Start)
+	} else {
+		logger.Info("SMTP_HOST is not set, email delivery is disabled")
+	}
 
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
+	lc.ServeGRPC(grpcServer, lis)
+	lc.OnShutdown("postgres", pgutil.ClosePools)
+	lc.OnShutdown("tracing", shutdownTracing)
 
-	logger.Info("Shutting down notification service...")
-	cancel()
-	grpcServer.GracefulStop()
+	if err := lc.Wait(); err != nil {
+		logger.Error("Notification service stopped with errors", zap.Error(err))
+		return
+	}
 	logger.Info("Notification service stopped")
 }
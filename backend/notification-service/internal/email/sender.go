@@ -0,0 +1,54 @@
+package email
+
+import (
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+type Sender interface {
+	Send(to string, subject string, body string) error
+}
+
+type SMTPSender struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+// Auth is only used when a username is set, local relays like mailpit accept anonymous mail
+func NewSMTPSender(host string, port string, username string, password string, from string) *SMTPSender {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &SMTPSender{
+		addr: net.JoinHostPort(host, port),
+		auth: auth,
+		from: from,
+	}
+}
+
+func (s *SMTPSender) Send(to string, subject string, body string) error {
+	if err := smtp.SendMail(s.addr, s.auth, s.from, []string{to}, s.message(to, subject, body)); err != nil {
+		return fmt.Errorf("failed to send email to %s: %w", to, err)
+	}
+	return nil
+}
+
+func (s *SMTPSender) message(to string, subject string, body string) []byte {
+	var msg strings.Builder
+	msg.WriteString("From: " + s.from + "\r\n")
+	msg.WriteString("To: " + to + "\r\n")
+	msg.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", subject) + "\r\n")
+	msg.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(body)
+	return []byte(msg.String())
+}
@@ -0,0 +1,36 @@
+package email
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSMTPSender_Send(t *testing.T) {
+	server := newSMTPServer(t)
+	host, port := server.hostPort()
+	sender := NewSMTPSender(host, port, "", "", "noreply@essays.local")
+
+	err := sender.Send("user@example.com", "New review from reviewer1", "Your essay has been reviewed\r\n")
+	require.NoError(t, err)
+
+	messages := server.received()
+	require.Len(t, messages, 1)
+	assert.Equal(t, "noreply@essays.local", messages[0].From)
+	assert.Equal(t, []string{"user@example.com"}, messages[0].To)
+	assert.Contains(t, messages[0].Data, "To: user@example.com\r\n")
+	assert.Contains(t, messages[0].Data, "Subject: New review from reviewer1\r\n")
+	assert.Contains(t, messages[0].Data, "\r\n\r\nYour essay has been reviewed\r\n")
+}
+
+func TestSMTPSender_SendRejected(t *testing.T) {
+	server := newSMTPServer(t)
+	server.reject = true
+	host, port := server.hostPort()
+	sender := NewSMTPSender(host, port, "", "", "noreply@essays.local")
+
+	err := sender.Send("user@example.com", "subject", "body")
+	assert.Error(t, err)
+	assert.Empty(t, server.received())
+}
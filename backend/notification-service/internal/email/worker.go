@@ -0,0 +1,211 @@
+package email
+
+import (
+	"context"
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/repository"
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/templates"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"go.uber.org/zap"
+)
+
+const (
+	// users per run, each comes with all of their pending notifications
+	defaultBatchSize   = 100
+	defaultMaxAttempts = 5
+	defaultBaseBackoff = time.Minute
+	defaultMaxBackoff  = 6 * time.Hour
+)
+
+type Worker struct {
+	sender         Sender
+	repository     repository.NotificationRepository
+	logger         *logging.Logger
+	baseURL        string
+	pollInterval   time.Duration
+	digestInterval time.Duration
+	batchSize      int
+	maxAttempts    int
+	baseBackoff    time.Duration
+	maxBackoff     time.Duration
+	now            func() time.Time
+}
+
+func NewWorker(sender Sender, repo repository.NotificationRepository, logger *logging.Logger, baseURL string, pollInterval time.Duration, digestInterval time.Duration) *Worker {
+	return &Worker{
+		sender:         sender,
+		repository:     repo,
+		logger:         logger,
+		baseURL:        baseURL,
+		pollInterval:   pollInterval,
+		digestInterval: digestInterval,
+		batchSize:      defaultBatchSize,
+		maxAttempts:    defaultMaxAttempts,
+		baseBackoff:    defaultBaseBackoff,
+		maxBackoff:     defaultMaxBackoff,
+		now:            time.Now,
+	}
+}
+
+func (w *Worker) Start(ctx context.Context) {
+	w.logger.Info("Starting email worker",
+		zap.Duration("poll_interval", w.pollInterval),
+		zap.Duration("digest_interval", w.digestInterval))
+
+	poll := time.NewTicker(w.pollInterval)
+	defer poll.Stop()
+	digest := time.NewTicker(w.digestInterval)
+	defer digest.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Stopping email worker...")
+			return
+		case <-poll.C:
+			w.SendImmediate(ctx)
+		case <-digest.C:
+			w.SendDigests(ctx)
+		}
+	}
+}
+
+// Sends one email per notification queued for immediate delivery
+func (w *Worker) SendImmediate(ctx context.Context) {
+	logger := w.logger.With(zap.String("operation", "send_immediate_emails"))
+
+	pending, err := w.repository.GetPendingEmails(ctx, models.EmailStatusPending, w.batchSize)
+	if err != nil {
+		logger.Error("Failed to load pending emails", zap.Error(err))
+		return
+	}
+
+	var sent, failed []int64
+	retried := 0
+	for _, p := range pending {
+		n := p.Notification
+		err := w.sender.Send(p.Email, templates.EmailSubject(n), templates.EmailBody(n, w.baseURL))
+		if err != nil {
+			logger.Warn("Failed to send notification email",
+				zap.Int64("notification_id", n.NotificationID),
+				zap.Int("attempts", p.Attempts+1),
+				zap.Error(err))
+			if w.retry(ctx, logger, []int64{n.NotificationID}, p.Attempts+1) {
+				retried++
+			} else {
+				failed = append(failed, n.NotificationID)
+			}
+			continue
+		}
+		sent = append(sent, n.NotificationID)
+	}
+
+	w.setStatus(ctx, logger, sent, models.EmailStatusSent)
+	w.setStatus(ctx, logger, failed, models.EmailStatusFailed)
+
+	if len(pending) > 0 {
+		logger.Info("Immediate emails processed",
+			zap.Int("sent", len(sent)),
+			zap.Int("retried", retried),
+			zap.Int("failed", len(failed)))
+	}
+}
+
+// Sends one email per user summarizing every notification queued for the digest
+func (w *Worker) SendDigests(ctx context.Context) {
+	logger := w.logger.With(zap.String("operation", "send_digest_emails"))
+
+	pending, err := w.repository.GetPendingEmails(ctx, models.EmailStatusDigest, w.batchSize)
+	if err != nil {
+		logger.Error("Failed to load digest emails", zap.Error(err))
+		return
+	}
+
+	var sent, failed []int64
+	retried := 0
+	for _, batch := range groupByUser(pending) {
+		notifications := make([]models.Notification, 0, len(batch))
+		ids := make([]int64, 0, len(batch))
+		attempts := 0
+		for _, p := range batch {
+			notifications = append(notifications, p.Notification)
+			ids = append(ids, p.Notification.NotificationID)
+			attempts = max(attempts, p.Attempts)
+		}
+		// the notifications are sent together, so they share the retries
+		attempts++
+
+		email := batch[len(batch)-1].Email
+		err := w.sender.Send(email, templates.DigestSubject(len(notifications)), templates.DigestBody(notifications, w.baseURL))
+		if err != nil {
+			logger.Warn("Failed to send digest email",
+				zap.Int64("user_id", batch[0].Notification.UserID),
+				zap.Int("attempts", attempts),
+				zap.Error(err))
+			if w.retry(ctx, logger, ids, attempts) {
+				retried += len(ids)
+			} else {
+				failed = append(failed, ids...)
+			}
+			continue
+		}
+		sent = append(sent, ids...)
+	}
+
+	w.setStatus(ctx, logger, sent, models.EmailStatusSent)
+	w.setStatus(ctx, logger, failed, models.EmailStatusFailed)
+
+	if len(pending) > 0 {
+		logger.Info("Digest emails processed",
+			zap.Int("notifications_sent", len(sent)),
+			zap.Int("notifications_retried", retried),
+			zap.Int("notifications_failed", len(failed)))
+	}
+}
+
+func (w *Worker) setStatus(ctx context.Context, logger *zap.Logger, ids []int64, emailStatus string) {
+	if len(ids) == 0 {
+		return
+	}
+	if err := w.repository.SetEmailStatus(ctx, ids, emailStatus); err != nil {
+		logger.Error("Failed to update email status",
+			zap.String("email_status", emailStatus),
+			zap.Error(err))
+	}
+}
+
+// Leaves emails that failed for the attempts-th time pending until the
+// backoff passes. Reports false once maxAttempts is used up, the caller then
+// marks them failed
+func (w *Worker) retry(ctx context.Context, logger *zap.Logger, ids []int64, attempts int) bool {
+	if attempts >= w.maxAttempts {
+		return false
+	}
+	if err := w.repository.RetryEmails(ctx, ids, w.now().Add(w.backoff(attempts))); err != nil {
+		logger.Error("Failed to schedule email retry", zap.Error(err))
+	}
+	return true
+}
+
+// Delay after the attempts-th failure, doubling from baseBackoff up to maxBackoff
+func (w *Worker) backoff(attempts int) time.Duration {
+	delay := w.baseBackoff
+	for i := 1; i < attempts && delay < w.maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, w.maxBackoff)
+}
+
+// Pending emails come ordered by user, so consecutive rows form one digest
+func groupByUser(pending []models.PendingEmail) [][]models.PendingEmail {
+	var groups [][]models.PendingEmail
+	for i, p := range pending {
+		if i == 0 || p.Notification.UserID != pending[i-1].Notification.UserID {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], p)
+	}
+	return groups
+}
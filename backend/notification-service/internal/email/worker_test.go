@@ -0,0 +1,162 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/repository/mocks"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func pendingReview(notificationID int64, userID int64, actor string, email string) models.PendingEmail {
+	return models.PendingEmail{
+		Notification: models.Notification{
+			NotificationID: notificationID,
+			UserID:         userID,
+			Type:           models.TypeNewReview,
+			Actor:          actor,
+			Payload:        models.Payload{ReviewID: notificationID},
+		},
+		Email: email,
+	}
+}
+
+func newTestWorker(t *testing.T, repo *mocks.MockNotificationRepository) (*Worker, *smtpServer) {
+	server := newSMTPServer(t)
+	host, port := server.hostPort()
+	sender := NewSMTPSender(host, port, "", "", "noreply@essays.local")
+	worker := NewWorker(sender, repo, logging.NewEmptyLogger(), "http://localhost:3000", 0, 0)
+	worker.now = func() time.Time { return fixedNow }
+	return worker, server
+}
+
+var fixedNow = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func TestWorker_SendImmediate(t *testing.T) {
+	repo := new(mocks.MockNotificationRepository)
+	repo.On("GetPendingEmails", mock.Anything, models.EmailStatusPending, defaultBatchSize).Return([]models.PendingEmail{
+		pendingReview(1, 10, "reviewer1", "first@example.com"),
+		pendingReview(2, 11, "reviewer2", "second@example.com"),
+	}, nil)
+	repo.On("SetEmailStatus", mock.Anything, []int64{1, 2}, models.EmailStatusSent).Return(nil)
+
+	worker, server := newTestWorker(t, repo)
+	worker.SendImmediate(context.Background())
+
+	messages := server.received()
+	require.Len(t, messages, 2)
+	assert.Equal(t, []string{"first@example.com"}, messages[0].To)
+	assert.Contains(t, messages[0].Data, "Subject: New review from reviewer1")
+	assert.Contains(t, messages[0].Data, "http://localhost:3000/my-essay#review-1")
+	assert.Equal(t, []string{"second@example.com"}, messages[1].To)
+	repo.AssertExpectations(t)
+}
+
+func TestWorker_SendImmediate_RetriesWithBackoff(t *testing.T) {
+	retried := pendingReview(1, 10, "reviewer1", "first@example.com")
+	retried.Attempts = 2
+
+	repo := new(mocks.MockNotificationRepository)
+	repo.On("GetPendingEmails", mock.Anything, models.EmailStatusPending, defaultBatchSize).Return([]models.PendingEmail{
+		pendingReview(2, 11, "reviewer2", "second@example.com"),
+		retried,
+	}, nil)
+	repo.On("RetryEmails", mock.Anything, []int64{2}, fixedNow.Add(defaultBaseBackoff)).Return(nil)
+	repo.On("RetryEmails", mock.Anything, []int64{1}, fixedNow.Add(4*defaultBaseBackoff)).Return(nil)
+
+	worker, server := newTestWorker(t, repo)
+	server.reject = true
+	worker.SendImmediate(context.Background())
+
+	assert.Empty(t, server.received())
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "SetEmailStatus", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWorker_SendImmediate_GivesUpAfterMaxAttempts(t *testing.T) {
+	exhausted := pendingReview(1, 10, "reviewer1", "first@example.com")
+	exhausted.Attempts = defaultMaxAttempts - 1
+
+	repo := new(mocks.MockNotificationRepository)
+	repo.On("GetPendingEmails", mock.Anything, models.EmailStatusPending, defaultBatchSize).
+		Return([]models.PendingEmail{exhausted}, nil)
+	repo.On("SetEmailStatus", mock.Anything, []int64{1}, models.EmailStatusFailed).Return(nil)
+
+	worker, server := newTestWorker(t, repo)
+	server.reject = true
+	worker.SendImmediate(context.Background())
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "RetryEmails", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWorker_SendImmediate_RepositoryError(t *testing.T) {
+	repo := new(mocks.MockNotificationRepository)
+	repo.On("GetPendingEmails", mock.Anything, models.EmailStatusPending, defaultBatchSize).
+		Return([]models.PendingEmail(nil), errors.New("database error"))
+
+	worker, server := newTestWorker(t, repo)
+	worker.SendImmediate(context.Background())
+
+	assert.Empty(t, server.received())
+	repo.AssertNotCalled(t, "SetEmailStatus", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWorker_SendDigests(t *testing.T) {
+	repo := new(mocks.MockNotificationRepository)
+	repo.On("GetPendingEmails", mock.Anything, models.EmailStatusDigest, defaultBatchSize).Return([]models.PendingEmail{
+		pendingReview(1, 10, "reviewer1", "first@example.com"),
+		pendingReview(2, 10, "reviewer2", "first@example.com"),
+		pendingReview(3, 11, "reviewer3", "second@example.com"),
+	}, nil)
+	repo.On("SetEmailStatus", mock.Anything, []int64{1, 2, 3}, models.EmailStatusSent).Return(nil)
+
+	worker, server := newTestWorker(t, repo)
+	worker.SendDigests(context.Background())
+
+	messages := server.received()
+	require.Len(t, messages, 2)
+
+	assert.Equal(t, []string{"first@example.com"}, messages[0].To)
+	assert.Contains(t, messages[0].Data, "Subject: You have 2 new notifications")
+	assert.Contains(t, messages[0].Data, "- Your essay has been reviewed by reviewer1")
+	assert.Contains(t, messages[0].Data, "- Your essay has been reviewed by reviewer2")
+
+	assert.Equal(t, []string{"second@example.com"}, messages[1].To)
+	assert.Contains(t, messages[1].Data, "Subject: You have 1 new notification")
+	repo.AssertExpectations(t)
+}
+
+func TestWorker_SendDigests_RetriesTogether(t *testing.T) {
+	second := pendingReview(2, 10, "reviewer2", "first@example.com")
+	second.Attempts = 1
+
+	repo := new(mocks.MockNotificationRepository)
+	repo.On("GetPendingEmails", mock.Anything, models.EmailStatusDigest, defaultBatchSize).Return([]models.PendingEmail{
+		pendingReview(1, 10, "reviewer1", "first@example.com"),
+		second,
+	}, nil)
+	repo.On("RetryEmails", mock.Anything, []int64{1, 2}, fixedNow.Add(2*defaultBaseBackoff)).Return(nil)
+
+	worker, server := newTestWorker(t, repo)
+	server.reject = true
+	worker.SendDigests(context.Background())
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "SetEmailStatus", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWorker_Backoff(t *testing.T) {
+	worker := NewWorker(nil, nil, logging.NewEmptyLogger(), "", 0, 0)
+
+	assert.Equal(t, defaultBaseBackoff, worker.backoff(1))
+	assert.Equal(t, 2*defaultBaseBackoff, worker.backoff(2))
+	assert.Equal(t, 8*defaultBaseBackoff, worker.backoff(4))
+	assert.Equal(t, defaultMaxBackoff, worker.backoff(20))
+}
@@ -7,28 +7,41 @@ import (
 
 	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/models"
 	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/repository"
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/webhook"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/monitoring"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/segmentio/kafka-go"
 )
 
 type NotificationEvent struct {
-	UserID   int64  `json:"user_id"`
-	Content  string `json:"content"`
-	EssayID  int64  `json:"essay_id,omitempty"`
-	ReviewID int64  `json:"review_id,omitempty"`
-	Author   string `json:"author,omitempty"`
+	Type        string `json:"type,omitempty"`
+	UserID      int64  `json:"user_id"`
+	Content     string `json:"content"`
+	EssayID     int64  `json:"essay_id,omitempty"`
+	ReviewID    int64  `json:"review_id,omitempty"`
+	ReplyID     int64  `json:"reply_id,omitempty"`
+	EssayAuthor string `json:"essay_author,omitempty"`
+	Author      string `json:"author,omitempty"`
 }
 
+var tracer = otel.Tracer("github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/kafka")
+
 type Consumer struct {
-	reader     *kafka.Reader
-	repository repository.NotificationRepository
-	logger     *logging.Logger
+	reader      *kafka.Reader
+	repository  repository.NotificationRepository
+	preferences repository.PreferenceRepository
+	webhooks    repository.WebhookRepository
+	baseURL     string
+	logger      *logging.Logger
 }
 
-func NewConsumer(brokers []string, topic string, groupID string, repo repository.NotificationRepository, logger *logging.Logger) *Consumer {
+func NewConsumer(brokers []string, topic string, groupID string, repo repository.NotificationRepository, preferences repository.PreferenceRepository, webhooks repository.WebhookRepository, baseURL string, logger *logging.Logger) *Consumer {
 	reader := kafka.NewReader(kafka.ReaderConfig{
 		Brokers: brokers,
 		Topic:   topic,
@@ -36,9 +49,12 @@ func NewConsumer(brokers []string, topic string, groupID string, repo repository
 	})
 
 	return &Consumer{
-		reader:     reader,
-		repository: repo,
-		logger:     logger,
+		reader:      reader,
+		repository:  repo,
+		preferences: preferences,
+		webhooks:    webhooks,
+		baseURL:     baseURL,
+		logger:      logger,
 	}
 }
 
@@ -71,40 +87,79 @@ func (c *Consumer) consumeMessage(ctx context.Context) {
 		return
 	}
 
-	c.logger.Debug("Received Kafka message",
+	ctx, span := tracer.Start(tracing.ExtractKafka(ctx, msg), msg.Topic+" process", trace.WithSpanKind(trace.SpanKindConsumer))
+	defer span.End()
+	for _, header := range msg.Headers {
+		if header.Key == logging.RequestIDHeader {
+			ctx = logging.WithRequestID(ctx, string(header.Value))
+		}
+	}
+
+	c.logger.WithContext(ctx).Debug("Received Kafka message",
 		zap.String("topic", msg.Topic),
 		zap.Int("partition", msg.Partition),
 		zap.Int64("offset", msg.Offset))
 
 	var event NotificationEvent
 	if err := json.Unmarshal(msg.Value, &event); err != nil {
-		c.logger.Error("Error unmarshaling Kafka message",
+		span.SetStatus(codes.Error, "failed to unmarshal message")
+		c.logger.WithContext(ctx).Error("Error unmarshaling Kafka message",
 			zap.Error(err),
 			zap.ByteString("message", msg.Value))
 		monitoring.KafkaMessagesProcessed.WithLabelValues("notifications", "unmarshall_error").Inc()
 		return
 	}
 
-	logger := c.logger.With(
+	logger := c.logger.WithContext(ctx).With(
 		zap.Int64("user_id", event.UserID),
 		zap.String("author", event.Author),
 	)
 
 	logger.Debug("Processing notification event")
 
+	delivery, err := c.preferences.GetDelivery(ctx, event.UserID, event.Type)
+	if err != nil {
+		logger.Warn("Failed to get delivery preference, falling back to in-app", zap.Error(err))
+		delivery = models.Delivery{Channel: models.ChannelInApp}
+	}
+
+	if delivery.Channel == models.ChannelOff {
+		if err := c.reader.CommitMessages(ctx, msg); err != nil {
+			logger.Error("Error committing Kafka message", zap.Error(err))
+			monitoring.KafkaMessagesProcessed.WithLabelValues("notifications", "commit_error").Inc()
+			return
+		}
+		monitoring.KafkaMessagesProcessed.WithLabelValues("notifications", "skipped").Inc()
+		logger.Debug("Notification type is turned off by user, skipping",
+			zap.String("type", event.Type))
+		return
+	}
+
 	notificationReq := models.NotificationRequest{
-		UserID:  event.UserID,
-		Content: event.Content,
+		UserID: event.UserID,
+		Type:   event.Type,
+		Actor:  event.Author,
+		Payload: models.Payload{
+			EssayID:     event.EssayID,
+			ReviewID:    event.ReviewID,
+			ReplyID:     event.ReplyID,
+			EssayAuthor: event.EssayAuthor,
+		},
+		Content:     event.Content,
+		EmailStatus: emailStatus(delivery),
 	}
 
-	notification, err := c.repository.Create(notificationReq)
+	notification, err := c.repository.Create(ctx, notificationReq)
 	if err != nil {
+		span.SetStatus(codes.Error, "failed to create notification")
 		logger.Error("Error creating notification from Kafka event",
 			zap.Error(err))
 		monitoring.KafkaMessagesProcessed.WithLabelValues("notifications", "creating_error").Inc()
 		return
 	}
 
+	c.enqueueWebhooks(ctx, logger, notification)
+
 	if err := c.reader.CommitMessages(ctx, msg); err != nil {
 		logger.Error("Error committing Kafka message",
 			zap.Error(err),
@@ -116,9 +171,40 @@ func (c *Consumer) consumeMessage(ctx context.Context) {
 	duration := time.Since(start)
 	monitoring.DbQueryDuration.WithLabelValues("create", "notifications").Observe(float64(duration.Milliseconds()))
 	monitoring.KafkaMessagesProcessed.WithLabelValues("notifications", "success").Inc()
-	monitoring.NotificationsCreated.WithLabelValues(event.Author).Inc()
+	monitoring.NotificationsCreated.WithLabelValues(event.Type).Inc()
 
 	logger.Info("Notification created from Kafka event",
 		zap.Int64("notification_id", notification.NotificationID),
 		zap.Duration("processing_time", duration))
 }
+
+// The notification is already stored, so webhook failures are logged instead of retrying the message
+func (c *Consumer) enqueueWebhooks(ctx context.Context, logger *zap.Logger, notification models.Notification) {
+	payload, err := webhook.NewPayload(notification, c.baseURL)
+	if err != nil {
+		logger.Error("Error building webhook payload", zap.Error(err))
+		return
+	}
+
+	if _, err := c.webhooks.EnqueueDeliveries(ctx, notification, payload); err != nil {
+		logger.Error("Error enqueueing webhook deliveries",
+			zap.Error(err),
+			zap.Int64("notification_id", notification.NotificationID))
+	}
+}
+
+// Email channels only apply when the user has an address to send to
+func emailStatus(delivery models.Delivery) string {
+	if delivery.Email == "" {
+		return models.EmailStatusNone
+	}
+
+	switch delivery.Channel {
+	case models.ChannelEmailImmediate:
+		return models.EmailStatusPending
+	case models.ChannelEmailDigest:
+		return models.EmailStatusDigest
+	default:
+		return models.EmailStatusNone
+	}
+}
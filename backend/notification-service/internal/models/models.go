@@ -2,26 +2,162 @@ package models
 
 import "time"
 
+// Notification types produced by other services
+const (
+	TypeNewReview    = "new_review"
+	TypeReviewReply  = "review_reply"
+	TypeReviewEdited = "review_edited"
+)
+
+// All notification types a user can set a delivery preference for
+var Types = []string{TypeNewReview, TypeReviewReply, TypeReviewEdited}
+
+// Delivery channels a user can choose per notification type
+const (
+	ChannelInApp          = "in_app"
+	ChannelEmailImmediate = "email_immediate"
+	ChannelEmailDigest    = "email_digest"
+	ChannelOff            = "off"
+)
+
+var Channels = []string{ChannelInApp, ChannelEmailImmediate, ChannelEmailDigest, ChannelOff}
+
+// Email delivery state of a notification
+const (
+	EmailStatusNone    = ""
+	EmailStatusPending = "pending"
+	EmailStatusDigest  = "digest"
+	EmailStatusSent    = "sent"
+	EmailStatusFailed  = "failed"
+)
+
 // Domain model
 type Notification struct {
-	NotificationID int64     `db:"notification_id"`
-	UserID         int64     `db:"user_id"`
-	Content        string    `db:"content"`
-	IsRead         bool      `db:"is_read"`
-	CreatedAt      time.Time `db:"created_at"`
+	NotificationID int64      `db:"notification_id"`
+	UserID         int64      `db:"user_id"`
+	Type           string     `db:"type"`
+	Actor          string     `db:"actor"`
+	Payload        Payload    `db:"payload"`
+	Content        string     `db:"content"`
+	IsRead         bool       `db:"is_read"`
+	ArchivedAt     *time.Time `db:"archived_at"`
+	EmailStatus    string     `db:"email_status"`
+	CreatedAt      time.Time  `db:"created_at"`
+}
+
+// References to the entities a notification is about, stored as JSONB,
+// EssayAuthor is left empty for essays of anonymous assignments
+type Payload struct {
+	EssayID     int64  `json:"essay_id,omitempty"`
+	ReviewID    int64  `json:"review_id,omitempty"`
+	ReplyID     int64  `json:"reply_id,omitempty"`
+	EssayAuthor string `json:"essay_author,omitempty"`
 }
 
 // Get response
 type NotificationResponse struct {
 	NotificationID int64     `json:"notification_id"`
 	UserID         int64     `json:"user_id"`
+	Type           string    `json:"type"`
+	Actor          string    `json:"actor"`
+	Payload        Payload   `json:"target"`
 	Content        string    `json:"content"`
+	Link           string    `json:"link"`
 	IsRead         bool      `json:"is_read"`
+	IsArchived     bool      `json:"is_archived"`
 	CreatedAt      time.Time `json:"created_at"`
 }
 
 // Create request DTO
 type NotificationRequest struct {
-	UserID  int64  `json:"user_id" binding:"required,number"`
-	Content string `json:"content" binding:"required"`
+	UserID      int64   `json:"user_id" binding:"required,number"`
+	Type        string  `json:"type"`
+	Actor       string  `json:"actor"`
+	Payload     Payload `json:"payload"`
+	Content     string  `json:"content"`
+	EmailStatus string  `json:"-"`
+}
+
+// Inbox listing filter
+type NotificationFilter struct {
+	UnreadOnly bool
+	Type       string
+	Archived   bool
+}
+
+// Per-user delivery settings, Channels is keyed by notification type
+type Preferences struct {
+	UserID   int64
+	Email    string
+	Channels map[string]string
+}
+
+// Where a single notification of some type should go for a user
+type Delivery struct {
+	Channel string
+	Email   string
+}
+
+// Notification waiting for email delivery together with its recipient address
+type PendingEmail struct {
+	Notification Notification
+	Email        string
+	Attempts     int
+}
+
+// Webhook delivery states
+const (
+	DeliveryStatusPending = "pending"
+	DeliveryStatusSuccess = "success"
+	DeliveryStatusFailed  = "failed"
+)
+
+// Outgoing webhook subscription, empty EventTypes means every type
+type Webhook struct {
+	WebhookID  int64     `db:"webhook_id"`
+	UserID     int64     `db:"user_id"`
+	URL        string    `db:"url"`
+	Secret     string    `db:"secret"`
+	EventTypes []string  `db:"event_types"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+// Create webhook request DTO
+type WebhookRequest struct {
+	UserID     int64
+	URL        string
+	Secret     string
+	EventTypes []string
+}
+
+// One entry of the webhook delivery log
+type WebhookDelivery struct {
+	DeliveryID     int64      `db:"delivery_id"`
+	WebhookID      int64      `db:"webhook_id"`
+	NotificationID int64      `db:"notification_id"`
+	EventType      string     `db:"event_type"`
+	Payload        []byte     `db:"payload"`
+	Status         string     `db:"status"`
+	Attempts       int        `db:"attempts"`
+	LastStatusCode int        `db:"last_status_code"`
+	LastError      string     `db:"last_error"`
+	NextAttemptAt  time.Time  `db:"next_attempt_at"`
+	DeliveredAt    *time.Time `db:"delivered_at"`
+	CreatedAt      time.Time  `db:"created_at"`
+}
+
+// Delivery due for an attempt together with where and how to sign it
+type DueDelivery struct {
+	Delivery WebhookDelivery
+	URL      string
+	Secret   string
+}
+
+// Outcome of one delivery attempt
+type DeliveryAttempt struct {
+	DeliveryID    int64
+	Status        string
+	StatusCode    int
+	Error         string
+	NextAttemptAt time.Time
 }
@@ -1,6 +1,9 @@
 package mocks
 
 import (
+	"context"
+	"time"
+
 	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/models"
 	"github.com/stretchr/testify/mock"
 )
@@ -9,27 +12,130 @@ type MockNotificationRepository struct {
 	mock.Mock
 }
 
-func (m *MockNotificationRepository) Create(notification models.NotificationRequest) (models.Notification, error) {
-	args := m.Called(notification)
+func (m *MockNotificationRepository) Create(ctx context.Context, notification models.NotificationRequest) (models.Notification, error) {
+	args := m.Called(ctx, notification)
 	return args.Get(0).(models.Notification), args.Error(1)
 }
 
-func (m *MockNotificationRepository) GetByUserID(userID int64) ([]models.Notification, error) {
-	args := m.Called(userID)
+func (m *MockNotificationRepository) GetByUserID(ctx context.Context, userID int64, filter models.NotificationFilter) ([]models.Notification, error) {
+	args := m.Called(ctx, userID, filter)
 	return args.Get(0).([]models.Notification), args.Error(1)
 }
 
-func (m *MockNotificationRepository) MarkAsRead(notificationID int64) error {
-	args := m.Called(notificationID)
+func (m *MockNotificationRepository) UnreadCount(ctx context.Context, userID int64) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockNotificationRepository) MarkAsRead(ctx context.Context, notificationID int64) error {
+	args := m.Called(ctx, notificationID)
 	return args.Error(0)
 }
 
-func (m *MockNotificationRepository) MarkAllAsRead(userID int64) error {
-	args := m.Called(userID)
+func (m *MockNotificationRepository) MarkAsReadOwned(ctx context.Context, notificationID int64, userID int64) error {
+	args := m.Called(ctx, notificationID, userID)
+	return args.Error(0)
+}
+
+func (m *MockNotificationRepository) MarkAllAsRead(ctx context.Context, userID int64) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockNotificationRepository) ArchiveOwned(ctx context.Context, notificationID int64, userID int64) error {
+	args := m.Called(ctx, notificationID, userID)
+	return args.Error(0)
+}
+
+func (m *MockNotificationRepository) ArchiveAll(ctx context.Context, userID int64, readOnly bool) (int64, error) {
+	args := m.Called(ctx, userID, readOnly)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockNotificationRepository) DeleteOwned(ctx context.Context, notificationID int64, userID int64) error {
+	args := m.Called(ctx, notificationID, userID)
 	return args.Error(0)
 }
 
-func (m *MockNotificationRepository) GetByID(notificationID int64) (models.Notification, error) {
-	args := m.Called(notificationID)
+func (m *MockNotificationRepository) DeleteAll(ctx context.Context, userID int64, readOnly bool) (int64, error) {
+	args := m.Called(ctx, userID, readOnly)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockNotificationRepository) GetByID(ctx context.Context, notificationID int64) (models.Notification, error) {
+	args := m.Called(ctx, notificationID)
 	return args.Get(0).(models.Notification), args.Error(1)
 }
+
+func (m *MockNotificationRepository) GetPendingEmails(ctx context.Context, emailStatus string, userLimit int) ([]models.PendingEmail, error) {
+	args := m.Called(ctx, emailStatus, userLimit)
+	return args.Get(0).([]models.PendingEmail), args.Error(1)
+}
+
+func (m *MockNotificationRepository) SetEmailStatus(ctx context.Context, notificationIDs []int64, emailStatus string) error {
+	args := m.Called(ctx, notificationIDs, emailStatus)
+	return args.Error(0)
+}
+
+func (m *MockNotificationRepository) RetryEmails(ctx context.Context, notificationIDs []int64, nextAttemptAt time.Time) error {
+	args := m.Called(ctx, notificationIDs, nextAttemptAt)
+	return args.Error(0)
+}
+
+type MockPreferenceRepository struct {
+	mock.Mock
+}
+
+func (m *MockPreferenceRepository) GetPreferences(ctx context.Context, userID int64) (models.Preferences, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(models.Preferences), args.Error(1)
+}
+
+func (m *MockPreferenceRepository) UpdatePreferences(ctx context.Context, preferences models.Preferences) (models.Preferences, error) {
+	args := m.Called(ctx, preferences)
+	return args.Get(0).(models.Preferences), args.Error(1)
+}
+
+func (m *MockPreferenceRepository) GetDelivery(ctx context.Context, userID int64, notificationType string) (models.Delivery, error) {
+	args := m.Called(ctx, userID, notificationType)
+	return args.Get(0).(models.Delivery), args.Error(1)
+}
+
+type MockWebhookRepository struct {
+	mock.Mock
+}
+
+func (m *MockWebhookRepository) Create(ctx context.Context, request models.WebhookRequest) (models.Webhook, error) {
+	args := m.Called(ctx, request)
+	return args.Get(0).(models.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookRepository) GetByUserID(ctx context.Context, userID int64) ([]models.Webhook, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]models.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookRepository) DeleteOwned(ctx context.Context, webhookID int64, userID int64) error {
+	args := m.Called(ctx, webhookID, userID)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) GetDeliveries(ctx context.Context, webhookID int64, userID int64, limit int) ([]models.WebhookDelivery, error) {
+	args := m.Called(ctx, webhookID, userID, limit)
+	return args.Get(0).([]models.WebhookDelivery), args.Error(1)
+}
+
+func (m *MockWebhookRepository) EnqueueDeliveries(ctx context.Context, notification models.Notification, payload []byte) (int64, error) {
+	args := m.Called(ctx, notification, payload)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockWebhookRepository) GetDueDeliveries(ctx context.Context, limit int) ([]models.DueDelivery, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).([]models.DueDelivery), args.Error(1)
+}
+
+func (m *MockWebhookRepository) RecordAttempt(ctx context.Context, attempt models.DeliveryAttempt) error {
+	args := m.Called(ctx, attempt)
+	return args.Error(0)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/models"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
@@ -19,16 +20,14 @@ type NotificationPgRepository struct {
 	logger *logging.Logger
 }
 
-func NewNotificationPgRepository(logger *logging.Logger) (NotificationRepository, error) {
-	pool, err := pgutil.GetPgxPool()
-	if err != nil {
-		return nil, err
-	}
-
-	return &NotificationPgRepository{db: pool, logger: logger}, nil
+func NewNotificationPgRepository(db *pgxpool.Pool, logger *logging.Logger) NotificationRepository {
+	return &NotificationPgRepository{db: db, logger: logger}
 }
 
-func (repository *NotificationPgRepository) Create(request models.NotificationRequest) (models.Notification, error) {
+func (repository *NotificationPgRepository) Create(ctx context.Context, request models.NotificationRequest) (models.Notification, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
 	logger := repository.logger.With(
 		zap.String("operation", "create_notification"),
 		zap.Int64("user_id", request.UserID),
@@ -37,12 +36,16 @@ func (repository *NotificationPgRepository) Create(request models.NotificationRe
 	logger.Debug("Creating notification")
 
 	var n models.Notification
-	err := repository.db.QueryRow(context.Background(),
-		`INSERT INTO notifications (user_id, content)
-		VALUES ($1, $2)
+	err := repository.db.QueryRow(ctx,
+		`INSERT INTO notifications (user_id, type, actor, payload, content, email_status)
+		VALUES ($1, $2, $3, $4, $5, $6)
 		RETURNING notification_id, is_read, created_at;`,
 		request.UserID,
+		request.Type,
+		request.Actor,
+		request.Payload,
 		request.Content,
+		request.EmailStatus,
 	).Scan(&n.NotificationID, &n.IsRead, &n.CreatedAt)
 
 	if err != nil {
@@ -51,27 +54,43 @@ func (repository *NotificationPgRepository) Create(request models.NotificationRe
 	}
 
 	n.UserID = request.UserID
+	n.Type = request.Type
+	n.Actor = request.Actor
+	n.Payload = request.Payload
 	n.Content = request.Content
+	n.EmailStatus = request.EmailStatus
 
 	logger.Info("Notification created successfully",
 		zap.Int64("notification_id", n.NotificationID))
 	return n, nil
 }
 
-func (repository *NotificationPgRepository) GetByUserID(userID int64) ([]models.Notification, error) {
+func (repository *NotificationPgRepository) GetByUserID(ctx context.Context, userID int64, filter models.NotificationFilter) ([]models.Notification, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
 	logger := repository.logger.With(
 		zap.String("operation", "get_notifications_by_user_id"),
 		zap.Int64("user_id", userID),
+		zap.Bool("unread_only", filter.UnreadOnly),
+		zap.String("type", filter.Type),
+		zap.Bool("archived", filter.Archived),
 	)
 
 	logger.Debug("Getting notifications by user ID")
 
-	rows, err := repository.db.Query(context.Background(),
-		`SELECT notification_id, user_id, content, is_read, created_at
+	rows, err := repository.db.Query(ctx,
+		`SELECT notification_id, user_id, type, actor, payload, content, is_read, archived_at, created_at
 		FROM notifications
 		WHERE user_id = $1
+			AND ($2::boolean = false OR is_read = false)
+			AND ($3::text = '' OR type = $3)
+			AND (archived_at IS NOT NULL) = $4
 		ORDER BY created_at DESC;`,
 		userID,
+		filter.UnreadOnly,
+		filter.Type,
+		filter.Archived,
 	)
 	if err != nil {
 		logger.Error("Failed to get notifications from database", zap.Error(err))
@@ -85,8 +104,12 @@ func (repository *NotificationPgRepository) GetByUserID(userID int64) ([]models.
 		err = rows.Scan(
 			&n.NotificationID,
 			&n.UserID,
+			&n.Type,
+			&n.Actor,
+			&n.Payload,
 			&n.Content,
 			&n.IsRead,
+			&n.ArchivedAt,
 			&n.CreatedAt,
 		)
 		if err != nil {
@@ -100,7 +123,37 @@ func (repository *NotificationPgRepository) GetByUserID(userID int64) ([]models.
 	return notifications, nil
 }
 
-func (repository *NotificationPgRepository) MarkAsRead(notificationID int64) error {
+func (repository *NotificationPgRepository) UnreadCount(ctx context.Context, userID int64) (int64, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "count_unread_notifications"),
+		zap.Int64("user_id", userID),
+	)
+
+	logger.Debug("Counting unread notifications")
+
+	var count int64
+	err := repository.db.QueryRow(ctx,
+		`SELECT COUNT(*)
+		FROM notifications
+		WHERE user_id = $1 AND is_read = false AND archived_at IS NULL;`,
+		userID,
+	).Scan(&count)
+	if err != nil {
+		logger.Error("Failed to count unread notifications in database", zap.Error(err))
+		return 0, fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+
+	logger.Debug("Counted unread notifications", zap.Int64("count", count))
+	return count, nil
+}
+
+func (repository *NotificationPgRepository) MarkAsRead(ctx context.Context, notificationID int64) error {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
 	logger := repository.logger.With(
 		zap.String("operation", "mark_notification_as_read"),
 		zap.Int64("notification_id", notificationID),
@@ -108,7 +161,7 @@ func (repository *NotificationPgRepository) MarkAsRead(notificationID int64) err
 
 	logger.Debug("Marking notification as read")
 
-	result, err := repository.db.Exec(context.Background(),
+	result, err := repository.db.Exec(ctx,
 		`UPDATE notifications
 		SET is_read = true
 		WHERE notification_id = $1;`,
@@ -129,7 +182,38 @@ func (repository *NotificationPgRepository) MarkAsRead(notificationID int64) err
 	return nil
 }
 
-func (repository *NotificationPgRepository) MarkAllAsRead(userID int64) error {
+func (repository *NotificationPgRepository) MarkAsReadOwned(ctx context.Context, notificationID int64, userID int64) error {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "mark_owned_notification_as_read"),
+		zap.Int64("notification_id", notificationID),
+		zap.Int64("user_id", userID),
+	)
+
+	logger.Debug("Marking owned notification as read")
+
+	err := repository.execOwned(ctx, logger,
+		`UPDATE notifications
+		SET is_read = true
+		WHERE notification_id = $1 AND user_id = $2
+		RETURNING notification_id`,
+		notificationID,
+		userID,
+	)
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("Owned notification marked as read successfully")
+	return nil
+}
+
+func (repository *NotificationPgRepository) MarkAllAsRead(ctx context.Context, userID int64) error {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
 	logger := repository.logger.With(
 		zap.String("operation", "mark_all_notifications_as_read"),
 		zap.Int64("user_id", userID),
@@ -137,7 +221,7 @@ func (repository *NotificationPgRepository) MarkAllAsRead(userID int64) error {
 
 	logger.Debug("Marking all notifications as read for user")
 
-	result, err := repository.db.Exec(context.Background(),
+	result, err := repository.db.Exec(ctx,
 		`UPDATE notifications
 		SET is_read = true
 		WHERE user_id = $1 AND is_read = false;`,
@@ -154,7 +238,122 @@ func (repository *NotificationPgRepository) MarkAllAsRead(userID int64) error {
 	return nil
 }
 
-func (repository *NotificationPgRepository) GetByID(notificationID int64) (models.Notification, error) {
+func (repository *NotificationPgRepository) ArchiveOwned(ctx context.Context, notificationID int64, userID int64) error {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "archive_owned_notification"),
+		zap.Int64("notification_id", notificationID),
+		zap.Int64("user_id", userID),
+	)
+
+	logger.Debug("Archiving owned notification")
+
+	err := repository.execOwned(ctx, logger,
+		`UPDATE notifications
+		SET archived_at = COALESCE(archived_at, CURRENT_TIMESTAMP)
+		WHERE notification_id = $1 AND user_id = $2
+		RETURNING notification_id`,
+		notificationID,
+		userID,
+	)
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("Owned notification archived successfully")
+	return nil
+}
+
+func (repository *NotificationPgRepository) ArchiveAll(ctx context.Context, userID int64, readOnly bool) (int64, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "archive_all_notifications"),
+		zap.Int64("user_id", userID),
+		zap.Bool("read_only", readOnly),
+	)
+
+	logger.Debug("Archiving all notifications for user")
+
+	result, err := repository.db.Exec(ctx,
+		`UPDATE notifications
+		SET archived_at = CURRENT_TIMESTAMP
+		WHERE user_id = $1 AND archived_at IS NULL AND ($2::boolean = false OR is_read = true);`,
+		userID,
+		readOnly,
+	)
+	if err != nil {
+		logger.Error("Failed to archive notifications in database", zap.Error(err))
+		return 0, fmt.Errorf("failed to archive notifications: %w", err)
+	}
+
+	rowsAffected := result.RowsAffected()
+	logger.Debug("Archived notifications", zap.Int64("rows_affected", rowsAffected))
+	return rowsAffected, nil
+}
+
+func (repository *NotificationPgRepository) DeleteOwned(ctx context.Context, notificationID int64, userID int64) error {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "delete_owned_notification"),
+		zap.Int64("notification_id", notificationID),
+		zap.Int64("user_id", userID),
+	)
+
+	logger.Debug("Deleting owned notification")
+
+	err := repository.execOwned(ctx, logger,
+		`DELETE FROM notifications
+		WHERE notification_id = $1 AND user_id = $2
+		RETURNING notification_id`,
+		notificationID,
+		userID,
+	)
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("Owned notification deleted successfully")
+	return nil
+}
+
+func (repository *NotificationPgRepository) DeleteAll(ctx context.Context, userID int64, readOnly bool) (int64, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "delete_all_notifications"),
+		zap.Int64("user_id", userID),
+		zap.Bool("read_only", readOnly),
+	)
+
+	logger.Debug("Deleting all notifications for user")
+
+	result, err := repository.db.Exec(ctx,
+		`DELETE FROM notifications
+		WHERE user_id = $1 AND ($2::boolean = false OR is_read = true);`,
+		userID,
+		readOnly,
+	)
+	if err != nil {
+		logger.Error("Failed to delete notifications in database", zap.Error(err))
+		return 0, fmt.Errorf("failed to delete notifications: %w", err)
+	}
+
+	rowsAffected := result.RowsAffected()
+	logger.Debug("Deleted notifications", zap.Int64("rows_affected", rowsAffected))
+	return rowsAffected, nil
+}
+
+func (repository *NotificationPgRepository) GetByID(ctx context.Context, notificationID int64) (models.Notification, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
 	logger := repository.logger.With(
 		zap.String("operation", "get_notification_by_id"),
 		zap.Int64("notification_id", notificationID),
@@ -163,16 +362,20 @@ func (repository *NotificationPgRepository) GetByID(notificationID int64) (model
 	logger.Debug("Getting notification by ID")
 
 	var n models.Notification
-	err := repository.db.QueryRow(context.Background(),
-		`SELECT notification_id, user_id, content, is_read, created_at
+	err := repository.db.QueryRow(ctx,
+		`SELECT notification_id, user_id, type, actor, payload, content, is_read, archived_at, created_at
 		FROM notifications
 		WHERE notification_id = $1;`,
 		notificationID,
 	).Scan(
 		&n.NotificationID,
 		&n.UserID,
+		&n.Type,
+		&n.Actor,
+		&n.Payload,
 		&n.Content,
 		&n.IsRead,
+		&n.ArchivedAt,
 		&n.CreatedAt,
 	)
 
@@ -189,6 +392,165 @@ func (repository *NotificationPgRepository) GetByID(notificationID int64) (model
 	return n, nil
 }
 
+// Every due notification of at most userLimit users, the limit applies to
+// users rather than rows so a digest never gets split over two batches
+func (repository *NotificationPgRepository) GetPendingEmails(ctx context.Context, emailStatus string, userLimit int) ([]models.PendingEmail, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "get_pending_emails"),
+		zap.String("email_status", emailStatus),
+		zap.Int("user_limit", userLimit),
+	)
+
+	logger.Debug("Getting notifications pending email delivery")
+
+	rows, err := repository.db.Query(ctx,
+		`WITH due AS (
+			SELECT n.notification_id, n.user_id, n.type, n.actor, n.payload, n.content, n.is_read, n.created_at, s.email, n.email_attempts
+			FROM notifications n
+			JOIN notification_settings s ON s.user_id = n.user_id
+			WHERE n.email_status = $1 AND s.email <> '' AND n.email_next_attempt_at <= CURRENT_TIMESTAMP
+		)
+		SELECT notification_id, user_id, type, actor, payload, content, is_read, created_at, email, email_attempts
+		FROM due
+		WHERE user_id IN (
+			SELECT DISTINCT user_id
+			FROM due
+			ORDER BY user_id
+			LIMIT $2
+		)
+		ORDER BY user_id, created_at;`,
+		emailStatus,
+		userLimit,
+	)
+	if err != nil {
+		logger.Error("Failed to get pending emails from database", zap.Error(err))
+		return nil, fmt.Errorf("failed to load pending emails: %w", err)
+	}
+	defer rows.Close()
+
+	var pending []models.PendingEmail
+	for rows.Next() {
+		var p models.PendingEmail
+		err = rows.Scan(
+			&p.Notification.NotificationID,
+			&p.Notification.UserID,
+			&p.Notification.Type,
+			&p.Notification.Actor,
+			&p.Notification.Payload,
+			&p.Notification.Content,
+			&p.Notification.IsRead,
+			&p.Notification.CreatedAt,
+			&p.Email,
+			&p.Attempts,
+		)
+		if err != nil {
+			logger.Error("Failed to scan pending email row", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan pending email: %w", err)
+		}
+		p.Notification.EmailStatus = emailStatus
+		pending = append(pending, p)
+	}
+
+	logger.Debug("Retrieved pending emails", zap.Int("count", len(pending)))
+	return pending, nil
+}
+
+func (repository *NotificationPgRepository) SetEmailStatus(ctx context.Context, notificationIDs []int64, emailStatus string) error {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "set_email_status"),
+		zap.Int("count", len(notificationIDs)),
+		zap.String("email_status", emailStatus),
+	)
+
+	logger.Debug("Updating email status of notifications")
+
+	_, err := repository.db.Exec(ctx,
+		`UPDATE notifications
+		SET email_status = $2
+		WHERE notification_id = ANY($1);`,
+		notificationIDs,
+		emailStatus,
+	)
+	if err != nil {
+		logger.Error("Failed to update email status in database", zap.Error(err))
+		return fmt.Errorf("failed to update email status: %w", err)
+	}
+
+	return nil
+}
+
+// Counts a failed send and leaves the emails pending until nextAttemptAt
+func (repository *NotificationPgRepository) RetryEmails(ctx context.Context, notificationIDs []int64, nextAttemptAt time.Time) error {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "retry_emails"),
+		zap.Int("count", len(notificationIDs)),
+		zap.Time("next_attempt_at", nextAttemptAt),
+	)
+
+	logger.Debug("Scheduling email retry of notifications")
+
+	_, err := repository.db.Exec(ctx,
+		`UPDATE notifications
+		SET email_attempts = email_attempts + 1,
+			email_next_attempt_at = $2
+		WHERE notification_id = ANY($1);`,
+		notificationIDs,
+		nextAttemptAt,
+	)
+	if err != nil {
+		logger.Error("Failed to schedule email retry in database", zap.Error(err))
+		return fmt.Errorf("failed to schedule email retry: %w", err)
+	}
+
+	return nil
+}
+
+// Runs a statement scoped to one notification of the user, telling a missing
+// notification apart from one that belongs to somebody else
+func (repository *NotificationPgRepository) execOwned(ctx context.Context, logger *zap.Logger, statement string, notificationID int64, userID int64) error {
+	var ownerID int64
+	var affected bool
+	err := repository.db.QueryRow(ctx,
+		`WITH target AS (
+			SELECT user_id
+			FROM notifications
+			WHERE notification_id = $1
+		), affected AS (
+			`+statement+`
+		)
+		SELECT target.user_id, EXISTS (SELECT 1 FROM affected)
+		FROM target;`,
+		notificationID,
+		userID,
+	).Scan(&ownerID, &affected)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Warn("Notification not found")
+			return NotificationNotFoundErr
+		}
+		logger.Error("Failed to update owned notification in database", zap.Error(err))
+		return fmt.Errorf("failed to update notification: %w", err)
+	}
+
+	if !affected {
+		logger.Warn("Attempt to access another user's notification",
+			zap.Int64("owner_id", ownerID))
+		return NotificationForbiddenErr
+	}
+
+	return nil
+}
+
 func (repository *NotificationPgRepository) DB() *pgxpool.Pool {
 	return repository.db
 }
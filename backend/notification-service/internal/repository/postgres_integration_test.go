@@ -0,0 +1,537 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/repository"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	pgutil "github.com/IAGrig/vt-csa-essays/backend/shared/pg_util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+)
+
+var (
+	testRepo           repository.NotificationRepository
+	testPreferenceRepo repository.PreferenceRepository
+	testWebhookRepo    repository.WebhookRepository
+)
+
+func TestMain(m *testing.M) {
+	ctx := context.Background()
+
+	container, err := setupTestDB(ctx)
+	if err != nil {
+		fmt.Printf("Failed to setup test database: %v\n", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if container != nil {
+			_ = container.Terminate(ctx)
+		}
+	}()
+
+	logger := logging.NewEmptyLogger()
+	pool, err := pgutil.GetPgxPool()
+	if err != nil {
+		fmt.Printf("Failed to connect to database: %v\n", err)
+		os.Exit(1)
+	}
+	testRepo = repository.NewNotificationPgRepository(pool, logger)
+	testPreferenceRepo = repository.NewPreferencePgRepository(pool, logger)
+	testWebhookRepo = repository.NewWebhookPgRepository(pool, logger)
+
+	code := m.Run()
+	os.Exit(code)
+}
+
+func setupTestDB(ctx context.Context) (testcontainers.Container, error) {
+	container, err := postgres.RunContainer(
+		ctx,
+		testcontainers.WithImage("postgres:16-alpine"),
+		postgres.WithDatabase("test_db"),
+		postgres.WithUsername("test_user"),
+		postgres.WithPassword("test_password"),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start postgres container: %w", err)
+	}
+
+	host, err := container.Host(ctx)
+	if err != nil {
+		return container, fmt.Errorf("failed to get container host: %w", err)
+	}
+
+	port, err := container.MappedPort(ctx, "5432")
+	if err != nil {
+		return container, fmt.Errorf("failed to get container port: %w", err)
+	}
+
+	os.Setenv("POSTGRES_HOST", host)
+	os.Setenv("POSTGRES_PORT", port.Port())
+	os.Setenv("POSTGRES_USER", "test_user")
+	os.Setenv("POSTGRES_PASSWORD", "test_password")
+	os.Setenv("POSTGRES_DB_NAME", "test_db")
+	os.Setenv("POSTGRES_SSL_MODE", "disable")
+
+	fmt.Printf("Database running at: %s:%s\n", host, port.Port())
+
+	if err := runGooseMigrations(host, port.Port()); err != nil {
+		return container, fmt.Errorf("failed to run goose migrations: %w", err)
+	}
+
+	return container, nil
+}
+
+func runGooseMigrations(host, port string) error {
+	connStr := fmt.Sprintf("postgres://test_user:test_password@%s:%s/test_db?sslmode=disable", host, port)
+
+	migrationsPath, err := getMigrationsPath()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Running goose migrations from: %s\n", migrationsPath)
+
+	db, err := sql.Open("pgx", connStr)
+	if err != nil {
+		return fmt.Errorf("failed to open database for migrations: %w", err)
+	}
+	defer db.Close()
+
+	if err := goose.SetDialect("postgres"); err != nil {
+		return fmt.Errorf("failed to set goose dialect: %w", err)
+	}
+
+	if err := goose.Up(db, migrationsPath); err != nil {
+		return fmt.Errorf("failed to run goose up: %w", err)
+	}
+
+	fmt.Println("Goose migrations completed successfully")
+	return nil
+}
+
+func getMigrationsPath() (string, error) {
+	testDir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+
+	possiblePaths := []string{
+		filepath.Join(testDir, "..", "..", "..", "migrations"),
+		filepath.Join(testDir, "..", "..", "migrations"),
+	}
+
+	for _, path := range possiblePaths {
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			continue
+		}
+		if _, err := os.Stat(absPath); err == nil {
+			return absPath, nil
+		}
+	}
+
+	return "", fmt.Errorf("migrations directory not found. Tried: %v", possiblePaths)
+}
+
+func TestIntegrationNotificationRepository_MarkAsReadOwned(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	cleanupTables(t)
+	ownerID := insertTestUser(t, "owner")
+	otherID := insertTestUser(t, "other")
+
+	notification, err := testRepo.Create(context.Background(), models.NotificationRequest{
+		UserID:  ownerID,
+		Content: "Owner's notification",
+	})
+	require.NoError(t, err)
+
+	err = testRepo.MarkAsReadOwned(context.Background(), notification.NotificationID, otherID)
+	assert.ErrorIs(t, err, repository.NotificationForbiddenErr)
+
+	stored, err := testRepo.GetByID(context.Background(), notification.NotificationID)
+	require.NoError(t, err)
+	assert.False(t, stored.IsRead)
+
+	err = testRepo.MarkAsReadOwned(context.Background(), notification.NotificationID, ownerID)
+	require.NoError(t, err)
+
+	stored, err = testRepo.GetByID(context.Background(), notification.NotificationID)
+	require.NoError(t, err)
+	assert.True(t, stored.IsRead)
+
+	err = testRepo.MarkAsReadOwned(context.Background(), notification.NotificationID, ownerID)
+	assert.NoError(t, err, "marking an already read notification is idempotent")
+
+	err = testRepo.MarkAsReadOwned(context.Background(), notification.NotificationID+1000, ownerID)
+	assert.ErrorIs(t, err, repository.NotificationNotFoundErr)
+}
+
+func TestIntegrationNotificationRepository_InboxFilters(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	cleanupTables(t)
+	userID := insertTestUser(t, "owner")
+
+	review, err := testRepo.Create(context.Background(), models.NotificationRequest{
+		UserID: userID,
+		Type:   models.TypeNewReview,
+		Actor:  "reviewer1",
+	})
+	require.NoError(t, err)
+
+	read, err := testRepo.Create(context.Background(), models.NotificationRequest{
+		UserID:  userID,
+		Content: "Already read",
+	})
+	require.NoError(t, err)
+	require.NoError(t, testRepo.MarkAsReadOwned(context.Background(), read.NotificationID, userID))
+
+	archived, err := testRepo.Create(context.Background(), models.NotificationRequest{
+		UserID:  userID,
+		Content: "Archived",
+	})
+	require.NoError(t, err)
+	require.NoError(t, testRepo.ArchiveOwned(context.Background(), archived.NotificationID, userID))
+
+	inbox, err := testRepo.GetByUserID(context.Background(), userID, models.NotificationFilter{})
+	require.NoError(t, err)
+	assert.Len(t, inbox, 2)
+
+	unread, err := testRepo.GetByUserID(context.Background(), userID, models.NotificationFilter{UnreadOnly: true})
+	require.NoError(t, err)
+	require.Len(t, unread, 1)
+	assert.Equal(t, review.NotificationID, unread[0].NotificationID)
+
+	byType, err := testRepo.GetByUserID(context.Background(), userID, models.NotificationFilter{Type: models.TypeNewReview})
+	require.NoError(t, err)
+	require.Len(t, byType, 1)
+	assert.Equal(t, review.NotificationID, byType[0].NotificationID)
+
+	archive, err := testRepo.GetByUserID(context.Background(), userID, models.NotificationFilter{Archived: true})
+	require.NoError(t, err)
+	require.Len(t, archive, 1)
+	assert.Equal(t, archived.NotificationID, archive[0].NotificationID)
+	assert.NotNil(t, archive[0].ArchivedAt)
+
+	count, err := testRepo.UnreadCount(context.Background(), userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestIntegrationNotificationRepository_ArchiveAndDelete(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	cleanupTables(t)
+	ownerID := insertTestUser(t, "owner")
+	otherID := insertTestUser(t, "other")
+
+	first, err := testRepo.Create(context.Background(), models.NotificationRequest{UserID: ownerID, Content: "First"})
+	require.NoError(t, err)
+	second, err := testRepo.Create(context.Background(), models.NotificationRequest{UserID: ownerID, Content: "Second"})
+	require.NoError(t, err)
+	_, err = testRepo.Create(context.Background(), models.NotificationRequest{UserID: ownerID, Content: "Third"})
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, testRepo.ArchiveOwned(context.Background(), first.NotificationID, otherID), repository.NotificationForbiddenErr)
+	assert.ErrorIs(t, testRepo.DeleteOwned(context.Background(), first.NotificationID, otherID), repository.NotificationForbiddenErr)
+	assert.ErrorIs(t, testRepo.DeleteOwned(context.Background(), first.NotificationID+1000, ownerID), repository.NotificationNotFoundErr)
+
+	require.NoError(t, testRepo.DeleteOwned(context.Background(), first.NotificationID, ownerID))
+	_, err = testRepo.GetByID(context.Background(), first.NotificationID)
+	assert.ErrorIs(t, err, repository.NotificationNotFoundErr)
+
+	require.NoError(t, testRepo.MarkAsReadOwned(context.Background(), second.NotificationID, ownerID))
+
+	archivedCount, err := testRepo.ArchiveAll(context.Background(), ownerID, true)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), archivedCount)
+
+	deletedCount, err := testRepo.DeleteAll(context.Background(), ownerID, false)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), deletedCount)
+
+	remaining, err := testRepo.GetByUserID(context.Background(), ownerID, models.NotificationFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, remaining)
+}
+
+func TestIntegrationPreferenceRepository_Preferences(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	cleanupTables(t)
+	userID := insertTestUser(t, "prefsuser")
+
+	defaults, err := testPreferenceRepo.GetPreferences(context.Background(), userID)
+	require.NoError(t, err)
+	assert.Empty(t, defaults.Email)
+	assert.Equal(t, models.ChannelInApp, defaults.Channels[models.TypeNewReview])
+
+	delivery, err := testPreferenceRepo.GetDelivery(context.Background(), userID, models.TypeNewReview)
+	require.NoError(t, err)
+	assert.Equal(t, models.Delivery{Channel: models.ChannelInApp}, delivery)
+
+	updated, err := testPreferenceRepo.UpdatePreferences(context.Background(), models.Preferences{
+		UserID:   userID,
+		Email:    "prefsuser@example.com",
+		Channels: map[string]string{models.TypeNewReview: models.ChannelEmailDigest},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "prefsuser@example.com", updated.Email)
+	assert.Equal(t, models.ChannelEmailDigest, updated.Channels[models.TypeNewReview])
+
+	delivery, err = testPreferenceRepo.GetDelivery(context.Background(), userID, models.TypeNewReview)
+	require.NoError(t, err)
+	assert.Equal(t, models.Delivery{Channel: models.ChannelEmailDigest, Email: "prefsuser@example.com"}, delivery)
+
+	// changing only the channels keeps the address
+	updated, err = testPreferenceRepo.UpdatePreferences(context.Background(), models.Preferences{
+		UserID:   userID,
+		Channels: map[string]string{models.TypeNewReview: models.ChannelEmailImmediate},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "prefsuser@example.com", updated.Email)
+	assert.Equal(t, models.ChannelEmailImmediate, updated.Channels[models.TypeNewReview])
+}
+
+func TestIntegrationNotificationRepository_PendingEmails(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	cleanupTables(t)
+	userID := insertTestUser(t, "mailuser")
+	noEmailID := insertTestUser(t, "noemailuser")
+
+	_, err := testPreferenceRepo.UpdatePreferences(context.Background(), models.Preferences{UserID: userID, Email: "mailuser@example.com"})
+	require.NoError(t, err)
+
+	pending, err := testRepo.Create(context.Background(), models.NotificationRequest{
+		UserID:      userID,
+		Type:        models.TypeNewReview,
+		Actor:       "reviewer1",
+		EmailStatus: models.EmailStatusPending,
+	})
+	require.NoError(t, err)
+	_, err = testRepo.Create(context.Background(), models.NotificationRequest{UserID: userID, Content: "In-app only"})
+	require.NoError(t, err)
+	_, err = testRepo.Create(context.Background(), models.NotificationRequest{
+		UserID:      noEmailID,
+		Content:     "No address",
+		EmailStatus: models.EmailStatusPending,
+	})
+	require.NoError(t, err)
+
+	emails, err := testRepo.GetPendingEmails(context.Background(), models.EmailStatusPending, 10)
+	require.NoError(t, err)
+	require.Len(t, emails, 1)
+	assert.Equal(t, pending.NotificationID, emails[0].Notification.NotificationID)
+	assert.Equal(t, "reviewer1", emails[0].Notification.Actor)
+	assert.Equal(t, "mailuser@example.com", emails[0].Email)
+
+	assert.Equal(t, 0, emails[0].Attempts)
+
+	// a retry waits for its backoff
+	require.NoError(t, testRepo.RetryEmails(context.Background(), []int64{pending.NotificationID}, time.Now().Add(time.Hour)))
+	emails, err = testRepo.GetPendingEmails(context.Background(), models.EmailStatusPending, 10)
+	require.NoError(t, err)
+	assert.Empty(t, emails)
+
+	require.NoError(t, testRepo.RetryEmails(context.Background(), []int64{pending.NotificationID}, time.Now().Add(-time.Second)))
+	emails, err = testRepo.GetPendingEmails(context.Background(), models.EmailStatusPending, 10)
+	require.NoError(t, err)
+	require.Len(t, emails, 1)
+	assert.Equal(t, 2, emails[0].Attempts)
+
+	require.NoError(t, testRepo.SetEmailStatus(context.Background(), []int64{pending.NotificationID}, models.EmailStatusSent))
+
+	emails, err = testRepo.GetPendingEmails(context.Background(), models.EmailStatusPending, 10)
+	require.NoError(t, err)
+	assert.Empty(t, emails)
+}
+
+func TestIntegrationNotificationRepository_PendingEmailsLimitsUsers(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	cleanupTables(t)
+	var userIDs []int64
+	for _, username := range []string{"digestuser1", "digestuser2"} {
+		userID := insertTestUser(t, username)
+		userIDs = append(userIDs, userID)
+		_, err := testPreferenceRepo.UpdatePreferences(context.Background(), models.Preferences{UserID: userID, Email: username + "@example.com"})
+		require.NoError(t, err)
+		for range 3 {
+			_, err := testRepo.Create(context.Background(), models.NotificationRequest{
+				UserID:      userID,
+				Type:        models.TypeNewReview,
+				EmailStatus: models.EmailStatusDigest,
+			})
+			require.NoError(t, err)
+		}
+	}
+
+	// the limit counts users, the first one comes with all of their rows
+	emails, err := testRepo.GetPendingEmails(context.Background(), models.EmailStatusDigest, 1)
+	require.NoError(t, err)
+	require.Len(t, emails, 3)
+	for _, email := range emails {
+		assert.Equal(t, min(userIDs[0], userIDs[1]), email.Notification.UserID)
+	}
+
+	emails, err = testRepo.GetPendingEmails(context.Background(), models.EmailStatusDigest, 2)
+	require.NoError(t, err)
+	assert.Len(t, emails, 6)
+}
+
+func TestIntegrationWebhookRepository_Subscriptions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	cleanupTables(t)
+	ownerID := insertTestUser(t, "hookowner")
+	otherID := insertTestUser(t, "hookother")
+
+	webhook, err := testWebhookRepo.Create(context.Background(), models.WebhookRequest{
+		UserID:     ownerID,
+		URL:        "https://chat.example.com/hook",
+		Secret:     "secret",
+		EventTypes: []string{models.TypeNewReview},
+	})
+	require.NoError(t, err)
+	assert.NotZero(t, webhook.WebhookID)
+
+	_, err = testWebhookRepo.Create(context.Background(), models.WebhookRequest{
+		UserID:     ownerID,
+		URL:        "https://chat.example.com/other-events",
+		Secret:     "secret",
+		EventTypes: []string{"other_event"},
+	})
+	require.NoError(t, err)
+
+	webhooks, err := testWebhookRepo.GetByUserID(context.Background(), ownerID)
+	require.NoError(t, err)
+	require.Len(t, webhooks, 2)
+	assert.Equal(t, []string{models.TypeNewReview}, webhooks[0].EventTypes)
+
+	assert.ErrorIs(t, testWebhookRepo.DeleteOwned(context.Background(), webhook.WebhookID, otherID), repository.WebhookForbiddenErr)
+	assert.ErrorIs(t, testWebhookRepo.DeleteOwned(context.Background(), webhook.WebhookID+1000, ownerID), repository.WebhookNotFoundErr)
+	_, err = testWebhookRepo.GetDeliveries(context.Background(), webhook.WebhookID, otherID, 10)
+	assert.ErrorIs(t, err, repository.WebhookForbiddenErr)
+
+	require.NoError(t, testWebhookRepo.DeleteOwned(context.Background(), webhook.WebhookID, ownerID))
+	webhooks, err = testWebhookRepo.GetByUserID(context.Background(), ownerID)
+	require.NoError(t, err)
+	assert.Len(t, webhooks, 1)
+}
+
+func TestIntegrationWebhookRepository_DeliveryLog(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	cleanupTables(t)
+	userID := insertTestUser(t, "hookuser")
+
+	webhook, err := testWebhookRepo.Create(context.Background(), models.WebhookRequest{
+		UserID: userID,
+		URL:    "https://chat.example.com/hook",
+		Secret: "secret",
+	})
+	require.NoError(t, err)
+
+	notification, err := testRepo.Create(context.Background(), models.NotificationRequest{
+		UserID: userID,
+		Type:   models.TypeNewReview,
+		Actor:  "reviewer1",
+	})
+	require.NoError(t, err)
+
+	enqueued, err := testWebhookRepo.EnqueueDeliveries(context.Background(), notification, []byte(`{"event":"new_review"}`))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), enqueued)
+
+	due, err := testWebhookRepo.GetDueDeliveries(context.Background(), 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, "https://chat.example.com/hook", due[0].URL)
+	assert.Equal(t, "secret", due[0].Secret)
+	assert.JSONEq(t, `{"event":"new_review"}`, string(due[0].Delivery.Payload))
+
+	err = testWebhookRepo.RecordAttempt(context.Background(), models.DeliveryAttempt{
+		DeliveryID:    due[0].Delivery.DeliveryID,
+		Status:        models.DeliveryStatusPending,
+		StatusCode:    500,
+		Error:         "receiver responded with status 500",
+		NextAttemptAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	due, err = testWebhookRepo.GetDueDeliveries(context.Background(), 10)
+	require.NoError(t, err)
+	assert.Empty(t, due)
+
+	log, err := testWebhookRepo.GetDeliveries(context.Background(), webhook.WebhookID, userID, 10)
+	require.NoError(t, err)
+	require.Len(t, log, 1)
+	assert.Equal(t, notification.NotificationID, log[0].NotificationID)
+	assert.Equal(t, models.DeliveryStatusPending, log[0].Status)
+	assert.Equal(t, 1, log[0].Attempts)
+	assert.Equal(t, 500, log[0].LastStatusCode)
+	assert.Nil(t, log[0].DeliveredAt)
+}
+
+func cleanupTables(t *testing.T) {
+	t.Helper()
+
+	repo := testRepo.(*repository.NotificationPgRepository)
+	_, err := repo.DB().Exec(context.Background(), "DELETE FROM notifications")
+	require.NoError(t, err)
+
+	_, err = repo.DB().Exec(context.Background(), "DELETE FROM users")
+	require.NoError(t, err)
+}
+
+func insertTestUser(t *testing.T, username string) int64 {
+	t.Helper()
+
+	validBcryptHash := "$2a$10$abcdefghijklmnopqrstuuxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+
+	repo := testRepo.(*repository.NotificationPgRepository)
+
+	var userID int64
+	err := repo.DB().QueryRow(context.Background(),
+		"INSERT INTO users (username, password_hash) VALUES ($1, $2) RETURNING user_id",
+		username, validBcryptHash).Scan(&userID)
+	require.NoError(t, err)
+
+	return userID
+}
@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/pg_util"
+	"go.uber.org/zap"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PreferencePgRepository struct {
+	db     *pgxpool.Pool
+	logger *logging.Logger
+}
+
+func NewPreferencePgRepository(db *pgxpool.Pool, logger *logging.Logger) PreferenceRepository {
+	return &PreferencePgRepository{db: db, logger: logger}
+}
+
+func (repository *PreferencePgRepository) GetPreferences(ctx context.Context, userID int64) (models.Preferences, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "get_preferences"),
+		zap.Int64("user_id", userID),
+	)
+
+	logger.Debug("Getting notification preferences")
+
+	preferences := defaultPreferences(userID)
+
+	err := repository.db.QueryRow(ctx,
+		`SELECT email FROM notification_settings WHERE user_id = $1;`,
+		userID,
+	).Scan(&preferences.Email)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		logger.Error("Failed to get notification settings from database", zap.Error(err))
+		return models.Preferences{}, fmt.Errorf("failed to get notification settings: %w", err)
+	}
+
+	rows, err := repository.db.Query(ctx,
+		`SELECT type, channel FROM notification_preferences WHERE user_id = $1;`,
+		userID,
+	)
+	if err != nil {
+		logger.Error("Failed to get notification preferences from database", zap.Error(err))
+		return models.Preferences{}, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var notificationType, channel string
+		if err := rows.Scan(&notificationType, &channel); err != nil {
+			logger.Error("Failed to scan notification preference row", zap.Error(err))
+			return models.Preferences{}, fmt.Errorf("failed to scan notification preference: %w", err)
+		}
+		preferences.Channels[notificationType] = channel
+	}
+
+	return preferences, nil
+}
+
+// Saves the channels given in preferences, an empty Email keeps the stored address
+func (repository *PreferencePgRepository) UpdatePreferences(ctx context.Context, preferences models.Preferences) (models.Preferences, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "update_preferences"),
+		zap.Int64("user_id", preferences.UserID),
+	)
+
+	logger.Debug("Updating notification preferences")
+
+	tx, err := repository.db.Begin(ctx)
+	if err != nil {
+		logger.Error("Failed to begin transaction", zap.Error(err))
+		return models.Preferences{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx,
+		`INSERT INTO notification_settings (user_id, email)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE
+		SET email = COALESCE(NULLIF(EXCLUDED.email, ''), notification_settings.email),
+			updated_at = CURRENT_TIMESTAMP;`,
+		preferences.UserID,
+		preferences.Email,
+	)
+	if err != nil {
+		logger.Error("Failed to save notification settings", zap.Error(err))
+		return models.Preferences{}, fmt.Errorf("failed to save notification settings: %w", err)
+	}
+
+	for notificationType, channel := range preferences.Channels {
+		_, err = tx.Exec(ctx,
+			`INSERT INTO notification_preferences (user_id, type, channel)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (user_id, type) DO UPDATE SET channel = EXCLUDED.channel;`,
+			preferences.UserID,
+			notificationType,
+			channel,
+		)
+		if err != nil {
+			logger.Error("Failed to save notification preference",
+				zap.String("type", notificationType),
+				zap.Error(err),
+			)
+			return models.Preferences{}, fmt.Errorf("failed to save notification preference: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Error("Failed to commit transaction", zap.Error(err))
+		return models.Preferences{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	logger.Info("Notification preferences updated")
+	return repository.GetPreferences(ctx, preferences.UserID)
+}
+
+func (repository *PreferencePgRepository) GetDelivery(ctx context.Context, userID int64, notificationType string) (models.Delivery, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "get_delivery"),
+		zap.Int64("user_id", userID),
+		zap.String("type", notificationType),
+	)
+
+	var delivery models.Delivery
+	err := repository.db.QueryRow(ctx,
+		`SELECT
+			COALESCE((SELECT channel FROM notification_preferences WHERE user_id = $1 AND type = $2), $3),
+			COALESCE((SELECT email FROM notification_settings WHERE user_id = $1), '');`,
+		userID,
+		notificationType,
+		models.ChannelInApp,
+	).Scan(&delivery.Channel, &delivery.Email)
+	if err != nil {
+		logger.Error("Failed to get delivery preference from database", zap.Error(err))
+		return models.Delivery{}, fmt.Errorf("failed to get delivery preference: %w", err)
+	}
+
+	return delivery, nil
+}
+
+// Every known type is delivered in-app until the user says otherwise
+func defaultPreferences(userID int64) models.Preferences {
+	channels := make(map[string]string, len(models.Types))
+	for _, notificationType := range models.Types {
+		channels[notificationType] = models.ChannelInApp
+	}
+	return models.Preferences{UserID: userID, Channels: channels}
+}
@@ -1,19 +1,49 @@
 package repository
 
 import (
+	"context"
 	"errors"
+	"time"
 
 	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/models"
 )
 
 var (
-	NotificationNotFoundErr = errors.New("notification not found")
+	NotificationNotFoundErr  = errors.New("notification not found")
+	NotificationForbiddenErr = errors.New("notification belongs to another user")
+	WebhookNotFoundErr       = errors.New("webhook not found")
+	WebhookForbiddenErr      = errors.New("webhook belongs to another user")
 )
 
 type NotificationRepository interface {
-	Create(notification models.NotificationRequest) (models.Notification, error)
-	GetByUserID(userID int64) ([]models.Notification, error)
-	MarkAsRead(notificationID int64) error
-	MarkAllAsRead(userID int64) error
-	GetByID(notificationID int64) (models.Notification, error)
+	Create(ctx context.Context, notification models.NotificationRequest) (models.Notification, error)
+	GetByUserID(ctx context.Context, userID int64, filter models.NotificationFilter) ([]models.Notification, error)
+	UnreadCount(ctx context.Context, userID int64) (int64, error)
+	MarkAsRead(ctx context.Context, notificationID int64) error
+	MarkAsReadOwned(ctx context.Context, notificationID int64, userID int64) error
+	MarkAllAsRead(ctx context.Context, userID int64) error
+	ArchiveOwned(ctx context.Context, notificationID int64, userID int64) error
+	ArchiveAll(ctx context.Context, userID int64, readOnly bool) (int64, error)
+	DeleteOwned(ctx context.Context, notificationID int64, userID int64) error
+	DeleteAll(ctx context.Context, userID int64, readOnly bool) (int64, error)
+	GetByID(ctx context.Context, notificationID int64) (models.Notification, error)
+	GetPendingEmails(ctx context.Context, emailStatus string, userLimit int) ([]models.PendingEmail, error)
+	SetEmailStatus(ctx context.Context, notificationIDs []int64, emailStatus string) error
+	RetryEmails(ctx context.Context, notificationIDs []int64, nextAttemptAt time.Time) error
+}
+
+type PreferenceRepository interface {
+	GetPreferences(ctx context.Context, userID int64) (models.Preferences, error)
+	UpdatePreferences(ctx context.Context, preferences models.Preferences) (models.Preferences, error)
+	GetDelivery(ctx context.Context, userID int64, notificationType string) (models.Delivery, error)
+}
+
+type WebhookRepository interface {
+	Create(ctx context.Context, request models.WebhookRequest) (models.Webhook, error)
+	GetByUserID(ctx context.Context, userID int64) ([]models.Webhook, error)
+	DeleteOwned(ctx context.Context, webhookID int64, userID int64) error
+	GetDeliveries(ctx context.Context, webhookID int64, userID int64, limit int) ([]models.WebhookDelivery, error)
+	EnqueueDeliveries(ctx context.Context, notification models.Notification, payload []byte) (int64, error)
+	GetDueDeliveries(ctx context.Context, limit int) ([]models.DueDelivery, error)
+	RecordAttempt(ctx context.Context, attempt models.DeliveryAttempt) error
 }
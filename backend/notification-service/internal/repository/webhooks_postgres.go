@@ -0,0 +1,343 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/pg_util"
+	"go.uber.org/zap"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type WebhookPgRepository struct {
+	db     *pgxpool.Pool
+	logger *logging.Logger
+}
+
+func NewWebhookPgRepository(db *pgxpool.Pool, logger *logging.Logger) WebhookRepository {
+	return &WebhookPgRepository{db: db, logger: logger}
+}
+
+func (repository *WebhookPgRepository) Create(ctx context.Context, request models.WebhookRequest) (models.Webhook, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "create_webhook"),
+		zap.Int64("user_id", request.UserID),
+	)
+
+	logger.Debug("Creating webhook")
+
+	eventTypes := request.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+
+	webhook := models.Webhook{
+		UserID:     request.UserID,
+		URL:        request.URL,
+		Secret:     request.Secret,
+		EventTypes: eventTypes,
+	}
+	err := repository.db.QueryRow(ctx,
+		`INSERT INTO webhooks (user_id, url, secret, event_types)
+		VALUES ($1, $2, $3, $4)
+		RETURNING webhook_id, created_at;`,
+		request.UserID,
+		request.URL,
+		request.Secret,
+		eventTypes,
+	).Scan(&webhook.WebhookID, &webhook.CreatedAt)
+	if err != nil {
+		logger.Error("Failed to create webhook in database", zap.Error(err))
+		return models.Webhook{}, fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	logger.Info("Webhook created", zap.Int64("webhook_id", webhook.WebhookID))
+	return webhook, nil
+}
+
+func (repository *WebhookPgRepository) GetByUserID(ctx context.Context, userID int64) ([]models.Webhook, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "get_webhooks_by_user_id"),
+		zap.Int64("user_id", userID),
+	)
+
+	logger.Debug("Getting webhooks for user")
+
+	rows, err := repository.db.Query(ctx,
+		`SELECT webhook_id, user_id, url, secret, event_types, created_at
+		FROM webhooks
+		WHERE user_id = $1
+		ORDER BY created_at;`,
+		userID,
+	)
+	if err != nil {
+		logger.Error("Failed to get webhooks from database", zap.Error(err))
+		return nil, fmt.Errorf("failed to load webhooks: %w", err)
+	}
+	defer rows.Close()
+
+	var webhooks []models.Webhook
+	for rows.Next() {
+		var w models.Webhook
+		err = rows.Scan(&w.WebhookID, &w.UserID, &w.URL, &w.Secret, &w.EventTypes, &w.CreatedAt)
+		if err != nil {
+			logger.Error("Failed to scan webhook row", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan webhook: %w", err)
+		}
+		webhooks = append(webhooks, w)
+	}
+
+	return webhooks, nil
+}
+
+func (repository *WebhookPgRepository) DeleteOwned(ctx context.Context, webhookID int64, userID int64) error {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "delete_owned_webhook"),
+		zap.Int64("webhook_id", webhookID),
+		zap.Int64("user_id", userID),
+	)
+
+	logger.Debug("Deleting owned webhook")
+
+	if err := repository.checkOwner(ctx, logger, webhookID, userID); err != nil {
+		return err
+	}
+
+	_, err := repository.db.Exec(ctx,
+		`DELETE FROM webhooks WHERE webhook_id = $1 AND user_id = $2;`,
+		webhookID,
+		userID,
+	)
+	if err != nil {
+		logger.Error("Failed to delete webhook from database", zap.Error(err))
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	logger.Info("Webhook deleted")
+	return nil
+}
+
+func (repository *WebhookPgRepository) GetDeliveries(ctx context.Context, webhookID int64, userID int64, limit int) ([]models.WebhookDelivery, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "get_webhook_deliveries"),
+		zap.Int64("webhook_id", webhookID),
+		zap.Int64("user_id", userID),
+	)
+
+	logger.Debug("Getting webhook delivery log")
+
+	if err := repository.checkOwner(ctx, logger, webhookID, userID); err != nil {
+		return nil, err
+	}
+
+	rows, err := repository.db.Query(ctx,
+		`SELECT delivery_id, webhook_id, COALESCE(notification_id, 0), event_type, payload, status,
+			attempts, last_status_code, last_error, next_attempt_at, delivered_at, created_at
+		FROM webhook_deliveries
+		WHERE webhook_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2;`,
+		webhookID,
+		limit,
+	)
+	if err != nil {
+		logger.Error("Failed to get webhook deliveries from database", zap.Error(err))
+		return nil, fmt.Errorf("failed to load webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []models.WebhookDelivery
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			logger.Error("Failed to scan webhook delivery row", zap.Error(err))
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	return deliveries, nil
+}
+
+func (repository *WebhookPgRepository) EnqueueDeliveries(ctx context.Context, notification models.Notification, payload []byte) (int64, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "enqueue_webhook_deliveries"),
+		zap.Int64("notification_id", notification.NotificationID),
+		zap.Int64("user_id", notification.UserID),
+		zap.String("type", notification.Type),
+	)
+
+	tag, err := repository.db.Exec(ctx,
+		`INSERT INTO webhook_deliveries (webhook_id, notification_id, event_type, payload)
+		SELECT webhook_id, $1, $3, $4
+		FROM webhooks
+		WHERE user_id = $2 AND (cardinality(event_types) = 0 OR $3 = ANY(event_types));`,
+		notification.NotificationID,
+		notification.UserID,
+		notification.Type,
+		payload,
+	)
+	if err != nil {
+		logger.Error("Failed to enqueue webhook deliveries", zap.Error(err))
+		return 0, fmt.Errorf("failed to enqueue webhook deliveries: %w", err)
+	}
+
+	if tag.RowsAffected() > 0 {
+		logger.Debug("Webhook deliveries enqueued", zap.Int64("count", tag.RowsAffected()))
+	}
+	return tag.RowsAffected(), nil
+}
+
+func (repository *WebhookPgRepository) GetDueDeliveries(ctx context.Context, limit int) ([]models.DueDelivery, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "get_due_webhook_deliveries"),
+		zap.Int("limit", limit),
+	)
+
+	rows, err := repository.db.Query(ctx,
+		`SELECT d.delivery_id, d.webhook_id, COALESCE(d.notification_id, 0), d.event_type, d.payload, d.status,
+			d.attempts, d.last_status_code, d.last_error, d.next_attempt_at, d.delivered_at, d.created_at,
+			w.url, w.secret
+		FROM webhook_deliveries d
+		JOIN webhooks w ON w.webhook_id = d.webhook_id
+		WHERE d.status = 'pending' AND d.next_attempt_at <= CURRENT_TIMESTAMP
+		ORDER BY d.next_attempt_at
+		LIMIT $1;`,
+		limit,
+	)
+	if err != nil {
+		logger.Error("Failed to get due webhook deliveries from database", zap.Error(err))
+		return nil, fmt.Errorf("failed to load due webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var due []models.DueDelivery
+	for rows.Next() {
+		var d models.DueDelivery
+		err = rows.Scan(
+			&d.Delivery.DeliveryID,
+			&d.Delivery.WebhookID,
+			&d.Delivery.NotificationID,
+			&d.Delivery.EventType,
+			&d.Delivery.Payload,
+			&d.Delivery.Status,
+			&d.Delivery.Attempts,
+			&d.Delivery.LastStatusCode,
+			&d.Delivery.LastError,
+			&d.Delivery.NextAttemptAt,
+			&d.Delivery.DeliveredAt,
+			&d.Delivery.CreatedAt,
+			&d.URL,
+			&d.Secret,
+		)
+		if err != nil {
+			logger.Error("Failed to scan due webhook delivery row", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		due = append(due, d)
+	}
+
+	return due, nil
+}
+
+func (repository *WebhookPgRepository) RecordAttempt(ctx context.Context, attempt models.DeliveryAttempt) error {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "record_webhook_attempt"),
+		zap.Int64("delivery_id", attempt.DeliveryID),
+		zap.String("status", attempt.Status),
+	)
+
+	_, err := repository.db.Exec(ctx,
+		`UPDATE webhook_deliveries
+		SET attempts = attempts + 1,
+			status = $2,
+			last_status_code = $3,
+			last_error = $4,
+			next_attempt_at = $5,
+			delivered_at = CASE WHEN $2 = 'success' THEN CURRENT_TIMESTAMP ELSE delivered_at END
+		WHERE delivery_id = $1;`,
+		attempt.DeliveryID,
+		attempt.Status,
+		attempt.StatusCode,
+		attempt.Error,
+		attempt.NextAttemptAt,
+	)
+	if err != nil {
+		logger.Error("Failed to record webhook attempt", zap.Error(err))
+		return fmt.Errorf("failed to record webhook attempt: %w", err)
+	}
+
+	return nil
+}
+
+func (repository *WebhookPgRepository) checkOwner(ctx context.Context, logger *zap.Logger, webhookID int64, userID int64) error {
+	var ownerID int64
+	err := repository.db.QueryRow(ctx,
+		`SELECT user_id FROM webhooks WHERE webhook_id = $1;`,
+		webhookID,
+	).Scan(&ownerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Warn("Webhook not found")
+			return WebhookNotFoundErr
+		}
+		logger.Error("Failed to get webhook owner from database", zap.Error(err))
+		return fmt.Errorf("failed to get webhook: %w", err)
+	}
+
+	if ownerID != userID {
+		logger.Warn("Attempt to access another user's webhook",
+			zap.Int64("owner_id", ownerID))
+		return WebhookForbiddenErr
+	}
+
+	return nil
+}
+
+func scanDelivery(rows pgx.Rows) (models.WebhookDelivery, error) {
+	var d models.WebhookDelivery
+	err := rows.Scan(
+		&d.DeliveryID,
+		&d.WebhookID,
+		&d.NotificationID,
+		&d.EventType,
+		&d.Payload,
+		&d.Status,
+		&d.Attempts,
+		&d.LastStatusCode,
+		&d.LastError,
+		&d.NextAttemptAt,
+		&d.DeliveredAt,
+		&d.CreatedAt,
+	)
+	if err != nil {
+		return models.WebhookDelivery{}, fmt.Errorf("failed to scan webhook delivery: %w", err)
+	}
+	return d, nil
+}
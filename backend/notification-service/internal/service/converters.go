@@ -1,8 +1,16 @@
 package service
 
 import (
-	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/models"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
 
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/templates"
 	pb "github.com/IAGrig/vt-csa-essays/backend/proto/notification"
 )
 
@@ -15,8 +23,126 @@ func toProtoNotificationResponse(notification models.Notification) *pb.Notificat
 	return &pb.NotificationResponse{
 		NotificationId: notification.NotificationID,
 		UserId:         notification.UserID,
-		Content:        notification.Content,
+		Content:        templates.Content(notification),
 		IsRead:         notification.IsRead,
+		IsArchived:     notification.ArchivedAt != nil,
 		CreatedAt:      createdAt,
+		Type:           notification.Type,
+		Actor:          notification.Actor,
+		Target: &pb.NotificationTarget{
+			EssayId:  notification.Payload.EssayID,
+			ReviewId: notification.Payload.ReviewID,
+		},
+		Link: templates.Link(notification),
+	}
+}
+
+func toProtoPreferencesResponse(preferences models.Preferences) *pb.PreferencesResponse {
+	response := &pb.PreferencesResponse{
+		UserId: preferences.UserID,
+		Email:  preferences.Email,
+	}
+
+	types := make([]string, 0, len(preferences.Channels))
+	for notificationType := range preferences.Channels {
+		types = append(types, notificationType)
+	}
+	sort.Strings(types)
+
+	for _, notificationType := range types {
+		response.Preferences = append(response.Preferences, &pb.Preference{
+			Type:    notificationType,
+			Channel: preferences.Channels[notificationType],
+		})
+	}
+	return response
+}
+
+func fromProtoUpdatePreferencesRequest(in *pb.UpdatePreferencesRequest) (models.Preferences, error) {
+	if in.UserId <= 0 {
+		return models.Preferences{}, errors.New("user_id is required")
+	}
+
+	email := strings.TrimSpace(in.Email)
+	if email != "" {
+		address, err := mail.ParseAddress(email)
+		if err != nil || address.Address != email {
+			return models.Preferences{}, fmt.Errorf("invalid email address %q", in.Email)
+		}
+	}
+
+	channels := make(map[string]string, len(in.Preferences))
+	for _, preference := range in.Preferences {
+		if !slices.Contains(models.Types, preference.Type) {
+			return models.Preferences{}, fmt.Errorf("unknown notification type %q", preference.Type)
+		}
+		if !slices.Contains(models.Channels, preference.Channel) {
+			return models.Preferences{}, fmt.Errorf("unknown channel %q", preference.Channel)
+		}
+		channels[preference.Type] = preference.Channel
+	}
+
+	return models.Preferences{UserID: in.UserId, Email: email, Channels: channels}, nil
+}
+
+func toProtoWebhookResponse(w models.Webhook, withSecret bool) *pb.WebhookResponse {
+	response := &pb.WebhookResponse{
+		WebhookId:  w.WebhookID,
+		UserId:     w.UserID,
+		Url:        w.URL,
+		EventTypes: w.EventTypes,
+		CreatedAt:  w.CreatedAt.Unix(),
+	}
+	if withSecret {
+		response.Secret = w.Secret
+	}
+	return response
+}
+
+func toProtoWebhookDeliveryResponse(d models.WebhookDelivery) *pb.WebhookDeliveryResponse {
+	var deliveredAt int64
+	if d.DeliveredAt != nil {
+		deliveredAt = d.DeliveredAt.Unix()
+	}
+
+	return &pb.WebhookDeliveryResponse{
+		DeliveryId:     d.DeliveryID,
+		WebhookId:      d.WebhookID,
+		NotificationId: d.NotificationID,
+		EventType:      d.EventType,
+		Status:         d.Status,
+		Attempts:       int32(d.Attempts),
+		LastStatusCode: int32(d.LastStatusCode),
+		LastError:      d.LastError,
+		NextAttemptAt:  d.NextAttemptAt.Unix(),
+		DeliveredAt:    deliveredAt,
+		CreatedAt:      d.CreatedAt.Unix(),
 	}
 }
+
+func fromProtoCreateWebhookRequest(in *pb.CreateWebhookRequest) (models.WebhookRequest, error) {
+	if in.UserId <= 0 {
+		return models.WebhookRequest{}, errors.New("user_id is required")
+	}
+
+	target, err := url.Parse(strings.TrimSpace(in.Url))
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return models.WebhookRequest{}, fmt.Errorf("invalid webhook url %q", in.Url)
+	}
+
+	eventTypes := []string{}
+	for _, eventType := range in.EventTypes {
+		if !slices.Contains(models.Types, eventType) {
+			return models.WebhookRequest{}, fmt.Errorf("unknown event type %q", eventType)
+		}
+		if !slices.Contains(eventTypes, eventType) {
+			eventTypes = append(eventTypes, eventType)
+		}
+	}
+
+	return models.WebhookRequest{
+		UserID:     in.UserId,
+		URL:        target.String(),
+		EventTypes: eventTypes,
+	}, nil
+}
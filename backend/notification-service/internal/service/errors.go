@@ -0,0 +1,19 @@
+package service
+
+import (
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/repository"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/grpcerr"
+	"google.golang.org/grpc/codes"
+)
+
+// Domain errors and the statuses clients see them as
+var ErrorMappings = grpcerr.Mappings{
+	{Err: repository.NotificationNotFoundErr, Code: codes.NotFound, Reason: "NOTIFICATION_NOT_FOUND"},
+	{Err: repository.NotificationForbiddenErr, Code: codes.PermissionDenied, Reason: "NOTIFICATION_FORBIDDEN"},
+	{Err: repository.WebhookNotFoundErr, Code: codes.NotFound, Reason: "WEBHOOK_NOT_FOUND"},
+	{Err: repository.WebhookForbiddenErr, Code: codes.PermissionDenied, Reason: "WEBHOOK_FORBIDDEN"},
+}
+
+func statusError(err error) error {
+	return ErrorMappings.Status(err)
+}
@@ -2,37 +2,55 @@ package service
 
 import (
 	"context"
+	"net"
 
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/models"
 	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/repository"
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/webhook"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/IAGrig/vt-csa-essays/backend/proto/notification"
 )
 
+const maxDeliveriesLimit = 100
+
 type notificationService struct {
 	pb.UnimplementedNotificationServiceServer
-	repository repository.NotificationRepository
-	logger     *logging.Logger
+	repository  repository.NotificationRepository
+	preferences repository.PreferenceRepository
+	webhooks    repository.WebhookRepository
+	resolver    webhook.Resolver
+	logger      *logging.Logger
 }
 
-func New(repository repository.NotificationRepository, logger *logging.Logger) pb.NotificationServiceServer {
+func New(repository repository.NotificationRepository, preferences repository.PreferenceRepository, webhooks repository.WebhookRepository, logger *logging.Logger) pb.NotificationServiceServer {
 	return &notificationService{
-		repository: repository,
-		logger:     logger,
+		repository:  repository,
+		preferences: preferences,
+		webhooks:    webhooks,
+		resolver:    net.DefaultResolver,
+		logger:      logger,
 	}
 }
 
 func (s *notificationService) GetByUserID(in *pb.GetByUserIDRequest, stream grpc.ServerStreamingServer[pb.NotificationResponse]) error {
-	logger := s.logger.With(
+	logger := logging.FromContext(stream.Context(), s.logger).With(
 		zap.String("operation", "get_notifications_by_user_id"),
 		zap.Int64("user_id", in.UserId),
 	)
 
 	logger.Debug("Getting notifications for user")
 
-	notifications, err := s.repository.GetByUserID(in.UserId)
+	filter := models.NotificationFilter{
+		UnreadOnly: in.UnreadOnly,
+		Type:       in.Type,
+		Archived:   in.Archived,
+	}
+	notifications, err := s.repository.GetByUserID(stream.Context(), in.UserId, filter)
 	if err != nil {
 		logger.Error("Failed to get notifications from repository", zap.Error(err))
 		return err
@@ -51,18 +69,41 @@ func (s *notificationService) GetByUserID(in *pb.GetByUserIDRequest, stream grpc
 	return nil
 }
 
+func (s *notificationService) UnreadCount(ctx context.Context, in *pb.UnreadCountRequest) (*pb.UnreadCountResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "count_unread_notifications"),
+		zap.Int64("user_id", in.UserId),
+	)
+
+	logger.Debug("Counting unread notifications")
+
+	count, err := s.repository.UnreadCount(ctx, in.UserId)
+	if err != nil {
+		logger.Error("Failed to count unread notifications", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.UnreadCountResponse{Count: count}, nil
+}
+
 func (s *notificationService) MarkAsRead(ctx context.Context, in *pb.MarkAsReadRequest) (*pb.MarkAsReadResponse, error) {
-	logger := s.logger.With(
+	logger := logging.FromContext(ctx, s.logger).With(
 		zap.String("operation", "mark_notification_as_read"),
 		zap.Int64("notification_id", in.NotificationId),
+		zap.Int64("user_id", in.UserId),
 	)
 
 	logger.Debug("Marking notification as read")
 
-	err := s.repository.MarkAsRead(in.NotificationId)
+	if in.UserId <= 0 {
+		logger.Warn("Mark as read request without caller user ID")
+		return &pb.MarkAsReadResponse{Success: false}, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	err := s.repository.MarkAsReadOwned(ctx, in.NotificationId, in.UserId)
 	if err != nil {
 		logger.Warn("Failed to mark notification as read", zap.Error(err))
-		return &pb.MarkAsReadResponse{Success: false}, err
+		return &pb.MarkAsReadResponse{Success: false}, statusError(err)
 	}
 
 	logger.Debug("Notification marked as read successfully")
@@ -70,14 +111,14 @@ func (s *notificationService) MarkAsRead(ctx context.Context, in *pb.MarkAsReadR
 }
 
 func (s *notificationService) MarkAllAsRead(ctx context.Context, in *pb.MarkAllAsReadRequest) (*pb.MarkAllAsReadResponse, error) {
-	logger := s.logger.With(
+	logger := logging.FromContext(ctx, s.logger).With(
 		zap.String("operation", "mark_all_notifications_as_read"),
 		zap.Int64("user_id", in.UserId),
 	)
 
 	logger.Debug("Marking all notifications as read for user")
 
-	err := s.repository.MarkAllAsRead(in.UserId)
+	err := s.repository.MarkAllAsRead(ctx, in.UserId)
 	if err != nil {
 		logger.Error("Failed to mark all notifications as read", zap.Error(err))
 		return &pb.MarkAllAsReadResponse{Success: false}, err
@@ -86,3 +127,252 @@ func (s *notificationService) MarkAllAsRead(ctx context.Context, in *pb.MarkAllA
 	logger.Debug("All notifications marked as read successfully")
 	return &pb.MarkAllAsReadResponse{Success: true}, nil
 }
+
+func (s *notificationService) Archive(ctx context.Context, in *pb.ArchiveRequest) (*pb.ArchiveResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "archive_notification"),
+		zap.Int64("notification_id", in.NotificationId),
+		zap.Int64("user_id", in.UserId),
+	)
+
+	logger.Debug("Archiving notification")
+
+	if in.UserId <= 0 {
+		logger.Warn("Archive request without caller user ID")
+		return &pb.ArchiveResponse{Success: false}, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if err := s.repository.ArchiveOwned(ctx, in.NotificationId, in.UserId); err != nil {
+		logger.Warn("Failed to archive notification", zap.Error(err))
+		return &pb.ArchiveResponse{Success: false}, statusError(err)
+	}
+
+	logger.Debug("Notification archived successfully")
+	return &pb.ArchiveResponse{Success: true}, nil
+}
+
+func (s *notificationService) ArchiveAll(ctx context.Context, in *pb.ArchiveAllRequest) (*pb.ArchiveAllResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "archive_all_notifications"),
+		zap.Int64("user_id", in.UserId),
+		zap.Bool("read_only", in.ReadOnly),
+	)
+
+	logger.Debug("Archiving all notifications for user")
+
+	affected, err := s.repository.ArchiveAll(ctx, in.UserId, in.ReadOnly)
+	if err != nil {
+		logger.Error("Failed to archive all notifications", zap.Error(err))
+		return &pb.ArchiveAllResponse{Success: false}, err
+	}
+
+	logger.Debug("Notifications archived successfully", zap.Int64("affected", affected))
+	return &pb.ArchiveAllResponse{Success: true, Affected: affected}, nil
+}
+
+func (s *notificationService) Delete(ctx context.Context, in *pb.DeleteRequest) (*pb.DeleteResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "delete_notification"),
+		zap.Int64("notification_id", in.NotificationId),
+		zap.Int64("user_id", in.UserId),
+	)
+
+	logger.Debug("Deleting notification")
+
+	if in.UserId <= 0 {
+		logger.Warn("Delete request without caller user ID")
+		return &pb.DeleteResponse{Success: false}, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if err := s.repository.DeleteOwned(ctx, in.NotificationId, in.UserId); err != nil {
+		logger.Warn("Failed to delete notification", zap.Error(err))
+		return &pb.DeleteResponse{Success: false}, statusError(err)
+	}
+
+	logger.Debug("Notification deleted successfully")
+	return &pb.DeleteResponse{Success: true}, nil
+}
+
+func (s *notificationService) DeleteAll(ctx context.Context, in *pb.DeleteAllRequest) (*pb.DeleteAllResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "delete_all_notifications"),
+		zap.Int64("user_id", in.UserId),
+		zap.Bool("read_only", in.ReadOnly),
+	)
+
+	logger.Debug("Deleting all notifications for user")
+
+	affected, err := s.repository.DeleteAll(ctx, in.UserId, in.ReadOnly)
+	if err != nil {
+		logger.Error("Failed to delete all notifications", zap.Error(err))
+		return &pb.DeleteAllResponse{Success: false}, err
+	}
+
+	logger.Debug("Notifications deleted successfully", zap.Int64("affected", affected))
+	return &pb.DeleteAllResponse{Success: true, Affected: affected}, nil
+}
+
+func (s *notificationService) GetPreferences(ctx context.Context, in *pb.GetPreferencesRequest) (*pb.PreferencesResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "get_notification_preferences"),
+		zap.Int64("user_id", in.UserId),
+	)
+
+	logger.Debug("Getting notification preferences")
+
+	if in.UserId <= 0 {
+		logger.Warn("Get preferences request without user ID")
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	preferences, err := s.preferences.GetPreferences(ctx, in.UserId)
+	if err != nil {
+		logger.Error("Failed to get notification preferences", zap.Error(err))
+		return nil, err
+	}
+
+	return toProtoPreferencesResponse(preferences), nil
+}
+
+func (s *notificationService) UpdatePreferences(ctx context.Context, in *pb.UpdatePreferencesRequest) (*pb.PreferencesResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "update_notification_preferences"),
+		zap.Int64("user_id", in.UserId),
+	)
+
+	logger.Debug("Updating notification preferences")
+
+	preferences, err := fromProtoUpdatePreferencesRequest(in)
+	if err != nil {
+		logger.Warn("Invalid preferences update", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	updated, err := s.preferences.UpdatePreferences(ctx, preferences)
+	if err != nil {
+		logger.Error("Failed to update notification preferences", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info("Notification preferences updated")
+	return toProtoPreferencesResponse(updated), nil
+}
+
+func (s *notificationService) CreateWebhook(ctx context.Context, in *pb.CreateWebhookRequest) (*pb.WebhookResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "create_webhook"),
+		zap.Int64("user_id", in.UserId),
+	)
+
+	logger.Debug("Creating webhook")
+
+	request, err := fromProtoCreateWebhookRequest(in)
+	if err != nil {
+		logger.Warn("Invalid webhook", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := webhook.CheckURL(ctx, s.resolver, request.URL); err != nil {
+		logger.Warn("Rejected webhook url", zap.String("url", request.URL), zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	request.Secret, err = webhook.GenerateSecret()
+	if err != nil {
+		logger.Error("Failed to generate webhook secret", zap.Error(err))
+		return nil, err
+	}
+
+	created, err := s.webhooks.Create(ctx, request)
+	if err != nil {
+		logger.Error("Failed to create webhook", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info("Webhook created", zap.Int64("webhook_id", created.WebhookID))
+	return toProtoWebhookResponse(created, true), nil
+}
+
+func (s *notificationService) ListWebhooks(in *pb.ListWebhooksRequest, stream grpc.ServerStreamingServer[pb.WebhookResponse]) error {
+	logger := logging.FromContext(stream.Context(), s.logger).With(
+		zap.String("operation", "list_webhooks"),
+		zap.Int64("user_id", in.UserId),
+	)
+
+	logger.Debug("Listing webhooks for user")
+
+	webhooks, err := s.webhooks.GetByUserID(stream.Context(), in.UserId)
+	if err != nil {
+		logger.Error("Failed to get webhooks from repository", zap.Error(err))
+		return err
+	}
+
+	for _, w := range webhooks {
+		if err := stream.Send(toProtoWebhookResponse(w, false)); err != nil {
+			logger.Error("Failed to send webhook in stream",
+				zap.Int64("webhook_id", w.WebhookID),
+				zap.Error(err))
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *notificationService) DeleteWebhook(ctx context.Context, in *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "delete_webhook"),
+		zap.Int64("webhook_id", in.WebhookId),
+		zap.Int64("user_id", in.UserId),
+	)
+
+	logger.Debug("Deleting webhook")
+
+	if in.UserId <= 0 {
+		logger.Warn("Delete webhook request without caller user ID")
+		return &pb.DeleteWebhookResponse{Success: false}, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if err := s.webhooks.DeleteOwned(ctx, in.WebhookId, in.UserId); err != nil {
+		logger.Warn("Failed to delete webhook", zap.Error(err))
+		return &pb.DeleteWebhookResponse{Success: false}, statusError(err)
+	}
+
+	return &pb.DeleteWebhookResponse{Success: true}, nil
+}
+
+func (s *notificationService) ListWebhookDeliveries(in *pb.ListWebhookDeliveriesRequest, stream grpc.ServerStreamingServer[pb.WebhookDeliveryResponse]) error {
+	logger := logging.FromContext(stream.Context(), s.logger).With(
+		zap.String("operation", "list_webhook_deliveries"),
+		zap.Int64("webhook_id", in.WebhookId),
+		zap.Int64("user_id", in.UserId),
+	)
+
+	logger.Debug("Listing webhook deliveries")
+
+	if in.UserId <= 0 {
+		logger.Warn("List webhook deliveries request without caller user ID")
+		return status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	limit := int(in.Limit)
+	if limit <= 0 || limit > maxDeliveriesLimit {
+		limit = maxDeliveriesLimit
+	}
+
+	deliveries, err := s.webhooks.GetDeliveries(stream.Context(), in.WebhookId, in.UserId, limit)
+	if err != nil {
+		logger.Warn("Failed to get webhook deliveries", zap.Error(err))
+		return statusError(err)
+	}
+
+	for _, delivery := range deliveries {
+		if err := stream.Send(toProtoWebhookDeliveryResponse(delivery)); err != nil {
+			logger.Error("Failed to send webhook delivery in stream",
+				zap.Int64("delivery_id", delivery.DeliveryID),
+				zap.Error(err))
+			return err
+		}
+	}
+
+	return nil
+}
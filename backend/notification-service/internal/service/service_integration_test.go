@@ -12,13 +12,16 @@ import (
 	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/repository"
 	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/service"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	pgutil "github.com/IAGrig/vt-csa-essays/backend/shared/pg_util"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
 	"github.com/testcontainers/testcontainers-go/wait"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/pressly/goose/v3"
@@ -71,14 +74,16 @@ func TestMain(m *testing.M) {
 	}()
 
 	logger := logging.NewEmptyLogger()
-	var repoErr error
-	testRepo, repoErr = repository.NewNotificationPgRepository(logger)
-	if repoErr != nil {
-		fmt.Printf("Failed to create repository: %v\n", repoErr)
+	pool, err := pgutil.GetPgxPool()
+	if err != nil {
+		fmt.Printf("Failed to connect to database: %v\n", err)
 		os.Exit(1)
 	}
+	testRepo = repository.NewNotificationPgRepository(pool, logger)
+	preferenceRepo := repository.NewPreferencePgRepository(pool, logger)
+	webhookRepo := repository.NewWebhookPgRepository(pool, logger)
 
-	testService = service.New(testRepo, logger)
+	testService = service.New(testRepo, preferenceRepo, webhookRepo, logger)
 
 	code := m.Run()
 	os.Exit(code)
@@ -188,19 +193,19 @@ func TestIntegrationNotificationService_GetByUserID(t *testing.T) {
 	user1ID := insertTestUser(t, "user1")
 	user2ID := insertTestUser(t, "user2")
 
-	_, err := testRepo.Create(models.NotificationRequest{
+	_, err := testRepo.Create(context.Background(), models.NotificationRequest{
 		UserID:  user1ID,
 		Content: "Test notification 1",
 	})
 	require.NoError(t, err)
 
-	_, err = testRepo.Create(models.NotificationRequest{
+	_, err = testRepo.Create(context.Background(), models.NotificationRequest{
 		UserID:  user1ID,
 		Content: "Test notification 2",
 	})
 	require.NoError(t, err)
 
-	_, err = testRepo.Create(models.NotificationRequest{
+	_, err = testRepo.Create(context.Background(), models.NotificationRequest{
 		UserID:  user2ID,
 		Content: "Other user notification",
 	})
@@ -218,6 +223,41 @@ func TestIntegrationNotificationService_GetByUserID(t *testing.T) {
 	}
 }
 
+func TestIntegrationNotificationService_GetByUserID_RendersPayload(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	cleanupTables(t)
+	userID := insertTestUser(t, "user1")
+
+	created, err := testRepo.Create(context.Background(), models.NotificationRequest{
+		UserID:  userID,
+		Type:    models.TypeNewReview,
+		Actor:   "reviewer1",
+		Payload: models.Payload{EssayID: 11, ReviewID: 42},
+	})
+	require.NoError(t, err)
+
+	stored, err := testRepo.GetByID(context.Background(), created.NotificationID)
+	require.NoError(t, err)
+	assert.Equal(t, models.Payload{EssayID: 11, ReviewID: 42}, stored.Payload)
+	assert.Empty(t, stored.Content)
+
+	stream := &mockStream{}
+	err = testService.GetByUserID(&pb.GetByUserIDRequest{UserId: userID}, stream)
+	require.NoError(t, err)
+	require.Len(t, stream.notifications, 1)
+
+	notification := stream.notifications[0]
+	assert.Equal(t, models.TypeNewReview, notification.Type)
+	assert.Equal(t, "reviewer1", notification.Actor)
+	assert.Equal(t, "Your essay has been reviewed by reviewer1", notification.Content)
+	assert.Equal(t, int64(11), notification.Target.EssayId)
+	assert.Equal(t, int64(42), notification.Target.ReviewId)
+	assert.Equal(t, "/my-essay#review-42", notification.Link)
+}
+
 func TestIntegrationNotificationService_MarkAsRead(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration test in short mode")
@@ -226,27 +266,59 @@ func TestIntegrationNotificationService_MarkAsRead(t *testing.T) {
 	cleanupTables(t)
 	userID := insertTestUser(t, "user1")
 
-	notification, err := testRepo.Create(models.NotificationRequest{
+	notification, err := testRepo.Create(context.Background(), models.NotificationRequest{
 		UserID:  userID,
 		Content: "Test notification",
 	})
 	require.NoError(t, err)
 
-	initialNotification, err := testRepo.GetByID(notification.NotificationID)
+	initialNotification, err := testRepo.GetByID(context.Background(), notification.NotificationID)
 	require.NoError(t, err)
 	assert.False(t, initialNotification.IsRead)
 
-	req := &pb.MarkAsReadRequest{NotificationId: notification.NotificationID}
+	req := &pb.MarkAsReadRequest{NotificationId: notification.NotificationID, UserId: userID}
 	resp, err := testService.MarkAsRead(context.Background(), req)
 
 	require.NoError(t, err)
 	assert.True(t, resp.Success)
 
-	updatedNotification, err := testRepo.GetByID(notification.NotificationID)
+	updatedNotification, err := testRepo.GetByID(context.Background(), notification.NotificationID)
 	require.NoError(t, err)
 	assert.True(t, updatedNotification.IsRead)
 }
 
+func TestIntegrationNotificationService_MarkAsRead_Ownership(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	cleanupTables(t)
+	ownerID := insertTestUser(t, "owner")
+	otherID := insertTestUser(t, "other")
+
+	notification, err := testRepo.Create(context.Background(), models.NotificationRequest{
+		UserID:  ownerID,
+		Content: "Owner's notification",
+	})
+	require.NoError(t, err)
+
+	_, err = testService.MarkAsRead(context.Background(), &pb.MarkAsReadRequest{
+		NotificationId: notification.NotificationID,
+		UserId:         otherID,
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	unchanged, err := testRepo.GetByID(context.Background(), notification.NotificationID)
+	require.NoError(t, err)
+	assert.False(t, unchanged.IsRead)
+
+	_, err = testService.MarkAsRead(context.Background(), &pb.MarkAsReadRequest{
+		NotificationId: notification.NotificationID + 1000,
+		UserId:         ownerID,
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
 func TestIntegrationNotificationService_MarkAllAsRead(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration test in short mode")
@@ -256,19 +328,19 @@ func TestIntegrationNotificationService_MarkAllAsRead(t *testing.T) {
 	user1ID := insertTestUser(t, "user1")
 	user2ID := insertTestUser(t, "user2")
 
-	_, err := testRepo.Create(models.NotificationRequest{
+	_, err := testRepo.Create(context.Background(), models.NotificationRequest{
 		UserID:  user1ID,
 		Content: "Test notification 1",
 	})
 	require.NoError(t, err)
 
-	_, err = testRepo.Create(models.NotificationRequest{
+	_, err = testRepo.Create(context.Background(), models.NotificationRequest{
 		UserID:  user1ID,
 		Content: "Test notification 2",
 	})
 	require.NoError(t, err)
 
-	_, err = testRepo.Create(models.NotificationRequest{
+	_, err = testRepo.Create(context.Background(), models.NotificationRequest{
 		UserID:  user2ID,
 		Content: "Other user notification",
 	})
@@ -280,14 +352,14 @@ func TestIntegrationNotificationService_MarkAllAsRead(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, resp.Success)
 
-	notifications, err := testRepo.GetByUserID(user1ID)
+	notifications, err := testRepo.GetByUserID(context.Background(), user1ID, models.NotificationFilter{})
 	require.NoError(t, err)
 
 	for _, notification := range notifications {
 		assert.True(t, notification.IsRead)
 	}
 
-	user2Notifications, err := testRepo.GetByUserID(user2ID)
+	user2Notifications, err := testRepo.GetByUserID(context.Background(), user2ID, models.NotificationFilter{})
 	require.NoError(t, err)
 	assert.False(t, user2Notifications[0].IsRead)
 }
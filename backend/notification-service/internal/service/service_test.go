@@ -2,14 +2,20 @@ package service
 
 import (
 	"context"
+	"net"
+	"net/netip"
 	"testing"
 
 	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/repository"
 	repoMocks "github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/repository/mocks"
 	pb "github.com/IAGrig/vt-csa-essays/backend/proto/notification"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 type MinimalServerStream struct {
@@ -63,7 +69,7 @@ func TestNotificationService_GetByUserID(t *testing.T) {
 						IsRead:         true,
 					},
 				}
-				mockRepo.On("GetByUserID", int64(123)).Return(notifications, nil)
+				mockRepo.On("GetByUserID", mock.Anything, int64(123), models.NotificationFilter{}).Return(notifications, nil)
 			},
 			expectedCount: 2,
 			expectedError: false,
@@ -72,7 +78,21 @@ func TestNotificationService_GetByUserID(t *testing.T) {
 			name:  "success - streams empty list when no notifications",
 			input: &pb.GetByUserIDRequest{UserId: 456},
 			setupMock: func(mockRepo *repoMocks.MockNotificationRepository) {
-				mockRepo.On("GetByUserID", int64(456)).Return([]models.Notification{}, nil)
+				mockRepo.On("GetByUserID", mock.Anything, int64(456), models.NotificationFilter{}).Return([]models.Notification{}, nil)
+			},
+			expectedCount: 0,
+			expectedError: false,
+		},
+		{
+			name: "success - passes inbox filters to repository",
+			input: &pb.GetByUserIDRequest{
+				UserId:     123,
+				UnreadOnly: true,
+				Type:       models.TypeNewReview,
+			},
+			setupMock: func(mockRepo *repoMocks.MockNotificationRepository) {
+				filter := models.NotificationFilter{UnreadOnly: true, Type: models.TypeNewReview}
+				mockRepo.On("GetByUserID", mock.Anything, int64(123), filter).Return([]models.Notification{}, nil)
 			},
 			expectedCount: 0,
 			expectedError: false,
@@ -81,7 +101,7 @@ func TestNotificationService_GetByUserID(t *testing.T) {
 			name:  "error - repository returns error",
 			input: &pb.GetByUserIDRequest{UserId: 123},
 			setupMock: func(mockRepo *repoMocks.MockNotificationRepository) {
-				mockRepo.On("GetByUserID", int64(123)).Return([]models.Notification{}, assert.AnError)
+				mockRepo.On("GetByUserID", mock.Anything, int64(123), models.NotificationFilter{}).Return([]models.Notification{}, assert.AnError)
 			},
 			expectedCount: 0,
 			expectedError: true,
@@ -98,7 +118,7 @@ func TestNotificationService_GetByUserID(t *testing.T) {
 						IsRead:         false,
 					},
 				}
-				mockRepo.On("GetByUserID", int64(123)).Return(notifications, nil)
+				mockRepo.On("GetByUserID", mock.Anything, int64(123), models.NotificationFilter{}).Return(notifications, nil)
 			},
 			sendError:     assert.AnError,
 			expectedCount: 0,
@@ -117,7 +137,7 @@ func TestNotificationService_GetByUserID(t *testing.T) {
 			}
 
 			logger := logging.NewEmptyLogger()
-			service := New(mockRepo, logger)
+			service := New(mockRepo, new(repoMocks.MockPreferenceRepository), new(repoMocks.MockWebhookRepository), logger)
 			err := service.GetByUserID(tt.input, stream)
 
 			if tt.expectedError {
@@ -149,24 +169,54 @@ func TestNotificationService_MarkAsRead(t *testing.T) {
 		setupMock      func(*repoMocks.MockNotificationRepository)
 		expectedResult *pb.MarkAsReadResponse
 		expectedError  bool
+		expectedCode   codes.Code
 	}{
 		{
 			name:  "success - marks notification as read",
-			input: &pb.MarkAsReadRequest{NotificationId: 1},
+			input: &pb.MarkAsReadRequest{NotificationId: 1, UserId: 123},
 			setupMock: func(mockRepo *repoMocks.MockNotificationRepository) {
-				mockRepo.On("MarkAsRead", int64(1)).Return(nil)
+				mockRepo.On("MarkAsReadOwned", mock.Anything, int64(1), int64(123)).Return(nil)
 			},
 			expectedResult: &pb.MarkAsReadResponse{Success: true},
 			expectedError:  false,
 		},
+		{
+			name:           "error - missing caller user ID",
+			input:          &pb.MarkAsReadRequest{NotificationId: 1},
+			setupMock:      func(mockRepo *repoMocks.MockNotificationRepository) {},
+			expectedResult: &pb.MarkAsReadResponse{Success: false},
+			expectedError:  true,
+			expectedCode:   codes.InvalidArgument,
+		},
+		{
+			name:  "error - notification not found",
+			input: &pb.MarkAsReadRequest{NotificationId: 999, UserId: 123},
+			setupMock: func(mockRepo *repoMocks.MockNotificationRepository) {
+				mockRepo.On("MarkAsReadOwned", mock.Anything, int64(999), int64(123)).Return(repository.NotificationNotFoundErr)
+			},
+			expectedResult: &pb.MarkAsReadResponse{Success: false},
+			expectedError:  true,
+			expectedCode:   codes.NotFound,
+		},
+		{
+			name:  "error - notification belongs to another user",
+			input: &pb.MarkAsReadRequest{NotificationId: 1, UserId: 456},
+			setupMock: func(mockRepo *repoMocks.MockNotificationRepository) {
+				mockRepo.On("MarkAsReadOwned", mock.Anything, int64(1), int64(456)).Return(repository.NotificationForbiddenErr)
+			},
+			expectedResult: &pb.MarkAsReadResponse{Success: false},
+			expectedError:  true,
+			expectedCode:   codes.PermissionDenied,
+		},
 		{
 			name:  "error - repository returns error",
-			input: &pb.MarkAsReadRequest{NotificationId: 1},
+			input: &pb.MarkAsReadRequest{NotificationId: 1, UserId: 123},
 			setupMock: func(mockRepo *repoMocks.MockNotificationRepository) {
-				mockRepo.On("MarkAsRead", int64(1)).Return(assert.AnError)
+				mockRepo.On("MarkAsReadOwned", mock.Anything, int64(1), int64(123)).Return(assert.AnError)
 			},
 			expectedResult: &pb.MarkAsReadResponse{Success: false},
 			expectedError:  true,
+			expectedCode:   codes.Internal,
 		},
 	}
 
@@ -176,11 +226,12 @@ func TestNotificationService_MarkAsRead(t *testing.T) {
 			tt.setupMock(mockRepo)
 
 			logger := logging.NewEmptyLogger()
-			service := New(mockRepo, logger)
+			service := New(mockRepo, new(repoMocks.MockPreferenceRepository), new(repoMocks.MockWebhookRepository), logger)
 			result, err := service.MarkAsRead(context.Background(), tt.input)
 
 			if tt.expectedError {
 				assert.Error(t, err)
+				assert.Equal(t, tt.expectedCode, status.Code(err))
 			} else {
 				assert.NoError(t, err)
 			}
@@ -203,7 +254,7 @@ func TestNotificationService_MarkAllAsRead(t *testing.T) {
 			name:  "success - marks all notifications as read",
 			input: &pb.MarkAllAsReadRequest{UserId: 123},
 			setupMock: func(mockRepo *repoMocks.MockNotificationRepository) {
-				mockRepo.On("MarkAllAsRead", int64(123)).Return(nil)
+				mockRepo.On("MarkAllAsRead", mock.Anything, int64(123)).Return(nil)
 			},
 			expectedResult: &pb.MarkAllAsReadResponse{Success: true},
 			expectedError:  false,
@@ -212,7 +263,7 @@ func TestNotificationService_MarkAllAsRead(t *testing.T) {
 			name:  "error - repository returns error",
 			input: &pb.MarkAllAsReadRequest{UserId: 123},
 			setupMock: func(mockRepo *repoMocks.MockNotificationRepository) {
-				mockRepo.On("MarkAllAsRead", int64(123)).Return(assert.AnError)
+				mockRepo.On("MarkAllAsRead", mock.Anything, int64(123)).Return(assert.AnError)
 			},
 			expectedResult: &pb.MarkAllAsReadResponse{Success: false},
 			expectedError:  true,
@@ -225,7 +276,7 @@ func TestNotificationService_MarkAllAsRead(t *testing.T) {
 			tt.setupMock(mockRepo)
 
 			logger := logging.NewEmptyLogger()
-			service := New(mockRepo, logger)
+			service := New(mockRepo, new(repoMocks.MockPreferenceRepository), new(repoMocks.MockWebhookRepository), logger)
 			result, err := service.MarkAllAsRead(context.Background(), tt.input)
 
 			if tt.expectedError {
@@ -240,6 +291,479 @@ func TestNotificationService_MarkAllAsRead(t *testing.T) {
 	}
 }
 
+func TestNotificationService_UnreadCount(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         *pb.UnreadCountRequest
+		setupMock     func(*repoMocks.MockNotificationRepository)
+		expectedCount int64
+		expectedError bool
+	}{
+		{
+			name:  "success - returns unread count",
+			input: &pb.UnreadCountRequest{UserId: 123},
+			setupMock: func(mockRepo *repoMocks.MockNotificationRepository) {
+				mockRepo.On("UnreadCount", mock.Anything, int64(123)).Return(int64(4), nil)
+			},
+			expectedCount: 4,
+			expectedError: false,
+		},
+		{
+			name:  "error - repository returns error",
+			input: &pb.UnreadCountRequest{UserId: 123},
+			setupMock: func(mockRepo *repoMocks.MockNotificationRepository) {
+				mockRepo.On("UnreadCount", mock.Anything, int64(123)).Return(int64(0), assert.AnError)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(repoMocks.MockNotificationRepository)
+			tt.setupMock(mockRepo)
+
+			logger := logging.NewEmptyLogger()
+			service := New(mockRepo, new(repoMocks.MockPreferenceRepository), new(repoMocks.MockWebhookRepository), logger)
+			result, err := service.UnreadCount(context.Background(), tt.input)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedCount, result.Count)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNotificationService_Archive(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         *pb.ArchiveRequest
+		setupMock     func(*repoMocks.MockNotificationRepository)
+		expectedError bool
+		expectedCode  codes.Code
+	}{
+		{
+			name:  "success - archives owned notification",
+			input: &pb.ArchiveRequest{NotificationId: 1, UserId: 123},
+			setupMock: func(mockRepo *repoMocks.MockNotificationRepository) {
+				mockRepo.On("ArchiveOwned", mock.Anything, int64(1), int64(123)).Return(nil)
+			},
+		},
+		{
+			name:          "error - missing caller user ID",
+			input:         &pb.ArchiveRequest{NotificationId: 1},
+			setupMock:     func(mockRepo *repoMocks.MockNotificationRepository) {},
+			expectedError: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name:  "error - notification belongs to another user",
+			input: &pb.ArchiveRequest{NotificationId: 1, UserId: 456},
+			setupMock: func(mockRepo *repoMocks.MockNotificationRepository) {
+				mockRepo.On("ArchiveOwned", mock.Anything, int64(1), int64(456)).Return(repository.NotificationForbiddenErr)
+			},
+			expectedError: true,
+			expectedCode:  codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(repoMocks.MockNotificationRepository)
+			tt.setupMock(mockRepo)
+
+			logger := logging.NewEmptyLogger()
+			service := New(mockRepo, new(repoMocks.MockPreferenceRepository), new(repoMocks.MockWebhookRepository), logger)
+			result, err := service.Archive(context.Background(), tt.input)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedCode, status.Code(err))
+				assert.False(t, result.Success)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, result.Success)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNotificationService_Delete(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         *pb.DeleteRequest
+		setupMock     func(*repoMocks.MockNotificationRepository)
+		expectedError bool
+		expectedCode  codes.Code
+	}{
+		{
+			name:  "success - deletes owned notification",
+			input: &pb.DeleteRequest{NotificationId: 1, UserId: 123},
+			setupMock: func(mockRepo *repoMocks.MockNotificationRepository) {
+				mockRepo.On("DeleteOwned", mock.Anything, int64(1), int64(123)).Return(nil)
+			},
+		},
+		{
+			name:  "error - notification not found",
+			input: &pb.DeleteRequest{NotificationId: 999, UserId: 123},
+			setupMock: func(mockRepo *repoMocks.MockNotificationRepository) {
+				mockRepo.On("DeleteOwned", mock.Anything, int64(999), int64(123)).Return(repository.NotificationNotFoundErr)
+			},
+			expectedError: true,
+			expectedCode:  codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(repoMocks.MockNotificationRepository)
+			tt.setupMock(mockRepo)
+
+			logger := logging.NewEmptyLogger()
+			service := New(mockRepo, new(repoMocks.MockPreferenceRepository), new(repoMocks.MockWebhookRepository), logger)
+			result, err := service.Delete(context.Background(), tt.input)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedCode, status.Code(err))
+				assert.False(t, result.Success)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, result.Success)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNotificationService_BulkArchiveAndDelete(t *testing.T) {
+	mockRepo := new(repoMocks.MockNotificationRepository)
+	mockRepo.On("ArchiveAll", mock.Anything, int64(123), true).Return(int64(3), nil)
+	mockRepo.On("DeleteAll", mock.Anything, int64(123), false).Return(int64(5), nil)
+
+	logger := logging.NewEmptyLogger()
+	service := New(mockRepo, new(repoMocks.MockPreferenceRepository), new(repoMocks.MockWebhookRepository), logger)
+
+	archived, err := service.ArchiveAll(context.Background(), &pb.ArchiveAllRequest{UserId: 123, ReadOnly: true})
+	assert.NoError(t, err)
+	assert.True(t, archived.Success)
+	assert.Equal(t, int64(3), archived.Affected)
+
+	deleted, err := service.DeleteAll(context.Background(), &pb.DeleteAllRequest{UserId: 123})
+	assert.NoError(t, err)
+	assert.True(t, deleted.Success)
+	assert.Equal(t, int64(5), deleted.Affected)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestNotificationService_GetPreferences(t *testing.T) {
+	tests := []struct {
+		name          string
+		request       *pb.GetPreferencesRequest
+		setupMock     func(*repoMocks.MockPreferenceRepository)
+		expected      *pb.PreferencesResponse
+		expectedError codes.Code
+	}{
+		{
+			name:    "returns stored preferences",
+			request: &pb.GetPreferencesRequest{UserId: 123},
+			setupMock: func(m *repoMocks.MockPreferenceRepository) {
+				m.On("GetPreferences", mock.Anything, int64(123)).Return(models.Preferences{
+					UserID:   123,
+					Email:    "user@example.com",
+					Channels: map[string]string{models.TypeNewReview: models.ChannelEmailDigest},
+				}, nil)
+			},
+			expected: &pb.PreferencesResponse{
+				UserId: 123,
+				Email:  "user@example.com",
+				Preferences: []*pb.Preference{
+					{Type: models.TypeNewReview, Channel: models.ChannelEmailDigest},
+				},
+			},
+		},
+		{
+			name:          "rejects missing user id",
+			request:       &pb.GetPreferencesRequest{},
+			setupMock:     func(m *repoMocks.MockPreferenceRepository) {},
+			expectedError: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPreferences := new(repoMocks.MockPreferenceRepository)
+			tt.setupMock(mockPreferences)
+
+			service := New(new(repoMocks.MockNotificationRepository), mockPreferences, new(repoMocks.MockWebhookRepository), logging.NewEmptyLogger())
+			response, err := service.GetPreferences(context.Background(), tt.request)
+
+			if tt.expectedError != codes.OK {
+				assert.Equal(t, tt.expectedError, status.Code(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, response)
+			}
+			mockPreferences.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNotificationService_UpdatePreferences(t *testing.T) {
+	tests := []struct {
+		name          string
+		request       *pb.UpdatePreferencesRequest
+		setupMock     func(*repoMocks.MockPreferenceRepository)
+		expectedError codes.Code
+	}{
+		{
+			name: "saves valid preferences",
+			request: &pb.UpdatePreferencesRequest{
+				UserId: 123,
+				Email:  " user@example.com ",
+				Preferences: []*pb.Preference{
+					{Type: models.TypeNewReview, Channel: models.ChannelEmailImmediate},
+				},
+			},
+			setupMock: func(m *repoMocks.MockPreferenceRepository) {
+				preferences := models.Preferences{
+					UserID:   123,
+					Email:    "user@example.com",
+					Channels: map[string]string{models.TypeNewReview: models.ChannelEmailImmediate},
+				}
+				m.On("UpdatePreferences", mock.Anything, preferences).Return(preferences, nil)
+			},
+		},
+		{
+			name: "rejects unknown channel",
+			request: &pb.UpdatePreferencesRequest{
+				UserId:      123,
+				Preferences: []*pb.Preference{{Type: models.TypeNewReview, Channel: "sms"}},
+			},
+			setupMock:     func(m *repoMocks.MockPreferenceRepository) {},
+			expectedError: codes.InvalidArgument,
+		},
+		{
+			name: "rejects unknown type",
+			request: &pb.UpdatePreferencesRequest{
+				UserId:      123,
+				Preferences: []*pb.Preference{{Type: "unknown", Channel: models.ChannelOff}},
+			},
+			setupMock:     func(m *repoMocks.MockPreferenceRepository) {},
+			expectedError: codes.InvalidArgument,
+		},
+		{
+			name:          "rejects invalid email",
+			request:       &pb.UpdatePreferencesRequest{UserId: 123, Email: "not an email"},
+			setupMock:     func(m *repoMocks.MockPreferenceRepository) {},
+			expectedError: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPreferences := new(repoMocks.MockPreferenceRepository)
+			tt.setupMock(mockPreferences)
+
+			service := New(new(repoMocks.MockNotificationRepository), mockPreferences, new(repoMocks.MockWebhookRepository), logging.NewEmptyLogger())
+			response, err := service.UpdatePreferences(context.Background(), tt.request)
+
+			if tt.expectedError != codes.OK {
+				assert.Equal(t, tt.expectedError, status.Code(err))
+				assert.Nil(t, response)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(123), response.UserId)
+				assert.Equal(t, "user@example.com", response.Email)
+			}
+			mockPreferences.AssertExpectations(t)
+		})
+	}
+}
+
+// Collects messages of any streaming RPC
+type collectingStream[T any] struct {
+	MinimalServerStream
+	sent []*T
+}
+
+func newCollectingStream[T any]() *collectingStream[T] {
+	return &collectingStream[T]{MinimalServerStream: MinimalServerStream{ctx: context.Background()}}
+}
+
+func (m *collectingStream[T]) Send(msg *T) error {
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+func TestNotificationService_CreateWebhook(t *testing.T) {
+	tests := []struct {
+		name          string
+		request       *pb.CreateWebhookRequest
+		setupMock     func(*repoMocks.MockWebhookRepository)
+		expectedError codes.Code
+	}{
+		{
+			name: "creates webhook with generated secret",
+			request: &pb.CreateWebhookRequest{
+				UserId:     123,
+				Url:        "https://chat.example.com/hooks/abc",
+				EventTypes: []string{models.TypeNewReview, models.TypeNewReview},
+			},
+			setupMock: func(m *repoMocks.MockWebhookRepository) {
+				m.On("Create", mock.Anything, mock.MatchedBy(func(r models.WebhookRequest) bool {
+					return r.UserID == 123 &&
+						r.URL == "https://chat.example.com/hooks/abc" &&
+						len(r.EventTypes) == 1 &&
+						len(r.Secret) == 64
+				})).Return(models.Webhook{
+					WebhookID:  1,
+					UserID:     123,
+					URL:        "https://chat.example.com/hooks/abc",
+					Secret:     "generated",
+					EventTypes: []string{models.TypeNewReview},
+				}, nil)
+			},
+		},
+		{
+			name:          "rejects non http url",
+			request:       &pb.CreateWebhookRequest{UserId: 123, Url: "ftp://example.com/hook"},
+			setupMock:     func(m *repoMocks.MockWebhookRepository) {},
+			expectedError: codes.InvalidArgument,
+		},
+		{
+			name:          "rejects unknown event type",
+			request:       &pb.CreateWebhookRequest{UserId: 123, Url: "https://example.com", EventTypes: []string{"unknown"}},
+			setupMock:     func(m *repoMocks.MockWebhookRepository) {},
+			expectedError: codes.InvalidArgument,
+		},
+		{
+			name:          "rejects missing user id",
+			request:       &pb.CreateWebhookRequest{Url: "https://example.com"},
+			setupMock:     func(m *repoMocks.MockWebhookRepository) {},
+			expectedError: codes.InvalidArgument,
+		},
+		{
+			name:          "rejects loopback address",
+			request:       &pb.CreateWebhookRequest{UserId: 123, Url: "http://127.0.0.1:8080/hook"},
+			setupMock:     func(m *repoMocks.MockWebhookRepository) {},
+			expectedError: codes.InvalidArgument,
+		},
+		{
+			name:          "rejects metadata address",
+			request:       &pb.CreateWebhookRequest{UserId: 123, Url: "http://169.254.169.254/latest/meta-data"},
+			setupMock:     func(m *repoMocks.MockWebhookRepository) {},
+			expectedError: codes.InvalidArgument,
+		},
+		{
+			name:          "rejects host resolving to private address",
+			request:       &pb.CreateWebhookRequest{UserId: 123, Url: "https://internal.example.com/hook"},
+			setupMock:     func(m *repoMocks.MockWebhookRepository) {},
+			expectedError: codes.InvalidArgument,
+		},
+		{
+			name:          "rejects unresolvable host",
+			request:       &pb.CreateWebhookRequest{UserId: 123, Url: "https://missing.example.com/hook"},
+			setupMock:     func(m *repoMocks.MockWebhookRepository) {},
+			expectedError: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockWebhooks := new(repoMocks.MockWebhookRepository)
+			tt.setupMock(mockWebhooks)
+
+			service := New(new(repoMocks.MockNotificationRepository), new(repoMocks.MockPreferenceRepository), mockWebhooks, logging.NewEmptyLogger())
+			service.(*notificationService).resolver = stubResolver{
+				"chat.example.com":     {netip.MustParseAddr("93.184.215.14")},
+				"example.com":          {netip.MustParseAddr("93.184.215.14"), netip.MustParseAddr("2606:2800:21f:cb07::1")},
+				"internal.example.com": {netip.MustParseAddr("93.184.215.14"), netip.MustParseAddr("10.0.0.5")},
+			}
+			response, err := service.CreateWebhook(context.Background(), tt.request)
+
+			if tt.expectedError != codes.OK {
+				assert.Equal(t, tt.expectedError, status.Code(err))
+				assert.Nil(t, response)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(1), response.WebhookId)
+				assert.Equal(t, "generated", response.Secret)
+			}
+			mockWebhooks.AssertExpectations(t)
+		})
+	}
+}
+
+// Resolves the hosts it was given, any other host doesn't exist
+type stubResolver map[string][]netip.Addr
+
+func (r stubResolver) LookupNetIP(_ context.Context, _, host string) ([]netip.Addr, error) {
+	addrs, ok := r[host]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	return addrs, nil
+}
+
+func TestNotificationService_ListWebhooksHidesSecret(t *testing.T) {
+	mockWebhooks := new(repoMocks.MockWebhookRepository)
+	mockWebhooks.On("GetByUserID", mock.Anything, int64(123)).Return([]models.Webhook{
+		{WebhookID: 1, UserID: 123, URL: "https://example.com", Secret: "secret", EventTypes: []string{}},
+	}, nil)
+
+	service := New(new(repoMocks.MockNotificationRepository), new(repoMocks.MockPreferenceRepository), mockWebhooks, logging.NewEmptyLogger())
+	stream := newCollectingStream[pb.WebhookResponse]()
+
+	err := service.ListWebhooks(&pb.ListWebhooksRequest{UserId: 123}, stream)
+
+	assert.NoError(t, err)
+	assert.Len(t, stream.sent, 1)
+	assert.Equal(t, "https://example.com", stream.sent[0].Url)
+	assert.Empty(t, stream.sent[0].Secret)
+}
+
+func TestNotificationService_WebhookOwnership(t *testing.T) {
+	mockWebhooks := new(repoMocks.MockWebhookRepository)
+	mockWebhooks.On("DeleteOwned", mock.Anything, int64(1), int64(123)).Return(nil)
+	mockWebhooks.On("DeleteOwned", mock.Anything, int64(2), int64(123)).Return(repository.WebhookForbiddenErr)
+	mockWebhooks.On("GetDeliveries", mock.Anything, int64(3), int64(123), maxDeliveriesLimit).
+		Return([]models.WebhookDelivery(nil), repository.WebhookNotFoundErr)
+	mockWebhooks.On("GetDeliveries", mock.Anything, int64(1), int64(123), 10).Return([]models.WebhookDelivery{
+		{DeliveryID: 9, WebhookID: 1, Status: models.DeliveryStatusFailed, Attempts: 6, LastStatusCode: 500},
+	}, nil)
+
+	service := New(new(repoMocks.MockNotificationRepository), new(repoMocks.MockPreferenceRepository), mockWebhooks, logging.NewEmptyLogger())
+
+	deleted, err := service.DeleteWebhook(context.Background(), &pb.DeleteWebhookRequest{WebhookId: 1, UserId: 123})
+	assert.NoError(t, err)
+	assert.True(t, deleted.Success)
+
+	_, err = service.DeleteWebhook(context.Background(), &pb.DeleteWebhookRequest{WebhookId: 2, UserId: 123})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	err = service.ListWebhookDeliveries(&pb.ListWebhookDeliveriesRequest{WebhookId: 3, UserId: 123}, newCollectingStream[pb.WebhookDeliveryResponse]())
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	stream := newCollectingStream[pb.WebhookDeliveryResponse]()
+	err = service.ListWebhookDeliveries(&pb.ListWebhookDeliveriesRequest{WebhookId: 1, UserId: 123, Limit: 10}, stream)
+	assert.NoError(t, err)
+	assert.Len(t, stream.sent, 1)
+	assert.Equal(t, models.DeliveryStatusFailed, stream.sent[0].Status)
+	assert.Equal(t, int32(500), stream.sent[0].LastStatusCode)
+
+	mockWebhooks.AssertExpectations(t)
+}
+
 func TestToProtoNotificationResponse(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -259,6 +783,28 @@ func TestToProtoNotificationResponse(t *testing.T) {
 				UserId:         123,
 				Content:        "Test notification",
 				IsRead:         false,
+				Target:         &pb.NotificationTarget{},
+			},
+		},
+		{
+			name: "renders typed notification with target and link",
+			input: models.Notification{
+				NotificationID: 2,
+				UserID:         123,
+				Type:           models.TypeNewReview,
+				Actor:          "reviewer1",
+				Payload:        models.Payload{EssayID: 5, ReviewID: 9},
+				IsRead:         true,
+			},
+			expected: &pb.NotificationResponse{
+				NotificationId: 2,
+				UserId:         123,
+				Content:        "Your essay has been reviewed by reviewer1",
+				IsRead:         true,
+				Type:           models.TypeNewReview,
+				Actor:          "reviewer1",
+				Target:         &pb.NotificationTarget{EssayId: 5, ReviewId: 9},
+				Link:           "/my-essay#review-9",
 			},
 		},
 		{
@@ -274,6 +820,7 @@ func TestToProtoNotificationResponse(t *testing.T) {
 				UserId:         0,
 				Content:        "",
 				IsRead:         false,
+				Target:         &pb.NotificationTarget{},
 			},
 		},
 	}
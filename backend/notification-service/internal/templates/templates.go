@@ -0,0 +1,131 @@
+package templates
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/models"
+)
+
+type notificationTemplate struct {
+	content *template.Template
+	link    *template.Template
+	subject *template.Template
+}
+
+var registry = map[string]notificationTemplate{
+	models.TypeNewReview: {
+		content: template.Must(template.New("new_review_content").Parse(
+			"Your essay has been reviewed by {{.Actor}}")),
+		link: template.Must(template.New("new_review_link").Parse(
+			"/my-essay#review-{{.Payload.ReviewID}}")),
+		subject: template.Must(template.New("new_review_subject").Parse(
+			"New review from {{.Actor}}")),
+	},
+	models.TypeReviewReply: {
+		content: template.Must(template.New("review_reply_content").Parse(
+			"{{.Actor}} replied to the discussion of a review")),
+		link: template.Must(template.New("review_reply_link").Parse(
+			"{{if .Payload.EssayAuthor}}/essay/{{.Payload.EssayAuthor}}{{else}}/essay/by-id/{{.Payload.EssayID}}{{end}}" +
+				"#reply-{{.Payload.ReplyID}}")),
+		subject: template.Must(template.New("review_reply_subject").Parse(
+			"New reply from {{.Actor}}")),
+	},
+	models.TypeReviewEdited: {
+		content: template.Must(template.New("review_edited_content").Parse(
+			"{{.Actor}} edited their review of your essay")),
+		link: template.Must(template.New("review_edited_link").Parse(
+			"/my-essay#review-{{.Payload.ReviewID}}")),
+		subject: template.Must(template.New("review_edited_subject").Parse(
+			"{{.Actor}} edited a review of your essay")),
+	},
+}
+
+// Renders the notification text for its type, falling back to the stored content
+func Content(n models.Notification) string {
+	tmpl, ok := registry[n.Type]
+	if !ok || tmpl.content == nil {
+		return n.Content
+	}
+
+	rendered, err := execute(tmpl.content, n)
+	if err != nil {
+		return n.Content
+	}
+	return rendered
+}
+
+// Renders the frontend path the notification points to, empty if there is none
+func Link(n models.Notification) string {
+	tmpl, ok := registry[n.Type]
+	if !ok || tmpl.link == nil {
+		return ""
+	}
+
+	rendered, err := execute(tmpl.link, n)
+	if err != nil {
+		return ""
+	}
+	return rendered
+}
+
+// Renders the subject of a single notification email
+func EmailSubject(n models.Notification) string {
+	tmpl, ok := registry[n.Type]
+	if !ok || tmpl.subject == nil {
+		return "New notification"
+	}
+
+	rendered, err := execute(tmpl.subject, n)
+	if err != nil {
+		return "New notification"
+	}
+	return rendered
+}
+
+// Renders the plain text body of a single notification email, baseURL prefixes the link
+func EmailBody(n models.Notification, baseURL string) string {
+	var body strings.Builder
+	writeEmailLine(&body, n, baseURL)
+	return body.String()
+}
+
+func DigestSubject(count int) string {
+	if count == 1 {
+		return "You have 1 new notification"
+	}
+	return fmt.Sprintf("You have %d new notifications", count)
+}
+
+// Renders one email summarizing several notifications
+func DigestBody(notifications []models.Notification, baseURL string) string {
+	var body strings.Builder
+	body.WriteString(DigestSubject(len(notifications)))
+	body.WriteString(":\r\n\r\n")
+	for _, n := range notifications {
+		body.WriteString("- ")
+		writeEmailLine(&body, n, baseURL)
+	}
+	return body.String()
+}
+
+func writeEmailLine(body *strings.Builder, n models.Notification, baseURL string) {
+	body.WriteString(Content(n))
+	body.WriteString("\r\n")
+	if link := Link(n); link != "" {
+		body.WriteString("  ")
+		body.WriteString(strings.TrimRight(baseURL, "/"))
+		body.WriteString(link)
+		body.WriteString("\r\n")
+	}
+}
+
+func execute(tmpl *template.Template, n models.Notification) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, n); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,151 @@
+package templates
+
+import (
+	"testing"
+
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContent(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    models.Notification
+		expected string
+	}{
+		{
+			name: "renders new review template",
+			input: models.Notification{
+				Type:    models.TypeNewReview,
+				Actor:   "reviewer1",
+				Payload: models.Payload{EssayID: 3, ReviewID: 7},
+				Content: "stored content",
+			},
+			expected: "Your essay has been reviewed by reviewer1",
+		},
+		{
+			name: "renders review reply template",
+			input: models.Notification{
+				Type:    models.TypeReviewReply,
+				Actor:   "author1",
+				Payload: models.Payload{EssayID: 3, ReviewID: 7, ReplyID: 12, EssayAuthor: "author1"},
+			},
+			expected: "author1 replied to the discussion of a review",
+		},
+		{
+			name: "renders review edited template",
+			input: models.Notification{
+				Type:    models.TypeReviewEdited,
+				Actor:   "Reviewer A",
+				Payload: models.Payload{EssayID: 3, ReviewID: 7},
+			},
+			expected: "Reviewer A edited their review of your essay",
+		},
+		{
+			name: "falls back to stored content for unknown type",
+			input: models.Notification{
+				Type:    "unknown",
+				Content: "stored content",
+			},
+			expected: "stored content",
+		},
+		{
+			name: "falls back to stored content for legacy notification",
+			input: models.Notification{
+				Content: "Your essay has been reviewed by someone",
+			},
+			expected: "Your essay has been reviewed by someone",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Content(tt.input))
+		})
+	}
+}
+
+func TestLink(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    models.Notification
+		expected string
+	}{
+		{
+			name: "links new review to the review on the author's essay",
+			input: models.Notification{
+				Type:    models.TypeNewReview,
+				Payload: models.Payload{EssayID: 3, ReviewID: 7},
+			},
+			expected: "/my-essay#review-7",
+		},
+		{
+			name: "links review reply to the reply on the discussed essay",
+			input: models.Notification{
+				Type:    models.TypeReviewReply,
+				Payload: models.Payload{EssayID: 3, ReviewID: 7, ReplyID: 12, EssayAuthor: "author1"},
+			},
+			expected: "/essay/author1#reply-12",
+		},
+		{
+			name: "links anonymous review reply by essay id",
+			input: models.Notification{
+				Type:    models.TypeReviewReply,
+				Payload: models.Payload{EssayID: 3, ReviewID: 7, ReplyID: 12},
+			},
+			expected: "/essay/by-id/3#reply-12",
+		},
+		{
+			name: "links edited review on the author's essay",
+			input: models.Notification{
+				Type:    models.TypeReviewEdited,
+				Payload: models.Payload{EssayID: 3, ReviewID: 7},
+			},
+			expected: "/my-essay#review-7",
+		},
+		{
+			name:     "no link for unknown type",
+			input:    models.Notification{Type: "unknown"},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Link(tt.input))
+		})
+	}
+}
+
+func TestEmailSubjectAndBody(t *testing.T) {
+	n := models.Notification{
+		Type:    models.TypeNewReview,
+		Actor:   "reviewer1",
+		Payload: models.Payload{EssayID: 3, ReviewID: 7},
+	}
+
+	assert.Equal(t, "New review from reviewer1", EmailSubject(n))
+	assert.Equal(t, "New notification", EmailSubject(models.Notification{Content: "legacy"}))
+
+	assert.Equal(t,
+		"Your essay has been reviewed by reviewer1\r\n  https://essays.example.com/my-essay#review-7\r\n",
+		EmailBody(n, "https://essays.example.com/"),
+	)
+	assert.Equal(t, "legacy\r\n", EmailBody(models.Notification{Content: "legacy"}, "https://essays.example.com"))
+}
+
+func TestDigest(t *testing.T) {
+	notifications := []models.Notification{
+		{Type: models.TypeNewReview, Actor: "reviewer1", Payload: models.Payload{ReviewID: 1}},
+		{Type: models.TypeNewReview, Actor: "reviewer2", Payload: models.Payload{ReviewID: 2}},
+	}
+
+	assert.Equal(t, "You have 1 new notification", DigestSubject(1))
+	assert.Equal(t, "You have 2 new notifications", DigestSubject(2))
+	assert.Equal(t,
+		"You have 2 new notifications:\r\n\r\n"+
+			"- Your essay has been reviewed by reviewer1\r\n  http://localhost/my-essay#review-1\r\n"+
+			"- Your essay has been reviewed by reviewer2\r\n  http://localhost/my-essay#review-2\r\n",
+		DigestBody(notifications, "http://localhost"),
+	)
+}
@@ -0,0 +1,168 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/repository"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultBatchSize   = 50
+	defaultMaxAttempts = 6
+	defaultBaseBackoff = 30 * time.Second
+	defaultMaxBackoff  = time.Hour
+	requestTimeout     = 10 * time.Second
+	maxErrorLength     = 500
+)
+
+type Dispatcher struct {
+	client       *http.Client
+	repository   repository.WebhookRepository
+	logger       *logging.Logger
+	pollInterval time.Duration
+	batchSize    int
+	maxAttempts  int
+	baseBackoff  time.Duration
+	maxBackoff   time.Duration
+	now          func() time.Time
+}
+
+func NewDispatcher(repo repository.WebhookRepository, logger *logging.Logger, pollInterval time.Duration) *Dispatcher {
+	return &Dispatcher{
+		client:       newClient(PublicAddr),
+		repository:   repo,
+		logger:       logger,
+		pollInterval: pollInterval,
+		batchSize:    defaultBatchSize,
+		maxAttempts:  defaultMaxAttempts,
+		baseBackoff:  defaultBaseBackoff,
+		maxBackoff:   defaultMaxBackoff,
+		now:          time.Now,
+	}
+}
+
+func (d *Dispatcher) Start(ctx context.Context) {
+	d.logger.Info("Starting webhook dispatcher",
+		zap.Duration("poll_interval", d.pollInterval))
+
+	ticker := time.NewTicker(d.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			d.logger.Info("Stopping webhook dispatcher...")
+			return
+		case <-ticker.C:
+			d.DeliverDue(ctx)
+		}
+	}
+}
+
+// Attempts every delivery whose next attempt time has come
+func (d *Dispatcher) DeliverDue(ctx context.Context) {
+	logger := d.logger.With(zap.String("operation", "deliver_due_webhooks"))
+
+	due, err := d.repository.GetDueDeliveries(ctx, d.batchSize)
+	if err != nil {
+		logger.Error("Failed to load due webhook deliveries", zap.Error(err))
+		return
+	}
+
+	for _, delivery := range due {
+		attempt := d.attempt(ctx, delivery)
+		if err := d.repository.RecordAttempt(ctx, attempt); err != nil {
+			logger.Error("Failed to record webhook attempt",
+				zap.Int64("delivery_id", attempt.DeliveryID),
+				zap.Error(err))
+			continue
+		}
+
+		logger.Debug("Webhook delivery attempted",
+			zap.Int64("delivery_id", attempt.DeliveryID),
+			zap.Int64("webhook_id", delivery.Delivery.WebhookID),
+			zap.String("status", attempt.Status),
+			zap.Int("status_code", attempt.StatusCode))
+	}
+}
+
+func (d *Dispatcher) attempt(ctx context.Context, due models.DueDelivery) models.DeliveryAttempt {
+	now := d.now()
+	attempt := models.DeliveryAttempt{
+		DeliveryID:    due.Delivery.DeliveryID,
+		NextAttemptAt: now,
+	}
+
+	statusCode, err := d.post(ctx, due, now)
+	attempt.StatusCode = statusCode
+	if err == nil {
+		attempt.Status = models.DeliveryStatusSuccess
+		return attempt
+	}
+
+	attempt.Error = truncate(err.Error(), maxErrorLength)
+	attempts := due.Delivery.Attempts + 1
+	if attempts >= d.maxAttempts {
+		attempt.Status = models.DeliveryStatusFailed
+		return attempt
+	}
+
+	attempt.Status = models.DeliveryStatusPending
+	attempt.NextAttemptAt = now.Add(d.backoff(attempts))
+	return attempt
+}
+
+func (d *Dispatcher) post(ctx context.Context, due models.DueDelivery, now time.Time) (int, error) {
+	timestamp := now.Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, due.URL, bytes.NewReader(due.Delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "vt-csa-essays-webhooks/1.0")
+	req.Header.Set(HeaderEvent, due.Delivery.EventType)
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(due.Delivery.DeliveryID, 10))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(due.Secret, timestamp, due.Delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("receiver responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// Exponential backoff: base, 2*base, 4*base... capped at maxBackoff
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	delay := d.baseBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= d.maxBackoff {
+			return d.maxBackoff
+		}
+	}
+	return delay
+}
+
+func truncate(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+	return s[:limit]
+}
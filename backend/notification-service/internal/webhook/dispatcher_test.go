@@ -0,0 +1,171 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/repository/mocks"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type receivedRequest struct {
+	Header http.Header
+	Body   []byte
+}
+
+// Local HTTP receiver answering with the configured status code
+type receiver struct {
+	server     *httptest.Server
+	mu         sync.Mutex
+	requests   []receivedRequest
+	statusCode int
+}
+
+func newReceiver(t *testing.T, statusCode int) *receiver {
+	r := &receiver{statusCode: statusCode}
+	r.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		r.mu.Lock()
+		r.requests = append(r.requests, receivedRequest{Header: req.Header.Clone(), Body: body})
+		r.mu.Unlock()
+		w.WriteHeader(r.statusCode)
+	}))
+	t.Cleanup(r.server.Close)
+	return r
+}
+
+func (r *receiver) received() []receivedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]receivedRequest(nil), r.requests...)
+}
+
+var fixedNow = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func newTestDispatcher(repo *mocks.MockWebhookRepository) *Dispatcher {
+	dispatcher := NewDispatcher(repo, logging.NewEmptyLogger(), time.Second)
+	dispatcher.now = func() time.Time { return fixedNow }
+	// receivers of the tests listen on loopback
+	dispatcher.client = newClient(func(netip.Addr) bool { return true })
+	return dispatcher
+}
+
+func dueDelivery(url string, attempts int) models.DueDelivery {
+	return models.DueDelivery{
+		Delivery: models.WebhookDelivery{
+			DeliveryID: 42,
+			WebhookID:  7,
+			EventType:  models.TypeNewReview,
+			Payload:    []byte(`{"event":"new_review"}`),
+			Attempts:   attempts,
+		},
+		URL:    url,
+		Secret: "secret",
+	}
+}
+
+func TestDispatcher_DeliverDue_Success(t *testing.T) {
+	receiver := newReceiver(t, http.StatusNoContent)
+
+	repo := new(mocks.MockWebhookRepository)
+	repo.On("GetDueDeliveries", mock.Anything, defaultBatchSize).Return([]models.DueDelivery{dueDelivery(receiver.server.URL, 0)}, nil)
+	repo.On("RecordAttempt", mock.Anything, models.DeliveryAttempt{
+		DeliveryID:    42,
+		Status:        models.DeliveryStatusSuccess,
+		StatusCode:    http.StatusNoContent,
+		NextAttemptAt: fixedNow,
+	}).Return(nil)
+
+	newTestDispatcher(repo).DeliverDue(context.Background())
+
+	requests := receiver.received()
+	require.Len(t, requests, 1)
+	assert.Equal(t, `{"event":"new_review"}`, string(requests[0].Body))
+	assert.Equal(t, "application/json", requests[0].Header.Get("Content-Type"))
+	assert.Equal(t, models.TypeNewReview, requests[0].Header.Get(HeaderEvent))
+	assert.Equal(t, "42", requests[0].Header.Get(HeaderDelivery))
+
+	timestamp, err := strconv.ParseInt(requests[0].Header.Get(HeaderTimestamp), 10, 64)
+	require.NoError(t, err)
+	assert.Equal(t, fixedNow.Unix(), timestamp)
+	assert.Equal(t, Sign("secret", timestamp, requests[0].Body), requests[0].Header.Get(HeaderSignature))
+
+	repo.AssertExpectations(t)
+}
+
+func TestDispatcher_DeliverDue_RetriesWithBackoff(t *testing.T) {
+	receiver := newReceiver(t, http.StatusInternalServerError)
+
+	repo := new(mocks.MockWebhookRepository)
+	repo.On("GetDueDeliveries", mock.Anything, defaultBatchSize).Return([]models.DueDelivery{dueDelivery(receiver.server.URL, 2)}, nil)
+	repo.On("RecordAttempt", mock.Anything, models.DeliveryAttempt{
+		DeliveryID:    42,
+		Status:        models.DeliveryStatusPending,
+		StatusCode:    http.StatusInternalServerError,
+		Error:         "receiver responded with status 500",
+		NextAttemptAt: fixedNow.Add(4 * defaultBaseBackoff),
+	}).Return(nil)
+
+	newTestDispatcher(repo).DeliverDue(context.Background())
+
+	assert.Len(t, receiver.received(), 1)
+	repo.AssertExpectations(t)
+}
+
+func TestDispatcher_DeliverDue_GivesUpAfterMaxAttempts(t *testing.T) {
+	receiver := newReceiver(t, http.StatusBadGateway)
+
+	repo := new(mocks.MockWebhookRepository)
+	repo.On("GetDueDeliveries", mock.Anything, defaultBatchSize).
+		Return([]models.DueDelivery{dueDelivery(receiver.server.URL, defaultMaxAttempts-1)}, nil)
+	repo.On("RecordAttempt", mock.Anything, models.DeliveryAttempt{
+		DeliveryID:    42,
+		Status:        models.DeliveryStatusFailed,
+		StatusCode:    http.StatusBadGateway,
+		Error:         "receiver responded with status 502",
+		NextAttemptAt: fixedNow,
+	}).Return(nil)
+
+	newTestDispatcher(repo).DeliverDue(context.Background())
+
+	repo.AssertExpectations(t)
+}
+
+func TestDispatcher_DeliverDue_UnreachableReceiver(t *testing.T) {
+	receiver := newReceiver(t, http.StatusOK)
+	url := receiver.server.URL
+	receiver.server.Close()
+
+	repo := new(mocks.MockWebhookRepository)
+	repo.On("GetDueDeliveries", mock.Anything, defaultBatchSize).Return([]models.DueDelivery{dueDelivery(url, 0)}, nil)
+	repo.On("RecordAttempt", mock.Anything, mock.MatchedBy(func(attempt models.DeliveryAttempt) bool {
+		return attempt.Status == models.DeliveryStatusPending &&
+			attempt.StatusCode == 0 &&
+			attempt.Error != "" &&
+			attempt.NextAttemptAt.Equal(fixedNow.Add(defaultBaseBackoff))
+	})).Return(nil)
+
+	newTestDispatcher(repo).DeliverDue(context.Background())
+
+	repo.AssertExpectations(t)
+}
+
+func TestDispatcher_Backoff(t *testing.T) {
+	dispatcher := newTestDispatcher(new(mocks.MockWebhookRepository))
+
+	assert.Equal(t, 30*time.Second, dispatcher.backoff(1))
+	assert.Equal(t, time.Minute, dispatcher.backoff(2))
+	assert.Equal(t, 2*time.Minute, dispatcher.backoff(3))
+	assert.Equal(t, time.Hour, dispatcher.backoff(20))
+}
@@ -0,0 +1,111 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublicAddr(t *testing.T) {
+	tests := []struct {
+		addr   string
+		public bool
+	}{
+		{"93.184.215.14", true},
+		{"2606:2800:21f:cb07::1", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"fc00::1", false},
+		{"0.0.0.0", false},
+		{"100.64.0.1", false},
+		{"224.0.0.1", false},
+		{"255.255.255.255", false},
+		{"::ffff:127.0.0.1", false},
+		{"::ffff:10.0.0.1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			assert.Equal(t, tt.public, PublicAddr(netip.MustParseAddr(tt.addr)))
+		})
+	}
+}
+
+type stubResolver map[string][]netip.Addr
+
+func (r stubResolver) LookupNetIP(_ context.Context, _, host string) ([]netip.Addr, error) {
+	addrs, ok := r[host]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return addrs, nil
+}
+
+func TestCheckURL(t *testing.T) {
+	resolver := stubResolver{
+		"example.com":    {netip.MustParseAddr("93.184.215.14")},
+		"rebind.example": {netip.MustParseAddr("93.184.215.14"), netip.MustParseAddr("192.168.0.10")},
+		"localhost":      {netip.MustParseAddr("127.0.0.1")},
+	}
+
+	tests := []struct {
+		name      string
+		url       string
+		forbidden bool
+		wantErr   bool
+	}{
+		{name: "public host", url: "https://example.com/hook"},
+		{name: "public literal", url: "http://93.184.215.14:8080/hook"},
+		{name: "loopback literal", url: "http://127.0.0.1/hook", forbidden: true, wantErr: true},
+		{name: "ipv6 loopback literal", url: "http://[::1]:8080/hook", forbidden: true, wantErr: true},
+		{name: "metadata address", url: "http://169.254.169.254/latest/meta-data", forbidden: true, wantErr: true},
+		{name: "localhost", url: "http://localhost:9000", forbidden: true, wantErr: true},
+		{name: "one private address among public ones", url: "https://rebind.example/hook", forbidden: true, wantErr: true},
+		{name: "unresolvable host", url: "https://missing.example/hook", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckURL(context.Background(), resolver, tt.url)
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.forbidden, errors.Is(err, ErrForbiddenAddress))
+		})
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(target.Close)
+	redirecting := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusFound))
+	t.Cleanup(redirecting.Close)
+
+	t.Run("refuses non public addresses", func(t *testing.T) {
+		_, err := newClient(PublicAddr).Get(target.URL)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrForbiddenAddress)
+	})
+
+	t.Run("does not follow redirects", func(t *testing.T) {
+		resp, err := newClient(func(netip.Addr) bool { return true }).Get(redirecting.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+	})
+}
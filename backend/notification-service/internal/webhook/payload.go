@@ -0,0 +1,76 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/templates"
+)
+
+// Headers sent with every delivery
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+type Event struct {
+	Event        string            `json:"event"`
+	CreatedAt    time.Time         `json:"created_at"`
+	Notification EventNotification `json:"notification"`
+}
+
+type EventNotification struct {
+	NotificationID int64          `json:"notification_id"`
+	UserID         int64          `json:"user_id"`
+	Actor          string         `json:"actor,omitempty"`
+	Target         models.Payload `json:"target"`
+	Content        string         `json:"content"`
+	URL            string         `json:"url,omitempty"`
+}
+
+// Builds the JSON body posted to subscribers, links are made absolute with baseURL
+func NewPayload(n models.Notification, baseURL string) ([]byte, error) {
+	var url string
+	if link := templates.Link(n); link != "" {
+		url = strings.TrimRight(baseURL, "/") + link
+	}
+
+	return json.Marshal(Event{
+		Event:     n.Type,
+		CreatedAt: n.CreatedAt.UTC(),
+		Notification: EventNotification{
+			NotificationID: n.NotificationID,
+			UserID:         n.UserID,
+			Actor:          n.Actor,
+			Target:         n.Payload,
+			Content:        templates.Content(n),
+			URL:            url,
+		},
+	})
+}
+
+// Signature over "<timestamp>.<body>" so receivers can reject replayed requests
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func GenerateSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
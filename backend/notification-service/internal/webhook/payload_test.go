@@ -0,0 +1,62 @@
+package webhook
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/notification-service/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPayload(t *testing.T) {
+	body, err := NewPayload(models.Notification{
+		NotificationID: 5,
+		UserID:         10,
+		Type:           models.TypeNewReview,
+		Actor:          "reviewer1",
+		Payload:        models.Payload{EssayID: 3, ReviewID: 7},
+		CreatedAt:      time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+	}, "https://essays.example.com/")
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"event": "new_review",
+		"created_at": "2025-01-02T03:04:05Z",
+		"notification": {
+			"notification_id": 5,
+			"user_id": 10,
+			"actor": "reviewer1",
+			"target": {"essay_id": 3, "review_id": 7},
+			"content": "Your essay has been reviewed by reviewer1",
+			"url": "https://essays.example.com/my-essay#review-7"
+		}
+	}`, string(body))
+
+	var event Event
+	require.NoError(t, json.Unmarshal(body, &event))
+	assert.Equal(t, models.TypeNewReview, event.Event)
+}
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"event":"new_review"}`)
+
+	signature := Sign("secret", 1700000000, body)
+	assert.Equal(t, "sha256=", signature[:7])
+	assert.Len(t, signature, 7+64)
+
+	assert.Equal(t, signature, Sign("secret", 1700000000, body))
+	assert.NotEqual(t, signature, Sign("other", 1700000000, body))
+	assert.NotEqual(t, signature, Sign("secret", 1700000001, body))
+}
+
+func TestGenerateSecret(t *testing.T) {
+	first, err := GenerateSecret()
+	require.NoError(t, err)
+	second, err := GenerateSecret()
+	require.NoError(t, err)
+
+	assert.Len(t, first, 64)
+	assert.NotEqual(t, first, second)
+}
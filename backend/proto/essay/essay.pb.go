@@ -23,9 +23,11 @@ const (
 )
 
 type EssayAddRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	Author        string                 `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Content string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Author  string                 `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	// 0 submits the essay outside of any assignment
+	AssignmentId  int64 `protobuf:"varint,3,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -74,12 +76,24 @@ func (x *EssayAddRequest) GetAuthor() string {
 	return ""
 }
 
+func (x *EssayAddRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
 type EssayResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	Author        string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content      string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Author       string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	CreatedAt    int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AssignmentId int64                  `protobuf:"varint,5,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Anonymous    bool                   `protobuf:"varint,6,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	AuthorAlias  string                 `protobuf:"bytes,7,opt,name=author_alias,json=authorAlias,proto3" json:"author_alias,omitempty"`
+	// Filled in listings, unset when the review service is unavailable
+	ReviewStats   *review.ReviewStats `protobuf:"bytes,8,opt,name=review_stats,json=reviewStats,proto3" json:"review_stats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -142,6 +156,34 @@ func (x *EssayResponse) GetCreatedAt() int64 {
 	return 0
 }
 
+func (x *EssayResponse) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *EssayResponse) GetAnonymous() bool {
+	if x != nil {
+		return x.Anonymous
+	}
+	return false
+}
+
+func (x *EssayResponse) GetAuthorAlias() string {
+	if x != nil {
+		return x.AuthorAlias
+	}
+	return ""
+}
+
+func (x *EssayResponse) GetReviewStats() *review.ReviewStats {
+	if x != nil {
+		return x.ReviewStats
+	}
+	return nil
+}
+
 type EmptyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -222,21 +264,72 @@ func (x *GetByAuthorNameRequest) GetAuthorname() string {
 	return ""
 }
 
-type EssayWithReviewsResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Id            int32                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Content       string                   `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	Author        string                   `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
-	AuthorId      int32                    `protobuf:"varint,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	CreatedAt     int64                    `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Reviews       []*review.ReviewResponse `protobuf:"bytes,6,rep,name=reviews,proto3" json:"reviews,omitempty"`
+// Reaches essays of anonymous assignments without naming their author
+type GetByIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *GetByIdRequest) Reset() {
+	*x = GetByIdRequest{}
+	mi := &file_essay_essay_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByIdRequest) ProtoMessage() {}
+
+func (x *GetByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_essay_essay_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetByIdRequest) Descriptor() ([]byte, []int) {
+	return file_essay_essay_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetByIdRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type EssayWithReviewsResponse struct {
+	state        protoimpl.MessageState   `protogen:"open.v1"`
+	Id           int32                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content      string                   `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Author       string                   `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	AuthorId     int32                    `protobuf:"varint,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	CreatedAt    int64                    `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Reviews      []*review.ReviewResponse `protobuf:"bytes,6,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	Revision     int32                    `protobuf:"varint,7,opt,name=revision,proto3" json:"revision,omitempty"`
+	AssignmentId int64                    `protobuf:"varint,8,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Anonymous    bool                     `protobuf:"varint,9,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	AuthorAlias  string                   `protobuf:"bytes,10,opt,name=author_alias,json=authorAlias,proto3" json:"author_alias,omitempty"`
+	// set when review-service could not be reached, reviews is empty then
+	ReviewsUnavailable bool `protobuf:"varint,11,opt,name=reviews_unavailable,json=reviewsUnavailable,proto3" json:"reviews_unavailable,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
 func (x *EssayWithReviewsResponse) Reset() {
 	*x = EssayWithReviewsResponse{}
-	mi := &file_essay_essay_proto_msgTypes[4]
+	mi := &file_essay_essay_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -248,7 +341,7 @@ func (x *EssayWithReviewsResponse) String() string {
 func (*EssayWithReviewsResponse) ProtoMessage() {}
 
 func (x *EssayWithReviewsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_essay_essay_proto_msgTypes[4]
+	mi := &file_essay_essay_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -261,7 +354,7 @@ func (x *EssayWithReviewsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EssayWithReviewsResponse.ProtoReflect.Descriptor instead.
 func (*EssayWithReviewsResponse) Descriptor() ([]byte, []int) {
-	return file_essay_essay_proto_rawDescGZIP(), []int{4}
+	return file_essay_essay_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *EssayWithReviewsResponse) GetId() int32 {
@@ -306,6 +399,41 @@ func (x *EssayWithReviewsResponse) GetReviews() []*review.ReviewResponse {
 	return nil
 }
 
+func (x *EssayWithReviewsResponse) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *EssayWithReviewsResponse) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *EssayWithReviewsResponse) GetAnonymous() bool {
+	if x != nil {
+		return x.Anonymous
+	}
+	return false
+}
+
+func (x *EssayWithReviewsResponse) GetAuthorAlias() string {
+	if x != nil {
+		return x.AuthorAlias
+	}
+	return ""
+}
+
+func (x *EssayWithReviewsResponse) GetReviewsUnavailable() bool {
+	if x != nil {
+		return x.ReviewsUnavailable
+	}
+	return false
+}
+
 type RemoveByAuthorNameRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Authorname    string                 `protobuf:"bytes,1,opt,name=authorname,proto3" json:"authorname,omitempty"`
@@ -315,7 +443,7 @@ type RemoveByAuthorNameRequest struct {
 
 func (x *RemoveByAuthorNameRequest) Reset() {
 	*x = RemoveByAuthorNameRequest{}
-	mi := &file_essay_essay_proto_msgTypes[5]
+	mi := &file_essay_essay_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -327,7 +455,7 @@ func (x *RemoveByAuthorNameRequest) String() string {
 func (*RemoveByAuthorNameRequest) ProtoMessage() {}
 
 func (x *RemoveByAuthorNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_essay_essay_proto_msgTypes[5]
+	mi := &file_essay_essay_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -340,7 +468,7 @@ func (x *RemoveByAuthorNameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveByAuthorNameRequest.ProtoReflect.Descriptor instead.
 func (*RemoveByAuthorNameRequest) Descriptor() ([]byte, []int) {
-	return file_essay_essay_proto_rawDescGZIP(), []int{5}
+	return file_essay_essay_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *RemoveByAuthorNameRequest) GetAuthorname() string {
@@ -350,6 +478,58 @@ func (x *RemoveByAuthorNameRequest) GetAuthorname() string {
 	return ""
 }
 
+type UpdateByAuthorNameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Authorname    string                 `protobuf:"bytes,1,opt,name=authorname,proto3" json:"authorname,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateByAuthorNameRequest) Reset() {
+	*x = UpdateByAuthorNameRequest{}
+	mi := &file_essay_essay_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateByAuthorNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateByAuthorNameRequest) ProtoMessage() {}
+
+func (x *UpdateByAuthorNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_essay_essay_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateByAuthorNameRequest.ProtoReflect.Descriptor instead.
+func (*UpdateByAuthorNameRequest) Descriptor() ([]byte, []int) {
+	return file_essay_essay_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateByAuthorNameRequest) GetAuthorname() string {
+	if x != nil {
+		return x.Authorname
+	}
+	return ""
+}
+
+func (x *UpdateByAuthorNameRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
 type SearchByContentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
@@ -359,7 +539,7 @@ type SearchByContentRequest struct {
 
 func (x *SearchByContentRequest) Reset() {
 	*x = SearchByContentRequest{}
-	mi := &file_essay_essay_proto_msgTypes[6]
+	mi := &file_essay_essay_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -371,7 +551,7 @@ func (x *SearchByContentRequest) String() string {
 func (*SearchByContentRequest) ProtoMessage() {}
 
 func (x *SearchByContentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_essay_essay_proto_msgTypes[6]
+	mi := &file_essay_essay_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -384,7 +564,7 @@ func (x *SearchByContentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchByContentRequest.ProtoReflect.Descriptor instead.
 func (*SearchByContentRequest) Descriptor() ([]byte, []int) {
-	return file_essay_essay_proto_rawDescGZIP(), []int{6}
+	return file_essay_essay_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SearchByContentRequest) GetContent() string {
@@ -398,21 +578,28 @@ var File_essay_essay_proto protoreflect.FileDescriptor
 
 const file_essay_essay_proto_rawDesc = "" +
 	"\n" +
-	"\x11essay/essay.proto\x12\x05essay\x1a\x13review/review.proto\"C\n" +
+	"\x11essay/essay.proto\x12\x05essay\x1a\x13review/review.proto\"h\n" +
 	"\x0fEssayAddRequest\x12\x18\n" +
 	"\acontent\x18\x01 \x01(\tR\acontent\x12\x16\n" +
-	"\x06author\x18\x02 \x01(\tR\x06author\"p\n" +
+	"\x06author\x18\x02 \x01(\tR\x06author\x12#\n" +
+	"\rassignment_id\x18\x03 \x01(\x03R\fassignmentId\"\x8e\x02\n" +
 	"\rEssayResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12\x16\n" +
 	"\x06author\x18\x03 \x01(\tR\x06author\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x04 \x01(\x03R\tcreatedAt\"\x0e\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\x12#\n" +
+	"\rassignment_id\x18\x05 \x01(\x03R\fassignmentId\x12\x1c\n" +
+	"\tanonymous\x18\x06 \x01(\bR\tanonymous\x12!\n" +
+	"\fauthor_alias\x18\a \x01(\tR\vauthorAlias\x126\n" +
+	"\freview_stats\x18\b \x01(\v2\x13.review.ReviewStatsR\vreviewStats\"\x0e\n" +
 	"\fEmptyRequest\"8\n" +
 	"\x16GetByAuthorNameRequest\x12\x1e\n" +
 	"\n" +
 	"authorname\x18\x01 \x01(\tR\n" +
-	"authorname\"\xca\x01\n" +
+	"authorname\" \n" +
+	"\x0eGetByIdRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xfd\x02\n" +
 	"\x18EssayWithReviewsResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12\x16\n" +
@@ -420,18 +607,31 @@ const file_essay_essay_proto_rawDesc = "" +
 	"\tauthor_id\x18\x04 \x01(\x05R\bauthorId\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x120\n" +
-	"\areviews\x18\x06 \x03(\v2\x16.review.ReviewResponseR\areviews\";\n" +
+	"\areviews\x18\x06 \x03(\v2\x16.review.ReviewResponseR\areviews\x12\x1a\n" +
+	"\brevision\x18\a \x01(\x05R\brevision\x12#\n" +
+	"\rassignment_id\x18\b \x01(\x03R\fassignmentId\x12\x1c\n" +
+	"\tanonymous\x18\t \x01(\bR\tanonymous\x12!\n" +
+	"\fauthor_alias\x18\n" +
+	" \x01(\tR\vauthorAlias\x12/\n" +
+	"\x13reviews_unavailable\x18\v \x01(\bR\x12reviewsUnavailable\";\n" +
 	"\x19RemoveByAuthorNameRequest\x12\x1e\n" +
 	"\n" +
 	"authorname\x18\x01 \x01(\tR\n" +
-	"authorname\"2\n" +
+	"authorname\"U\n" +
+	"\x19UpdateByAuthorNameRequest\x12\x1e\n" +
+	"\n" +
+	"authorname\x18\x01 \x01(\tR\n" +
+	"authorname\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"2\n" +
 	"\x16SearchByContentRequest\x12\x18\n" +
-	"\acontent\x18\x01 \x01(\tR\acontent2\xf5\x02\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent2\x8a\x04\n" +
 	"\fEssayService\x125\n" +
 	"\x03Add\x12\x16.essay.EssayAddRequest\x1a\x14.essay.EssayResponse\"\x00\x12=\n" +
 	"\fGetAllEssays\x12\x13.essay.EmptyRequest\x1a\x14.essay.EssayResponse\"\x000\x01\x12S\n" +
-	"\x0fGetByAuthorName\x12\x1d.essay.GetByAuthorNameRequest\x1a\x1f.essay.EssayWithReviewsResponse\"\x00\x12N\n" +
-	"\x12RemoveByAuthorName\x12 .essay.RemoveByAuthorNameRequest\x1a\x14.essay.EssayResponse\"\x00\x12J\n" +
+	"\x0fGetByAuthorName\x12\x1d.essay.GetByAuthorNameRequest\x1a\x1f.essay.EssayWithReviewsResponse\"\x00\x12C\n" +
+	"\aGetById\x12\x15.essay.GetByIdRequest\x1a\x1f.essay.EssayWithReviewsResponse\"\x00\x12N\n" +
+	"\x12RemoveByAuthorName\x12 .essay.RemoveByAuthorNameRequest\x1a\x14.essay.EssayResponse\"\x00\x12N\n" +
+	"\x12UpdateByAuthorName\x12 .essay.UpdateByAuthorNameRequest\x1a\x14.essay.EssayResponse\"\x00\x12J\n" +
 	"\x0fSearchByContent\x12\x1d.essay.SearchByContentRequest\x1a\x14.essay.EssayResponse\"\x000\x01B5Z3github.com/IAGrig/vt-csa-essays/backend/proto/essayb\x06proto3"
 
 var (
@@ -446,34 +646,42 @@ func file_essay_essay_proto_rawDescGZIP() []byte {
 	return file_essay_essay_proto_rawDescData
 }
 
-var file_essay_essay_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_essay_essay_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_essay_essay_proto_goTypes = []any{
 	(*EssayAddRequest)(nil),           // 0: essay.EssayAddRequest
 	(*EssayResponse)(nil),             // 1: essay.EssayResponse
 	(*EmptyRequest)(nil),              // 2: essay.EmptyRequest
 	(*GetByAuthorNameRequest)(nil),    // 3: essay.GetByAuthorNameRequest
-	(*EssayWithReviewsResponse)(nil),  // 4: essay.EssayWithReviewsResponse
-	(*RemoveByAuthorNameRequest)(nil), // 5: essay.RemoveByAuthorNameRequest
-	(*SearchByContentRequest)(nil),    // 6: essay.SearchByContentRequest
-	(*review.ReviewResponse)(nil),     // 7: review.ReviewResponse
+	(*GetByIdRequest)(nil),            // 4: essay.GetByIdRequest
+	(*EssayWithReviewsResponse)(nil),  // 5: essay.EssayWithReviewsResponse
+	(*RemoveByAuthorNameRequest)(nil), // 6: essay.RemoveByAuthorNameRequest
+	(*UpdateByAuthorNameRequest)(nil), // 7: essay.UpdateByAuthorNameRequest
+	(*SearchByContentRequest)(nil),    // 8: essay.SearchByContentRequest
+	(*review.ReviewStats)(nil),        // 9: review.ReviewStats
+	(*review.ReviewResponse)(nil),     // 10: review.ReviewResponse
 }
 var file_essay_essay_proto_depIdxs = []int32{
-	7, // 0: essay.EssayWithReviewsResponse.reviews:type_name -> review.ReviewResponse
-	0, // 1: essay.EssayService.Add:input_type -> essay.EssayAddRequest
-	2, // 2: essay.EssayService.GetAllEssays:input_type -> essay.EmptyRequest
-	3, // 3: essay.EssayService.GetByAuthorName:input_type -> essay.GetByAuthorNameRequest
-	5, // 4: essay.EssayService.RemoveByAuthorName:input_type -> essay.RemoveByAuthorNameRequest
-	6, // 5: essay.EssayService.SearchByContent:input_type -> essay.SearchByContentRequest
-	1, // 6: essay.EssayService.Add:output_type -> essay.EssayResponse
-	1, // 7: essay.EssayService.GetAllEssays:output_type -> essay.EssayResponse
-	4, // 8: essay.EssayService.GetByAuthorName:output_type -> essay.EssayWithReviewsResponse
-	1, // 9: essay.EssayService.RemoveByAuthorName:output_type -> essay.EssayResponse
-	1, // 10: essay.EssayService.SearchByContent:output_type -> essay.EssayResponse
-	6, // [6:11] is the sub-list for method output_type
-	1, // [1:6] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	9,  // 0: essay.EssayResponse.review_stats:type_name -> review.ReviewStats
+	10, // 1: essay.EssayWithReviewsResponse.reviews:type_name -> review.ReviewResponse
+	0,  // 2: essay.EssayService.Add:input_type -> essay.EssayAddRequest
+	2,  // 3: essay.EssayService.GetAllEssays:input_type -> essay.EmptyRequest
+	3,  // 4: essay.EssayService.GetByAuthorName:input_type -> essay.GetByAuthorNameRequest
+	4,  // 5: essay.EssayService.GetById:input_type -> essay.GetByIdRequest
+	6,  // 6: essay.EssayService.RemoveByAuthorName:input_type -> essay.RemoveByAuthorNameRequest
+	7,  // 7: essay.EssayService.UpdateByAuthorName:input_type -> essay.UpdateByAuthorNameRequest
+	8,  // 8: essay.EssayService.SearchByContent:input_type -> essay.SearchByContentRequest
+	1,  // 9: essay.EssayService.Add:output_type -> essay.EssayResponse
+	1,  // 10: essay.EssayService.GetAllEssays:output_type -> essay.EssayResponse
+	5,  // 11: essay.EssayService.GetByAuthorName:output_type -> essay.EssayWithReviewsResponse
+	5,  // 12: essay.EssayService.GetById:output_type -> essay.EssayWithReviewsResponse
+	1,  // 13: essay.EssayService.RemoveByAuthorName:output_type -> essay.EssayResponse
+	1,  // 14: essay.EssayService.UpdateByAuthorName:output_type -> essay.EssayResponse
+	1,  // 15: essay.EssayService.SearchByContent:output_type -> essay.EssayResponse
+	9,  // [9:16] is the sub-list for method output_type
+	2,  // [2:9] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_essay_essay_proto_init() }
@@ -487,7 +695,7 @@ func file_essay_essay_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_essay_essay_proto_rawDesc), len(file_essay_essay_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
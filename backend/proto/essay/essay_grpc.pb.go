@@ -22,7 +22,9 @@ const (
 	EssayService_Add_FullMethodName                = "/essay.EssayService/Add"
 	EssayService_GetAllEssays_FullMethodName       = "/essay.EssayService/GetAllEssays"
 	EssayService_GetByAuthorName_FullMethodName    = "/essay.EssayService/GetByAuthorName"
+	EssayService_GetById_FullMethodName            = "/essay.EssayService/GetById"
 	EssayService_RemoveByAuthorName_FullMethodName = "/essay.EssayService/RemoveByAuthorName"
+	EssayService_UpdateByAuthorName_FullMethodName = "/essay.EssayService/UpdateByAuthorName"
 	EssayService_SearchByContent_FullMethodName    = "/essay.EssayService/SearchByContent"
 )
 
@@ -33,7 +35,9 @@ type EssayServiceClient interface {
 	Add(ctx context.Context, in *EssayAddRequest, opts ...grpc.CallOption) (*EssayResponse, error)
 	GetAllEssays(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EssayResponse], error)
 	GetByAuthorName(ctx context.Context, in *GetByAuthorNameRequest, opts ...grpc.CallOption) (*EssayWithReviewsResponse, error)
+	GetById(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*EssayWithReviewsResponse, error)
 	RemoveByAuthorName(ctx context.Context, in *RemoveByAuthorNameRequest, opts ...grpc.CallOption) (*EssayResponse, error)
+	UpdateByAuthorName(ctx context.Context, in *UpdateByAuthorNameRequest, opts ...grpc.CallOption) (*EssayResponse, error)
 	SearchByContent(ctx context.Context, in *SearchByContentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EssayResponse], error)
 }
 
@@ -84,6 +88,16 @@ func (c *essayServiceClient) GetByAuthorName(ctx context.Context, in *GetByAutho
 	return out, nil
 }
 
+func (c *essayServiceClient) GetById(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*EssayWithReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EssayWithReviewsResponse)
+	err := c.cc.Invoke(ctx, EssayService_GetById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *essayServiceClient) RemoveByAuthorName(ctx context.Context, in *RemoveByAuthorNameRequest, opts ...grpc.CallOption) (*EssayResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EssayResponse)
@@ -94,6 +108,16 @@ func (c *essayServiceClient) RemoveByAuthorName(ctx context.Context, in *RemoveB
 	return out, nil
 }
 
+func (c *essayServiceClient) UpdateByAuthorName(ctx context.Context, in *UpdateByAuthorNameRequest, opts ...grpc.CallOption) (*EssayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EssayResponse)
+	err := c.cc.Invoke(ctx, EssayService_UpdateByAuthorName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *essayServiceClient) SearchByContent(ctx context.Context, in *SearchByContentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EssayResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &EssayService_ServiceDesc.Streams[1], EssayService_SearchByContent_FullMethodName, cOpts...)
@@ -120,7 +144,9 @@ type EssayServiceServer interface {
 	Add(context.Context, *EssayAddRequest) (*EssayResponse, error)
 	GetAllEssays(*EmptyRequest, grpc.ServerStreamingServer[EssayResponse]) error
 	GetByAuthorName(context.Context, *GetByAuthorNameRequest) (*EssayWithReviewsResponse, error)
+	GetById(context.Context, *GetByIdRequest) (*EssayWithReviewsResponse, error)
 	RemoveByAuthorName(context.Context, *RemoveByAuthorNameRequest) (*EssayResponse, error)
+	UpdateByAuthorName(context.Context, *UpdateByAuthorNameRequest) (*EssayResponse, error)
 	SearchByContent(*SearchByContentRequest, grpc.ServerStreamingServer[EssayResponse]) error
 	mustEmbedUnimplementedEssayServiceServer()
 }
@@ -141,9 +167,15 @@ func (UnimplementedEssayServiceServer) GetAllEssays(*EmptyRequest, grpc.ServerSt
 func (UnimplementedEssayServiceServer) GetByAuthorName(context.Context, *GetByAuthorNameRequest) (*EssayWithReviewsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetByAuthorName not implemented")
 }
+func (UnimplementedEssayServiceServer) GetById(context.Context, *GetByIdRequest) (*EssayWithReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetById not implemented")
+}
 func (UnimplementedEssayServiceServer) RemoveByAuthorName(context.Context, *RemoveByAuthorNameRequest) (*EssayResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveByAuthorName not implemented")
 }
+func (UnimplementedEssayServiceServer) UpdateByAuthorName(context.Context, *UpdateByAuthorNameRequest) (*EssayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateByAuthorName not implemented")
+}
 func (UnimplementedEssayServiceServer) SearchByContent(*SearchByContentRequest, grpc.ServerStreamingServer[EssayResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method SearchByContent not implemented")
 }
@@ -215,6 +247,24 @@ func _EssayService_GetByAuthorName_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EssayService_GetById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EssayServiceServer).GetById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EssayService_GetById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EssayServiceServer).GetById(ctx, req.(*GetByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EssayService_RemoveByAuthorName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemoveByAuthorNameRequest)
 	if err := dec(in); err != nil {
@@ -233,6 +283,24 @@ func _EssayService_RemoveByAuthorName_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EssayService_UpdateByAuthorName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateByAuthorNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EssayServiceServer).UpdateByAuthorName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EssayService_UpdateByAuthorName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EssayServiceServer).UpdateByAuthorName(ctx, req.(*UpdateByAuthorNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EssayService_SearchByContent_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SearchByContentRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -259,10 +327,18 @@ var EssayService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetByAuthorName",
 			Handler:    _EssayService_GetByAuthorName_Handler,
 		},
+		{
+			MethodName: "GetById",
+			Handler:    _EssayService_GetById_Handler,
+		},
 		{
 			MethodName: "RemoveByAuthorName",
 			Handler:    _EssayService_RemoveByAuthorName_Handler,
 		},
+		{
+			MethodName: "UpdateByAuthorName",
+			Handler:    _EssayService_UpdateByAuthorName_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -28,6 +28,13 @@ type ReviewAddRequest struct {
 	Rank          int32                  `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
 	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
 	Author        string                 `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	// Rubric reviews derive rank from the weighted total. Essays of an
+	// assignment with a rubric are scored with that one, 0 picks it
+	RubricId int64             `protobuf:"varint,6,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	Scores   []*CriterionScore `protobuf:"bytes,7,rep,name=scores,proto3" json:"scores,omitempty"`
+	// Essay revision the comment offsets refer to, 0 means the current one
+	EssayRevision int32            `protobuf:"varint,8,opt,name=essay_revision,json=essayRevision,proto3" json:"essay_revision,omitempty"`
+	Comments      []*InlineComment `protobuf:"bytes,9,rep,name=comments,proto3" json:"comments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -97,14 +104,54 @@ func (x *ReviewAddRequest) GetAuthor() string {
 	return ""
 }
 
+func (x *ReviewAddRequest) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *ReviewAddRequest) GetScores() []*CriterionScore {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *ReviewAddRequest) GetEssayRevision() int32 {
+	if x != nil {
+		return x.EssayRevision
+	}
+	return 0
+}
+
+func (x *ReviewAddRequest) GetComments() []*InlineComment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
 type ReviewResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	EssayId       int32                  `protobuf:"varint,2,opt,name=essay_id,json=essayId,proto3" json:"essay_id,omitempty"`
-	Rank          int32                  `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
-	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	Author        string                 `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EssayId   int32                  `protobuf:"varint,2,opt,name=essay_id,json=essayId,proto3" json:"essay_id,omitempty"`
+	Rank      int32                  `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	Content   string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Author    string                 `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	CreatedAt int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RubricId  int64                  `protobuf:"varint,7,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	// Weighted percentage of the rubric maximum, 0 for reviews without a rubric
+	TotalScore float64           `protobuf:"fixed64,8,opt,name=total_score,json=totalScore,proto3" json:"total_score,omitempty"`
+	Scores     []*CriterionScore `protobuf:"bytes,9,rep,name=scores,proto3" json:"scores,omitempty"`
+	Comments   []*InlineComment  `protobuf:"bytes,10,rep,name=comments,proto3" json:"comments,omitempty"`
+	// Set when the essay belongs to a double-blind assignment
+	Anonymous bool `protobuf:"varint,11,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	// Stable pseudonym of the author within the assignment, e.g. "Reviewer A"
+	AuthorAlias string `protobuf:"bytes,12,opt,name=author_alias,json=authorAlias,proto3" json:"author_alias,omitempty"`
+	Edited      bool   `protobuf:"varint,13,opt,name=edited,proto3" json:"edited,omitempty"`
+	// Time of the latest edit, 0 for reviews that were never edited
+	EditedAt      int64 `protobuf:"varint,14,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -181,26 +228,92 @@ func (x *ReviewResponse) GetCreatedAt() int64 {
 	return 0
 }
 
-type EmptyRequest struct {
+func (x *ReviewResponse) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *ReviewResponse) GetTotalScore() float64 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+func (x *ReviewResponse) GetScores() []*CriterionScore {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *ReviewResponse) GetComments() []*InlineComment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ReviewResponse) GetAnonymous() bool {
+	if x != nil {
+		return x.Anonymous
+	}
+	return false
+}
+
+func (x *ReviewResponse) GetAuthorAlias() string {
+	if x != nil {
+		return x.AuthorAlias
+	}
+	return ""
+}
+
+func (x *ReviewResponse) GetEdited() bool {
+	if x != nil {
+		return x.Edited
+	}
+	return false
+}
+
+func (x *ReviewResponse) GetEditedAt() int64 {
+	if x != nil {
+		return x.EditedAt
+	}
+	return 0
+}
+
+// Comment on the essay characters [start_offset, end_offset)
+type InlineComment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StartOffset   int32                  `protobuf:"varint,2,opt,name=start_offset,json=startOffset,proto3" json:"start_offset,omitempty"`
+	EndOffset     int32                  `protobuf:"varint,3,opt,name=end_offset,json=endOffset,proto3" json:"end_offset,omitempty"`
+	Quote         string                 `protobuf:"bytes,4,opt,name=quote,proto3" json:"quote,omitempty"`
+	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	EssayRevision int32                  `protobuf:"varint,6,opt,name=essay_revision,json=essayRevision,proto3" json:"essay_revision,omitempty"`
+	// Set when the quoted text can no longer be found in the essay
+	Orphaned      bool  `protobuf:"varint,7,opt,name=orphaned,proto3" json:"orphaned,omitempty"`
+	CreatedAt     int64 `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EmptyRequest) Reset() {
-	*x = EmptyRequest{}
+func (x *InlineComment) Reset() {
+	*x = InlineComment{}
 	mi := &file_review_review_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EmptyRequest) String() string {
+func (x *InlineComment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmptyRequest) ProtoMessage() {}
+func (*InlineComment) ProtoMessage() {}
 
-func (x *EmptyRequest) ProtoReflect() protoreflect.Message {
+func (x *InlineComment) ProtoReflect() protoreflect.Message {
 	mi := &file_review_review_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -212,32 +325,89 @@ func (x *EmptyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmptyRequest.ProtoReflect.Descriptor instead.
-func (*EmptyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InlineComment.ProtoReflect.Descriptor instead.
+func (*InlineComment) Descriptor() ([]byte, []int) {
 	return file_review_review_proto_rawDescGZIP(), []int{2}
 }
 
-type GetByEssayIdRequest struct {
+func (x *InlineComment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InlineComment) GetStartOffset() int32 {
+	if x != nil {
+		return x.StartOffset
+	}
+	return 0
+}
+
+func (x *InlineComment) GetEndOffset() int32 {
+	if x != nil {
+		return x.EndOffset
+	}
+	return 0
+}
+
+func (x *InlineComment) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+func (x *InlineComment) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *InlineComment) GetEssayRevision() int32 {
+	if x != nil {
+		return x.EssayRevision
+	}
+	return 0
+}
+
+func (x *InlineComment) GetOrphaned() bool {
+	if x != nil {
+		return x.Orphaned
+	}
+	return false
+}
+
+func (x *InlineComment) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// Sent once the essay text has changed
+type ReanchorCommentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EssayId       int32                  `protobuf:"varint,1,opt,name=essay_id,json=essayId,proto3" json:"essay_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetByEssayIdRequest) Reset() {
-	*x = GetByEssayIdRequest{}
+func (x *ReanchorCommentsRequest) Reset() {
+	*x = ReanchorCommentsRequest{}
 	mi := &file_review_review_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetByEssayIdRequest) String() string {
+func (x *ReanchorCommentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetByEssayIdRequest) ProtoMessage() {}
+func (*ReanchorCommentsRequest) ProtoMessage() {}
 
-func (x *GetByEssayIdRequest) ProtoReflect() protoreflect.Message {
+func (x *ReanchorCommentsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_review_review_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -249,39 +419,43 @@ func (x *GetByEssayIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetByEssayIdRequest.ProtoReflect.Descriptor instead.
-func (*GetByEssayIdRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReanchorCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ReanchorCommentsRequest) Descriptor() ([]byte, []int) {
 	return file_review_review_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetByEssayIdRequest) GetEssayId() int32 {
+func (x *ReanchorCommentsRequest) GetEssayId() int32 {
 	if x != nil {
 		return x.EssayId
 	}
 	return 0
 }
 
-type RemoveByIdRequest struct {
+type ReanchorCommentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EssayRevision int32                  `protobuf:"varint,1,opt,name=essay_revision,json=essayRevision,proto3" json:"essay_revision,omitempty"`
+	// Comments moved onto the new revision
+	Reanchored int32 `protobuf:"varint,2,opt,name=reanchored,proto3" json:"reanchored,omitempty"`
+	// Comments whose quote is gone from the new text
+	Orphaned      int32 `protobuf:"varint,3,opt,name=orphaned,proto3" json:"orphaned,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveByIdRequest) Reset() {
-	*x = RemoveByIdRequest{}
+func (x *ReanchorCommentsResponse) Reset() {
+	*x = ReanchorCommentsResponse{}
 	mi := &file_review_review_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveByIdRequest) String() string {
+func (x *ReanchorCommentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveByIdRequest) ProtoMessage() {}
+func (*ReanchorCommentsResponse) ProtoMessage() {}
 
-func (x *RemoveByIdRequest) ProtoReflect() protoreflect.Message {
+func (x *ReanchorCommentsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_review_review_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -293,48 +467,3038 @@ func (x *RemoveByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveByIdRequest.ProtoReflect.Descriptor instead.
-func (*RemoveByIdRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReanchorCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ReanchorCommentsResponse) Descriptor() ([]byte, []int) {
 	return file_review_review_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *RemoveByIdRequest) GetId() int32 {
+func (x *ReanchorCommentsResponse) GetEssayRevision() int32 {
+	if x != nil {
+		return x.EssayRevision
+	}
+	return 0
+}
+
+func (x *ReanchorCommentsResponse) GetReanchored() int32 {
+	if x != nil {
+		return x.Reanchored
+	}
+	return 0
+}
+
+func (x *ReanchorCommentsResponse) GetOrphaned() int32 {
+	if x != nil {
+		return x.Orphaned
+	}
+	return 0
+}
+
+type CriterionScore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CriterionId   int64                  `protobuf:"varint,1,opt,name=criterion_id,json=criterionId,proto3" json:"criterion_id,omitempty"`
+	Score         int32                  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	CriterionName string                 `protobuf:"bytes,4,opt,name=criterion_name,json=criterionName,proto3" json:"criterion_name,omitempty"`
+	Weight        float64                `protobuf:"fixed64,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	MinScore      int32                  `protobuf:"varint,6,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
+	MaxScore      int32                  `protobuf:"varint,7,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CriterionScore) Reset() {
+	*x = CriterionScore{}
+	mi := &file_review_review_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CriterionScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CriterionScore) ProtoMessage() {}
+
+func (x *CriterionScore) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CriterionScore.ProtoReflect.Descriptor instead.
+func (*CriterionScore) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CriterionScore) GetCriterionId() int64 {
+	if x != nil {
+		return x.CriterionId
+	}
+	return 0
+}
+
+func (x *CriterionScore) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *CriterionScore) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *CriterionScore) GetCriterionName() string {
+	if x != nil {
+		return x.CriterionName
+	}
+	return ""
+}
+
+func (x *CriterionScore) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *CriterionScore) GetMinScore() int32 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+func (x *CriterionScore) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+type Criterion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Weight        float64                `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	MinScore      int32                  `protobuf:"varint,5,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
+	MaxScore      int32                  `protobuf:"varint,6,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Criterion) Reset() {
+	*x = Criterion{}
+	mi := &file_review_review_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Criterion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Criterion) ProtoMessage() {}
+
+func (x *Criterion) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Criterion.ProtoReflect.Descriptor instead.
+func (*Criterion) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Criterion) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-var File_review_review_proto protoreflect.FileDescriptor
+func (x *Criterion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
 
-const file_review_review_proto_rawDesc = "" +
-	"\n" +
-	"\x13review/review.proto\x12\x06review\"\x9b\x01\n" +
-	"\x10ReviewAddRequest\x12\x19\n" +
-	"\bessay_id\x18\x01 \x01(\x05R\aessayId\x12&\n" +
-	"\x0fessay_author_id\x18\x02 \x01(\x05R\ressayAuthorId\x12\x12\n" +
-	"\x04rank\x18\x03 \x01(\x05R\x04rank\x12\x18\n" +
-	"\acontent\x18\x04 \x01(\tR\acontent\x12\x16\n" +
-	"\x06author\x18\x05 \x01(\tR\x06author\"\xa0\x01\n" +
-	"\x0eReviewResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
-	"\bessay_id\x18\x02 \x01(\x05R\aessayId\x12\x12\n" +
-	"\x04rank\x18\x03 \x01(\x05R\x04rank\x12\x18\n" +
-	"\acontent\x18\x04 \x01(\tR\acontent\x12\x16\n" +
-	"\x06author\x18\x05 \x01(\tR\x06author\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x06 \x01(\x03R\tcreatedAt\"\x0e\n" +
-	"\fEmptyRequest\"0\n" +
-	"\x13GetByEssayIdRequest\x12\x19\n" +
-	"\bessay_id\x18\x01 \x01(\x05R\aessayId\"#\n" +
-	"\x11RemoveByIdRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id2\x99\x02\n" +
-	"\rReviewService\x129\n" +
-	"\x03Add\x12\x18.review.ReviewAddRequest\x1a\x16.review.ReviewResponse\"\x00\x12A\n" +
-	"\rGetAllReviews\x12\x14.review.EmptyRequest\x1a\x16.review.ReviewResponse\"\x000\x01\x12G\n" +
-	"\fGetByEssayId\x12\x1b.review.GetByEssayIdRequest\x1a\x16.review.ReviewResponse\"\x000\x01\x12A\n" +
+func (x *Criterion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Criterion) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Criterion) GetMinScore() int32 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+func (x *Criterion) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+type CreateRubricRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,2,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Criteria      []*Criterion           `protobuf:"bytes,3,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRubricRequest) Reset() {
+	*x = CreateRubricRequest{}
+	mi := &file_review_review_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRubricRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRubricRequest) ProtoMessage() {}
+
+func (x *CreateRubricRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRubricRequest.ProtoReflect.Descriptor instead.
+func (*CreateRubricRequest) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateRubricRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateRubricRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CreateRubricRequest) GetCriteria() []*Criterion {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+type GetRubricRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRubricRequest) Reset() {
+	*x = GetRubricRequest{}
+	mi := &file_review_review_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRubricRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRubricRequest) ProtoMessage() {}
+
+func (x *GetRubricRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRubricRequest.ProtoReflect.Descriptor instead.
+func (*GetRubricRequest) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetRubricRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RubricResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Criteria      []*Criterion           `protobuf:"bytes,4,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RubricResponse) Reset() {
+	*x = RubricResponse{}
+	mi := &file_review_review_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RubricResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RubricResponse) ProtoMessage() {}
+
+func (x *RubricResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RubricResponse.ProtoReflect.Descriptor instead.
+func (*RubricResponse) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RubricResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RubricResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RubricResponse) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *RubricResponse) GetCriteria() []*Criterion {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *RubricResponse) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type EmptyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmptyRequest) Reset() {
+	*x = EmptyRequest{}
+	mi := &file_review_review_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmptyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyRequest) ProtoMessage() {}
+
+func (x *EmptyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyRequest.ProtoReflect.Descriptor instead.
+func (*EmptyRequest) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{10}
+}
+
+type GetByEssayIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EssayId       int32                  `protobuf:"varint,1,opt,name=essay_id,json=essayId,proto3" json:"essay_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetByEssayIdRequest) Reset() {
+	*x = GetByEssayIdRequest{}
+	mi := &file_review_review_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetByEssayIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByEssayIdRequest) ProtoMessage() {}
+
+func (x *GetByEssayIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByEssayIdRequest.ProtoReflect.Descriptor instead.
+func (*GetByEssayIdRequest) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetByEssayIdRequest) GetEssayId() int32 {
+	if x != nil {
+		return x.EssayId
+	}
+	return 0
+}
+
+// Newest reviews of an author first, zero filters are ignored
+type GetByAuthorRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Author  string                 `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	EssayId int32                  `protobuf:"varint,2,opt,name=essay_id,json=essayId,proto3" json:"essay_id,omitempty"`
+	MinRank int32                  `protobuf:"varint,3,opt,name=min_rank,json=minRank,proto3" json:"min_rank,omitempty"`
+	// Page size, 0 means the default of 50
+	Limit  int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Reviews of double-blind assignments are left out unless set, so their
+	// reviewer can't be found by name
+	IncludeAnonymous bool `protobuf:"varint,6,opt,name=include_anonymous,json=includeAnonymous,proto3" json:"include_anonymous,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetByAuthorRequest) Reset() {
+	*x = GetByAuthorRequest{}
+	mi := &file_review_review_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetByAuthorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByAuthorRequest) ProtoMessage() {}
+
+func (x *GetByAuthorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByAuthorRequest.ProtoReflect.Descriptor instead.
+func (*GetByAuthorRequest) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetByAuthorRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *GetByAuthorRequest) GetEssayId() int32 {
+	if x != nil {
+		return x.EssayId
+	}
+	return 0
+}
+
+func (x *GetByAuthorRequest) GetMinRank() int32 {
+	if x != nil {
+		return x.MinRank
+	}
+	return 0
+}
+
+func (x *GetByAuthorRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetByAuthorRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetByAuthorRequest) GetIncludeAnonymous() bool {
+	if x != nil {
+		return x.IncludeAnonymous
+	}
+	return false
+}
+
+type RemoveByIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveByIdRequest) Reset() {
+	*x = RemoveByIdRequest{}
+	mi := &file_review_review_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveByIdRequest) ProtoMessage() {}
+
+func (x *RemoveByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveByIdRequest.ProtoReflect.Descriptor instead.
+func (*RemoveByIdRequest) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RemoveByIdRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateReviewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Only the review author may edit the review
+	Author  string `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	Rank    int32  `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// Required for rubric reviews, the rank is derived from them
+	Scores        []*CriterionScore `protobuf:"bytes,5,rep,name=scores,proto3" json:"scores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReviewRequest) Reset() {
+	*x = UpdateReviewRequest{}
+	mi := &file_review_review_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReviewRequest) ProtoMessage() {}
+
+func (x *UpdateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReviewRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateReviewRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateReviewRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *UpdateReviewRequest) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *UpdateReviewRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *UpdateReviewRequest) GetScores() []*CriterionScore {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type GetReviewHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      int32                  `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewHistoryRequest) Reset() {
+	*x = GetReviewHistoryRequest{}
+	mi := &file_review_review_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewHistoryRequest) ProtoMessage() {}
+
+func (x *GetReviewHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetReviewHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetReviewHistoryRequest) GetReviewId() int32 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+type ReviewVersionResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReviewId   int32                  `protobuf:"varint,2,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Rank       int32                  `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	Content    string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	TotalScore float64                `protobuf:"fixed64,5,opt,name=total_score,json=totalScore,proto3" json:"total_score,omitempty"`
+	// When this version was written and when an edit replaced it
+	WrittenAt     int64 `protobuf:"varint,6,opt,name=written_at,json=writtenAt,proto3" json:"written_at,omitempty"`
+	ReplacedAt    int64 `protobuf:"varint,7,opt,name=replaced_at,json=replacedAt,proto3" json:"replaced_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewVersionResponse) Reset() {
+	*x = ReviewVersionResponse{}
+	mi := &file_review_review_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewVersionResponse) ProtoMessage() {}
+
+func (x *ReviewVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewVersionResponse.ProtoReflect.Descriptor instead.
+func (*ReviewVersionResponse) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReviewVersionResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReviewVersionResponse) GetReviewId() int32 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *ReviewVersionResponse) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *ReviewVersionResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ReviewVersionResponse) GetTotalScore() float64 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+func (x *ReviewVersionResponse) GetWrittenAt() int64 {
+	if x != nil {
+		return x.WrittenAt
+	}
+	return 0
+}
+
+func (x *ReviewVersionResponse) GetReplacedAt() int64 {
+	if x != nil {
+		return x.ReplacedAt
+	}
+	return 0
+}
+
+type AddReplyRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId int32                  `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	// Reply being answered, 0 starts a new thread under the review
+	ParentId      int64  `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Author        string `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Content       string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddReplyRequest) Reset() {
+	*x = AddReplyRequest{}
+	mi := &file_review_review_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddReplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddReplyRequest) ProtoMessage() {}
+
+func (x *AddReplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddReplyRequest.ProtoReflect.Descriptor instead.
+func (*AddReplyRequest) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AddReplyRequest) GetReviewId() int32 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *AddReplyRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *AddReplyRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *AddReplyRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type GetRepliesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      int32                  `protobuf:"varint,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRepliesRequest) Reset() {
+	*x = GetRepliesRequest{}
+	mi := &file_review_review_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRepliesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRepliesRequest) ProtoMessage() {}
+
+func (x *GetRepliesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRepliesRequest.ProtoReflect.Descriptor instead.
+func (*GetRepliesRequest) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetRepliesRequest) GetReviewId() int32 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+type RemoveReplyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Author        string                 `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveReplyRequest) Reset() {
+	*x = RemoveReplyRequest{}
+	mi := &file_review_review_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveReplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveReplyRequest) ProtoMessage() {}
+
+func (x *RemoveReplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveReplyRequest.ProtoReflect.Descriptor instead.
+func (*RemoveReplyRequest) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RemoveReplyRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RemoveReplyRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+type ReplyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReviewId      int32                  `protobuf:"varint,2,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	ParentId      int64                  `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Author        string                 `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Anonymous     bool                   `protobuf:"varint,7,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	AuthorAlias   string                 `protobuf:"bytes,8,opt,name=author_alias,json=authorAlias,proto3" json:"author_alias,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplyResponse) Reset() {
+	*x = ReplyResponse{}
+	mi := &file_review_review_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyResponse) ProtoMessage() {}
+
+func (x *ReplyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyResponse.ProtoReflect.Descriptor instead.
+func (*ReplyResponse) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReplyResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReplyResponse) GetReviewId() int32 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *ReplyResponse) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *ReplyResponse) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *ReplyResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ReplyResponse) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ReplyResponse) GetAnonymous() bool {
+	if x != nil {
+		return x.Anonymous
+	}
+	return false
+}
+
+func (x *ReplyResponse) GetAuthorAlias() string {
+	if x != nil {
+		return x.AuthorAlias
+	}
+	return ""
+}
+
+type CreateAssignmentRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Title     string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	CreatedBy string                 `protobuf:"bytes,2,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// Hides essay authors and reviewers from students behind pseudonyms
+	Anonymous bool `protobuf:"varint,3,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	// Rubric every review of the assignment's essays is scored with, 0 for none
+	RubricId      int64 `protobuf:"varint,4,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAssignmentRequest) Reset() {
+	*x = CreateAssignmentRequest{}
+	mi := &file_review_review_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAssignmentRequest) ProtoMessage() {}
+
+func (x *CreateAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateAssignmentRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateAssignmentRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CreateAssignmentRequest) GetAnonymous() bool {
+	if x != nil {
+		return x.Anonymous
+	}
+	return false
+}
+
+func (x *CreateAssignmentRequest) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+type GetAssignmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssignmentRequest) Reset() {
+	*x = GetAssignmentRequest{}
+	mi := &file_review_review_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssignmentRequest) ProtoMessage() {}
+
+func (x *GetAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*GetAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetAssignmentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateAssignmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Anonymous     bool                   `protobuf:"varint,3,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,4,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	RubricId      int64                  `protobuf:"varint,5,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAssignmentRequest) Reset() {
+	*x = UpdateAssignmentRequest{}
+	mi := &file_review_review_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAssignmentRequest) ProtoMessage() {}
+
+func (x *UpdateAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateAssignmentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateAssignmentRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateAssignmentRequest) GetAnonymous() bool {
+	if x != nil {
+		return x.Anonymous
+	}
+	return false
+}
+
+func (x *UpdateAssignmentRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *UpdateAssignmentRequest) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+type AssignmentResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title     string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	CreatedBy string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Anonymous bool                   `protobuf:"varint,4,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	CreatedAt int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Essay authors see their grades only once they are released
+	GradesReleased bool  `protobuf:"varint,6,opt,name=grades_released,json=gradesReleased,proto3" json:"grades_released,omitempty"`
+	RubricId       int64 `protobuf:"varint,7,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AssignmentResponse) Reset() {
+	*x = AssignmentResponse{}
+	mi := &file_review_review_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentResponse) ProtoMessage() {}
+
+func (x *AssignmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentResponse.ProtoReflect.Descriptor instead.
+func (*AssignmentResponse) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AssignmentResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AssignmentResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AssignmentResponse) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *AssignmentResponse) GetAnonymous() bool {
+	if x != nil {
+		return x.Anonymous
+	}
+	return false
+}
+
+func (x *AssignmentResponse) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *AssignmentResponse) GetGradesReleased() bool {
+	if x != nil {
+		return x.GradesReleased
+	}
+	return false
+}
+
+func (x *AssignmentResponse) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+type SetGradesReleasedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  int64                  `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	Released      bool                   `protobuf:"varint,2,opt,name=released,proto3" json:"released,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,3,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGradesReleasedRequest) Reset() {
+	*x = SetGradesReleasedRequest{}
+	mi := &file_review_review_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGradesReleasedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGradesReleasedRequest) ProtoMessage() {}
+
+func (x *SetGradesReleasedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGradesReleasedRequest.ProtoReflect.Descriptor instead.
+func (*SetGradesReleasedRequest) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetGradesReleasedRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *SetGradesReleasedRequest) GetReleased() bool {
+	if x != nil {
+		return x.Released
+	}
+	return false
+}
+
+func (x *SetGradesReleasedRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+type SetGradeRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EssayId int32                  `protobuf:"varint,1,opt,name=essay_id,json=essayId,proto3" json:"essay_id,omitempty"`
+	// Percentage in [0, 100]
+	Grade    float64 `protobuf:"fixed64,2,opt,name=grade,proto3" json:"grade,omitempty"`
+	Feedback string  `protobuf:"bytes,3,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	GradedBy string  `protobuf:"bytes,4,opt,name=graded_by,json=gradedBy,proto3" json:"graded_by,omitempty"`
+	// Uses the peer score as the grade instead of the grade field
+	SeedFromPeers bool `protobuf:"varint,5,opt,name=seed_from_peers,json=seedFromPeers,proto3" json:"seed_from_peers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGradeRequest) Reset() {
+	*x = SetGradeRequest{}
+	mi := &file_review_review_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGradeRequest) ProtoMessage() {}
+
+func (x *SetGradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGradeRequest.ProtoReflect.Descriptor instead.
+func (*SetGradeRequest) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetGradeRequest) GetEssayId() int32 {
+	if x != nil {
+		return x.EssayId
+	}
+	return 0
+}
+
+func (x *SetGradeRequest) GetGrade() float64 {
+	if x != nil {
+		return x.Grade
+	}
+	return 0
+}
+
+func (x *SetGradeRequest) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *SetGradeRequest) GetGradedBy() string {
+	if x != nil {
+		return x.GradedBy
+	}
+	return ""
+}
+
+func (x *SetGradeRequest) GetSeedFromPeers() bool {
+	if x != nil {
+		return x.SeedFromPeers
+	}
+	return false
+}
+
+type GetGradeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EssayId       int32                  `protobuf:"varint,1,opt,name=essay_id,json=essayId,proto3" json:"essay_id,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,2,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradeRequest) Reset() {
+	*x = GetGradeRequest{}
+	mi := &file_review_review_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradeRequest) ProtoMessage() {}
+
+func (x *GetGradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradeRequest.ProtoReflect.Descriptor instead.
+func (*GetGradeRequest) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetGradeRequest) GetEssayId() int32 {
+	if x != nil {
+		return x.EssayId
+	}
+	return 0
+}
+
+func (x *GetGradeRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+type GradeResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	EssayId  int32                  `protobuf:"varint,1,opt,name=essay_id,json=essayId,proto3" json:"essay_id,omitempty"`
+	Grade    float64                `protobuf:"fixed64,2,opt,name=grade,proto3" json:"grade,omitempty"`
+	Feedback string                 `protobuf:"bytes,3,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	GradedBy string                 `protobuf:"bytes,4,opt,name=graded_by,json=gradedBy,proto3" json:"graded_by,omitempty"`
+	GradedAt int64                  `protobuf:"varint,5,opt,name=graded_at,json=gradedAt,proto3" json:"graded_at,omitempty"`
+	// Mean weighted rubric total of the peer reviews, 0 when none was scored
+	// with a rubric
+	PeerScore       float64 `protobuf:"fixed64,6,opt,name=peer_score,json=peerScore,proto3" json:"peer_score,omitempty"`
+	PeerReviewCount int32   `protobuf:"varint,7,opt,name=peer_review_count,json=peerReviewCount,proto3" json:"peer_review_count,omitempty"`
+	Released        bool    `protobuf:"varint,8,opt,name=released,proto3" json:"released,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GradeResponse) Reset() {
+	*x = GradeResponse{}
+	mi := &file_review_review_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeResponse) ProtoMessage() {}
+
+func (x *GradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeResponse.ProtoReflect.Descriptor instead.
+func (*GradeResponse) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GradeResponse) GetEssayId() int32 {
+	if x != nil {
+		return x.EssayId
+	}
+	return 0
+}
+
+func (x *GradeResponse) GetGrade() float64 {
+	if x != nil {
+		return x.Grade
+	}
+	return 0
+}
+
+func (x *GradeResponse) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *GradeResponse) GetGradedBy() string {
+	if x != nil {
+		return x.GradedBy
+	}
+	return ""
+}
+
+func (x *GradeResponse) GetGradedAt() int64 {
+	if x != nil {
+		return x.GradedAt
+	}
+	return 0
+}
+
+func (x *GradeResponse) GetPeerScore() float64 {
+	if x != nil {
+		return x.PeerScore
+	}
+	return 0
+}
+
+func (x *GradeResponse) GetPeerReviewCount() int32 {
+	if x != nil {
+		return x.PeerReviewCount
+	}
+	return 0
+}
+
+func (x *GradeResponse) GetReleased() bool {
+	if x != nil {
+		return x.Released
+	}
+	return false
+}
+
+type GetGradebookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  int64                  `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,2,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradebookRequest) Reset() {
+	*x = GetGradebookRequest{}
+	mi := &file_review_review_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradebookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradebookRequest) ProtoMessage() {}
+
+func (x *GetGradebookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradebookRequest.ProtoReflect.Descriptor instead.
+func (*GetGradebookRequest) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetGradebookRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *GetGradebookRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+// One essay of the assignment, grade fields are empty unless graded is set
+type GradebookEntry struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	EssayId         int32                  `protobuf:"varint,1,opt,name=essay_id,json=essayId,proto3" json:"essay_id,omitempty"`
+	EssayAuthor     string                 `protobuf:"bytes,2,opt,name=essay_author,json=essayAuthor,proto3" json:"essay_author,omitempty"`
+	SubmittedAt     int64                  `protobuf:"varint,3,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	PeerReviewCount int32                  `protobuf:"varint,4,opt,name=peer_review_count,json=peerReviewCount,proto3" json:"peer_review_count,omitempty"`
+	PeerScore       float64                `protobuf:"fixed64,5,opt,name=peer_score,json=peerScore,proto3" json:"peer_score,omitempty"`
+	Graded          bool                   `protobuf:"varint,6,opt,name=graded,proto3" json:"graded,omitempty"`
+	Grade           float64                `protobuf:"fixed64,7,opt,name=grade,proto3" json:"grade,omitempty"`
+	Feedback        string                 `protobuf:"bytes,8,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	GradedBy        string                 `protobuf:"bytes,9,opt,name=graded_by,json=gradedBy,proto3" json:"graded_by,omitempty"`
+	GradedAt        int64                  `protobuf:"varint,10,opt,name=graded_at,json=gradedAt,proto3" json:"graded_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GradebookEntry) Reset() {
+	*x = GradebookEntry{}
+	mi := &file_review_review_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradebookEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradebookEntry) ProtoMessage() {}
+
+func (x *GradebookEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradebookEntry.ProtoReflect.Descriptor instead.
+func (*GradebookEntry) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GradebookEntry) GetEssayId() int32 {
+	if x != nil {
+		return x.EssayId
+	}
+	return 0
+}
+
+func (x *GradebookEntry) GetEssayAuthor() string {
+	if x != nil {
+		return x.EssayAuthor
+	}
+	return ""
+}
+
+func (x *GradebookEntry) GetSubmittedAt() int64 {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return 0
+}
+
+func (x *GradebookEntry) GetPeerReviewCount() int32 {
+	if x != nil {
+		return x.PeerReviewCount
+	}
+	return 0
+}
+
+func (x *GradebookEntry) GetPeerScore() float64 {
+	if x != nil {
+		return x.PeerScore
+	}
+	return 0
+}
+
+func (x *GradebookEntry) GetGraded() bool {
+	if x != nil {
+		return x.Graded
+	}
+	return false
+}
+
+func (x *GradebookEntry) GetGrade() float64 {
+	if x != nil {
+		return x.Grade
+	}
+	return 0
+}
+
+func (x *GradebookEntry) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *GradebookEntry) GetGradedBy() string {
+	if x != nil {
+		return x.GradedBy
+	}
+	return ""
+}
+
+func (x *GradebookEntry) GetGradedAt() int64 {
+	if x != nil {
+		return x.GradedAt
+	}
+	return 0
+}
+
+// Aggregates over a set of reviews, rank fields are 0 when there are none
+type ReviewStats struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ReviewCount int32                  `protobuf:"varint,1,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"`
+	MeanRank    float64                `protobuf:"fixed64,2,opt,name=mean_rank,json=meanRank,proto3" json:"mean_rank,omitempty"`
+	MedianRank  float64                `protobuf:"fixed64,3,opt,name=median_rank,json=medianRank,proto3" json:"median_rank,omitempty"`
+	// Number of reviews per rank
+	RankDistribution map[int32]int32 `protobuf:"bytes,4,rep,name=rank_distribution,json=rankDistribution,proto3" json:"rank_distribution,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// 0 when there are no reviews
+	LastReviewAt int64 `protobuf:"varint,5,opt,name=last_review_at,json=lastReviewAt,proto3" json:"last_review_at,omitempty"`
+	// Mean rank with every review weighted by the reliability of its author
+	WeightedMeanRank float64 `protobuf:"fixed64,6,opt,name=weighted_mean_rank,json=weightedMeanRank,proto3" json:"weighted_mean_rank,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReviewStats) Reset() {
+	*x = ReviewStats{}
+	mi := &file_review_review_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewStats) ProtoMessage() {}
+
+func (x *ReviewStats) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewStats.ProtoReflect.Descriptor instead.
+func (*ReviewStats) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ReviewStats) GetReviewCount() int32 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+func (x *ReviewStats) GetMeanRank() float64 {
+	if x != nil {
+		return x.MeanRank
+	}
+	return 0
+}
+
+func (x *ReviewStats) GetMedianRank() float64 {
+	if x != nil {
+		return x.MedianRank
+	}
+	return 0
+}
+
+func (x *ReviewStats) GetRankDistribution() map[int32]int32 {
+	if x != nil {
+		return x.RankDistribution
+	}
+	return nil
+}
+
+func (x *ReviewStats) GetLastReviewAt() int64 {
+	if x != nil {
+		return x.LastReviewAt
+	}
+	return 0
+}
+
+func (x *ReviewStats) GetWeightedMeanRank() float64 {
+	if x != nil {
+		return x.WeightedMeanRank
+	}
+	return 0
+}
+
+type GetEssayStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EssayId       int32                  `protobuf:"varint,1,opt,name=essay_id,json=essayId,proto3" json:"essay_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEssayStatsRequest) Reset() {
+	*x = GetEssayStatsRequest{}
+	mi := &file_review_review_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEssayStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEssayStatsRequest) ProtoMessage() {}
+
+func (x *GetEssayStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEssayStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetEssayStatsRequest) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetEssayStatsRequest) GetEssayId() int32 {
+	if x != nil {
+		return x.EssayId
+	}
+	return 0
+}
+
+type GetEssayStatsBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EssayIds      []int32                `protobuf:"varint,1,rep,packed,name=essay_ids,json=essayIds,proto3" json:"essay_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEssayStatsBatchRequest) Reset() {
+	*x = GetEssayStatsBatchRequest{}
+	mi := &file_review_review_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEssayStatsBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEssayStatsBatchRequest) ProtoMessage() {}
+
+func (x *GetEssayStatsBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEssayStatsBatchRequest.ProtoReflect.Descriptor instead.
+func (*GetEssayStatsBatchRequest) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetEssayStatsBatchRequest) GetEssayIds() []int32 {
+	if x != nil {
+		return x.EssayIds
+	}
+	return nil
+}
+
+type EssayStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EssayId       int32                  `protobuf:"varint,1,opt,name=essay_id,json=essayId,proto3" json:"essay_id,omitempty"`
+	Stats         *ReviewStats           `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EssayStatsResponse) Reset() {
+	*x = EssayStatsResponse{}
+	mi := &file_review_review_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EssayStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EssayStatsResponse) ProtoMessage() {}
+
+func (x *EssayStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EssayStatsResponse.ProtoReflect.Descriptor instead.
+func (*EssayStatsResponse) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *EssayStatsResponse) GetEssayId() int32 {
+	if x != nil {
+		return x.EssayId
+	}
+	return 0
+}
+
+func (x *EssayStatsResponse) GetStats() *ReviewStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type GetAssignmentStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  int64                  `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssignmentStatsRequest) Reset() {
+	*x = GetAssignmentStatsRequest{}
+	mi := &file_review_review_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssignmentStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssignmentStatsRequest) ProtoMessage() {}
+
+func (x *GetAssignmentStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssignmentStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetAssignmentStatsRequest) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetAssignmentStatsRequest) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+type AssignmentStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  int64                  `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	EssayCount    int32                  `protobuf:"varint,2,opt,name=essay_count,json=essayCount,proto3" json:"essay_count,omitempty"`
+	Stats         *ReviewStats           `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignmentStatsResponse) Reset() {
+	*x = AssignmentStatsResponse{}
+	mi := &file_review_review_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignmentStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentStatsResponse) ProtoMessage() {}
+
+func (x *AssignmentStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentStatsResponse.ProtoReflect.Descriptor instead.
+func (*AssignmentStatsResponse) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AssignmentStatsResponse) GetAssignmentId() int64 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *AssignmentStatsResponse) GetEssayCount() int32 {
+	if x != nil {
+		return x.EssayCount
+	}
+	return 0
+}
+
+func (x *AssignmentStatsResponse) GetStats() *ReviewStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type SetCalibrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EssayId       int32                  `protobuf:"varint,1,opt,name=essay_id,json=essayId,proto3" json:"essay_id,omitempty"`
+	ReferenceRank int32                  `protobuf:"varint,2,opt,name=reference_rank,json=referenceRank,proto3" json:"reference_rank,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,3,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCalibrationRequest) Reset() {
+	*x = SetCalibrationRequest{}
+	mi := &file_review_review_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCalibrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCalibrationRequest) ProtoMessage() {}
+
+func (x *SetCalibrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCalibrationRequest.ProtoReflect.Descriptor instead.
+func (*SetCalibrationRequest) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SetCalibrationRequest) GetEssayId() int32 {
+	if x != nil {
+		return x.EssayId
+	}
+	return 0
+}
+
+func (x *SetCalibrationRequest) GetReferenceRank() int32 {
+	if x != nil {
+		return x.ReferenceRank
+	}
+	return 0
+}
+
+func (x *SetCalibrationRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+type RemoveCalibrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EssayId       int32                  `protobuf:"varint,1,opt,name=essay_id,json=essayId,proto3" json:"essay_id,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,2,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCalibrationRequest) Reset() {
+	*x = RemoveCalibrationRequest{}
+	mi := &file_review_review_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCalibrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCalibrationRequest) ProtoMessage() {}
+
+func (x *RemoveCalibrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCalibrationRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCalibrationRequest) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RemoveCalibrationRequest) GetEssayId() int32 {
+	if x != nil {
+		return x.EssayId
+	}
+	return 0
+}
+
+func (x *RemoveCalibrationRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+type CalibrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EssayId       int32                  `protobuf:"varint,1,opt,name=essay_id,json=essayId,proto3" json:"essay_id,omitempty"`
+	ReferenceRank int32                  `protobuf:"varint,2,opt,name=reference_rank,json=referenceRank,proto3" json:"reference_rank,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalibrationResponse) Reset() {
+	*x = CalibrationResponse{}
+	mi := &file_review_review_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalibrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalibrationResponse) ProtoMessage() {}
+
+func (x *CalibrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalibrationResponse.ProtoReflect.Descriptor instead.
+func (*CalibrationResponse) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CalibrationResponse) GetEssayId() int32 {
+	if x != nil {
+		return x.EssayId
+	}
+	return 0
+}
+
+func (x *CalibrationResponse) GetReferenceRank() int32 {
+	if x != nil {
+		return x.ReferenceRank
+	}
+	return 0
+}
+
+func (x *CalibrationResponse) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CalibrationResponse) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// Agreement of a reviewer with the consensus and the calibration references, 1 is full agreement
+type ReviewerReliabilityResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Reviewer           string                 `protobuf:"bytes,1,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	Score              float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	ConsensusReviews   int32                  `protobuf:"varint,3,opt,name=consensus_reviews,json=consensusReviews,proto3" json:"consensus_reviews,omitempty"`
+	CalibrationReviews int32                  `protobuf:"varint,4,opt,name=calibration_reviews,json=calibrationReviews,proto3" json:"calibration_reviews,omitempty"`
+	ComputedAt         int64                  `protobuf:"varint,5,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ReviewerReliabilityResponse) Reset() {
+	*x = ReviewerReliabilityResponse{}
+	mi := &file_review_review_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewerReliabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewerReliabilityResponse) ProtoMessage() {}
+
+func (x *ReviewerReliabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewerReliabilityResponse.ProtoReflect.Descriptor instead.
+func (*ReviewerReliabilityResponse) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ReviewerReliabilityResponse) GetReviewer() string {
+	if x != nil {
+		return x.Reviewer
+	}
+	return ""
+}
+
+func (x *ReviewerReliabilityResponse) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ReviewerReliabilityResponse) GetConsensusReviews() int32 {
+	if x != nil {
+		return x.ConsensusReviews
+	}
+	return 0
+}
+
+func (x *ReviewerReliabilityResponse) GetCalibrationReviews() int32 {
+	if x != nil {
+		return x.CalibrationReviews
+	}
+	return 0
+}
+
+func (x *ReviewerReliabilityResponse) GetComputedAt() int64 {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return 0
+}
+
+// Replaces the whole draft, fields are only validated on submit
+type SaveDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EssayId       int32                  `protobuf:"varint,1,opt,name=essay_id,json=essayId,proto3" json:"essay_id,omitempty"`
+	Author        string                 `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	Rank          int32                  `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	RubricId      int64                  `protobuf:"varint,5,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	Scores        []*CriterionScore      `protobuf:"bytes,6,rep,name=scores,proto3" json:"scores,omitempty"`
+	EssayRevision int32                  `protobuf:"varint,7,opt,name=essay_revision,json=essayRevision,proto3" json:"essay_revision,omitempty"`
+	Comments      []*InlineComment       `protobuf:"bytes,8,rep,name=comments,proto3" json:"comments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveDraftRequest) Reset() {
+	*x = SaveDraftRequest{}
+	mi := &file_review_review_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveDraftRequest) ProtoMessage() {}
+
+func (x *SaveDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveDraftRequest.ProtoReflect.Descriptor instead.
+func (*SaveDraftRequest) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SaveDraftRequest) GetEssayId() int32 {
+	if x != nil {
+		return x.EssayId
+	}
+	return 0
+}
+
+func (x *SaveDraftRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *SaveDraftRequest) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *SaveDraftRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *SaveDraftRequest) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *SaveDraftRequest) GetScores() []*CriterionScore {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *SaveDraftRequest) GetEssayRevision() int32 {
+	if x != nil {
+		return x.EssayRevision
+	}
+	return 0
+}
+
+func (x *SaveDraftRequest) GetComments() []*InlineComment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+type GetDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EssayId       int32                  `protobuf:"varint,1,opt,name=essay_id,json=essayId,proto3" json:"essay_id,omitempty"`
+	Author        string                 `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDraftRequest) Reset() {
+	*x = GetDraftRequest{}
+	mi := &file_review_review_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDraftRequest) ProtoMessage() {}
+
+func (x *GetDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDraftRequest.ProtoReflect.Descriptor instead.
+func (*GetDraftRequest) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetDraftRequest) GetEssayId() int32 {
+	if x != nil {
+		return x.EssayId
+	}
+	return 0
+}
+
+func (x *GetDraftRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+type SubmitDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EssayId       int32                  `protobuf:"varint,1,opt,name=essay_id,json=essayId,proto3" json:"essay_id,omitempty"`
+	EssayAuthorId int32                  `protobuf:"varint,2,opt,name=essay_author_id,json=essayAuthorId,proto3" json:"essay_author_id,omitempty"`
+	Author        string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitDraftRequest) Reset() {
+	*x = SubmitDraftRequest{}
+	mi := &file_review_review_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitDraftRequest) ProtoMessage() {}
+
+func (x *SubmitDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitDraftRequest.ProtoReflect.Descriptor instead.
+func (*SubmitDraftRequest) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SubmitDraftRequest) GetEssayId() int32 {
+	if x != nil {
+		return x.EssayId
+	}
+	return 0
+}
+
+func (x *SubmitDraftRequest) GetEssayAuthorId() int32 {
+	if x != nil {
+		return x.EssayAuthorId
+	}
+	return 0
+}
+
+func (x *SubmitDraftRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+type DraftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EssayId       int32                  `protobuf:"varint,1,opt,name=essay_id,json=essayId,proto3" json:"essay_id,omitempty"`
+	Author        string                 `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	Rank          int32                  `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	RubricId      int64                  `protobuf:"varint,5,opt,name=rubric_id,json=rubricId,proto3" json:"rubric_id,omitempty"`
+	Scores        []*CriterionScore      `protobuf:"bytes,6,rep,name=scores,proto3" json:"scores,omitempty"`
+	EssayRevision int32                  `protobuf:"varint,7,opt,name=essay_revision,json=essayRevision,proto3" json:"essay_revision,omitempty"`
+	Comments      []*InlineComment       `protobuf:"bytes,8,rep,name=comments,proto3" json:"comments,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DraftResponse) Reset() {
+	*x = DraftResponse{}
+	mi := &file_review_review_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftResponse) ProtoMessage() {}
+
+func (x *DraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_review_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftResponse.ProtoReflect.Descriptor instead.
+func (*DraftResponse) Descriptor() ([]byte, []int) {
+	return file_review_review_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DraftResponse) GetEssayId() int32 {
+	if x != nil {
+		return x.EssayId
+	}
+	return 0
+}
+
+func (x *DraftResponse) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *DraftResponse) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *DraftResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *DraftResponse) GetRubricId() int64 {
+	if x != nil {
+		return x.RubricId
+	}
+	return 0
+}
+
+func (x *DraftResponse) GetScores() []*CriterionScore {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *DraftResponse) GetEssayRevision() int32 {
+	if x != nil {
+		return x.EssayRevision
+	}
+	return 0
+}
+
+func (x *DraftResponse) GetComments() []*InlineComment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *DraftResponse) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+var File_review_review_proto protoreflect.FileDescriptor
+
+const file_review_review_proto_rawDesc = "" +
+	"\n" +
+	"\x13review/review.proto\x12\x06review\"\xc2\x02\n" +
+	"\x10ReviewAddRequest\x12\x19\n" +
+	"\bessay_id\x18\x01 \x01(\x05R\aessayId\x12&\n" +
+	"\x0fessay_author_id\x18\x02 \x01(\x05R\ressayAuthorId\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\x05R\x04rank\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x16\n" +
+	"\x06author\x18\x05 \x01(\tR\x06author\x12\x1b\n" +
+	"\trubric_id\x18\x06 \x01(\x03R\brubricId\x12.\n" +
+	"\x06scores\x18\a \x03(\v2\x16.review.CriterionScoreR\x06scores\x12%\n" +
+	"\x0eessay_revision\x18\b \x01(\x05R\ressayRevision\x121\n" +
+	"\bcomments\x18\t \x03(\v2\x15.review.InlineCommentR\bcomments\"\xb7\x03\n" +
+	"\x0eReviewResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
+	"\bessay_id\x18\x02 \x01(\x05R\aessayId\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\x05R\x04rank\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x16\n" +
+	"\x06author\x18\x05 \x01(\tR\x06author\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12\x1b\n" +
+	"\trubric_id\x18\a \x01(\x03R\brubricId\x12\x1f\n" +
+	"\vtotal_score\x18\b \x01(\x01R\n" +
+	"totalScore\x12.\n" +
+	"\x06scores\x18\t \x03(\v2\x16.review.CriterionScoreR\x06scores\x121\n" +
+	"\bcomments\x18\n" +
+	" \x03(\v2\x15.review.InlineCommentR\bcomments\x12\x1c\n" +
+	"\tanonymous\x18\v \x01(\bR\tanonymous\x12!\n" +
+	"\fauthor_alias\x18\f \x01(\tR\vauthorAlias\x12\x16\n" +
+	"\x06edited\x18\r \x01(\bR\x06edited\x12\x1b\n" +
+	"\tedited_at\x18\x0e \x01(\x03R\beditedAt\"\xf3\x01\n" +
+	"\rInlineComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\fstart_offset\x18\x02 \x01(\x05R\vstartOffset\x12\x1d\n" +
+	"\n" +
+	"end_offset\x18\x03 \x01(\x05R\tendOffset\x12\x14\n" +
+	"\x05quote\x18\x04 \x01(\tR\x05quote\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12%\n" +
+	"\x0eessay_revision\x18\x06 \x01(\x05R\ressayRevision\x12\x1a\n" +
+	"\borphaned\x18\a \x01(\bR\borphaned\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\"4\n" +
+	"\x17ReanchorCommentsRequest\x12\x19\n" +
+	"\bessay_id\x18\x01 \x01(\x05R\aessayId\"}\n" +
+	"\x18ReanchorCommentsResponse\x12%\n" +
+	"\x0eessay_revision\x18\x01 \x01(\x05R\ressayRevision\x12\x1e\n" +
+	"\n" +
+	"reanchored\x18\x02 \x01(\x05R\n" +
+	"reanchored\x12\x1a\n" +
+	"\borphaned\x18\x03 \x01(\x05R\borphaned\"\xdc\x01\n" +
+	"\x0eCriterionScore\x12!\n" +
+	"\fcriterion_id\x18\x01 \x01(\x03R\vcriterionId\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x05R\x05score\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\x12%\n" +
+	"\x0ecriterion_name\x18\x04 \x01(\tR\rcriterionName\x12\x16\n" +
+	"\x06weight\x18\x05 \x01(\x01R\x06weight\x12\x1b\n" +
+	"\tmin_score\x18\x06 \x01(\x05R\bminScore\x12\x1b\n" +
+	"\tmax_score\x18\a \x01(\x05R\bmaxScore\"\xa3\x01\n" +
+	"\tCriterion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06weight\x18\x04 \x01(\x01R\x06weight\x12\x1b\n" +
+	"\tmin_score\x18\x05 \x01(\x05R\bminScore\x12\x1b\n" +
+	"\tmax_score\x18\x06 \x01(\x05R\bmaxScore\"y\n" +
+	"\x13CreateRubricRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x02 \x01(\tR\tcreatedBy\x12-\n" +
+	"\bcriteria\x18\x03 \x03(\v2\x11.review.CriterionR\bcriteria\"\"\n" +
+	"\x10GetRubricRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xa3\x01\n" +
+	"\x0eRubricResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\tR\tcreatedBy\x12-\n" +
+	"\bcriteria\x18\x04 \x03(\v2\x11.review.CriterionR\bcriteria\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\"\x0e\n" +
+	"\fEmptyRequest\"0\n" +
+	"\x13GetByEssayIdRequest\x12\x19\n" +
+	"\bessay_id\x18\x01 \x01(\x05R\aessayId\"\xbd\x01\n" +
+	"\x12GetByAuthorRequest\x12\x16\n" +
+	"\x06author\x18\x01 \x01(\tR\x06author\x12\x19\n" +
+	"\bessay_id\x18\x02 \x01(\x05R\aessayId\x12\x19\n" +
+	"\bmin_rank\x18\x03 \x01(\x05R\aminRank\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\x12+\n" +
+	"\x11include_anonymous\x18\x06 \x01(\bR\x10includeAnonymous\"#\n" +
+	"\x11RemoveByIdRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x9b\x01\n" +
+	"\x13UpdateReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06author\x18\x02 \x01(\tR\x06author\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\x05R\x04rank\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12.\n" +
+	"\x06scores\x18\x05 \x03(\v2\x16.review.CriterionScoreR\x06scores\"6\n" +
+	"\x17GetReviewHistoryRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\x05R\breviewId\"\xd3\x01\n" +
+	"\x15ReviewVersionResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\treview_id\x18\x02 \x01(\x05R\breviewId\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\x05R\x04rank\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1f\n" +
+	"\vtotal_score\x18\x05 \x01(\x01R\n" +
+	"totalScore\x12\x1d\n" +
+	"\n" +
+	"written_at\x18\x06 \x01(\x03R\twrittenAt\x12\x1f\n" +
+	"\vreplaced_at\x18\a \x01(\x03R\n" +
+	"replacedAt\"}\n" +
+	"\x0fAddReplyRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\x05R\breviewId\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\x03R\bparentId\x12\x16\n" +
+	"\x06author\x18\x03 \x01(\tR\x06author\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\"0\n" +
+	"\x11GetRepliesRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\x05R\breviewId\"<\n" +
+	"\x12RemoveReplyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06author\x18\x02 \x01(\tR\x06author\"\xeb\x01\n" +
+	"\rReplyResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\treview_id\x18\x02 \x01(\x05R\breviewId\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\x03R\bparentId\x12\x16\n" +
+	"\x06author\x18\x04 \x01(\tR\x06author\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12\x1c\n" +
+	"\tanonymous\x18\a \x01(\bR\tanonymous\x12!\n" +
+	"\fauthor_alias\x18\b \x01(\tR\vauthorAlias\"\x89\x01\n" +
+	"\x17CreateAssignmentRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x02 \x01(\tR\tcreatedBy\x12\x1c\n" +
+	"\tanonymous\x18\x03 \x01(\bR\tanonymous\x12\x1b\n" +
+	"\trubric_id\x18\x04 \x01(\x03R\brubricId\"&\n" +
+	"\x14GetAssignmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x9d\x01\n" +
+	"\x17UpdateAssignmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1c\n" +
+	"\tanonymous\x18\x03 \x01(\bR\tanonymous\x12!\n" +
+	"\frequested_by\x18\x04 \x01(\tR\vrequestedBy\x12\x1b\n" +
+	"\trubric_id\x18\x05 \x01(\x03R\brubricId\"\xdc\x01\n" +
+	"\x12AssignmentResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\tR\tcreatedBy\x12\x1c\n" +
+	"\tanonymous\x18\x04 \x01(\bR\tanonymous\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12'\n" +
+	"\x0fgrades_released\x18\x06 \x01(\bR\x0egradesReleased\x12\x1b\n" +
+	"\trubric_id\x18\a \x01(\x03R\brubricId\"~\n" +
+	"\x18SetGradesReleasedRequest\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\x03R\fassignmentId\x12\x1a\n" +
+	"\breleased\x18\x02 \x01(\bR\breleased\x12!\n" +
+	"\frequested_by\x18\x03 \x01(\tR\vrequestedBy\"\xa3\x01\n" +
+	"\x0fSetGradeRequest\x12\x19\n" +
+	"\bessay_id\x18\x01 \x01(\x05R\aessayId\x12\x14\n" +
+	"\x05grade\x18\x02 \x01(\x01R\x05grade\x12\x1a\n" +
+	"\bfeedback\x18\x03 \x01(\tR\bfeedback\x12\x1b\n" +
+	"\tgraded_by\x18\x04 \x01(\tR\bgradedBy\x12&\n" +
+	"\x0fseed_from_peers\x18\x05 \x01(\bR\rseedFromPeers\"O\n" +
+	"\x0fGetGradeRequest\x12\x19\n" +
+	"\bessay_id\x18\x01 \x01(\x05R\aessayId\x12!\n" +
+	"\frequested_by\x18\x02 \x01(\tR\vrequestedBy\"\xfd\x01\n" +
+	"\rGradeResponse\x12\x19\n" +
+	"\bessay_id\x18\x01 \x01(\x05R\aessayId\x12\x14\n" +
+	"\x05grade\x18\x02 \x01(\x01R\x05grade\x12\x1a\n" +
+	"\bfeedback\x18\x03 \x01(\tR\bfeedback\x12\x1b\n" +
+	"\tgraded_by\x18\x04 \x01(\tR\bgradedBy\x12\x1b\n" +
+	"\tgraded_at\x18\x05 \x01(\x03R\bgradedAt\x12\x1d\n" +
+	"\n" +
+	"peer_score\x18\x06 \x01(\x01R\tpeerScore\x12*\n" +
+	"\x11peer_review_count\x18\a \x01(\x05R\x0fpeerReviewCount\x12\x1a\n" +
+	"\breleased\x18\b \x01(\bR\breleased\"]\n" +
+	"\x13GetGradebookRequest\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\x03R\fassignmentId\x12!\n" +
+	"\frequested_by\x18\x02 \x01(\tR\vrequestedBy\"\xc0\x02\n" +
+	"\x0eGradebookEntry\x12\x19\n" +
+	"\bessay_id\x18\x01 \x01(\x05R\aessayId\x12!\n" +
+	"\fessay_author\x18\x02 \x01(\tR\vessayAuthor\x12!\n" +
+	"\fsubmitted_at\x18\x03 \x01(\x03R\vsubmittedAt\x12*\n" +
+	"\x11peer_review_count\x18\x04 \x01(\x05R\x0fpeerReviewCount\x12\x1d\n" +
+	"\n" +
+	"peer_score\x18\x05 \x01(\x01R\tpeerScore\x12\x16\n" +
+	"\x06graded\x18\x06 \x01(\bR\x06graded\x12\x14\n" +
+	"\x05grade\x18\a \x01(\x01R\x05grade\x12\x1a\n" +
+	"\bfeedback\x18\b \x01(\tR\bfeedback\x12\x1b\n" +
+	"\tgraded_by\x18\t \x01(\tR\bgradedBy\x12\x1b\n" +
+	"\tgraded_at\x18\n" +
+	" \x01(\x03R\bgradedAt\"\xdf\x02\n" +
+	"\vReviewStats\x12!\n" +
+	"\freview_count\x18\x01 \x01(\x05R\vreviewCount\x12\x1b\n" +
+	"\tmean_rank\x18\x02 \x01(\x01R\bmeanRank\x12\x1f\n" +
+	"\vmedian_rank\x18\x03 \x01(\x01R\n" +
+	"medianRank\x12V\n" +
+	"\x11rank_distribution\x18\x04 \x03(\v2).review.ReviewStats.RankDistributionEntryR\x10rankDistribution\x12$\n" +
+	"\x0elast_review_at\x18\x05 \x01(\x03R\flastReviewAt\x12,\n" +
+	"\x12weighted_mean_rank\x18\x06 \x01(\x01R\x10weightedMeanRank\x1aC\n" +
+	"\x15RankDistributionEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"1\n" +
+	"\x14GetEssayStatsRequest\x12\x19\n" +
+	"\bessay_id\x18\x01 \x01(\x05R\aessayId\"8\n" +
+	"\x19GetEssayStatsBatchRequest\x12\x1b\n" +
+	"\tessay_ids\x18\x01 \x03(\x05R\bessayIds\"Z\n" +
+	"\x12EssayStatsResponse\x12\x19\n" +
+	"\bessay_id\x18\x01 \x01(\x05R\aessayId\x12)\n" +
+	"\x05stats\x18\x02 \x01(\v2\x13.review.ReviewStatsR\x05stats\"@\n" +
+	"\x19GetAssignmentStatsRequest\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\x03R\fassignmentId\"\x8a\x01\n" +
+	"\x17AssignmentStatsResponse\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\x03R\fassignmentId\x12\x1f\n" +
+	"\vessay_count\x18\x02 \x01(\x05R\n" +
+	"essayCount\x12)\n" +
+	"\x05stats\x18\x03 \x01(\v2\x13.review.ReviewStatsR\x05stats\"|\n" +
+	"\x15SetCalibrationRequest\x12\x19\n" +
+	"\bessay_id\x18\x01 \x01(\x05R\aessayId\x12%\n" +
+	"\x0ereference_rank\x18\x02 \x01(\x05R\rreferenceRank\x12!\n" +
+	"\frequested_by\x18\x03 \x01(\tR\vrequestedBy\"X\n" +
+	"\x18RemoveCalibrationRequest\x12\x19\n" +
+	"\bessay_id\x18\x01 \x01(\x05R\aessayId\x12!\n" +
+	"\frequested_by\x18\x02 \x01(\tR\vrequestedBy\"\x95\x01\n" +
+	"\x13CalibrationResponse\x12\x19\n" +
+	"\bessay_id\x18\x01 \x01(\x05R\aessayId\x12%\n" +
+	"\x0ereference_rank\x18\x02 \x01(\x05R\rreferenceRank\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\"\xce\x01\n" +
+	"\x1bReviewerReliabilityResponse\x12\x1a\n" +
+	"\breviewer\x18\x01 \x01(\tR\breviewer\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12+\n" +
+	"\x11consensus_reviews\x18\x03 \x01(\x05R\x10consensusReviews\x12/\n" +
+	"\x13calibration_reviews\x18\x04 \x01(\x05R\x12calibrationReviews\x12\x1f\n" +
+	"\vcomputed_at\x18\x05 \x01(\x03R\n" +
+	"computedAt\"\x9a\x02\n" +
+	"\x10SaveDraftRequest\x12\x19\n" +
+	"\bessay_id\x18\x01 \x01(\x05R\aessayId\x12\x16\n" +
+	"\x06author\x18\x02 \x01(\tR\x06author\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\x05R\x04rank\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1b\n" +
+	"\trubric_id\x18\x05 \x01(\x03R\brubricId\x12.\n" +
+	"\x06scores\x18\x06 \x03(\v2\x16.review.CriterionScoreR\x06scores\x12%\n" +
+	"\x0eessay_revision\x18\a \x01(\x05R\ressayRevision\x121\n" +
+	"\bcomments\x18\b \x03(\v2\x15.review.InlineCommentR\bcomments\"D\n" +
+	"\x0fGetDraftRequest\x12\x19\n" +
+	"\bessay_id\x18\x01 \x01(\x05R\aessayId\x12\x16\n" +
+	"\x06author\x18\x02 \x01(\tR\x06author\"o\n" +
+	"\x12SubmitDraftRequest\x12\x19\n" +
+	"\bessay_id\x18\x01 \x01(\x05R\aessayId\x12&\n" +
+	"\x0fessay_author_id\x18\x02 \x01(\x05R\ressayAuthorId\x12\x16\n" +
+	"\x06author\x18\x03 \x01(\tR\x06author\"\xb6\x02\n" +
+	"\rDraftResponse\x12\x19\n" +
+	"\bessay_id\x18\x01 \x01(\x05R\aessayId\x12\x16\n" +
+	"\x06author\x18\x02 \x01(\tR\x06author\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\x05R\x04rank\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1b\n" +
+	"\trubric_id\x18\x05 \x01(\x03R\brubricId\x12.\n" +
+	"\x06scores\x18\x06 \x03(\v2\x16.review.CriterionScoreR\x06scores\x12%\n" +
+	"\x0eessay_revision\x18\a \x01(\x05R\ressayRevision\x121\n" +
+	"\bcomments\x18\b \x03(\v2\x15.review.InlineCommentR\bcomments\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\x03R\tupdatedAt2\xdc\x12\n" +
+	"\rReviewService\x129\n" +
+	"\x03Add\x12\x18.review.ReviewAddRequest\x1a\x16.review.ReviewResponse\"\x00\x12A\n" +
+	"\rGetAllReviews\x12\x14.review.EmptyRequest\x1a\x16.review.ReviewResponse\"\x000\x01\x12G\n" +
+	"\fGetByEssayId\x12\x1b.review.GetByEssayIdRequest\x1a\x16.review.ReviewResponse\"\x000\x01\x12E\n" +
+	"\vGetByAuthor\x12\x1a.review.GetByAuthorRequest\x1a\x16.review.ReviewResponse\"\x000\x01\x12A\n" +
+	"\n" +
+	"RemoveById\x12\x19.review.RemoveByIdRequest\x1a\x16.review.ReviewResponse\"\x00\x12E\n" +
+	"\fUpdateReview\x12\x1b.review.UpdateReviewRequest\x1a\x16.review.ReviewResponse\"\x00\x12V\n" +
+	"\x10GetReviewHistory\x12\x1f.review.GetReviewHistoryRequest\x1a\x1d.review.ReviewVersionResponse\"\x000\x01\x12K\n" +
+	"\rGetEssayStats\x12\x1c.review.GetEssayStatsRequest\x1a\x1a.review.EssayStatsResponse\"\x00\x12W\n" +
+	"\x12GetEssayStatsBatch\x12!.review.GetEssayStatsBatchRequest\x1a\x1a.review.EssayStatsResponse\"\x000\x01\x12Z\n" +
+	"\x12GetAssignmentStats\x12!.review.GetAssignmentStatsRequest\x1a\x1f.review.AssignmentStatsResponse\"\x00\x12E\n" +
+	"\fCreateRubric\x12\x1b.review.CreateRubricRequest\x1a\x16.review.RubricResponse\"\x00\x12?\n" +
+	"\tGetRubric\x12\x18.review.GetRubricRequest\x1a\x16.review.RubricResponse\"\x00\x12A\n" +
+	"\rGetAllRubrics\x12\x14.review.EmptyRequest\x1a\x16.review.RubricResponse\"\x000\x01\x12<\n" +
+	"\bAddReply\x12\x17.review.AddReplyRequest\x1a\x15.review.ReplyResponse\"\x00\x12B\n" +
 	"\n" +
-	"RemoveById\x12\x19.review.RemoveByIdRequest\x1a\x16.review.ReviewResponse\"\x00B6Z4github.com/IAGrig/vt-csa-essays/backend/proto/reviewb\x06proto3"
+	"GetReplies\x12\x19.review.GetRepliesRequest\x1a\x15.review.ReplyResponse\"\x000\x01\x12B\n" +
+	"\vRemoveReply\x12\x1a.review.RemoveReplyRequest\x1a\x15.review.ReplyResponse\"\x00\x12Q\n" +
+	"\x10CreateAssignment\x12\x1f.review.CreateAssignmentRequest\x1a\x1a.review.AssignmentResponse\"\x00\x12K\n" +
+	"\rGetAssignment\x12\x1c.review.GetAssignmentRequest\x1a\x1a.review.AssignmentResponse\"\x00\x12I\n" +
+	"\x11GetAllAssignments\x12\x14.review.EmptyRequest\x1a\x1a.review.AssignmentResponse\"\x000\x01\x12Q\n" +
+	"\x10UpdateAssignment\x12\x1f.review.UpdateAssignmentRequest\x1a\x1a.review.AssignmentResponse\"\x00\x12S\n" +
+	"\x11SetGradesReleased\x12 .review.SetGradesReleasedRequest\x1a\x1a.review.AssignmentResponse\"\x00\x12<\n" +
+	"\bSetGrade\x12\x17.review.SetGradeRequest\x1a\x15.review.GradeResponse\"\x00\x12<\n" +
+	"\bGetGrade\x12\x17.review.GetGradeRequest\x1a\x15.review.GradeResponse\"\x00\x12G\n" +
+	"\fGetGradebook\x12\x1b.review.GetGradebookRequest\x1a\x16.review.GradebookEntry\"\x000\x01\x12N\n" +
+	"\x0eSetCalibration\x12\x1d.review.SetCalibrationRequest\x1a\x1b.review.CalibrationResponse\"\x00\x12T\n" +
+	"\x11RemoveCalibration\x12 .review.RemoveCalibrationRequest\x1a\x1b.review.CalibrationResponse\"\x00\x12U\n" +
+	"\x14RecomputeReliability\x12\x14.review.EmptyRequest\x1a#.review.ReviewerReliabilityResponse\"\x000\x01\x12W\n" +
+	"\x16GetReviewerReliability\x12\x14.review.EmptyRequest\x1a#.review.ReviewerReliabilityResponse\"\x000\x01\x12>\n" +
+	"\tSaveDraft\x12\x18.review.SaveDraftRequest\x1a\x15.review.DraftResponse\"\x00\x12<\n" +
+	"\bGetDraft\x12\x17.review.GetDraftRequest\x1a\x15.review.DraftResponse\"\x00\x12C\n" +
+	"\vSubmitDraft\x12\x1a.review.SubmitDraftRequest\x1a\x16.review.ReviewResponse\"\x00\x12W\n" +
+	"\x10ReanchorComments\x12\x1f.review.ReanchorCommentsRequest\x1a .review.ReanchorCommentsResponse\"\x00B6Z4github.com/IAGrig/vt-csa-essays/backend/proto/reviewb\x06proto3"
 
 var (
 	file_review_review_proto_rawDescOnce sync.Once
@@ -348,28 +3512,139 @@ func file_review_review_proto_rawDescGZIP() []byte {
 	return file_review_review_proto_rawDescData
 }
 
-var file_review_review_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_review_review_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_review_review_proto_goTypes = []any{
-	(*ReviewAddRequest)(nil),    // 0: review.ReviewAddRequest
-	(*ReviewResponse)(nil),      // 1: review.ReviewResponse
-	(*EmptyRequest)(nil),        // 2: review.EmptyRequest
-	(*GetByEssayIdRequest)(nil), // 3: review.GetByEssayIdRequest
-	(*RemoveByIdRequest)(nil),   // 4: review.RemoveByIdRequest
+	(*ReviewAddRequest)(nil),            // 0: review.ReviewAddRequest
+	(*ReviewResponse)(nil),              // 1: review.ReviewResponse
+	(*InlineComment)(nil),               // 2: review.InlineComment
+	(*ReanchorCommentsRequest)(nil),     // 3: review.ReanchorCommentsRequest
+	(*ReanchorCommentsResponse)(nil),    // 4: review.ReanchorCommentsResponse
+	(*CriterionScore)(nil),              // 5: review.CriterionScore
+	(*Criterion)(nil),                   // 6: review.Criterion
+	(*CreateRubricRequest)(nil),         // 7: review.CreateRubricRequest
+	(*GetRubricRequest)(nil),            // 8: review.GetRubricRequest
+	(*RubricResponse)(nil),              // 9: review.RubricResponse
+	(*EmptyRequest)(nil),                // 10: review.EmptyRequest
+	(*GetByEssayIdRequest)(nil),         // 11: review.GetByEssayIdRequest
+	(*GetByAuthorRequest)(nil),          // 12: review.GetByAuthorRequest
+	(*RemoveByIdRequest)(nil),           // 13: review.RemoveByIdRequest
+	(*UpdateReviewRequest)(nil),         // 14: review.UpdateReviewRequest
+	(*GetReviewHistoryRequest)(nil),     // 15: review.GetReviewHistoryRequest
+	(*ReviewVersionResponse)(nil),       // 16: review.ReviewVersionResponse
+	(*AddReplyRequest)(nil),             // 17: review.AddReplyRequest
+	(*GetRepliesRequest)(nil),           // 18: review.GetRepliesRequest
+	(*RemoveReplyRequest)(nil),          // 19: review.RemoveReplyRequest
+	(*ReplyResponse)(nil),               // 20: review.ReplyResponse
+	(*CreateAssignmentRequest)(nil),     // 21: review.CreateAssignmentRequest
+	(*GetAssignmentRequest)(nil),        // 22: review.GetAssignmentRequest
+	(*UpdateAssignmentRequest)(nil),     // 23: review.UpdateAssignmentRequest
+	(*AssignmentResponse)(nil),          // 24: review.AssignmentResponse
+	(*SetGradesReleasedRequest)(nil),    // 25: review.SetGradesReleasedRequest
+	(*SetGradeRequest)(nil),             // 26: review.SetGradeRequest
+	(*GetGradeRequest)(nil),             // 27: review.GetGradeRequest
+	(*GradeResponse)(nil),               // 28: review.GradeResponse
+	(*GetGradebookRequest)(nil),         // 29: review.GetGradebookRequest
+	(*GradebookEntry)(nil),              // 30: review.GradebookEntry
+	(*ReviewStats)(nil),                 // 31: review.ReviewStats
+	(*GetEssayStatsRequest)(nil),        // 32: review.GetEssayStatsRequest
+	(*GetEssayStatsBatchRequest)(nil),   // 33: review.GetEssayStatsBatchRequest
+	(*EssayStatsResponse)(nil),          // 34: review.EssayStatsResponse
+	(*GetAssignmentStatsRequest)(nil),   // 35: review.GetAssignmentStatsRequest
+	(*AssignmentStatsResponse)(nil),     // 36: review.AssignmentStatsResponse
+	(*SetCalibrationRequest)(nil),       // 37: review.SetCalibrationRequest
+	(*RemoveCalibrationRequest)(nil),    // 38: review.RemoveCalibrationRequest
+	(*CalibrationResponse)(nil),         // 39: review.CalibrationResponse
+	(*ReviewerReliabilityResponse)(nil), // 40: review.ReviewerReliabilityResponse
+	(*SaveDraftRequest)(nil),            // 41: review.SaveDraftRequest
+	(*GetDraftRequest)(nil),             // 42: review.GetDraftRequest
+	(*SubmitDraftRequest)(nil),          // 43: review.SubmitDraftRequest
+	(*DraftResponse)(nil),               // 44: review.DraftResponse
+	nil,                                 // 45: review.ReviewStats.RankDistributionEntry
 }
 var file_review_review_proto_depIdxs = []int32{
-	0, // 0: review.ReviewService.Add:input_type -> review.ReviewAddRequest
-	2, // 1: review.ReviewService.GetAllReviews:input_type -> review.EmptyRequest
-	3, // 2: review.ReviewService.GetByEssayId:input_type -> review.GetByEssayIdRequest
-	4, // 3: review.ReviewService.RemoveById:input_type -> review.RemoveByIdRequest
-	1, // 4: review.ReviewService.Add:output_type -> review.ReviewResponse
-	1, // 5: review.ReviewService.GetAllReviews:output_type -> review.ReviewResponse
-	1, // 6: review.ReviewService.GetByEssayId:output_type -> review.ReviewResponse
-	1, // 7: review.ReviewService.RemoveById:output_type -> review.ReviewResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	5,  // 0: review.ReviewAddRequest.scores:type_name -> review.CriterionScore
+	2,  // 1: review.ReviewAddRequest.comments:type_name -> review.InlineComment
+	5,  // 2: review.ReviewResponse.scores:type_name -> review.CriterionScore
+	2,  // 3: review.ReviewResponse.comments:type_name -> review.InlineComment
+	6,  // 4: review.CreateRubricRequest.criteria:type_name -> review.Criterion
+	6,  // 5: review.RubricResponse.criteria:type_name -> review.Criterion
+	5,  // 6: review.UpdateReviewRequest.scores:type_name -> review.CriterionScore
+	45, // 7: review.ReviewStats.rank_distribution:type_name -> review.ReviewStats.RankDistributionEntry
+	31, // 8: review.EssayStatsResponse.stats:type_name -> review.ReviewStats
+	31, // 9: review.AssignmentStatsResponse.stats:type_name -> review.ReviewStats
+	5,  // 10: review.SaveDraftRequest.scores:type_name -> review.CriterionScore
+	2,  // 11: review.SaveDraftRequest.comments:type_name -> review.InlineComment
+	5,  // 12: review.DraftResponse.scores:type_name -> review.CriterionScore
+	2,  // 13: review.DraftResponse.comments:type_name -> review.InlineComment
+	0,  // 14: review.ReviewService.Add:input_type -> review.ReviewAddRequest
+	10, // 15: review.ReviewService.GetAllReviews:input_type -> review.EmptyRequest
+	11, // 16: review.ReviewService.GetByEssayId:input_type -> review.GetByEssayIdRequest
+	12, // 17: review.ReviewService.GetByAuthor:input_type -> review.GetByAuthorRequest
+	13, // 18: review.ReviewService.RemoveById:input_type -> review.RemoveByIdRequest
+	14, // 19: review.ReviewService.UpdateReview:input_type -> review.UpdateReviewRequest
+	15, // 20: review.ReviewService.GetReviewHistory:input_type -> review.GetReviewHistoryRequest
+	32, // 21: review.ReviewService.GetEssayStats:input_type -> review.GetEssayStatsRequest
+	33, // 22: review.ReviewService.GetEssayStatsBatch:input_type -> review.GetEssayStatsBatchRequest
+	35, // 23: review.ReviewService.GetAssignmentStats:input_type -> review.GetAssignmentStatsRequest
+	7,  // 24: review.ReviewService.CreateRubric:input_type -> review.CreateRubricRequest
+	8,  // 25: review.ReviewService.GetRubric:input_type -> review.GetRubricRequest
+	10, // 26: review.ReviewService.GetAllRubrics:input_type -> review.EmptyRequest
+	17, // 27: review.ReviewService.AddReply:input_type -> review.AddReplyRequest
+	18, // 28: review.ReviewService.GetReplies:input_type -> review.GetRepliesRequest
+	19, // 29: review.ReviewService.RemoveReply:input_type -> review.RemoveReplyRequest
+	21, // 30: review.ReviewService.CreateAssignment:input_type -> review.CreateAssignmentRequest
+	22, // 31: review.ReviewService.GetAssignment:input_type -> review.GetAssignmentRequest
+	10, // 32: review.ReviewService.GetAllAssignments:input_type -> review.EmptyRequest
+	23, // 33: review.ReviewService.UpdateAssignment:input_type -> review.UpdateAssignmentRequest
+	25, // 34: review.ReviewService.SetGradesReleased:input_type -> review.SetGradesReleasedRequest
+	26, // 35: review.ReviewService.SetGrade:input_type -> review.SetGradeRequest
+	27, // 36: review.ReviewService.GetGrade:input_type -> review.GetGradeRequest
+	29, // 37: review.ReviewService.GetGradebook:input_type -> review.GetGradebookRequest
+	37, // 38: review.ReviewService.SetCalibration:input_type -> review.SetCalibrationRequest
+	38, // 39: review.ReviewService.RemoveCalibration:input_type -> review.RemoveCalibrationRequest
+	10, // 40: review.ReviewService.RecomputeReliability:input_type -> review.EmptyRequest
+	10, // 41: review.ReviewService.GetReviewerReliability:input_type -> review.EmptyRequest
+	41, // 42: review.ReviewService.SaveDraft:input_type -> review.SaveDraftRequest
+	42, // 43: review.ReviewService.GetDraft:input_type -> review.GetDraftRequest
+	43, // 44: review.ReviewService.SubmitDraft:input_type -> review.SubmitDraftRequest
+	3,  // 45: review.ReviewService.ReanchorComments:input_type -> review.ReanchorCommentsRequest
+	1,  // 46: review.ReviewService.Add:output_type -> review.ReviewResponse
+	1,  // 47: review.ReviewService.GetAllReviews:output_type -> review.ReviewResponse
+	1,  // 48: review.ReviewService.GetByEssayId:output_type -> review.ReviewResponse
+	1,  // 49: review.ReviewService.GetByAuthor:output_type -> review.ReviewResponse
+	1,  // 50: review.ReviewService.RemoveById:output_type -> review.ReviewResponse
+	1,  // 51: review.ReviewService.UpdateReview:output_type -> review.ReviewResponse
+	16, // 52: review.ReviewService.GetReviewHistory:output_type -> review.ReviewVersionResponse
+	34, // 53: review.ReviewService.GetEssayStats:output_type -> review.EssayStatsResponse
+	34, // 54: review.ReviewService.GetEssayStatsBatch:output_type -> review.EssayStatsResponse
+	36, // 55: review.ReviewService.GetAssignmentStats:output_type -> review.AssignmentStatsResponse
+	9,  // 56: review.ReviewService.CreateRubric:output_type -> review.RubricResponse
+	9,  // 57: review.ReviewService.GetRubric:output_type -> review.RubricResponse
+	9,  // 58: review.ReviewService.GetAllRubrics:output_type -> review.RubricResponse
+	20, // 59: review.ReviewService.AddReply:output_type -> review.ReplyResponse
+	20, // 60: review.ReviewService.GetReplies:output_type -> review.ReplyResponse
+	20, // 61: review.ReviewService.RemoveReply:output_type -> review.ReplyResponse
+	24, // 62: review.ReviewService.CreateAssignment:output_type -> review.AssignmentResponse
+	24, // 63: review.ReviewService.GetAssignment:output_type -> review.AssignmentResponse
+	24, // 64: review.ReviewService.GetAllAssignments:output_type -> review.AssignmentResponse
+	24, // 65: review.ReviewService.UpdateAssignment:output_type -> review.AssignmentResponse
+	24, // 66: review.ReviewService.SetGradesReleased:output_type -> review.AssignmentResponse
+	28, // 67: review.ReviewService.SetGrade:output_type -> review.GradeResponse
+	28, // 68: review.ReviewService.GetGrade:output_type -> review.GradeResponse
+	30, // 69: review.ReviewService.GetGradebook:output_type -> review.GradebookEntry
+	39, // 70: review.ReviewService.SetCalibration:output_type -> review.CalibrationResponse
+	39, // 71: review.ReviewService.RemoveCalibration:output_type -> review.CalibrationResponse
+	40, // 72: review.ReviewService.RecomputeReliability:output_type -> review.ReviewerReliabilityResponse
+	40, // 73: review.ReviewService.GetReviewerReliability:output_type -> review.ReviewerReliabilityResponse
+	44, // 74: review.ReviewService.SaveDraft:output_type -> review.DraftResponse
+	44, // 75: review.ReviewService.GetDraft:output_type -> review.DraftResponse
+	1,  // 76: review.ReviewService.SubmitDraft:output_type -> review.ReviewResponse
+	4,  // 77: review.ReviewService.ReanchorComments:output_type -> review.ReanchorCommentsResponse
+	46, // [46:78] is the sub-list for method output_type
+	14, // [14:46] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_review_review_proto_init() }
@@ -383,7 +3658,7 @@ func file_review_review_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_review_review_proto_rawDesc), len(file_review_review_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
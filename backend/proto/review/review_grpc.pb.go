@@ -19,10 +19,38 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ReviewService_Add_FullMethodName           = "/review.ReviewService/Add"
-	ReviewService_GetAllReviews_FullMethodName = "/review.ReviewService/GetAllReviews"
-	ReviewService_GetByEssayId_FullMethodName  = "/review.ReviewService/GetByEssayId"
-	ReviewService_RemoveById_FullMethodName    = "/review.ReviewService/RemoveById"
+	ReviewService_Add_FullMethodName                    = "/review.ReviewService/Add"
+	ReviewService_GetAllReviews_FullMethodName          = "/review.ReviewService/GetAllReviews"
+	ReviewService_GetByEssayId_FullMethodName           = "/review.ReviewService/GetByEssayId"
+	ReviewService_GetByAuthor_FullMethodName            = "/review.ReviewService/GetByAuthor"
+	ReviewService_RemoveById_FullMethodName             = "/review.ReviewService/RemoveById"
+	ReviewService_UpdateReview_FullMethodName           = "/review.ReviewService/UpdateReview"
+	ReviewService_GetReviewHistory_FullMethodName       = "/review.ReviewService/GetReviewHistory"
+	ReviewService_GetEssayStats_FullMethodName          = "/review.ReviewService/GetEssayStats"
+	ReviewService_GetEssayStatsBatch_FullMethodName     = "/review.ReviewService/GetEssayStatsBatch"
+	ReviewService_GetAssignmentStats_FullMethodName     = "/review.ReviewService/GetAssignmentStats"
+	ReviewService_CreateRubric_FullMethodName           = "/review.ReviewService/CreateRubric"
+	ReviewService_GetRubric_FullMethodName              = "/review.ReviewService/GetRubric"
+	ReviewService_GetAllRubrics_FullMethodName          = "/review.ReviewService/GetAllRubrics"
+	ReviewService_AddReply_FullMethodName               = "/review.ReviewService/AddReply"
+	ReviewService_GetReplies_FullMethodName             = "/review.ReviewService/GetReplies"
+	ReviewService_RemoveReply_FullMethodName            = "/review.ReviewService/RemoveReply"
+	ReviewService_CreateAssignment_FullMethodName       = "/review.ReviewService/CreateAssignment"
+	ReviewService_GetAssignment_FullMethodName          = "/review.ReviewService/GetAssignment"
+	ReviewService_GetAllAssignments_FullMethodName      = "/review.ReviewService/GetAllAssignments"
+	ReviewService_UpdateAssignment_FullMethodName       = "/review.ReviewService/UpdateAssignment"
+	ReviewService_SetGradesReleased_FullMethodName      = "/review.ReviewService/SetGradesReleased"
+	ReviewService_SetGrade_FullMethodName               = "/review.ReviewService/SetGrade"
+	ReviewService_GetGrade_FullMethodName               = "/review.ReviewService/GetGrade"
+	ReviewService_GetGradebook_FullMethodName           = "/review.ReviewService/GetGradebook"
+	ReviewService_SetCalibration_FullMethodName         = "/review.ReviewService/SetCalibration"
+	ReviewService_RemoveCalibration_FullMethodName      = "/review.ReviewService/RemoveCalibration"
+	ReviewService_RecomputeReliability_FullMethodName   = "/review.ReviewService/RecomputeReliability"
+	ReviewService_GetReviewerReliability_FullMethodName = "/review.ReviewService/GetReviewerReliability"
+	ReviewService_SaveDraft_FullMethodName              = "/review.ReviewService/SaveDraft"
+	ReviewService_GetDraft_FullMethodName               = "/review.ReviewService/GetDraft"
+	ReviewService_SubmitDraft_FullMethodName            = "/review.ReviewService/SubmitDraft"
+	ReviewService_ReanchorComments_FullMethodName       = "/review.ReviewService/ReanchorComments"
 )
 
 // ReviewServiceClient is the client API for ReviewService service.
@@ -32,7 +60,35 @@ type ReviewServiceClient interface {
 	Add(ctx context.Context, in *ReviewAddRequest, opts ...grpc.CallOption) (*ReviewResponse, error)
 	GetAllReviews(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReviewResponse], error)
 	GetByEssayId(ctx context.Context, in *GetByEssayIdRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReviewResponse], error)
+	GetByAuthor(ctx context.Context, in *GetByAuthorRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReviewResponse], error)
 	RemoveById(ctx context.Context, in *RemoveByIdRequest, opts ...grpc.CallOption) (*ReviewResponse, error)
+	UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error)
+	GetReviewHistory(ctx context.Context, in *GetReviewHistoryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReviewVersionResponse], error)
+	GetEssayStats(ctx context.Context, in *GetEssayStatsRequest, opts ...grpc.CallOption) (*EssayStatsResponse, error)
+	GetEssayStatsBatch(ctx context.Context, in *GetEssayStatsBatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EssayStatsResponse], error)
+	GetAssignmentStats(ctx context.Context, in *GetAssignmentStatsRequest, opts ...grpc.CallOption) (*AssignmentStatsResponse, error)
+	CreateRubric(ctx context.Context, in *CreateRubricRequest, opts ...grpc.CallOption) (*RubricResponse, error)
+	GetRubric(ctx context.Context, in *GetRubricRequest, opts ...grpc.CallOption) (*RubricResponse, error)
+	GetAllRubrics(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RubricResponse], error)
+	AddReply(ctx context.Context, in *AddReplyRequest, opts ...grpc.CallOption) (*ReplyResponse, error)
+	GetReplies(ctx context.Context, in *GetRepliesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReplyResponse], error)
+	RemoveReply(ctx context.Context, in *RemoveReplyRequest, opts ...grpc.CallOption) (*ReplyResponse, error)
+	CreateAssignment(ctx context.Context, in *CreateAssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error)
+	GetAssignment(ctx context.Context, in *GetAssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error)
+	GetAllAssignments(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AssignmentResponse], error)
+	UpdateAssignment(ctx context.Context, in *UpdateAssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error)
+	SetGradesReleased(ctx context.Context, in *SetGradesReleasedRequest, opts ...grpc.CallOption) (*AssignmentResponse, error)
+	SetGrade(ctx context.Context, in *SetGradeRequest, opts ...grpc.CallOption) (*GradeResponse, error)
+	GetGrade(ctx context.Context, in *GetGradeRequest, opts ...grpc.CallOption) (*GradeResponse, error)
+	GetGradebook(ctx context.Context, in *GetGradebookRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GradebookEntry], error)
+	SetCalibration(ctx context.Context, in *SetCalibrationRequest, opts ...grpc.CallOption) (*CalibrationResponse, error)
+	RemoveCalibration(ctx context.Context, in *RemoveCalibrationRequest, opts ...grpc.CallOption) (*CalibrationResponse, error)
+	RecomputeReliability(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReviewerReliabilityResponse], error)
+	GetReviewerReliability(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReviewerReliabilityResponse], error)
+	SaveDraft(ctx context.Context, in *SaveDraftRequest, opts ...grpc.CallOption) (*DraftResponse, error)
+	GetDraft(ctx context.Context, in *GetDraftRequest, opts ...grpc.CallOption) (*DraftResponse, error)
+	SubmitDraft(ctx context.Context, in *SubmitDraftRequest, opts ...grpc.CallOption) (*ReviewResponse, error)
+	ReanchorComments(ctx context.Context, in *ReanchorCommentsRequest, opts ...grpc.CallOption) (*ReanchorCommentsResponse, error)
 }
 
 type reviewServiceClient struct {
@@ -91,6 +147,25 @@ func (c *reviewServiceClient) GetByEssayId(ctx context.Context, in *GetByEssayId
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ReviewService_GetByEssayIdClient = grpc.ServerStreamingClient[ReviewResponse]
 
+func (c *reviewServiceClient) GetByAuthor(ctx context.Context, in *GetByAuthorRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReviewResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ReviewService_ServiceDesc.Streams[2], ReviewService_GetByAuthor_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetByAuthorRequest, ReviewResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReviewService_GetByAuthorClient = grpc.ServerStreamingClient[ReviewResponse]
+
 func (c *reviewServiceClient) RemoveById(ctx context.Context, in *RemoveByIdRequest, opts ...grpc.CallOption) (*ReviewResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReviewResponse)
@@ -98,114 +173,1009 @@ func (c *reviewServiceClient) RemoveById(ctx context.Context, in *RemoveByIdRequ
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *reviewServiceClient) UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewResponse)
+	err := c.cc.Invoke(ctx, ReviewService_UpdateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) GetReviewHistory(ctx context.Context, in *GetReviewHistoryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReviewVersionResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ReviewService_ServiceDesc.Streams[3], ReviewService_GetReviewHistory_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetReviewHistoryRequest, ReviewVersionResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReviewService_GetReviewHistoryClient = grpc.ServerStreamingClient[ReviewVersionResponse]
+
+func (c *reviewServiceClient) GetEssayStats(ctx context.Context, in *GetEssayStatsRequest, opts ...grpc.CallOption) (*EssayStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EssayStatsResponse)
+	err := c.cc.Invoke(ctx, ReviewService_GetEssayStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) GetEssayStatsBatch(ctx context.Context, in *GetEssayStatsBatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EssayStatsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ReviewService_ServiceDesc.Streams[4], ReviewService_GetEssayStatsBatch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetEssayStatsBatchRequest, EssayStatsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReviewService_GetEssayStatsBatchClient = grpc.ServerStreamingClient[EssayStatsResponse]
+
+func (c *reviewServiceClient) GetAssignmentStats(ctx context.Context, in *GetAssignmentStatsRequest, opts ...grpc.CallOption) (*AssignmentStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignmentStatsResponse)
+	err := c.cc.Invoke(ctx, ReviewService_GetAssignmentStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) CreateRubric(ctx context.Context, in *CreateRubricRequest, opts ...grpc.CallOption) (*RubricResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RubricResponse)
+	err := c.cc.Invoke(ctx, ReviewService_CreateRubric_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) GetRubric(ctx context.Context, in *GetRubricRequest, opts ...grpc.CallOption) (*RubricResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RubricResponse)
+	err := c.cc.Invoke(ctx, ReviewService_GetRubric_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) GetAllRubrics(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RubricResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ReviewService_ServiceDesc.Streams[5], ReviewService_GetAllRubrics_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[EmptyRequest, RubricResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReviewService_GetAllRubricsClient = grpc.ServerStreamingClient[RubricResponse]
+
+func (c *reviewServiceClient) AddReply(ctx context.Context, in *AddReplyRequest, opts ...grpc.CallOption) (*ReplyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplyResponse)
+	err := c.cc.Invoke(ctx, ReviewService_AddReply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) GetReplies(ctx context.Context, in *GetRepliesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReplyResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ReviewService_ServiceDesc.Streams[6], ReviewService_GetReplies_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetRepliesRequest, ReplyResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReviewService_GetRepliesClient = grpc.ServerStreamingClient[ReplyResponse]
+
+func (c *reviewServiceClient) RemoveReply(ctx context.Context, in *RemoveReplyRequest, opts ...grpc.CallOption) (*ReplyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplyResponse)
+	err := c.cc.Invoke(ctx, ReviewService_RemoveReply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) CreateAssignment(ctx context.Context, in *CreateAssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignmentResponse)
+	err := c.cc.Invoke(ctx, ReviewService_CreateAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) GetAssignment(ctx context.Context, in *GetAssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignmentResponse)
+	err := c.cc.Invoke(ctx, ReviewService_GetAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) GetAllAssignments(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AssignmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ReviewService_ServiceDesc.Streams[7], ReviewService_GetAllAssignments_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[EmptyRequest, AssignmentResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReviewService_GetAllAssignmentsClient = grpc.ServerStreamingClient[AssignmentResponse]
+
+func (c *reviewServiceClient) UpdateAssignment(ctx context.Context, in *UpdateAssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignmentResponse)
+	err := c.cc.Invoke(ctx, ReviewService_UpdateAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) SetGradesReleased(ctx context.Context, in *SetGradesReleasedRequest, opts ...grpc.CallOption) (*AssignmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignmentResponse)
+	err := c.cc.Invoke(ctx, ReviewService_SetGradesReleased_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) SetGrade(ctx context.Context, in *SetGradeRequest, opts ...grpc.CallOption) (*GradeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GradeResponse)
+	err := c.cc.Invoke(ctx, ReviewService_SetGrade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) GetGrade(ctx context.Context, in *GetGradeRequest, opts ...grpc.CallOption) (*GradeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GradeResponse)
+	err := c.cc.Invoke(ctx, ReviewService_GetGrade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) GetGradebook(ctx context.Context, in *GetGradebookRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GradebookEntry], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ReviewService_ServiceDesc.Streams[8], ReviewService_GetGradebook_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetGradebookRequest, GradebookEntry]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReviewService_GetGradebookClient = grpc.ServerStreamingClient[GradebookEntry]
+
+func (c *reviewServiceClient) SetCalibration(ctx context.Context, in *SetCalibrationRequest, opts ...grpc.CallOption) (*CalibrationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalibrationResponse)
+	err := c.cc.Invoke(ctx, ReviewService_SetCalibration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) RemoveCalibration(ctx context.Context, in *RemoveCalibrationRequest, opts ...grpc.CallOption) (*CalibrationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalibrationResponse)
+	err := c.cc.Invoke(ctx, ReviewService_RemoveCalibration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) RecomputeReliability(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReviewerReliabilityResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ReviewService_ServiceDesc.Streams[9], ReviewService_RecomputeReliability_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[EmptyRequest, ReviewerReliabilityResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReviewService_RecomputeReliabilityClient = grpc.ServerStreamingClient[ReviewerReliabilityResponse]
+
+func (c *reviewServiceClient) GetReviewerReliability(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReviewerReliabilityResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ReviewService_ServiceDesc.Streams[10], ReviewService_GetReviewerReliability_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[EmptyRequest, ReviewerReliabilityResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReviewService_GetReviewerReliabilityClient = grpc.ServerStreamingClient[ReviewerReliabilityResponse]
+
+func (c *reviewServiceClient) SaveDraft(ctx context.Context, in *SaveDraftRequest, opts ...grpc.CallOption) (*DraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DraftResponse)
+	err := c.cc.Invoke(ctx, ReviewService_SaveDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) GetDraft(ctx context.Context, in *GetDraftRequest, opts ...grpc.CallOption) (*DraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DraftResponse)
+	err := c.cc.Invoke(ctx, ReviewService_GetDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) SubmitDraft(ctx context.Context, in *SubmitDraftRequest, opts ...grpc.CallOption) (*ReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewResponse)
+	err := c.cc.Invoke(ctx, ReviewService_SubmitDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) ReanchorComments(ctx context.Context, in *ReanchorCommentsRequest, opts ...grpc.CallOption) (*ReanchorCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReanchorCommentsResponse)
+	err := c.cc.Invoke(ctx, ReviewService_ReanchorComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReviewServiceServer is the server API for ReviewService service.
+// All implementations must embed UnimplementedReviewServiceServer
+// for forward compatibility.
+type ReviewServiceServer interface {
+	Add(context.Context, *ReviewAddRequest) (*ReviewResponse, error)
+	GetAllReviews(*EmptyRequest, grpc.ServerStreamingServer[ReviewResponse]) error
+	GetByEssayId(*GetByEssayIdRequest, grpc.ServerStreamingServer[ReviewResponse]) error
+	GetByAuthor(*GetByAuthorRequest, grpc.ServerStreamingServer[ReviewResponse]) error
+	RemoveById(context.Context, *RemoveByIdRequest) (*ReviewResponse, error)
+	UpdateReview(context.Context, *UpdateReviewRequest) (*ReviewResponse, error)
+	GetReviewHistory(*GetReviewHistoryRequest, grpc.ServerStreamingServer[ReviewVersionResponse]) error
+	GetEssayStats(context.Context, *GetEssayStatsRequest) (*EssayStatsResponse, error)
+	GetEssayStatsBatch(*GetEssayStatsBatchRequest, grpc.ServerStreamingServer[EssayStatsResponse]) error
+	GetAssignmentStats(context.Context, *GetAssignmentStatsRequest) (*AssignmentStatsResponse, error)
+	CreateRubric(context.Context, *CreateRubricRequest) (*RubricResponse, error)
+	GetRubric(context.Context, *GetRubricRequest) (*RubricResponse, error)
+	GetAllRubrics(*EmptyRequest, grpc.ServerStreamingServer[RubricResponse]) error
+	AddReply(context.Context, *AddReplyRequest) (*ReplyResponse, error)
+	GetReplies(*GetRepliesRequest, grpc.ServerStreamingServer[ReplyResponse]) error
+	RemoveReply(context.Context, *RemoveReplyRequest) (*ReplyResponse, error)
+	CreateAssignment(context.Context, *CreateAssignmentRequest) (*AssignmentResponse, error)
+	GetAssignment(context.Context, *GetAssignmentRequest) (*AssignmentResponse, error)
+	GetAllAssignments(*EmptyRequest, grpc.ServerStreamingServer[AssignmentResponse]) error
+	UpdateAssignment(context.Context, *UpdateAssignmentRequest) (*AssignmentResponse, error)
+	SetGradesReleased(context.Context, *SetGradesReleasedRequest) (*AssignmentResponse, error)
+	SetGrade(context.Context, *SetGradeRequest) (*GradeResponse, error)
+	GetGrade(context.Context, *GetGradeRequest) (*GradeResponse, error)
+	GetGradebook(*GetGradebookRequest, grpc.ServerStreamingServer[GradebookEntry]) error
+	SetCalibration(context.Context, *SetCalibrationRequest) (*CalibrationResponse, error)
+	RemoveCalibration(context.Context, *RemoveCalibrationRequest) (*CalibrationResponse, error)
+	RecomputeReliability(*EmptyRequest, grpc.ServerStreamingServer[ReviewerReliabilityResponse]) error
+	GetReviewerReliability(*EmptyRequest, grpc.ServerStreamingServer[ReviewerReliabilityResponse]) error
+	SaveDraft(context.Context, *SaveDraftRequest) (*DraftResponse, error)
+	GetDraft(context.Context, *GetDraftRequest) (*DraftResponse, error)
+	SubmitDraft(context.Context, *SubmitDraftRequest) (*ReviewResponse, error)
+	ReanchorComments(context.Context, *ReanchorCommentsRequest) (*ReanchorCommentsResponse, error)
+	mustEmbedUnimplementedReviewServiceServer()
+}
+
+// UnimplementedReviewServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReviewServiceServer struct{}
+
+func (UnimplementedReviewServiceServer) Add(context.Context, *ReviewAddRequest) (*ReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (UnimplementedReviewServiceServer) GetAllReviews(*EmptyRequest, grpc.ServerStreamingServer[ReviewResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetAllReviews not implemented")
+}
+func (UnimplementedReviewServiceServer) GetByEssayId(*GetByEssayIdRequest, grpc.ServerStreamingServer[ReviewResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetByEssayId not implemented")
+}
+func (UnimplementedReviewServiceServer) GetByAuthor(*GetByAuthorRequest, grpc.ServerStreamingServer[ReviewResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetByAuthor not implemented")
+}
+func (UnimplementedReviewServiceServer) RemoveById(context.Context, *RemoveByIdRequest) (*ReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveById not implemented")
+}
+func (UnimplementedReviewServiceServer) UpdateReview(context.Context, *UpdateReviewRequest) (*ReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReview not implemented")
+}
+func (UnimplementedReviewServiceServer) GetReviewHistory(*GetReviewHistoryRequest, grpc.ServerStreamingServer[ReviewVersionResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetReviewHistory not implemented")
+}
+func (UnimplementedReviewServiceServer) GetEssayStats(context.Context, *GetEssayStatsRequest) (*EssayStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEssayStats not implemented")
+}
+func (UnimplementedReviewServiceServer) GetEssayStatsBatch(*GetEssayStatsBatchRequest, grpc.ServerStreamingServer[EssayStatsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetEssayStatsBatch not implemented")
+}
+func (UnimplementedReviewServiceServer) GetAssignmentStats(context.Context, *GetAssignmentStatsRequest) (*AssignmentStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssignmentStats not implemented")
+}
+func (UnimplementedReviewServiceServer) CreateRubric(context.Context, *CreateRubricRequest) (*RubricResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRubric not implemented")
+}
+func (UnimplementedReviewServiceServer) GetRubric(context.Context, *GetRubricRequest) (*RubricResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRubric not implemented")
+}
+func (UnimplementedReviewServiceServer) GetAllRubrics(*EmptyRequest, grpc.ServerStreamingServer[RubricResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetAllRubrics not implemented")
+}
+func (UnimplementedReviewServiceServer) AddReply(context.Context, *AddReplyRequest) (*ReplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddReply not implemented")
+}
+func (UnimplementedReviewServiceServer) GetReplies(*GetRepliesRequest, grpc.ServerStreamingServer[ReplyResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetReplies not implemented")
+}
+func (UnimplementedReviewServiceServer) RemoveReply(context.Context, *RemoveReplyRequest) (*ReplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveReply not implemented")
+}
+func (UnimplementedReviewServiceServer) CreateAssignment(context.Context, *CreateAssignmentRequest) (*AssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAssignment not implemented")
+}
+func (UnimplementedReviewServiceServer) GetAssignment(context.Context, *GetAssignmentRequest) (*AssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssignment not implemented")
+}
+func (UnimplementedReviewServiceServer) GetAllAssignments(*EmptyRequest, grpc.ServerStreamingServer[AssignmentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetAllAssignments not implemented")
+}
+func (UnimplementedReviewServiceServer) UpdateAssignment(context.Context, *UpdateAssignmentRequest) (*AssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssignment not implemented")
+}
+func (UnimplementedReviewServiceServer) SetGradesReleased(context.Context, *SetGradesReleasedRequest) (*AssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGradesReleased not implemented")
+}
+func (UnimplementedReviewServiceServer) SetGrade(context.Context, *SetGradeRequest) (*GradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGrade not implemented")
+}
+func (UnimplementedReviewServiceServer) GetGrade(context.Context, *GetGradeRequest) (*GradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGrade not implemented")
+}
+func (UnimplementedReviewServiceServer) GetGradebook(*GetGradebookRequest, grpc.ServerStreamingServer[GradebookEntry]) error {
+	return status.Errorf(codes.Unimplemented, "method GetGradebook not implemented")
+}
+func (UnimplementedReviewServiceServer) SetCalibration(context.Context, *SetCalibrationRequest) (*CalibrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCalibration not implemented")
+}
+func (UnimplementedReviewServiceServer) RemoveCalibration(context.Context, *RemoveCalibrationRequest) (*CalibrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCalibration not implemented")
+}
+func (UnimplementedReviewServiceServer) RecomputeReliability(*EmptyRequest, grpc.ServerStreamingServer[ReviewerReliabilityResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method RecomputeReliability not implemented")
+}
+func (UnimplementedReviewServiceServer) GetReviewerReliability(*EmptyRequest, grpc.ServerStreamingServer[ReviewerReliabilityResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetReviewerReliability not implemented")
+}
+func (UnimplementedReviewServiceServer) SaveDraft(context.Context, *SaveDraftRequest) (*DraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveDraft not implemented")
+}
+func (UnimplementedReviewServiceServer) GetDraft(context.Context, *GetDraftRequest) (*DraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDraft not implemented")
+}
+func (UnimplementedReviewServiceServer) SubmitDraft(context.Context, *SubmitDraftRequest) (*ReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDraft not implemented")
+}
+func (UnimplementedReviewServiceServer) ReanchorComments(context.Context, *ReanchorCommentsRequest) (*ReanchorCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReanchorComments not implemented")
+}
+func (UnimplementedReviewServiceServer) mustEmbedUnimplementedReviewServiceServer() {}
+func (UnimplementedReviewServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeReviewServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReviewServiceServer will
+// result in compilation errors.
+type UnsafeReviewServiceServer interface {
+	mustEmbedUnimplementedReviewServiceServer()
+}
+
+func RegisterReviewServiceServer(s grpc.ServiceRegistrar, srv ReviewServiceServer) {
+	// If the following call pancis, it indicates UnimplementedReviewServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReviewService_ServiceDesc, srv)
+}
+
+func _ReviewService_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_Add_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).Add(ctx, req.(*ReviewAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_GetAllReviews_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EmptyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ReviewServiceServer).GetAllReviews(m, &grpc.GenericServerStream[EmptyRequest, ReviewResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReviewService_GetAllReviewsServer = grpc.ServerStreamingServer[ReviewResponse]
+
+func _ReviewService_GetByEssayId_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetByEssayIdRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ReviewServiceServer).GetByEssayId(m, &grpc.GenericServerStream[GetByEssayIdRequest, ReviewResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReviewService_GetByEssayIdServer = grpc.ServerStreamingServer[ReviewResponse]
+
+func _ReviewService_GetByAuthor_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetByAuthorRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ReviewServiceServer).GetByAuthor(m, &grpc.GenericServerStream[GetByAuthorRequest, ReviewResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReviewService_GetByAuthorServer = grpc.ServerStreamingServer[ReviewResponse]
+
+func _ReviewService_RemoveById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).RemoveById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_RemoveById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).RemoveById(ctx, req.(*RemoveByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).UpdateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_UpdateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).UpdateReview(ctx, req.(*UpdateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_GetReviewHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetReviewHistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ReviewServiceServer).GetReviewHistory(m, &grpc.GenericServerStream[GetReviewHistoryRequest, ReviewVersionResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReviewService_GetReviewHistoryServer = grpc.ServerStreamingServer[ReviewVersionResponse]
+
+func _ReviewService_GetEssayStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEssayStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).GetEssayStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_GetEssayStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).GetEssayStats(ctx, req.(*GetEssayStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_GetEssayStatsBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetEssayStatsBatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ReviewServiceServer).GetEssayStatsBatch(m, &grpc.GenericServerStream[GetEssayStatsBatchRequest, EssayStatsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReviewService_GetEssayStatsBatchServer = grpc.ServerStreamingServer[EssayStatsResponse]
+
+func _ReviewService_GetAssignmentStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssignmentStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).GetAssignmentStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_GetAssignmentStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).GetAssignmentStats(ctx, req.(*GetAssignmentStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_CreateRubric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRubricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).CreateRubric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_CreateRubric_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).CreateRubric(ctx, req.(*CreateRubricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_GetRubric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRubricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).GetRubric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_GetRubric_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).GetRubric(ctx, req.(*GetRubricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_GetAllRubrics_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EmptyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ReviewServiceServer).GetAllRubrics(m, &grpc.GenericServerStream[EmptyRequest, RubricResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReviewService_GetAllRubricsServer = grpc.ServerStreamingServer[RubricResponse]
+
+func _ReviewService_AddReply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddReplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).AddReply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_AddReply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).AddReply(ctx, req.(*AddReplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_GetReplies_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetRepliesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ReviewServiceServer).GetReplies(m, &grpc.GenericServerStream[GetRepliesRequest, ReplyResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReviewService_GetRepliesServer = grpc.ServerStreamingServer[ReplyResponse]
+
+func _ReviewService_RemoveReply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveReplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).RemoveReply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_RemoveReply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).RemoveReply(ctx, req.(*RemoveReplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_CreateAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).CreateAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_CreateAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).CreateAssignment(ctx, req.(*CreateAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// ReviewServiceServer is the server API for ReviewService service.
-// All implementations must embed UnimplementedReviewServiceServer
-// for forward compatibility.
-type ReviewServiceServer interface {
-	Add(context.Context, *ReviewAddRequest) (*ReviewResponse, error)
-	GetAllReviews(*EmptyRequest, grpc.ServerStreamingServer[ReviewResponse]) error
-	GetByEssayId(*GetByEssayIdRequest, grpc.ServerStreamingServer[ReviewResponse]) error
-	RemoveById(context.Context, *RemoveByIdRequest) (*ReviewResponse, error)
-	mustEmbedUnimplementedReviewServiceServer()
+func _ReviewService_GetAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).GetAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_GetAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).GetAssignment(ctx, req.(*GetAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedReviewServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedReviewServiceServer struct{}
+func _ReviewService_GetAllAssignments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EmptyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ReviewServiceServer).GetAllAssignments(m, &grpc.GenericServerStream[EmptyRequest, AssignmentResponse]{ServerStream: stream})
+}
 
-func (UnimplementedReviewServiceServer) Add(context.Context, *ReviewAddRequest) (*ReviewResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReviewService_GetAllAssignmentsServer = grpc.ServerStreamingServer[AssignmentResponse]
+
+func _ReviewService_UpdateAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).UpdateAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_UpdateAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).UpdateAssignment(ctx, req.(*UpdateAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedReviewServiceServer) GetAllReviews(*EmptyRequest, grpc.ServerStreamingServer[ReviewResponse]) error {
-	return status.Errorf(codes.Unimplemented, "method GetAllReviews not implemented")
+
+func _ReviewService_SetGradesReleased_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGradesReleasedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).SetGradesReleased(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_SetGradesReleased_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).SetGradesReleased(ctx, req.(*SetGradesReleasedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedReviewServiceServer) GetByEssayId(*GetByEssayIdRequest, grpc.ServerStreamingServer[ReviewResponse]) error {
-	return status.Errorf(codes.Unimplemented, "method GetByEssayId not implemented")
+
+func _ReviewService_SetGrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).SetGrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_SetGrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).SetGrade(ctx, req.(*SetGradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedReviewServiceServer) RemoveById(context.Context, *RemoveByIdRequest) (*ReviewResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveById not implemented")
+
+func _ReviewService_GetGrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).GetGrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_GetGrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).GetGrade(ctx, req.(*GetGradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedReviewServiceServer) mustEmbedUnimplementedReviewServiceServer() {}
-func (UnimplementedReviewServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafeReviewServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ReviewServiceServer will
-// result in compilation errors.
-type UnsafeReviewServiceServer interface {
-	mustEmbedUnimplementedReviewServiceServer()
+func _ReviewService_GetGradebook_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetGradebookRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ReviewServiceServer).GetGradebook(m, &grpc.GenericServerStream[GetGradebookRequest, GradebookEntry]{ServerStream: stream})
 }
 
-func RegisterReviewServiceServer(s grpc.ServiceRegistrar, srv ReviewServiceServer) {
-	// If the following call pancis, it indicates UnimplementedReviewServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReviewService_GetGradebookServer = grpc.ServerStreamingServer[GradebookEntry]
+
+func _ReviewService_SetCalibration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCalibrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ReviewService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).SetCalibration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_SetCalibration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).SetCalibration(ctx, req.(*SetCalibrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ReviewService_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReviewAddRequest)
+func _ReviewService_RemoveCalibration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCalibrationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ReviewServiceServer).Add(ctx, in)
+		return srv.(ReviewServiceServer).RemoveCalibration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ReviewService_Add_FullMethodName,
+		FullMethod: ReviewService_RemoveCalibration_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ReviewServiceServer).Add(ctx, req.(*ReviewAddRequest))
+		return srv.(ReviewServiceServer).RemoveCalibration(ctx, req.(*RemoveCalibrationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ReviewService_GetAllReviews_Handler(srv interface{}, stream grpc.ServerStream) error {
+func _ReviewService_RecomputeReliability_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(EmptyRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(ReviewServiceServer).GetAllReviews(m, &grpc.GenericServerStream[EmptyRequest, ReviewResponse]{ServerStream: stream})
+	return srv.(ReviewServiceServer).RecomputeReliability(m, &grpc.GenericServerStream[EmptyRequest, ReviewerReliabilityResponse]{ServerStream: stream})
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type ReviewService_GetAllReviewsServer = grpc.ServerStreamingServer[ReviewResponse]
+type ReviewService_RecomputeReliabilityServer = grpc.ServerStreamingServer[ReviewerReliabilityResponse]
 
-func _ReviewService_GetByEssayId_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetByEssayIdRequest)
+func _ReviewService_GetReviewerReliability_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EmptyRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(ReviewServiceServer).GetByEssayId(m, &grpc.GenericServerStream[GetByEssayIdRequest, ReviewResponse]{ServerStream: stream})
+	return srv.(ReviewServiceServer).GetReviewerReliability(m, &grpc.GenericServerStream[EmptyRequest, ReviewerReliabilityResponse]{ServerStream: stream})
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type ReviewService_GetByEssayIdServer = grpc.ServerStreamingServer[ReviewResponse]
+type ReviewService_GetReviewerReliabilityServer = grpc.ServerStreamingServer[ReviewerReliabilityResponse]
 
-func _ReviewService_RemoveById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveByIdRequest)
+func _ReviewService_SaveDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveDraftRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ReviewServiceServer).RemoveById(ctx, in)
+		return srv.(ReviewServiceServer).SaveDraft(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ReviewService_RemoveById_FullMethodName,
+		FullMethod: ReviewService_SaveDraft_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ReviewServiceServer).RemoveById(ctx, req.(*RemoveByIdRequest))
+		return srv.(ReviewServiceServer).SaveDraft(ctx, req.(*SaveDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_GetDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).GetDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_GetDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).GetDraft(ctx, req.(*GetDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_SubmitDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).SubmitDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_SubmitDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).SubmitDraft(ctx, req.(*SubmitDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_ReanchorComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReanchorCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).ReanchorComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_ReanchorComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).ReanchorComments(ctx, req.(*ReanchorCommentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -225,6 +1195,82 @@ var ReviewService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveById",
 			Handler:    _ReviewService_RemoveById_Handler,
 		},
+		{
+			MethodName: "UpdateReview",
+			Handler:    _ReviewService_UpdateReview_Handler,
+		},
+		{
+			MethodName: "GetEssayStats",
+			Handler:    _ReviewService_GetEssayStats_Handler,
+		},
+		{
+			MethodName: "GetAssignmentStats",
+			Handler:    _ReviewService_GetAssignmentStats_Handler,
+		},
+		{
+			MethodName: "CreateRubric",
+			Handler:    _ReviewService_CreateRubric_Handler,
+		},
+		{
+			MethodName: "GetRubric",
+			Handler:    _ReviewService_GetRubric_Handler,
+		},
+		{
+			MethodName: "AddReply",
+			Handler:    _ReviewService_AddReply_Handler,
+		},
+		{
+			MethodName: "RemoveReply",
+			Handler:    _ReviewService_RemoveReply_Handler,
+		},
+		{
+			MethodName: "CreateAssignment",
+			Handler:    _ReviewService_CreateAssignment_Handler,
+		},
+		{
+			MethodName: "GetAssignment",
+			Handler:    _ReviewService_GetAssignment_Handler,
+		},
+		{
+			MethodName: "UpdateAssignment",
+			Handler:    _ReviewService_UpdateAssignment_Handler,
+		},
+		{
+			MethodName: "SetGradesReleased",
+			Handler:    _ReviewService_SetGradesReleased_Handler,
+		},
+		{
+			MethodName: "SetGrade",
+			Handler:    _ReviewService_SetGrade_Handler,
+		},
+		{
+			MethodName: "GetGrade",
+			Handler:    _ReviewService_GetGrade_Handler,
+		},
+		{
+			MethodName: "SetCalibration",
+			Handler:    _ReviewService_SetCalibration_Handler,
+		},
+		{
+			MethodName: "RemoveCalibration",
+			Handler:    _ReviewService_RemoveCalibration_Handler,
+		},
+		{
+			MethodName: "SaveDraft",
+			Handler:    _ReviewService_SaveDraft_Handler,
+		},
+		{
+			MethodName: "GetDraft",
+			Handler:    _ReviewService_GetDraft_Handler,
+		},
+		{
+			MethodName: "SubmitDraft",
+			Handler:    _ReviewService_SubmitDraft_Handler,
+		},
+		{
+			MethodName: "ReanchorComments",
+			Handler:    _ReviewService_ReanchorComments_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -237,6 +1283,51 @@ var ReviewService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ReviewService_GetByEssayId_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetByAuthor",
+			Handler:       _ReviewService_GetByAuthor_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetReviewHistory",
+			Handler:       _ReviewService_GetReviewHistory_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetEssayStatsBatch",
+			Handler:       _ReviewService_GetEssayStatsBatch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetAllRubrics",
+			Handler:       _ReviewService_GetAllRubrics_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetReplies",
+			Handler:       _ReviewService_GetReplies_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetAllAssignments",
+			Handler:       _ReviewService_GetAllAssignments_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetGradebook",
+			Handler:       _ReviewService_GetGradebook_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RecomputeReliability",
+			Handler:       _ReviewService_RecomputeReliability_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetReviewerReliability",
+			Handler:       _ReviewService_GetReviewerReliability_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "review/review.proto",
 }
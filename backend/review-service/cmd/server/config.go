@@ -0,0 +1,18 @@
+package main
+
+import (
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/config"
+)
+
+// Loaded by config.Load, see it for the meaning of the tags
+type Config struct {
+	config.Server `yaml:",inline"`
+
+	GRPCPort                   string          `env:"REVIEW_SERVICE_GRPC_PORT" yaml:"grpc_port" required:"true"`
+	ReliabilityRefreshInterval time.Duration   `env:"RELIABILITY_REFRESH_INTERVAL" yaml:"reliability_refresh_interval" default:"10m"`
+	Kafka                      config.Kafka    `yaml:"kafka"`
+	Postgres                   config.Postgres `yaml:"postgres"`
+	TLS                        config.TLS      `yaml:"tls"`
+}
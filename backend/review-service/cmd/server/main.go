@@ -1,17 +1,24 @@
 package main
 
 import (
+	"context"
 	"net"
-	"os"
-	"os/signal"
-	"strings"
-	"syscall"
+	"net/http"
 
 	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/kafka"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/reliability"
 	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository"
 	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/service"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/config"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/grpcerr"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/grpcmw"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/health"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/lifecycle"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/monitoring"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/mtls"
+	pgutil "github.com/IAGrig/vt-csa-essays/backend/shared/pg_util"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/tracing"
 	"go.uber.org/zap"
 
 	"google.golang.org/grpc"
@@ -23,54 +30,101 @@ func main() {
 	logger := logging.New("review-service")
 	defer logger.Sync()
 
-	port := os.Getenv("REVIEW_SERVICE_GRPC_PORT")
-	kafkaBrokers := os.Getenv("KAFKA_BROKERS")
-	monitoringPort := os.Getenv("MONITORING_PORT")
+	var cfg Config
+	if err := config.Load(&cfg); err != nil {
+		logger.Fatal("Invalid configuration", zap.Error(err))
+	}
+	logger.Info("Starting review service")
+	config.Log(logger, cfg)
 
-	logger.Info("Starting review service",
-		zap.String("port", port),
-		zap.String("kafka_brokers", kafkaBrokers),
-		zap.String("monitoring_port", monitoringPort))
+	monitoring.StartMetricsServer(cfg.MonitoringPort)
 
-	monitoring.StartMetricsServer(monitoringPort)
+	lc := lifecycle.New(logger, cfg.ShutdownTimeout)
 
-	repo, err := repository.NewReviewPgRepository(logger)
+	certs, err := mtls.New(cfg.TLS, logger)
 	if err != nil {
-		logger.Fatal("Failed to create review repository",
-			zap.Error(err))
+		logger.Fatal("Failed to load TLS certificates", zap.Error(err))
 	}
+	lc.Go(certs.Watch)
 
-	brokers := strings.Split(kafkaBrokers, ",")
-	producer := kafka.NewProducer(brokers, "notifications", logger)
+	shutdownTracing, err := tracing.Init(context.Background(), "review-service")
+	if err != nil {
+		logger.Fatal("Failed to initialize tracing", zap.Error(err))
+	}
 
-	reviewService := service.New(repo, producer, logger)
+	pool, err := pgutil.GetPgxPool()
+	if err != nil {
+		logger.Fatal("Failed to connect to database",
+			zap.Error(err))
+	}
 
-	var opts []grpc.ServerOption
+	repo := repository.NewReviewPgRepository(pool, logger)
+	rubricRepo := repository.NewRubricPgRepository(pool, logger)
+	replyRepo := repository.NewReplyPgRepository(pool, logger)
+	assignmentRepo := repository.NewAssignmentPgRepository(pool, logger)
+	gradeRepo := repository.NewGradePgRepository(pool, logger)
+	reliabilityRepo := repository.NewReliabilityPgRepository(pool, logger)
+
+	producer := kafka.NewProducer(cfg.Kafka.Brokers, cfg.Kafka.Topic, logger)
+
+	reviewService := service.New(service.Repositories{
+		Reviews:     repo,
+		Rubrics:     rubricRepo,
+		Replies:     replyRepo,
+		Assignments: assignmentRepo,
+		Grades:      gradeRepo,
+		Reliability: reliabilityRepo,
+	}, producer, logger)
+
+	opts := []grpc.ServerOption{
+		certs.ServerOption(),
+		tracing.ServerOption(),
+		grpc.ChainUnaryInterceptor(
+			grpcmw.UnaryServerInterceptor("review", logger),
+			grpcerr.UnaryServerInterceptor(service.ErrorMappings, logger),
+		),
+		grpc.ChainStreamInterceptor(
+			grpcmw.StreamServerInterceptor("review", logger),
+			grpcerr.StreamServerInterceptor(service.ErrorMappings, logger),
+		),
+	}
 
 	grpcServer := grpc.NewServer(opts...)
 	pb.RegisterReviewServiceServer(grpcServer, reviewService)
 
-	lis, err := net.Listen("tcp", "0.0.0.0:"+port)
+	checker := health.New(pb.ReviewService_ServiceDesc.ServiceName, logger)
+	checker.Add("postgres", health.PingCheck(pool))
+	checker.Add("kafka", health.KafkaCheck(cfg.Kafka.Brokers, cfg.Kafka.Topic))
+	checker.Register(grpcServer)
+	checker.RegisterHTTP(http.DefaultServeMux)
+
+	lis, err := net.Listen("tcp", "0.0.0.0:"+cfg.GRPCPort)
 	if err != nil {
 		logger.Fatal("Failed to listen",
 			zap.Error(err),
-			zap.String("port", port))
+			zap.String("port", cfg.GRPCPort))
 	}
 
 	logger.Info("Review service starting",
-		zap.String("port", port))
-
-	go func() {
-		if err := grpcServer.Serve(lis); err != nil {
-			logger.Fatal("Failed to serve", zap.Error(err))
-		}
-	}()
+		zap.String("port", cfg.GRPCPort))
 
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
+	refresher := reliability.NewRefresher(reliabilityRepo, logger, cfg.ReliabilityRefreshInterval)
+	lc.Go(refresher.Start)
+	lc.Go(func(ctx context.Context) { checker.Start(ctx, cfg.HealthCheckInterval) })
 
-	logger.Info("Shutting down review service...")
-	grpcServer.GracefulStop()
+	lc.ServeGRPC(grpcServer, lis)
+	lc.OnShutdown("kafka producer", func(ctx context.Context) error {
+		if err := reviewService.Drain(ctx); err != nil {
+			return err
+		}
+		return producer.Close()
+	})
+	lc.OnShutdown("postgres", pgutil.ClosePools)
+	lc.OnShutdown("tracing", shutdownTracing)
+
+	if err := lc.Wait(); err != nil {
+		logger.Error("Review service stopped with errors", zap.Error(err))
+		return
+	}
 	logger.Info("Review service stopped")
 }
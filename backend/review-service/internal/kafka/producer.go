@@ -6,20 +6,29 @@ import (
 	"fmt"
 
 	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/segmentio/kafka-go"
 )
 
+// EssayAuthor is left empty for essays of anonymous assignments
 type NotificationEvent struct {
-	Type     string `json:"type"`
-	UserID   int64  `json:"user_id"`
-	Content  string `json:"content"`
-	EssayID  int64  `json:"essay_id"`
-	ReviewID int64  `json:"review_id"`
-	Author   string `json:"author"`
+	Type        string `json:"type"`
+	UserID      int64  `json:"user_id"`
+	Content     string `json:"content"`
+	EssayID     int64  `json:"essay_id"`
+	ReviewID    int64  `json:"review_id"`
+	ReplyID     int64  `json:"reply_id,omitempty"`
+	EssayAuthor string `json:"essay_author,omitempty"`
+	Author      string `json:"author"`
 }
 
+var tracer = otel.Tracer("github.com/IAGrig/vt-csa-essays/backend/review-service/internal/kafka")
+
 type Producer interface {
 	SendNotificationEvent(ctx context.Context, event NotificationEvent) error
 	Close() error
@@ -44,7 +53,7 @@ func NewProducer(brokers []string, topic string, logger *logging.Logger) Produce
 }
 
 func (p *KafkaProducer) SendNotificationEvent(ctx context.Context, event NotificationEvent) error {
-	logger := p.logger.With(
+	logger := p.logger.WithContext(ctx).With(
 		zap.String("operation", "send_notification_event"),
 		zap.String("type", event.Type),
 		zap.Int64("user_id", event.UserID),
@@ -53,6 +62,9 @@ func (p *KafkaProducer) SendNotificationEvent(ctx context.Context, event Notific
 		zap.String("author", event.Author),
 	)
 
+	ctx, span := tracer.Start(ctx, p.writer.Topic+" publish", trace.WithSpanKind(trace.SpanKindProducer))
+	defer span.End()
+
 	logger.Debug("Sending notification event to Kafka")
 
 	eventBytes, err := json.Marshal(event)
@@ -61,10 +73,18 @@ func (p *KafkaProducer) SendNotificationEvent(ctx context.Context, event Notific
 		return fmt.Errorf("failed to marshal event: %w", err)
 	}
 
-	err = p.writer.WriteMessages(ctx, kafka.Message{
+	msg := kafka.Message{
 		Value: eventBytes,
-	})
+	}
+	tracing.InjectKafka(ctx, &msg)
+	if requestID := logging.RequestID(ctx); requestID != "" {
+		msg.Headers = append(msg.Headers, kafka.Header{Key: logging.RequestIDHeader, Value: []byte(requestID)})
+	}
+
+	err = p.writer.WriteMessages(ctx, msg)
 	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to write message")
 		logger.Error("Failed to write message to Kafka", zap.Error(err))
 		return fmt.Errorf("failed to write message: %w", err)
 	}
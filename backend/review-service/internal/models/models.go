@@ -4,12 +4,76 @@ import "time"
 
 // Domain model
 type Review struct {
-	ID        int
-	EssayId   int
-	Rank      int
-	Content   string
-	Author    string
-	CreatedAt time.Time
+	ID         int
+	EssayId    int
+	Rank       int
+	Content    string
+	Author     string
+	RubricID   int64
+	TotalScore float64
+	Scores     []CriterionScore
+	Comments   []InlineComment
+	CreatedAt  time.Time
+	EditedAt   *time.Time
+	// Pseudonym shown instead of Author when the essay's assignment is anonymous
+	Anonymous   bool
+	AuthorAlias string
+}
+
+// Earlier version of an edited review
+type ReviewVersion struct {
+	ID         int64
+	ReviewID   int
+	Rank       int
+	Content    string
+	TotalScore float64
+	WrittenAt  time.Time
+	ReplacedAt time.Time
+}
+
+// Unsubmitted review, fields may be incomplete until it is submitted
+type ReviewDraft struct {
+	EssayID       int
+	Author        string
+	Rank          int
+	Content       string
+	RubricID      int64
+	Scores        []CriterionScore
+	EssayRevision int
+	Comments      []InlineComment
+	UpdatedAt     time.Time
+}
+
+// Filters for listing the reviews of one author, zero values are ignored
+type ReviewFilter struct {
+	Author           string
+	EssayID          int
+	MinRank          int
+	Limit            int
+	Offset           int
+	IncludeAnonymous bool
+}
+
+// Aggregates over a set of reviews, rank fields are 0 when there are none
+type ReviewStats struct {
+	ReviewCount      int
+	MeanRank         float64
+	WeightedMeanRank float64
+	MedianRank       float64
+	RankDistribution map[int]int
+	LastReviewAt     *time.Time
+}
+
+type EssayStats struct {
+	EssayID int
+	Stats   ReviewStats
+}
+
+// Review aggregates over all essays of an assignment
+type AssignmentStats struct {
+	AssignmentID int64
+	EssayCount   int
+	Stats        ReviewStats
 }
 
 // Get response
@@ -24,8 +88,187 @@ type ReviewResponse struct {
 
 // Add/update request DTO
 type ReviewRequest struct {
-	EssayId int    `json:"essayId" binding:"required,number"`
-	Rank    int    `json:"rank" binding:"required,number,gte=1,lte=3"`
-	Content string `json:"content" binding:"required"`
-	Author  string `json:"author" binding:"required"`
+	EssayId    int              `json:"essayId" binding:"required,number"`
+	Rank       int              `json:"rank" binding:"required,number,gte=1,lte=3"`
+	Content    string           `json:"content" binding:"required"`
+	Author     string           `json:"author" binding:"required"`
+	RubricID   int64            `json:"rubricId"`
+	TotalScore float64          `json:"totalScore"`
+	Scores     []CriterionScore `json:"scores"`
+	Comments   []InlineComment  `json:"comments"`
+}
+
+// Comment on the essay characters [StartOffset, EndOffset) of EssayRevision
+type InlineComment struct {
+	ID            int64
+	ReviewID      int
+	StartOffset   int
+	EndOffset     int
+	Quote         string
+	Content       string
+	EssayRevision int
+	Orphaned      bool
+	CreatedAt     time.Time
+}
+
+// Current essay text used to validate and re-anchor comments
+type EssayText struct {
+	EssayID  int
+	Content  string
+	Revision int
+}
+
+// Score given to one rubric criterion, criterion fields are filled on reads
+type CriterionScore struct {
+	CriterionID   int64
+	Score         int
+	Comment       string
+	CriterionName string
+	Weight        float64
+	MinScore      int
+	MaxScore      int
+}
+
+// Teacher defined grading scheme
+type Rubric struct {
+	ID        int64
+	Title     string
+	CreatedBy string
+	Criteria  []Criterion
+	CreatedAt time.Time
+}
+
+// One weighted rubric line scored on its own [MinScore, MaxScore] scale
+type Criterion struct {
+	ID          int64
+	Name        string
+	Description string
+	Weight      float64
+	MinScore    int
+	MaxScore    int
+}
+
+// Create rubric request DTO
+type RubricRequest struct {
+	Title     string
+	CreatedBy string
+	Criteria  []Criterion
+}
+
+// Message in the discussion under a review, ParentID is 0 for thread starters
+type Reply struct {
+	ID        int64
+	ReviewID  int
+	ParentID  int64
+	Author    string
+	Content   string
+	CreatedAt time.Time
+	// Filled from the review participants
+	Anonymous   bool
+	AuthorAlias string
+}
+
+// Add reply request DTO
+type ReplyRequest struct {
+	ReviewID int
+	ParentID int64
+	Author   string
+	Content  string
+}
+
+// Users allowed to take part in the discussion of a review
+type ReviewParticipants struct {
+	ReviewID      int
+	EssayID       int
+	Reviewer      string
+	ReviewerID    int64
+	EssayAuthor   string
+	EssayAuthorID int64
+	// Pseudonyms are set only for essays of anonymous assignments
+	Anonymous        bool
+	ReviewerAlias    string
+	EssayAuthorAlias string
+}
+
+// Group of essays reviewed under the same settings
+type Assignment struct {
+	ID             int64
+	Title          string
+	CreatedBy      string
+	Anonymous      bool
+	GradesReleased bool
+	CreatedAt      time.Time
+	// 0 when reviews may pick any rubric or none
+	RubricID int64
+}
+
+// Create/update assignment request DTO
+type AssignmentRequest struct {
+	Title     string
+	CreatedBy string
+	Anonymous bool
+	RubricID  int64
+}
+
+// Final teacher grade of an essay in percent
+type Grade struct {
+	EssayID  int
+	Grade    float64
+	Feedback string
+	GradedBy string
+	GradedAt time.Time
+}
+
+// Set grade request DTO
+type GradeRequest struct {
+	EssayID  int
+	Grade    float64
+	Feedback string
+	GradedBy string
+}
+
+// Essay facts that decide who may grade it and see the grade
+type GradingContext struct {
+	EssayID           int
+	EssayAuthor       string
+	AssignmentID      int64
+	AssignmentCreator string
+	GradesReleased    bool
+	PeerReviewCount   int
+	// Mean of the weighted rubric totals, nil without rubric scored peer reviews
+	PeerScore *float64
+}
+
+// Gradebook row, Grade is nil for essays that are not graded yet
+type GradebookEntry struct {
+	EssayID         int
+	EssayAuthor     string
+	SubmittedAt     time.Time
+	PeerReviewCount int
+	PeerScore       *float64
+	Grade           *Grade
+}
+
+// Essay with a teacher reference rank that reviewers are measured against
+type CalibrationEssay struct {
+	EssayID       int
+	ReferenceRank int
+	CreatedBy     string
+	CreatedAt     time.Time
+}
+
+// Set calibration request DTO
+type CalibrationRequest struct {
+	EssayID       int
+	ReferenceRank int
+	CreatedBy     string
+}
+
+// Agreement of a reviewer with the consensus and the calibration references in [0, 1]
+type ReviewerReliability struct {
+	Reviewer           string
+	Score              float64
+	ConsensusReviews   int
+	CalibrationReviews int
+	ComputedAt         time.Time
 }
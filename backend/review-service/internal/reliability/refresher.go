@@ -0,0 +1,55 @@
+package reliability
+
+import (
+	"context"
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"go.uber.org/zap"
+)
+
+// Periodically recomputes reviewer reliability so new reviews are taken into account
+type Refresher struct {
+	repository repository.ReliabilityRepository
+	logger     *logging.Logger
+	interval   time.Duration
+}
+
+func NewRefresher(repo repository.ReliabilityRepository, logger *logging.Logger, interval time.Duration) *Refresher {
+	return &Refresher{
+		repository: repo,
+		logger:     logger,
+		interval:   interval,
+	}
+}
+
+func (r *Refresher) Start(ctx context.Context) {
+	r.logger.Info("Starting reliability refresher",
+		zap.Duration("interval", r.interval))
+
+	r.Refresh(ctx)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			r.logger.Info("Stopping reliability refresher...")
+			return
+		case <-ticker.C:
+			r.Refresh(ctx)
+		}
+	}
+}
+
+func (r *Refresher) Refresh(ctx context.Context) {
+	reliability, err := r.repository.Recompute(ctx)
+	if err != nil {
+		r.logger.Error("Failed to refresh reviewer reliability", zap.Error(err))
+		return
+	}
+
+	r.logger.Debug("Reviewer reliability refreshed", zap.Int("count", len(reliability)))
+}
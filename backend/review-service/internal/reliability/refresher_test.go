@@ -0,0 +1,49 @@
+package reliability
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository/mocks"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRefresher_Start(t *testing.T) {
+	calls := make(chan struct{}, 10)
+	signal := func(mock.Arguments) { calls <- struct{}{} }
+
+	repo := new(mocks.MockReliabilityRepository)
+	repo.On("Recompute", mock.Anything, mock.Anything).Run(signal).
+		Return([]models.ReviewerReliability{{Reviewer: "alice", Score: 0.8}}, nil).Once()
+	// a failed refresh must not stop the ticker
+	repo.On("Recompute", mock.Anything, mock.Anything).Run(signal).
+		Return([]models.ReviewerReliability(nil), errors.New("connection refused"))
+
+	refresher := NewRefresher(repo, logging.NewEmptyLogger(), 10*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		refresher.Start(ctx)
+		close(done)
+	}()
+
+	for i := 0; i < 3; i++ {
+		select {
+		case <-calls:
+		case <-time.After(time.Second):
+			t.Fatalf("expected refresh %d", i+1)
+		}
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("refresher did not stop after cancellation")
+	}
+}
@@ -0,0 +1,225 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/pg_util"
+	"go.uber.org/zap"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AssignmentPgRepository struct {
+	db     *pgxpool.Pool
+	logger *logging.Logger
+}
+
+func NewAssignmentPgRepository(db *pgxpool.Pool, logger *logging.Logger) AssignmentRepository {
+	return &AssignmentPgRepository{db: db, logger: logger}
+}
+
+func (repository *AssignmentPgRepository) Create(ctx context.Context, request models.AssignmentRequest) (models.Assignment, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "create_assignment"),
+		zap.String("created_by", request.CreatedBy),
+	)
+
+	logger.Debug("Creating new assignment")
+
+	assignment := models.Assignment{
+		Title:     request.Title,
+		CreatedBy: request.CreatedBy,
+		Anonymous: request.Anonymous,
+		RubricID:  request.RubricID,
+	}
+	err := repository.db.QueryRow(ctx,
+		`INSERT INTO assignments (title, created_by, anonymous, rubric_id)
+		VALUES ($1, $2, $3, NULLIF($4, 0))
+		RETURNING assignment_id, created_at;`,
+		request.Title,
+		request.CreatedBy,
+		request.Anonymous,
+		request.RubricID,
+	).Scan(&assignment.ID, &assignment.CreatedAt)
+	if err != nil {
+		logger.Error("Failed to create assignment in database", zap.Error(err))
+		return models.Assignment{}, fmt.Errorf("failed to create assignment: %w", err)
+	}
+
+	logger.Info("Assignment created successfully", zap.Int64("assignment_id", assignment.ID))
+	return assignment, nil
+}
+
+func (repository *AssignmentPgRepository) GetByID(ctx context.Context, id int64) (models.Assignment, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "get_assignment_by_id"),
+		zap.Int64("assignment_id", id),
+	)
+
+	var assignment models.Assignment
+	err := repository.db.QueryRow(ctx,
+		`SELECT assignment_id, title, created_by, anonymous, grades_released, created_at, COALESCE(rubric_id, 0)
+		FROM assignments
+		WHERE assignment_id = $1;`,
+		id,
+	).Scan(&assignment.ID, &assignment.Title, &assignment.CreatedBy, &assignment.Anonymous, &assignment.GradesReleased, &assignment.CreatedAt, &assignment.RubricID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Debug("Assignment not found")
+			return models.Assignment{}, AssignmentNotFoundErr
+		}
+		logger.Error("Failed to get assignment from database", zap.Error(err))
+		return models.Assignment{}, fmt.Errorf("failed to get assignment: %w", err)
+	}
+
+	return assignment, nil
+}
+
+// Assignment the essay belongs to, AssignmentNotFoundErr for essays outside of any
+func (repository *AssignmentPgRepository) GetByEssayID(ctx context.Context, essayID int) (models.Assignment, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "get_assignment_by_essay_id"),
+		zap.Int("essay_id", essayID),
+	)
+
+	var assignment models.Assignment
+	err := repository.db.QueryRow(ctx,
+		`SELECT a.assignment_id, a.title, a.created_by, a.anonymous, a.grades_released, a.created_at, COALESCE(a.rubric_id, 0)
+		FROM essays e
+		JOIN assignments a ON a.assignment_id = e.assignment_id
+		WHERE e.essay_id = $1;`,
+		essayID,
+	).Scan(&assignment.ID, &assignment.Title, &assignment.CreatedBy, &assignment.Anonymous, &assignment.GradesReleased, &assignment.CreatedAt, &assignment.RubricID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Debug("Essay has no assignment")
+			return models.Assignment{}, AssignmentNotFoundErr
+		}
+		logger.Error("Failed to get assignment of essay from database", zap.Error(err))
+		return models.Assignment{}, fmt.Errorf("failed to get assignment of essay: %w", err)
+	}
+
+	return assignment, nil
+}
+
+func (repository *AssignmentPgRepository) GetAll(ctx context.Context) ([]models.Assignment, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(zap.String("operation", "get_all_assignments"))
+
+	logger.Debug("Getting all assignments")
+
+	rows, err := repository.db.Query(ctx,
+		`SELECT assignment_id, title, created_by, anonymous, grades_released, created_at, COALESCE(rubric_id, 0)
+		FROM assignments
+		ORDER BY created_at DESC, assignment_id DESC;`,
+	)
+	if err != nil {
+		logger.Error("Failed to query assignments", zap.Error(err))
+		return nil, fmt.Errorf("failed to get assignments: %w", err)
+	}
+	defer rows.Close()
+
+	var assignments []models.Assignment
+	for rows.Next() {
+		var assignment models.Assignment
+		err := rows.Scan(&assignment.ID, &assignment.Title, &assignment.CreatedBy, &assignment.Anonymous, &assignment.GradesReleased, &assignment.CreatedAt, &assignment.RubricID)
+		if err != nil {
+			logger.Error("Failed to scan assignment row", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan assignment: %w", err)
+		}
+		assignments = append(assignments, assignment)
+	}
+
+	if err := rows.Err(); err != nil {
+		logger.Error("Error during rows iteration", zap.Error(err))
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	logger.Debug("Retrieved assignments", zap.Int("count", len(assignments)))
+	return assignments, nil
+}
+
+func (repository *AssignmentPgRepository) Update(ctx context.Context, id int64, request models.AssignmentRequest) (models.Assignment, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "update_assignment"),
+		zap.Int64("assignment_id", id),
+	)
+
+	logger.Debug("Updating assignment")
+
+	var assignment models.Assignment
+	err := repository.db.QueryRow(ctx,
+		`UPDATE assignments
+		SET title = $2, anonymous = $3, rubric_id = NULLIF($4, 0)
+		WHERE assignment_id = $1
+		RETURNING assignment_id, title, created_by, anonymous, grades_released, created_at, COALESCE(rubric_id, 0);`,
+		id,
+		request.Title,
+		request.Anonymous,
+		request.RubricID,
+	).Scan(&assignment.ID, &assignment.Title, &assignment.CreatedBy, &assignment.Anonymous, &assignment.GradesReleased, &assignment.CreatedAt, &assignment.RubricID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Debug("Assignment not found for update")
+			return models.Assignment{}, AssignmentNotFoundErr
+		}
+		logger.Error("Failed to update assignment in database", zap.Error(err))
+		return models.Assignment{}, fmt.Errorf("failed to update assignment: %w", err)
+	}
+
+	logger.Info("Assignment updated successfully")
+	return assignment, nil
+}
+
+func (repository *AssignmentPgRepository) SetGradesReleased(ctx context.Context, id int64, released bool) (models.Assignment, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "set_grades_released"),
+		zap.Int64("assignment_id", id),
+		zap.Bool("released", released),
+	)
+
+	logger.Debug("Changing grade release")
+
+	var assignment models.Assignment
+	err := repository.db.QueryRow(ctx,
+		`UPDATE assignments
+		SET grades_released = $2
+		WHERE assignment_id = $1
+		RETURNING assignment_id, title, created_by, anonymous, grades_released, created_at, COALESCE(rubric_id, 0);`,
+		id,
+		released,
+	).Scan(&assignment.ID, &assignment.Title, &assignment.CreatedBy, &assignment.Anonymous, &assignment.GradesReleased, &assignment.CreatedAt, &assignment.RubricID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Debug("Assignment not found for grade release")
+			return models.Assignment{}, AssignmentNotFoundErr
+		}
+		logger.Error("Failed to change grade release in database", zap.Error(err))
+		return models.Assignment{}, fmt.Errorf("failed to change grade release: %w", err)
+	}
+
+	logger.Info("Grade release changed successfully")
+	return assignment, nil
+}
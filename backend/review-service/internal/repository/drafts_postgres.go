@@ -0,0 +1,244 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/pg_util"
+	"go.uber.org/zap"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// JSONB shape of a draft criterion score
+type draftScore struct {
+	CriterionID int64  `json:"criterion_id"`
+	Score       int    `json:"score"`
+	Comment     string `json:"comment,omitempty"`
+}
+
+// JSONB shape of a draft inline comment
+type draftComment struct {
+	StartOffset int    `json:"start_offset"`
+	EndOffset   int    `json:"end_offset"`
+	Quote       string `json:"quote,omitempty"`
+	Content     string `json:"content"`
+}
+
+// Creates or replaces the draft of the author for the essay
+func (repository *ReviewPgRepository) SaveDraft(ctx context.Context, draft models.ReviewDraft) (models.ReviewDraft, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "save_draft"),
+		zap.Int("essay_id", draft.EssayID),
+		zap.String("author", draft.Author),
+	)
+
+	scores, comments, err := encodeDraftDetails(draft)
+	if err != nil {
+		logger.Error("Failed to encode draft details", zap.Error(err))
+		return models.ReviewDraft{}, err
+	}
+
+	err = repository.db.QueryRow(ctx,
+		`INSERT INTO review_drafts (essay_id, author, rank, content, rubric_id, scores, essay_revision, comments)
+		VALUES ($1, $2, $3, $4, $5, $6::JSONB, $7, $8::JSONB)
+		ON CONFLICT (essay_id, author) DO UPDATE
+		SET rank = EXCLUDED.rank,
+			content = EXCLUDED.content,
+			rubric_id = EXCLUDED.rubric_id,
+			scores = EXCLUDED.scores,
+			essay_revision = EXCLUDED.essay_revision,
+			comments = EXCLUDED.comments,
+			updated_at = CURRENT_TIMESTAMP
+		RETURNING updated_at;`,
+		draft.EssayID,
+		draft.Author,
+		draft.Rank,
+		draft.Content,
+		draft.RubricID,
+		scores,
+		draft.EssayRevision,
+		comments,
+	).Scan(&draft.UpdatedAt)
+	if err != nil {
+		logger.Error("Failed to save draft in database", zap.Error(err))
+		return models.ReviewDraft{}, fmt.Errorf("failed to save draft: %w", err)
+	}
+
+	logger.Debug("Draft saved successfully")
+	return draft, nil
+}
+
+func (repository *ReviewPgRepository) GetDraft(ctx context.Context, essayID int, author string) (models.ReviewDraft, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "get_draft"),
+		zap.Int("essay_id", essayID),
+		zap.String("author", author),
+	)
+
+	draft := models.ReviewDraft{EssayID: essayID, Author: author}
+	var scores, comments []byte
+	err := repository.db.QueryRow(ctx,
+		`SELECT rank, content, rubric_id, scores, essay_revision, comments, updated_at
+		FROM review_drafts
+		WHERE essay_id = $1 AND author = $2;`,
+		essayID,
+		author,
+	).Scan(
+		&draft.Rank,
+		&draft.Content,
+		&draft.RubricID,
+		&scores,
+		&draft.EssayRevision,
+		&comments,
+		&draft.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Debug("Draft not found")
+			return models.ReviewDraft{}, DraftNotFoundErr
+		}
+		logger.Error("Failed to get draft from database", zap.Error(err))
+		return models.ReviewDraft{}, fmt.Errorf("failed to get draft: %w", err)
+	}
+
+	if err := decodeDraftDetails(&draft, scores, comments); err != nil {
+		logger.Error("Failed to decode draft details", zap.Error(err))
+		return models.ReviewDraft{}, err
+	}
+
+	return draft, nil
+}
+
+// Creates the review and drops the draft it was written in, in one transaction
+func (repository *ReviewPgRepository) AddFromDraft(ctx context.Context, request models.ReviewRequest, draftUpdatedAt time.Time) (models.Review, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "add_review_from_draft"),
+		zap.Int("essay_id", request.EssayId),
+		zap.String("author", request.Author),
+	)
+
+	tx, err := repository.db.Begin(ctx)
+	if err != nil {
+		logger.Error("Failed to begin transaction", zap.Error(err))
+		return models.Review{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// a concurrent submit finds nothing to delete and an autosave since the
+	// draft was read moves updated_at, so neither is lost or submitted twice
+	tag, err := tx.Exec(ctx,
+		`DELETE FROM review_drafts WHERE essay_id = $1 AND author = $2 AND updated_at = $3;`,
+		request.EssayId,
+		request.Author,
+		draftUpdatedAt,
+	)
+	if err != nil {
+		logger.Error("Failed to delete draft", zap.Error(err))
+		return models.Review{}, fmt.Errorf("failed to delete draft: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		var exists bool
+		err := tx.QueryRow(ctx,
+			`SELECT EXISTS (SELECT 1 FROM review_drafts WHERE essay_id = $1 AND author = $2);`,
+			request.EssayId,
+			request.Author,
+		).Scan(&exists)
+		if err != nil {
+			logger.Error("Failed to check draft", zap.Error(err))
+			return models.Review{}, fmt.Errorf("failed to check draft: %w", err)
+		}
+		if exists {
+			logger.Debug("Draft changed since it was read")
+			return models.Review{}, DraftChangedErr
+		}
+		logger.Debug("Draft not found")
+		return models.Review{}, DraftNotFoundErr
+	}
+
+	r, err := insertReview(ctx, tx, logger, request)
+	if err != nil {
+		return models.Review{}, err
+	}
+
+	// loaded before the commit so a failed lookup leaves the draft in place
+	reviews := []models.Review{r}
+	if err := attachAliases(ctx, tx, reviews); err != nil {
+		logger.Error("Failed to load reviewer alias", zap.Error(err))
+		return models.Review{}, err
+	}
+	r = reviews[0]
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Error("Failed to commit transaction", zap.Error(err))
+		return models.Review{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	logger.Info("Draft submitted successfully",
+		zap.Int("review_id", r.ID))
+	return r, nil
+}
+
+func encodeDraftDetails(draft models.ReviewDraft) (string, string, error) {
+	scores := make([]draftScore, 0, len(draft.Scores))
+	for _, s := range draft.Scores {
+		scores = append(scores, draftScore{CriterionID: s.CriterionID, Score: s.Score, Comment: s.Comment})
+	}
+	comments := make([]draftComment, 0, len(draft.Comments))
+	for _, c := range draft.Comments {
+		comments = append(comments, draftComment{
+			StartOffset: c.StartOffset,
+			EndOffset:   c.EndOffset,
+			Quote:       c.Quote,
+			Content:     c.Content,
+		})
+	}
+
+	encodedScores, err := json.Marshal(scores)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to encode draft scores: %w", err)
+	}
+	encodedComments, err := json.Marshal(comments)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to encode draft comments: %w", err)
+	}
+	return string(encodedScores), string(encodedComments), nil
+}
+
+func decodeDraftDetails(draft *models.ReviewDraft, scores, comments []byte) error {
+	var decodedScores []draftScore
+	if err := json.Unmarshal(scores, &decodedScores); err != nil {
+		return fmt.Errorf("failed to decode draft scores: %w", err)
+	}
+	var decodedComments []draftComment
+	if err := json.Unmarshal(comments, &decodedComments); err != nil {
+		return fmt.Errorf("failed to decode draft comments: %w", err)
+	}
+
+	for _, s := range decodedScores {
+		draft.Scores = append(draft.Scores, models.CriterionScore{CriterionID: s.CriterionID, Score: s.Score, Comment: s.Comment})
+	}
+	for _, c := range decodedComments {
+		draft.Comments = append(draft.Comments, models.InlineComment{
+			StartOffset:   c.StartOffset,
+			EndOffset:     c.EndOffset,
+			Quote:         c.Quote,
+			Content:       c.Content,
+			EssayRevision: draft.EssayRevision,
+		})
+	}
+	return nil
+}
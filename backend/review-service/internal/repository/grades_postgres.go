@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/pg_util"
+	"go.uber.org/zap"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Mean weighted rubric total of the joined reviews r, NULL when none of them
+// was scored with a rubric since a bare rank has no percentage to average
+const peerScoreSQL = `AVG(r.total_score)::DOUBLE PRECISION`
+
+type GradePgRepository struct {
+	db     *pgxpool.Pool
+	logger *logging.Logger
+}
+
+func NewGradePgRepository(db *pgxpool.Pool, logger *logging.Logger) GradeRepository {
+	return &GradePgRepository{db: db, logger: logger}
+}
+
+func (repository *GradePgRepository) Upsert(ctx context.Context, request models.GradeRequest) (models.Grade, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "upsert_grade"),
+		zap.Int("essay_id", request.EssayID),
+		zap.String("graded_by", request.GradedBy),
+	)
+
+	logger.Debug("Saving grade")
+
+	grade := models.Grade{
+		EssayID:  request.EssayID,
+		Grade:    request.Grade,
+		Feedback: request.Feedback,
+		GradedBy: request.GradedBy,
+	}
+	err := repository.db.QueryRow(ctx,
+		`INSERT INTO grades (essay_id, grade, feedback, graded_by)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (essay_id) DO UPDATE
+		SET grade = EXCLUDED.grade,
+			feedback = EXCLUDED.feedback,
+			graded_by = EXCLUDED.graded_by,
+			graded_at = CURRENT_TIMESTAMP
+		RETURNING graded_at;`,
+		request.EssayID,
+		request.Grade,
+		request.Feedback,
+		request.GradedBy,
+	).Scan(&grade.GradedAt)
+	if err != nil {
+		logger.Error("Failed to save grade in database", zap.Error(err))
+		return models.Grade{}, fmt.Errorf("failed to save grade: %w", err)
+	}
+
+	logger.Info("Grade saved successfully")
+	return grade, nil
+}
+
+func (repository *GradePgRepository) GetByEssayID(ctx context.Context, essayID int) (models.Grade, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "get_grade_by_essay_id"),
+		zap.Int("essay_id", essayID),
+	)
+
+	var grade models.Grade
+	err := repository.db.QueryRow(ctx,
+		`SELECT essay_id, grade, feedback, graded_by, graded_at
+		FROM grades
+		WHERE essay_id = $1;`,
+		essayID,
+	).Scan(&grade.EssayID, &grade.Grade, &grade.Feedback, &grade.GradedBy, &grade.GradedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Debug("Grade not found")
+			return models.Grade{}, GradeNotFoundErr
+		}
+		logger.Error("Failed to get grade from database", zap.Error(err))
+		return models.Grade{}, fmt.Errorf("failed to get grade: %w", err)
+	}
+
+	return grade, nil
+}
+
+func (repository *GradePgRepository) GetGradingContext(ctx context.Context, essayID int) (models.GradingContext, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "get_grading_context"),
+		zap.Int("essay_id", essayID),
+	)
+
+	result := models.GradingContext{EssayID: essayID}
+	err := repository.db.QueryRow(ctx,
+		`SELECT e.author,
+			COALESCE(a.assignment_id, 0),
+			COALESCE(a.created_by, ''),
+			COALESCE(a.grades_released, FALSE),
+			(SELECT COUNT(*) FROM reviews r WHERE r.essay_id = e.essay_id),
+			(SELECT `+peerScoreSQL+` FROM reviews r WHERE r.essay_id = e.essay_id)
+		FROM essays e
+		LEFT JOIN assignments a ON a.assignment_id = e.assignment_id
+		WHERE e.essay_id = $1;`,
+		essayID,
+	).Scan(
+		&result.EssayAuthor,
+		&result.AssignmentID,
+		&result.AssignmentCreator,
+		&result.GradesReleased,
+		&result.PeerReviewCount,
+		&result.PeerScore,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Debug("Essay not found")
+			return models.GradingContext{}, EssayNotFoundErr
+		}
+		logger.Error("Failed to get grading context from database", zap.Error(err))
+		return models.GradingContext{}, fmt.Errorf("failed to get grading context: %w", err)
+	}
+
+	return result, nil
+}
+
+func (repository *GradePgRepository) GetGradebook(ctx context.Context, assignmentID int64) ([]models.GradebookEntry, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "get_gradebook"),
+		zap.Int64("assignment_id", assignmentID),
+	)
+
+	logger.Debug("Getting gradebook")
+
+	rows, err := repository.db.Query(ctx,
+		`SELECT e.essay_id, e.author, e.created_at,
+			COUNT(r.review_id),
+			`+peerScoreSQL+`,
+			g.grade, g.feedback, g.graded_by, g.graded_at
+		FROM essays e
+		LEFT JOIN reviews r ON r.essay_id = e.essay_id
+		LEFT JOIN grades g ON g.essay_id = e.essay_id
+		WHERE e.assignment_id = $1
+		GROUP BY e.essay_id, g.essay_id
+		ORDER BY e.author, e.essay_id;`,
+		assignmentID,
+	)
+	if err != nil {
+		logger.Error("Failed to query gradebook", zap.Error(err))
+		return nil, fmt.Errorf("failed to get gradebook: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []models.GradebookEntry
+	for rows.Next() {
+		var entry models.GradebookEntry
+		var grade *float64
+		var feedback, gradedBy *string
+		var gradedAt *time.Time
+		err := rows.Scan(
+			&entry.EssayID,
+			&entry.EssayAuthor,
+			&entry.SubmittedAt,
+			&entry.PeerReviewCount,
+			&entry.PeerScore,
+			&grade,
+			&feedback,
+			&gradedBy,
+			&gradedAt,
+		)
+		if err != nil {
+			logger.Error("Failed to scan gradebook row", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan gradebook: %w", err)
+		}
+		if grade != nil {
+			entry.Grade = &models.Grade{
+				EssayID:  entry.EssayID,
+				Grade:    *grade,
+				Feedback: *feedback,
+				GradedBy: *gradedBy,
+				GradedAt: *gradedAt,
+			}
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("Error during rows iteration", zap.Error(err))
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	logger.Debug("Retrieved gradebook", zap.Int("count", len(entries)))
+	return entries, nil
+}
@@ -1,6 +1,9 @@
 package mocks
 
 import (
+	"context"
+	"time"
+
 	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
 	"github.com/stretchr/testify/mock"
 )
@@ -9,22 +12,207 @@ type MockReviewRepository struct {
 	mock.Mock
 }
 
-func (m *MockReviewRepository) Add(review models.ReviewRequest) (models.Review, error) {
-	args := m.Called(review)
+func (m *MockReviewRepository) Add(ctx context.Context, review models.ReviewRequest) (models.Review, error) {
+	args := m.Called(ctx, review)
 	return args.Get(0).(models.Review), args.Error(1)
 }
 
-func (m *MockReviewRepository) GetAllReviews() ([]models.Review, error) {
-	args := m.Called()
+func (m *MockReviewRepository) GetAllReviews(ctx context.Context) ([]models.Review, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]models.Review), args.Error(1)
+}
+
+func (m *MockReviewRepository) GetByEssayId(ctx context.Context, id int) ([]models.Review, error) {
+	args := m.Called(ctx, id)
 	return args.Get(0).([]models.Review), args.Error(1)
 }
 
-func (m *MockReviewRepository) GetByEssayId(id int) ([]models.Review, error) {
-	args := m.Called(id)
+func (m *MockReviewRepository) GetByAuthor(ctx context.Context, filter models.ReviewFilter) ([]models.Review, error) {
+	args := m.Called(ctx, filter)
 	return args.Get(0).([]models.Review), args.Error(1)
 }
 
-func (m *MockReviewRepository) RemoveById(id int) (models.Review, error) {
-	args := m.Called(id)
+func (m *MockReviewRepository) GetById(ctx context.Context, id int) (models.Review, error) {
+	args := m.Called(ctx, id)
 	return args.Get(0).(models.Review), args.Error(1)
 }
+
+func (m *MockReviewRepository) RemoveById(ctx context.Context, id int) (models.Review, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(models.Review), args.Error(1)
+}
+
+func (m *MockReviewRepository) Update(ctx context.Context, id int, review models.ReviewRequest) (models.Review, error) {
+	args := m.Called(ctx, id, review)
+	return args.Get(0).(models.Review), args.Error(1)
+}
+
+func (m *MockReviewRepository) GetHistory(ctx context.Context, id int) ([]models.ReviewVersion, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).([]models.ReviewVersion), args.Error(1)
+}
+
+func (m *MockReviewRepository) GetEssayText(ctx context.Context, essayID int) (models.EssayText, error) {
+	args := m.Called(ctx, essayID)
+	return args.Get(0).(models.EssayText), args.Error(1)
+}
+
+func (m *MockReviewRepository) GetEssayStats(ctx context.Context, essayIDs []int) ([]models.EssayStats, error) {
+	args := m.Called(ctx, essayIDs)
+	return args.Get(0).([]models.EssayStats), args.Error(1)
+}
+
+func (m *MockReviewRepository) GetAssignmentStats(ctx context.Context, assignmentID int64) (models.AssignmentStats, error) {
+	args := m.Called(ctx, assignmentID)
+	return args.Get(0).(models.AssignmentStats), args.Error(1)
+}
+
+func (m *MockReviewRepository) SaveDraft(ctx context.Context, draft models.ReviewDraft) (models.ReviewDraft, error) {
+	args := m.Called(ctx, draft)
+	return args.Get(0).(models.ReviewDraft), args.Error(1)
+}
+
+func (m *MockReviewRepository) GetDraft(ctx context.Context, essayID int, author string) (models.ReviewDraft, error) {
+	args := m.Called(ctx, essayID, author)
+	return args.Get(0).(models.ReviewDraft), args.Error(1)
+}
+
+func (m *MockReviewRepository) AddFromDraft(ctx context.Context, review models.ReviewRequest, draftUpdatedAt time.Time) (models.Review, error) {
+	args := m.Called(ctx, review, draftUpdatedAt)
+	return args.Get(0).(models.Review), args.Error(1)
+}
+
+func (m *MockReviewRepository) UpdateCommentAnchors(ctx context.Context, comments []models.InlineComment) error {
+	args := m.Called(ctx, comments)
+	return args.Error(0)
+}
+
+type MockRubricRepository struct {
+	mock.Mock
+}
+
+func (m *MockRubricRepository) Create(ctx context.Context, rubric models.RubricRequest) (models.Rubric, error) {
+	args := m.Called(ctx, rubric)
+	return args.Get(0).(models.Rubric), args.Error(1)
+}
+
+func (m *MockRubricRepository) GetByID(ctx context.Context, id int64) (models.Rubric, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(models.Rubric), args.Error(1)
+}
+
+func (m *MockRubricRepository) GetAll(ctx context.Context) ([]models.Rubric, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]models.Rubric), args.Error(1)
+}
+
+type MockReplyRepository struct {
+	mock.Mock
+}
+
+func (m *MockReplyRepository) Create(ctx context.Context, reply models.ReplyRequest) (models.Reply, error) {
+	args := m.Called(ctx, reply)
+	return args.Get(0).(models.Reply), args.Error(1)
+}
+
+func (m *MockReplyRepository) GetByID(ctx context.Context, id int64) (models.Reply, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(models.Reply), args.Error(1)
+}
+
+func (m *MockReplyRepository) GetByReviewID(ctx context.Context, reviewID int) ([]models.Reply, error) {
+	args := m.Called(ctx, reviewID)
+	return args.Get(0).([]models.Reply), args.Error(1)
+}
+
+func (m *MockReplyRepository) RemoveByID(ctx context.Context, id int64) (models.Reply, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(models.Reply), args.Error(1)
+}
+
+func (m *MockReplyRepository) GetParticipants(ctx context.Context, reviewID int) (models.ReviewParticipants, error) {
+	args := m.Called(ctx, reviewID)
+	return args.Get(0).(models.ReviewParticipants), args.Error(1)
+}
+
+type MockAssignmentRepository struct {
+	mock.Mock
+}
+
+func (m *MockAssignmentRepository) Create(ctx context.Context, assignment models.AssignmentRequest) (models.Assignment, error) {
+	args := m.Called(ctx, assignment)
+	return args.Get(0).(models.Assignment), args.Error(1)
+}
+
+func (m *MockAssignmentRepository) GetByID(ctx context.Context, id int64) (models.Assignment, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(models.Assignment), args.Error(1)
+}
+
+func (m *MockAssignmentRepository) GetByEssayID(ctx context.Context, essayID int) (models.Assignment, error) {
+	args := m.Called(ctx, essayID)
+	return args.Get(0).(models.Assignment), args.Error(1)
+}
+
+func (m *MockAssignmentRepository) GetAll(ctx context.Context) ([]models.Assignment, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]models.Assignment), args.Error(1)
+}
+
+func (m *MockAssignmentRepository) Update(ctx context.Context, id int64, assignment models.AssignmentRequest) (models.Assignment, error) {
+	args := m.Called(ctx, id, assignment)
+	return args.Get(0).(models.Assignment), args.Error(1)
+}
+
+func (m *MockAssignmentRepository) SetGradesReleased(ctx context.Context, id int64, released bool) (models.Assignment, error) {
+	args := m.Called(ctx, id, released)
+	return args.Get(0).(models.Assignment), args.Error(1)
+}
+
+type MockGradeRepository struct {
+	mock.Mock
+}
+
+func (m *MockGradeRepository) Upsert(ctx context.Context, grade models.GradeRequest) (models.Grade, error) {
+	args := m.Called(ctx, grade)
+	return args.Get(0).(models.Grade), args.Error(1)
+}
+
+func (m *MockGradeRepository) GetByEssayID(ctx context.Context, essayID int) (models.Grade, error) {
+	args := m.Called(ctx, essayID)
+	return args.Get(0).(models.Grade), args.Error(1)
+}
+
+func (m *MockGradeRepository) GetGradingContext(ctx context.Context, essayID int) (models.GradingContext, error) {
+	args := m.Called(ctx, essayID)
+	return args.Get(0).(models.GradingContext), args.Error(1)
+}
+
+func (m *MockGradeRepository) GetGradebook(ctx context.Context, assignmentID int64) ([]models.GradebookEntry, error) {
+	args := m.Called(ctx, assignmentID)
+	return args.Get(0).([]models.GradebookEntry), args.Error(1)
+}
+
+type MockReliabilityRepository struct {
+	mock.Mock
+}
+
+func (m *MockReliabilityRepository) SetCalibration(ctx context.Context, calibration models.CalibrationRequest) (models.CalibrationEssay, error) {
+	args := m.Called(ctx, calibration)
+	return args.Get(0).(models.CalibrationEssay), args.Error(1)
+}
+
+func (m *MockReliabilityRepository) RemoveCalibration(ctx context.Context, essayID int) (models.CalibrationEssay, error) {
+	args := m.Called(ctx, essayID)
+	return args.Get(0).(models.CalibrationEssay), args.Error(1)
+}
+
+func (m *MockReliabilityRepository) Recompute(ctx context.Context) ([]models.ReviewerReliability, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]models.ReviewerReliability), args.Error(1)
+}
+
+func (m *MockReliabilityRepository) GetAll(ctx context.Context) ([]models.ReviewerReliability, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]models.ReviewerReliability), args.Error(1)
+}
@@ -19,16 +19,19 @@ type ReviewPgRepository struct {
 	logger *logging.Logger
 }
 
-func NewReviewPgRepository(logger *logging.Logger) (ReviewRepository, error) {
-	pool, err := pgutil.GetPgxPool()
-	if err != nil {
-		return nil, err
-	}
+// Satisfied by both the pool and a transaction
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
 
-	return &ReviewPgRepository{db: pool, logger: logger}, nil
+func NewReviewPgRepository(db *pgxpool.Pool, logger *logging.Logger) ReviewRepository {
+	return &ReviewPgRepository{db: db, logger: logger}
 }
 
-func (repository *ReviewPgRepository) Add(request models.ReviewRequest) (models.Review, error) {
+func (repository *ReviewPgRepository) Add(ctx context.Context, request models.ReviewRequest) (models.Review, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
 	logger := repository.logger.With(
 		zap.String("operation", "add_review"),
 		zap.Int("essay_id", request.EssayId),
@@ -37,15 +40,50 @@ func (repository *ReviewPgRepository) Add(request models.ReviewRequest) (models.
 
 	logger.Debug("Creating new review")
 
+	tx, err := repository.db.Begin(ctx)
+	if err != nil {
+		logger.Error("Failed to begin transaction", zap.Error(err))
+		return models.Review{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	r, err := insertReview(ctx, tx, logger, request)
+	if err != nil {
+		return models.Review{}, err
+	}
+
+	// the alias is created by a trigger on insert, loading it before the commit
+	// keeps a failed lookup from reporting an error for a stored review
+	reviews := []models.Review{r}
+	if err := attachAliases(ctx, tx, reviews); err != nil {
+		logger.Error("Failed to load reviewer alias", zap.Error(err))
+		return models.Review{}, err
+	}
+	r = reviews[0]
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Error("Failed to commit transaction", zap.Error(err))
+		return models.Review{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	logger.Info("Review created successfully",
+		zap.Int("review_id", r.ID))
+	return r, nil
+}
+
+// Inserts the review with its scores and inline comments, aliases are not loaded
+func insertReview(ctx context.Context, tx pgx.Tx, logger *zap.Logger, request models.ReviewRequest) (models.Review, error) {
 	var r models.Review
-	err := repository.db.QueryRow(context.Background(),
-		`INSERT INTO reviews (essay_id, rank, content, author)
-		VALUES ($1, $2, $3, $4)
+	err := tx.QueryRow(ctx,
+		`INSERT INTO reviews (essay_id, rank, content, author, rubric_id, total_score)
+		VALUES ($1, $2, $3, $4, NULLIF($5, 0), CASE WHEN $5 = 0 THEN NULL ELSE $6::DOUBLE PRECISION END)
 		RETURNING review_id, created_at;`,
 		request.EssayId,
 		request.Rank,
 		request.Content,
 		request.Author,
+		request.RubricID,
+		request.TotalScore,
 	).Scan(&r.ID, &r.CreatedAt)
 
 	if err != nil {
@@ -53,23 +91,67 @@ func (repository *ReviewPgRepository) Add(request models.ReviewRequest) (models.
 		return models.Review{}, fmt.Errorf("failed to create review: %w", err)
 	}
 
+	for _, score := range request.Scores {
+		_, err := tx.Exec(ctx,
+			`INSERT INTO review_scores (review_id, criterion_id, score, comment)
+			VALUES ($1, $2, $3, $4);`,
+			r.ID,
+			score.CriterionID,
+			score.Score,
+			score.Comment,
+		)
+		if err != nil {
+			logger.Error("Failed to save criterion score",
+				zap.Int64("criterion_id", score.CriterionID),
+				zap.Error(err))
+			return models.Review{}, fmt.Errorf("failed to save criterion score: %w", err)
+		}
+	}
+
+	comments := make([]models.InlineComment, 0, len(request.Comments))
+	for _, comment := range request.Comments {
+		err := tx.QueryRow(ctx,
+			`INSERT INTO review_comments (review_id, essay_revision, start_offset, end_offset, quote, content)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			RETURNING comment_id, created_at;`,
+			r.ID,
+			comment.EssayRevision,
+			comment.StartOffset,
+			comment.EndOffset,
+			comment.Quote,
+			comment.Content,
+		).Scan(&comment.ID, &comment.CreatedAt)
+		if err != nil {
+			logger.Error("Failed to save inline comment", zap.Error(err))
+			return models.Review{}, fmt.Errorf("failed to save inline comment: %w", err)
+		}
+		comment.ReviewID = r.ID
+		comments = append(comments, comment)
+	}
+
 	r.EssayId = request.EssayId
 	r.Rank = request.Rank
 	r.Content = request.Content
 	r.Author = request.Author
-
-	logger.Info("Review created successfully",
-		zap.Int("review_id", r.ID))
+	r.RubricID = request.RubricID
+	r.TotalScore = request.TotalScore
+	r.Scores = request.Scores
+	if len(comments) > 0 {
+		r.Comments = comments
+	}
 	return r, nil
 }
 
-func (repository *ReviewPgRepository) GetAllReviews() ([]models.Review, error) {
+func (repository *ReviewPgRepository) GetAllReviews(ctx context.Context) ([]models.Review, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
 	logger := repository.logger.With(zap.String("operation", "get_all_reviews"))
 
 	logger.Debug("Getting all reviews")
 
-	rows, err := repository.db.Query(context.Background(),
-		`SELECT review_id, essay_id, rank, content, author, created_at
+	rows, err := repository.db.Query(ctx,
+		`SELECT review_id, essay_id, rank, content, author, COALESCE(rubric_id, 0), COALESCE(total_score, 0), created_at, edited_at
 		FROM reviews
 		ORDER BY created_at DESC;`,
 	)
@@ -88,7 +170,10 @@ func (repository *ReviewPgRepository) GetAllReviews() ([]models.Review, error) {
 			&r.Rank,
 			&r.Content,
 			&r.Author,
+			&r.RubricID,
+			&r.TotalScore,
 			&r.CreatedAt,
+			&r.EditedAt,
 		)
 		if err != nil {
 			logger.Error("Failed to scan review row", zap.Error(err))
@@ -97,11 +182,19 @@ func (repository *ReviewPgRepository) GetAllReviews() ([]models.Review, error) {
 		reviews = append(reviews, r)
 	}
 
+	if err := repository.attachDetails(ctx, reviews); err != nil {
+		logger.Error("Failed to load review details", zap.Error(err))
+		return nil, err
+	}
+
 	logger.Debug("Retrieved reviews", zap.Int("count", len(reviews)))
 	return reviews, nil
 }
 
-func (repository *ReviewPgRepository) GetByEssayId(id int) ([]models.Review, error) {
+func (repository *ReviewPgRepository) GetByEssayId(ctx context.Context, id int) ([]models.Review, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
 	logger := repository.logger.With(
 		zap.String("operation", "get_reviews_by_essay_id"),
 		zap.Int("essay_id", id),
@@ -109,8 +202,8 @@ func (repository *ReviewPgRepository) GetByEssayId(id int) ([]models.Review, err
 
 	logger.Debug("Getting reviews by essay ID")
 
-	rows, err := repository.db.Query(context.Background(),
-		`SELECT review_id, essay_id, rank, content, author, created_at
+	rows, err := repository.db.Query(ctx,
+		`SELECT review_id, essay_id, rank, content, author, COALESCE(rubric_id, 0), COALESCE(total_score, 0), created_at, edited_at
 		FROM reviews
 		WHERE essay_id = $1;`,
 		id)
@@ -129,7 +222,10 @@ func (repository *ReviewPgRepository) GetByEssayId(id int) ([]models.Review, err
 			&r.Rank,
 			&r.Content,
 			&r.Author,
+			&r.RubricID,
+			&r.TotalScore,
 			&r.CreatedAt,
+			&r.EditedAt,
 		)
 		if err != nil {
 			logger.Error("Failed to scan review row", zap.Error(err))
@@ -138,11 +234,134 @@ func (repository *ReviewPgRepository) GetByEssayId(id int) ([]models.Review, err
 		reviews = append(reviews, r)
 	}
 
+	if err := repository.attachDetails(ctx, reviews); err != nil {
+		logger.Error("Failed to load review details", zap.Error(err))
+		return nil, err
+	}
+
 	logger.Debug("Retrieved reviews for essay", zap.Int("count", len(reviews)))
 	return reviews, nil
 }
 
-func (repository *ReviewPgRepository) RemoveById(id int) (models.Review, error) {
+func (repository *ReviewPgRepository) GetByAuthor(ctx context.Context, filter models.ReviewFilter) ([]models.Review, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "get_reviews_by_author"),
+		zap.String("author", filter.Author),
+		zap.Int("limit", filter.Limit),
+		zap.Int("offset", filter.Offset),
+		zap.Bool("include_anonymous", filter.IncludeAnonymous),
+	)
+
+	logger.Debug("Getting reviews by author")
+
+	rows, err := repository.db.Query(ctx,
+		`SELECT r.review_id, r.essay_id, r.rank, r.content, r.author, COALESCE(r.rubric_id, 0), COALESCE(r.total_score, 0), r.created_at, r.edited_at
+		FROM reviews r
+		WHERE r.author = $1
+			AND ($2 = 0 OR r.essay_id = $2)
+			AND r.rank >= $3
+			AND ($6 OR NOT EXISTS (
+				SELECT 1
+				FROM essays e
+				JOIN assignments a ON a.assignment_id = e.assignment_id
+				WHERE e.essay_id = r.essay_id AND a.anonymous
+			))
+		ORDER BY r.created_at DESC, r.review_id DESC
+		LIMIT $4 OFFSET $5;`,
+		filter.Author,
+		filter.EssayID,
+		filter.MinRank,
+		filter.Limit,
+		filter.Offset,
+		filter.IncludeAnonymous,
+	)
+	if err != nil {
+		logger.Error("Failed to get reviews by author from database", zap.Error(err))
+		return nil, fmt.Errorf("failed to load reviews: %w", err)
+	}
+	defer rows.Close()
+
+	var reviews []models.Review
+	for rows.Next() {
+		var r models.Review
+		err = rows.Scan(
+			&r.ID,
+			&r.EssayId,
+			&r.Rank,
+			&r.Content,
+			&r.Author,
+			&r.RubricID,
+			&r.TotalScore,
+			&r.CreatedAt,
+			&r.EditedAt,
+		)
+		if err != nil {
+			logger.Error("Failed to scan review row", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan review: %w", err)
+		}
+		reviews = append(reviews, r)
+	}
+
+	if err := repository.attachDetails(ctx, reviews); err != nil {
+		logger.Error("Failed to load review details", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Debug("Retrieved reviews for author", zap.Int("count", len(reviews)))
+	return reviews, nil
+}
+
+func (repository *ReviewPgRepository) GetById(ctx context.Context, id int) (models.Review, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "get_review_by_id"),
+		zap.Int("review_id", id),
+	)
+
+	var r models.Review
+	err := repository.db.QueryRow(ctx,
+		`SELECT review_id, essay_id, rank, content, author, COALESCE(rubric_id, 0), COALESCE(total_score, 0), created_at, edited_at
+		FROM reviews
+		WHERE review_id = $1;`,
+		id,
+	).Scan(
+		&r.ID,
+		&r.EssayId,
+		&r.Rank,
+		&r.Content,
+		&r.Author,
+		&r.RubricID,
+		&r.TotalScore,
+		&r.CreatedAt,
+		&r.EditedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Debug("Review not found")
+			return models.Review{}, ReviewNotFoundErr
+		}
+		logger.Error("Failed to get review from database", zap.Error(err))
+		return models.Review{}, fmt.Errorf("failed to get review: %w", err)
+	}
+
+	reviews := []models.Review{r}
+	if err := repository.attachDetails(ctx, reviews); err != nil {
+		logger.Error("Failed to load review details", zap.Error(err))
+		return models.Review{}, err
+	}
+
+	return reviews[0], nil
+}
+
+func (repository *ReviewPgRepository) RemoveById(ctx context.Context, id int) (models.Review, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
 	logger := repository.logger.With(
 		zap.String("operation", "remove_review_by_id"),
 		zap.Int("review_id", id),
@@ -151,10 +370,10 @@ func (repository *ReviewPgRepository) RemoveById(id int) (models.Review, error)
 	logger.Debug("Removing review by ID")
 
 	var r models.Review
-	err := repository.db.QueryRow(context.Background(),
+	err := repository.db.QueryRow(ctx,
 		`DELETE FROM reviews
 			WHERE review_id = $1
-			RETURNING review_id, essay_id, rank, content, author, created_at;`,
+			RETURNING review_id, essay_id, rank, content, author, COALESCE(rubric_id, 0), COALESCE(total_score, 0), created_at, edited_at;`,
 		id,
 	).Scan(
 		&r.ID,
@@ -162,7 +381,10 @@ func (repository *ReviewPgRepository) RemoveById(id int) (models.Review, error)
 		&r.Rank,
 		&r.Content,
 		&r.Author,
+		&r.RubricID,
+		&r.TotalScore,
 		&r.CreatedAt,
+		&r.EditedAt,
 	)
 
 	if err != nil {
@@ -178,6 +400,380 @@ func (repository *ReviewPgRepository) RemoveById(id int) (models.Review, error)
 	return r, nil
 }
 
+// Replaces rank, content and rubric scores, keeping the previous text as a version
+func (repository *ReviewPgRepository) Update(ctx context.Context, id int, request models.ReviewRequest) (models.Review, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "update_review"),
+		zap.Int("review_id", id),
+	)
+
+	logger.Debug("Updating review")
+
+	tx, err := repository.db.Begin(ctx)
+	if err != nil {
+		logger.Error("Failed to begin transaction", zap.Error(err))
+		return models.Review{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx,
+		`INSERT INTO review_versions (review_id, rank, content, total_score, written_at)
+		SELECT review_id, rank, content, total_score, COALESCE(edited_at, created_at)
+		FROM reviews
+		WHERE review_id = $1
+		FOR UPDATE;`,
+		id,
+	)
+	if err != nil {
+		logger.Error("Failed to save review version", zap.Error(err))
+		return models.Review{}, fmt.Errorf("failed to save review version: %w", err)
+	}
+
+	var r models.Review
+	err = tx.QueryRow(ctx,
+		`UPDATE reviews
+		SET rank = $2,
+			content = $3,
+			total_score = CASE WHEN rubric_id IS NULL THEN NULL ELSE $4::DOUBLE PRECISION END,
+			edited_at = CURRENT_TIMESTAMP
+		WHERE review_id = $1
+		RETURNING review_id, essay_id, rank, content, author, COALESCE(rubric_id, 0), COALESCE(total_score, 0), created_at, edited_at;`,
+		id,
+		request.Rank,
+		request.Content,
+		request.TotalScore,
+	).Scan(
+		&r.ID,
+		&r.EssayId,
+		&r.Rank,
+		&r.Content,
+		&r.Author,
+		&r.RubricID,
+		&r.TotalScore,
+		&r.CreatedAt,
+		&r.EditedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Debug("Review not found for update")
+			return models.Review{}, ReviewNotFoundErr
+		}
+		logger.Error("Failed to update review in database", zap.Error(err))
+		return models.Review{}, fmt.Errorf("failed to update review: %w", err)
+	}
+
+	if len(request.Scores) > 0 {
+		_, err = tx.Exec(ctx,
+			`DELETE FROM review_scores WHERE review_id = $1;`,
+			id,
+		)
+		if err != nil {
+			logger.Error("Failed to clear criterion scores", zap.Error(err))
+			return models.Review{}, fmt.Errorf("failed to clear criterion scores: %w", err)
+		}
+
+		for _, score := range request.Scores {
+			_, err = tx.Exec(ctx,
+				`INSERT INTO review_scores (review_id, criterion_id, score, comment)
+				VALUES ($1, $2, $3, $4);`,
+				id,
+				score.CriterionID,
+				score.Score,
+				score.Comment,
+			)
+			if err != nil {
+				logger.Error("Failed to save criterion score",
+					zap.Int64("criterion_id", score.CriterionID),
+					zap.Error(err))
+				return models.Review{}, fmt.Errorf("failed to save criterion score: %w", err)
+			}
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Error("Failed to commit transaction", zap.Error(err))
+		return models.Review{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	reviews := []models.Review{r}
+	if err := repository.attachDetails(ctx, reviews); err != nil {
+		logger.Error("Failed to load review details", zap.Error(err))
+		return models.Review{}, err
+	}
+
+	logger.Info("Review updated successfully")
+	return reviews[0], nil
+}
+
+// Returns earlier versions of the review, oldest first
+func (repository *ReviewPgRepository) GetHistory(ctx context.Context, id int) ([]models.ReviewVersion, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "get_review_history"),
+		zap.Int("review_id", id),
+	)
+
+	logger.Debug("Getting review history")
+
+	var exists bool
+	err := repository.db.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM reviews WHERE review_id = $1);`,
+		id,
+	).Scan(&exists)
+	if err != nil {
+		logger.Error("Failed to check review existence", zap.Error(err))
+		return nil, fmt.Errorf("failed to check review: %w", err)
+	}
+	if !exists {
+		logger.Debug("Review not found")
+		return nil, ReviewNotFoundErr
+	}
+
+	rows, err := repository.db.Query(ctx,
+		`SELECT version_id, review_id, rank, content, COALESCE(total_score, 0), written_at, replaced_at
+		FROM review_versions
+		WHERE review_id = $1
+		ORDER BY version_id;`,
+		id,
+	)
+	if err != nil {
+		logger.Error("Failed to query review versions", zap.Error(err))
+		return nil, fmt.Errorf("failed to get review history: %w", err)
+	}
+	defer rows.Close()
+
+	var versions []models.ReviewVersion
+	for rows.Next() {
+		var v models.ReviewVersion
+		err := rows.Scan(&v.ID, &v.ReviewID, &v.Rank, &v.Content, &v.TotalScore, &v.WrittenAt, &v.ReplacedAt)
+		if err != nil {
+			logger.Error("Failed to scan review version row", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan review version: %w", err)
+		}
+		versions = append(versions, v)
+	}
+
+	if err := rows.Err(); err != nil {
+		logger.Error("Error during rows iteration", zap.Error(err))
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	logger.Debug("Retrieved review history", zap.Int("count", len(versions)))
+	return versions, nil
+}
+
+func (repository *ReviewPgRepository) GetEssayText(ctx context.Context, essayID int) (models.EssayText, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "get_essay_text"),
+		zap.Int("essay_id", essayID),
+	)
+
+	text := models.EssayText{EssayID: essayID}
+	err := repository.db.QueryRow(ctx,
+		`SELECT content, revision FROM essays WHERE essay_id = $1;`,
+		essayID,
+	).Scan(&text.Content, &text.Revision)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Debug("Essay not found")
+			return models.EssayText{}, EssayNotFoundErr
+		}
+		logger.Error("Failed to get essay text", zap.Error(err))
+		return models.EssayText{}, fmt.Errorf("failed to get essay text: %w", err)
+	}
+
+	return text, nil
+}
+
+func (repository *ReviewPgRepository) UpdateCommentAnchors(ctx context.Context, comments []models.InlineComment) error {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "update_comment_anchors"),
+		zap.Int("count", len(comments)),
+	)
+
+	batch := &pgx.Batch{}
+	for _, comment := range comments {
+		batch.Queue(
+			`UPDATE review_comments
+			SET start_offset = $2, end_offset = $3, essay_revision = $4, orphaned = $5
+			WHERE comment_id = $1;`,
+			comment.ID,
+			comment.StartOffset,
+			comment.EndOffset,
+			comment.EssayRevision,
+			comment.Orphaned,
+		)
+	}
+
+	if err := repository.db.SendBatch(ctx, batch).Close(); err != nil {
+		logger.Error("Failed to update comment anchors", zap.Error(err))
+		return fmt.Errorf("failed to update comment anchors: %w", err)
+	}
+
+	logger.Debug("Comment anchors updated")
+	return nil
+}
+
+func (repository *ReviewPgRepository) attachDetails(ctx context.Context, reviews []models.Review) error {
+	if err := repository.attachScores(ctx, reviews); err != nil {
+		return err
+	}
+	if err := repository.attachComments(ctx, reviews); err != nil {
+		return err
+	}
+	return attachAliases(ctx, repository.db, reviews)
+}
+
+// Marks reviews of anonymous assignments and loads the reviewers' pseudonyms
+func attachAliases(ctx context.Context, q querier, reviews []models.Review) error {
+	if len(reviews) == 0 {
+		return nil
+	}
+
+	index := make(map[int]int, len(reviews))
+	ids := make([]int64, 0, len(reviews))
+	for i, r := range reviews {
+		index[r.ID] = i
+		ids = append(ids, int64(r.ID))
+	}
+
+	rows, err := q.Query(ctx,
+		`SELECT r.review_id, al.alias
+		FROM reviews r
+		JOIN essays e ON e.essay_id = r.essay_id
+		JOIN assignments a ON a.assignment_id = e.assignment_id
+		JOIN assignment_aliases al ON al.assignment_id = a.assignment_id
+			AND al.kind = 'reviewer' AND al.username = r.author
+		WHERE r.review_id = ANY($1) AND a.anonymous;`,
+		ids,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to load reviewer aliases: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var reviewID int
+		var alias string
+		if err := rows.Scan(&reviewID, &alias); err != nil {
+			return fmt.Errorf("failed to scan reviewer alias: %w", err)
+		}
+		i := index[reviewID]
+		reviews[i].Anonymous = true
+		reviews[i].AuthorAlias = alias
+	}
+
+	return rows.Err()
+}
+
+func (repository *ReviewPgRepository) attachComments(ctx context.Context, reviews []models.Review) error {
+	if len(reviews) == 0 {
+		return nil
+	}
+
+	index := make(map[int]int, len(reviews))
+	ids := make([]int64, 0, len(reviews))
+	for i, r := range reviews {
+		index[r.ID] = i
+		ids = append(ids, int64(r.ID))
+	}
+
+	rows, err := repository.db.Query(ctx,
+		`SELECT comment_id, review_id, essay_revision, start_offset, end_offset, quote, content, orphaned, created_at
+		FROM review_comments
+		WHERE review_id = ANY($1)
+		ORDER BY review_id, start_offset, comment_id;`,
+		ids,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to load inline comments: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var comment models.InlineComment
+		err = rows.Scan(
+			&comment.ID,
+			&comment.ReviewID,
+			&comment.EssayRevision,
+			&comment.StartOffset,
+			&comment.EndOffset,
+			&comment.Quote,
+			&comment.Content,
+			&comment.Orphaned,
+			&comment.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to scan inline comment: %w", err)
+		}
+		i := index[comment.ReviewID]
+		reviews[i].Comments = append(reviews[i].Comments, comment)
+	}
+
+	return rows.Err()
+}
+
+// Loads the per-criterion breakdown of rubric reviews in one query
+func (repository *ReviewPgRepository) attachScores(ctx context.Context, reviews []models.Review) error {
+	index := make(map[int]int)
+	var ids []int64
+	for i, r := range reviews {
+		if r.RubricID != 0 {
+			index[r.ID] = i
+			ids = append(ids, int64(r.ID))
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	rows, err := repository.db.Query(ctx,
+		`SELECT s.review_id, s.criterion_id, s.score, s.comment, c.name, c.weight, c.min_score, c.max_score
+		FROM review_scores s
+		JOIN rubric_criteria c ON c.criterion_id = s.criterion_id
+		WHERE s.review_id = ANY($1)
+		ORDER BY s.review_id, c.position;`,
+		ids,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to load criterion scores: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var reviewID int
+		var score models.CriterionScore
+		err = rows.Scan(
+			&reviewID,
+			&score.CriterionID,
+			&score.Score,
+			&score.Comment,
+			&score.CriterionName,
+			&score.Weight,
+			&score.MinScore,
+			&score.MaxScore,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to scan criterion score: %w", err)
+		}
+		i := index[reviewID]
+		reviews[i].Scores = append(reviews[i].Scores, score)
+	}
+
+	return rows.Err()
+}
+
 func (repository *ReviewPgRepository) DB() *pgxpool.Pool {
 	return repository.db
 }
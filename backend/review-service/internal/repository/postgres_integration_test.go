@@ -7,10 +7,12 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
 	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	pgutil "github.com/IAGrig/vt-csa-essays/backend/shared/pg_util"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/testcontainers/testcontainers-go"
@@ -22,7 +24,12 @@ import (
 )
 
 var (
-	testRepo repository.ReviewRepository
+	testRepo            repository.ReviewRepository
+	testRubricRepo      repository.RubricRepository
+	testReplyRepo       repository.ReplyRepository
+	testAssignmentRepo  repository.AssignmentRepository
+	testGradeRepo       repository.GradeRepository
+	testReliabilityRepo repository.ReliabilityRepository
 )
 
 func TestMain(m *testing.M) {
@@ -40,12 +47,17 @@ func TestMain(m *testing.M) {
 	}()
 
 	logger := logging.NewEmptyLogger()
-	var repoErr error
-	testRepo, repoErr = repository.NewReviewPgRepository(logger)
-	if repoErr != nil {
-		fmt.Printf("Failed to create repository: %v\n", repoErr)
+	pool, err := pgutil.GetPgxPool()
+	if err != nil {
+		fmt.Printf("Failed to connect to database: %v\n", err)
 		os.Exit(1)
 	}
+	testRepo = repository.NewReviewPgRepository(pool, logger)
+	testRubricRepo = repository.NewRubricPgRepository(pool, logger)
+	testReplyRepo = repository.NewReplyPgRepository(pool, logger)
+	testAssignmentRepo = repository.NewAssignmentPgRepository(pool, logger)
+	testGradeRepo = repository.NewGradePgRepository(pool, logger)
+	testReliabilityRepo = repository.NewReliabilityPgRepository(pool, logger)
 
 	code := m.Run()
 	os.Exit(code)
@@ -162,7 +174,7 @@ func TestIntegrationReviewRepository_Add(t *testing.T) {
 		Author:  "test-reviewer",
 	}
 
-	review, err := testRepo.Add(reviewReq)
+	review, err := testRepo.Add(context.Background(), reviewReq)
 	require.NoError(t, err)
 	assert.NotZero(t, review.ID)
 	assert.Equal(t, reviewReq.EssayId, review.EssayId)
@@ -188,14 +200,14 @@ func TestIntegrationReviewRepository_GetByEssayId(t *testing.T) {
 	review2 := models.ReviewRequest{EssayId: 1, Rank: 1, Content: "Good", Author: "reviewer2"}
 	review3 := models.ReviewRequest{EssayId: 2, Rank: 3, Content: "Average", Author: "reviewer1"}
 
-	_, err := testRepo.Add(review1)
+	_, err := testRepo.Add(context.Background(), review1)
 	require.NoError(t, err)
-	_, err = testRepo.Add(review2)
+	_, err = testRepo.Add(context.Background(), review2)
 	require.NoError(t, err)
-	_, err = testRepo.Add(review3)
+	_, err = testRepo.Add(context.Background(), review3)
 	require.NoError(t, err)
 
-	reviews, err := testRepo.GetByEssayId(1)
+	reviews, err := testRepo.GetByEssayId(context.Background(), 1)
 	require.NoError(t, err)
 	assert.Len(t, reviews, 2)
 
@@ -219,12 +231,68 @@ func TestIntegrationReviewRepository_GetAllReviews(t *testing.T) {
 	review1 := models.ReviewRequest{EssayId: 1, Rank: 2, Content: "Review 1", Author: "reviewer1"}
 	review2 := models.ReviewRequest{EssayId: 2, Rank: 1, Content: "Review 2", Author: "reviewer2"}
 
-	_, err := testRepo.Add(review1)
+	_, err := testRepo.Add(context.Background(), review1)
+	require.NoError(t, err)
+	_, err = testRepo.Add(context.Background(), review2)
+	require.NoError(t, err)
+
+	reviews, err := testRepo.GetAllReviews(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, reviews, 2)
+}
+
+func TestIntegrationReviewRepository_GetByAuthor(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	cleanupTables(t)
+	insertTestUser(t, "reviewer1")
+	insertTestUser(t, "reviewer2")
+	insertTestUser(t, "test-author")
+	insertTestEssay(t, 1, "test-author")
+	insertTestEssay(t, 2, "test-author")
+
+	for _, req := range []models.ReviewRequest{
+		{EssayId: 1, Rank: 1, Content: "First", Author: "reviewer1"},
+		{EssayId: 2, Rank: 3, Content: "Second", Author: "reviewer1"},
+		{EssayId: 2, Rank: 2, Content: "Third", Author: "reviewer1"},
+		{EssayId: 1, Rank: 3, Content: "Other", Author: "reviewer2"},
+	} {
+		_, err := testRepo.Add(context.Background(), req)
+		require.NoError(t, err)
+	}
+
+	reviews, err := testRepo.GetByAuthor(context.Background(), models.ReviewFilter{Author: "reviewer1", Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, reviews, 2)
+	assert.Equal(t, "Third", reviews[0].Content)
+	assert.Equal(t, "Second", reviews[1].Content)
+
+	reviews, err = testRepo.GetByAuthor(context.Background(), models.ReviewFilter{Author: "reviewer1", Limit: 2, Offset: 2})
+	require.NoError(t, err)
+	require.Len(t, reviews, 1)
+	assert.Equal(t, "First", reviews[0].Content)
+
+	reviews, err = testRepo.GetByAuthor(context.Background(), models.ReviewFilter{Author: "reviewer1", EssayID: 2, MinRank: 3, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, reviews, 1)
+	assert.Equal(t, "Second", reviews[0].Content)
+
+	// reviews of double-blind essays are left out before the page is cut
+	insertTestUser(t, "teacher")
+	assignment, err := testAssignmentRepo.Create(context.Background(), models.AssignmentRequest{Title: "Blind week", CreatedBy: "teacher", Anonymous: true})
+	require.NoError(t, err)
+	_, err = testRepo.(*repository.ReviewPgRepository).DB().Exec(context.Background(),
+		"UPDATE essays SET assignment_id = $1 WHERE essay_id = 2", assignment.ID)
 	require.NoError(t, err)
-	_, err = testRepo.Add(review2)
+
+	reviews, err = testRepo.GetByAuthor(context.Background(), models.ReviewFilter{Author: "reviewer1", Limit: 2})
 	require.NoError(t, err)
+	require.Len(t, reviews, 1)
+	assert.Equal(t, "First", reviews[0].Content)
 
-	reviews, err := testRepo.GetAllReviews()
+	reviews, err = testRepo.GetByAuthor(context.Background(), models.ReviewFilter{Author: "reviewer1", Limit: 2, IncludeAnonymous: true})
 	require.NoError(t, err)
 	assert.Len(t, reviews, 2)
 }
@@ -246,31 +314,340 @@ func TestIntegrationReviewRepository_RemoveById(t *testing.T) {
 		Author:  "reviewer",
 	}
 
-	addedReview, err := testRepo.Add(reviewReq)
+	addedReview, err := testRepo.Add(context.Background(), reviewReq)
 	require.NoError(t, err)
 
-	removedReview, err := testRepo.RemoveById(addedReview.ID)
+	removedReview, err := testRepo.RemoveById(context.Background(), addedReview.ID)
 	require.NoError(t, err)
 	assert.Equal(t, addedReview.ID, removedReview.ID)
 
-	reviews, err := testRepo.GetByEssayId(1)
+	reviews, err := testRepo.GetByEssayId(context.Background(), 1)
 	require.NoError(t, err)
 	assert.Len(t, reviews, 0)
 
-	_, err = testRepo.RemoveById(999)
+	_, err = testRepo.RemoveById(context.Background(), 999)
+	assert.ErrorIs(t, err, repository.ReviewNotFoundErr)
+}
+
+func TestIntegrationReviewRepository_UpdateKeepsHistory(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	cleanupTables(t)
+	insertTestUser(t, "reviewer")
+	insertTestUser(t, "test-author")
+	insertTestEssay(t, 1, "test-author")
+
+	added, err := testRepo.Add(context.Background(), models.ReviewRequest{EssayId: 1, Rank: 1, Content: "First take", Author: "reviewer"})
+	require.NoError(t, err)
+	assert.Nil(t, added.EditedAt)
+
+	_, err = testRepo.Update(context.Background(), added.ID, models.ReviewRequest{Rank: 2, Content: "Second take"})
+	require.NoError(t, err)
+	updated, err := testRepo.Update(context.Background(), added.ID, models.ReviewRequest{Rank: 3, Content: "Final take"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, updated.Rank)
+	assert.Equal(t, "Final take", updated.Content)
+	assert.Equal(t, added.CreatedAt.Unix(), updated.CreatedAt.Unix())
+	require.NotNil(t, updated.EditedAt)
+
+	fetched, err := testRepo.GetById(context.Background(), added.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Final take", fetched.Content)
+	assert.NotNil(t, fetched.EditedAt)
+
+	history, err := testRepo.GetHistory(context.Background(), added.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, "First take", history[0].Content)
+	assert.Equal(t, 1, history[0].Rank)
+	assert.Equal(t, added.CreatedAt.Unix(), history[0].WrittenAt.Unix())
+	assert.Equal(t, "Second take", history[1].Content)
+
+	_, err = testRepo.Update(context.Background(), 999, models.ReviewRequest{Rank: 1, Content: "Nothing"})
+	assert.ErrorIs(t, err, repository.ReviewNotFoundErr)
+	_, err = testRepo.GetHistory(context.Background(), 999)
+	assert.ErrorIs(t, err, repository.ReviewNotFoundErr)
+	_, err = testRepo.GetById(context.Background(), 999)
 	assert.ErrorIs(t, err, repository.ReviewNotFoundErr)
 }
 
+func TestIntegrationRubricRepository_CreateAndGet(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	insertTestUser(t, "teacher")
+
+	created, err := testRubricRepo.Create(context.Background(), models.RubricRequest{
+		Title:     "Argumentative essay",
+		CreatedBy: "teacher",
+		Criteria: []models.Criterion{
+			{Name: "Thesis", Description: "Clear claim", Weight: 2, MinScore: 0, MaxScore: 4},
+			{Name: "Evidence", Weight: 1, MinScore: 1, MaxScore: 5},
+		},
+	})
+	require.NoError(t, err)
+	assert.NotZero(t, created.ID)
+	require.Len(t, created.Criteria, 2)
+	assert.NotZero(t, created.Criteria[0].ID)
+
+	rubric, err := testRubricRepo.GetByID(context.Background(), created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Argumentative essay", rubric.Title)
+	assert.Equal(t, "teacher", rubric.CreatedBy)
+	require.Len(t, rubric.Criteria, 2)
+	assert.Equal(t, "Thesis", rubric.Criteria[0].Name)
+	assert.Equal(t, "Clear claim", rubric.Criteria[0].Description)
+	assert.Equal(t, 2.0, rubric.Criteria[0].Weight)
+	assert.Equal(t, "Evidence", rubric.Criteria[1].Name)
+	assert.Equal(t, 1, rubric.Criteria[1].MinScore)
+
+	rubrics, err := testRubricRepo.GetAll(context.Background())
+	require.NoError(t, err)
+	assert.NotEmpty(t, rubrics)
+
+	_, err = testRubricRepo.GetByID(context.Background(), 999999)
+	assert.ErrorIs(t, err, repository.RubricNotFoundErr)
+}
+
+func TestIntegrationReviewRepository_AddWithScores(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	cleanupTables(t)
+	insertTestUser(t, "teacher")
+	insertTestUser(t, "reviewer")
+	insertTestUser(t, "test-author")
+	insertTestEssay(t, 1, "test-author")
+
+	rubric, err := testRubricRepo.Create(context.Background(), models.RubricRequest{
+		Title:     "Scored",
+		CreatedBy: "teacher",
+		Criteria: []models.Criterion{
+			{Name: "Structure", Weight: 1, MinScore: 0, MaxScore: 10},
+			{Name: "Style", Weight: 3, MinScore: 0, MaxScore: 10},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = testRepo.Add(context.Background(), models.ReviewRequest{
+		EssayId:    1,
+		Rank:       3,
+		Content:    "Scored review",
+		Author:     "reviewer",
+		RubricID:   rubric.ID,
+		TotalScore: 85,
+		Scores: []models.CriterionScore{
+			{CriterionID: rubric.Criteria[1].ID, Score: 8, Comment: "Nice voice"},
+			{CriterionID: rubric.Criteria[0].ID, Score: 10},
+		},
+	})
+	require.NoError(t, err)
+
+	reviews, err := testRepo.GetByEssayId(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, reviews, 1)
+	assert.Equal(t, rubric.ID, reviews[0].RubricID)
+	assert.Equal(t, 85.0, reviews[0].TotalScore)
+	require.Len(t, reviews[0].Scores, 2)
+	assert.Equal(t, "Structure", reviews[0].Scores[0].CriterionName)
+	assert.Equal(t, 10, reviews[0].Scores[0].Score)
+	assert.Equal(t, "Style", reviews[0].Scores[1].CriterionName)
+	assert.Equal(t, "Nice voice", reviews[0].Scores[1].Comment)
+	assert.Equal(t, 3.0, reviews[0].Scores[1].Weight)
+}
+
+func TestIntegrationReviewRepository_InlineComments(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	cleanupTables(t)
+	insertTestUser(t, "reviewer")
+	insertTestUser(t, "test-author")
+	insertTestEssay(t, 1, "test-author")
+
+	text, err := testRepo.GetEssayText(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "Test essay content", text.Content)
+	assert.Equal(t, 1, text.Revision)
+
+	added, err := testRepo.Add(context.Background(), models.ReviewRequest{
+		EssayId: 1,
+		Rank:    2,
+		Content: "With comments",
+		Author:  "reviewer",
+		Comments: []models.InlineComment{
+			{StartOffset: 5, EndOffset: 10, Quote: "essay", Content: "Which one?", EssayRevision: 1},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, added.Comments, 1)
+	assert.NotZero(t, added.Comments[0].ID)
+
+	repo := testRepo.(*repository.ReviewPgRepository)
+	_, err = repo.DB().Exec(context.Background(),
+		"UPDATE essays SET content = $1 WHERE essay_id = $2", "A test essay content", 1)
+	require.NoError(t, err)
+
+	text, err = testRepo.GetEssayText(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, text.Revision)
+
+	comment := added.Comments[0]
+	comment.StartOffset, comment.EndOffset, comment.EssayRevision = 7, 12, 2
+	require.NoError(t, testRepo.UpdateCommentAnchors(context.Background(), []models.InlineComment{comment}))
+
+	reviews, err := testRepo.GetByEssayId(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, reviews, 1)
+	require.Len(t, reviews[0].Comments, 1)
+	assert.Equal(t, 7, reviews[0].Comments[0].StartOffset)
+	assert.Equal(t, 2, reviews[0].Comments[0].EssayRevision)
+	assert.Equal(t, "Which one?", reviews[0].Comments[0].Content)
+
+	_, err = testRepo.GetEssayText(context.Background(), 999)
+	assert.ErrorIs(t, err, repository.EssayNotFoundErr)
+}
+
+func TestIntegrationReplyRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	cleanupTables(t)
+	insertTestUser(t, "reviewer")
+	insertTestUser(t, "test-author")
+	insertTestEssay(t, 1, "test-author")
+
+	review, err := testRepo.Add(context.Background(), models.ReviewRequest{EssayId: 1, Rank: 2, Content: "Discuss me", Author: "reviewer"})
+	require.NoError(t, err)
+
+	participants, err := testReplyRepo.GetParticipants(context.Background(), review.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, participants.EssayID)
+	assert.Equal(t, "reviewer", participants.Reviewer)
+	assert.Equal(t, "test-author", participants.EssayAuthor)
+	assert.NotZero(t, participants.ReviewerID)
+	assert.NotZero(t, participants.EssayAuthorID)
+
+	thread, err := testReplyRepo.Create(context.Background(), models.ReplyRequest{ReviewID: review.ID, Author: "test-author", Content: "Why?"})
+	require.NoError(t, err)
+	assert.NotZero(t, thread.ID)
+	assert.Zero(t, thread.ParentID)
+
+	answer, err := testReplyRepo.Create(context.Background(), models.ReplyRequest{ReviewID: review.ID, ParentID: thread.ID, Author: "reviewer", Content: "Because"})
+	require.NoError(t, err)
+
+	fetched, err := testReplyRepo.GetByID(context.Background(), answer.ID)
+	require.NoError(t, err)
+	assert.Equal(t, thread.ID, fetched.ParentID)
+
+	replies, err := testReplyRepo.GetByReviewID(context.Background(), review.ID)
+	require.NoError(t, err)
+	require.Len(t, replies, 2)
+	assert.Equal(t, thread.ID, replies[0].ID)
+
+	removed, err := testReplyRepo.RemoveByID(context.Background(), thread.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Why?", removed.Content)
+
+	replies, err = testReplyRepo.GetByReviewID(context.Background(), review.ID)
+	require.NoError(t, err)
+	assert.Empty(t, replies)
+
+	_, err = testReplyRepo.RemoveByID(context.Background(), thread.ID)
+	assert.ErrorIs(t, err, repository.ReplyNotFoundErr)
+
+	_, err = testReplyRepo.GetParticipants(context.Background(), 999999)
+	assert.ErrorIs(t, err, repository.ReviewNotFoundErr)
+}
+
+func TestIntegrationAssignmentRepository_Aliases(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	cleanupTables(t)
+	insertTestUser(t, "teacher")
+	insertTestUser(t, "anon-author")
+	insertTestUser(t, "first-reviewer")
+	insertTestUser(t, "second-reviewer")
+	insertTestEssay(t, 2, "anon-author")
+
+	assignment, err := testAssignmentRepo.Create(context.Background(), models.AssignmentRequest{Title: "Blind week", CreatedBy: "teacher", Anonymous: true})
+	require.NoError(t, err)
+	assert.NotZero(t, assignment.ID)
+
+	repo := testRepo.(*repository.ReviewPgRepository)
+	_, err = repo.DB().Exec(context.Background(),
+		"UPDATE essays SET assignment_id = $1 WHERE essay_id = 2", assignment.ID)
+	require.NoError(t, err)
+
+	ofEssay, err := testAssignmentRepo.GetByEssayID(context.Background(), 2)
+	require.NoError(t, err)
+	assert.Equal(t, assignment.ID, ofEssay.ID)
+	assert.Zero(t, ofEssay.RubricID)
+	_, err = testAssignmentRepo.GetByEssayID(context.Background(), 999)
+	assert.ErrorIs(t, err, repository.AssignmentNotFoundErr)
+
+	first, err := testRepo.Add(context.Background(), models.ReviewRequest{EssayId: 2, Rank: 2, Content: "First", Author: "first-reviewer"})
+	require.NoError(t, err)
+	assert.True(t, first.Anonymous)
+	assert.Equal(t, "Reviewer A", first.AuthorAlias)
+
+	_, err = testRepo.Add(context.Background(), models.ReviewRequest{EssayId: 2, Rank: 3, Content: "Second", Author: "second-reviewer"})
+	require.NoError(t, err)
+	again, err := testRepo.Add(context.Background(), models.ReviewRequest{EssayId: 2, Rank: 1, Content: "Again", Author: "first-reviewer"})
+	require.NoError(t, err)
+	assert.Equal(t, "Reviewer A", again.AuthorAlias)
+
+	reviews, err := testRepo.GetByEssayId(context.Background(), 2)
+	require.NoError(t, err)
+	aliases := map[string]string{}
+	for _, review := range reviews {
+		aliases[review.Author] = review.AuthorAlias
+	}
+	assert.Equal(t, map[string]string{"first-reviewer": "Reviewer A", "second-reviewer": "Reviewer B"}, aliases)
+
+	participants, err := testReplyRepo.GetParticipants(context.Background(), first.ID)
+	require.NoError(t, err)
+	assert.True(t, participants.Anonymous)
+	assert.Equal(t, "Reviewer A", participants.ReviewerAlias)
+	assert.Equal(t, "Author A", participants.EssayAuthorAlias)
+
+	updated, err := testAssignmentRepo.Update(context.Background(), assignment.ID, models.AssignmentRequest{Title: "Open week", Anonymous: false})
+	require.NoError(t, err)
+	assert.Equal(t, "teacher", updated.CreatedBy)
+
+	reviews, err = testRepo.GetByEssayId(context.Background(), 2)
+	require.NoError(t, err)
+	for _, review := range reviews {
+		assert.False(t, review.Anonymous)
+		assert.Empty(t, review.AuthorAlias)
+	}
+
+	all, err := testAssignmentRepo.GetAll(context.Background())
+	require.NoError(t, err)
+	assert.NotEmpty(t, all)
+
+	_, err = testAssignmentRepo.GetByID(context.Background(), 999999)
+	assert.ErrorIs(t, err, repository.AssignmentNotFoundErr)
+}
+
 func cleanupTables(t *testing.T) {
 	t.Helper()
 
-	reviews, err := testRepo.GetAllReviews()
+	reviews, err := testRepo.GetAllReviews(context.Background())
 	if err != nil {
 		return
 	}
 
 	for _, review := range reviews {
-		_, _ = testRepo.RemoveById(review.ID)
+		_, _ = testRepo.RemoveById(context.Background(), review.ID)
 	}
 }
 
@@ -294,3 +671,256 @@ func insertTestEssay(t *testing.T, essayId int, author string) {
 		essayId, "Test essay content", author)
 	require.NoError(t, err)
 }
+
+func TestIntegrationReviewRepository_Stats(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	cleanupTables(t)
+	insertTestUser(t, "teacher")
+	insertTestUser(t, "stats-author")
+	insertTestUser(t, "other-author")
+	insertTestUser(t, "stats-reviewer")
+	insertTestEssay(t, 31, "stats-author")
+	insertTestEssay(t, 32, "other-author")
+
+	assignment, err := testAssignmentRepo.Create(context.Background(), models.AssignmentRequest{Title: "Stats week", CreatedBy: "teacher"})
+	require.NoError(t, err)
+	repo := testRepo.(*repository.ReviewPgRepository)
+	_, err = repo.DB().Exec(context.Background(),
+		"UPDATE essays SET assignment_id = $1 WHERE essay_id IN (31, 32)", assignment.ID)
+	require.NoError(t, err)
+
+	for _, rank := range []int{1, 3, 3} {
+		_, err := testRepo.Add(context.Background(), models.ReviewRequest{EssayId: 31, Rank: rank, Content: "Stats", Author: "stats-reviewer"})
+		require.NoError(t, err)
+	}
+
+	stats, err := testRepo.GetEssayStats(context.Background(), []int{32, 31})
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+
+	assert.Equal(t, 31, stats[0].EssayID)
+	assert.Equal(t, 3, stats[0].Stats.ReviewCount)
+	assert.InDelta(t, 7.0/3, stats[0].Stats.MeanRank, 1e-9)
+	assert.Equal(t, 3.0, stats[0].Stats.MedianRank)
+	assert.Equal(t, map[int]int{1: 1, 3: 2}, stats[0].Stats.RankDistribution)
+	assert.NotNil(t, stats[0].Stats.LastReviewAt)
+
+	assert.Equal(t, 32, stats[1].EssayID)
+	assert.Zero(t, stats[1].Stats.ReviewCount)
+	assert.Zero(t, stats[1].Stats.MeanRank)
+	assert.Nil(t, stats[1].Stats.LastReviewAt)
+
+	assignmentStats, err := testRepo.GetAssignmentStats(context.Background(), assignment.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, assignmentStats.EssayCount)
+	assert.Equal(t, 3, assignmentStats.Stats.ReviewCount)
+	assert.Equal(t, map[int]int{1: 1, 3: 2}, assignmentStats.Stats.RankDistribution)
+}
+
+func TestIntegrationGradeRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	cleanupTables(t)
+	insertTestUser(t, "teacher")
+	insertTestUser(t, "graded-author")
+	insertTestUser(t, "ungraded-author")
+	insertTestUser(t, "grade-reviewer")
+	insertTestEssay(t, 41, "graded-author")
+	insertTestEssay(t, 42, "ungraded-author")
+
+	assignment, err := testAssignmentRepo.Create(context.Background(), models.AssignmentRequest{Title: "Graded week", CreatedBy: "teacher"})
+	require.NoError(t, err)
+	assert.False(t, assignment.GradesReleased)
+	repo := testRepo.(*repository.ReviewPgRepository)
+	_, err = repo.DB().Exec(context.Background(),
+		"UPDATE essays SET assignment_id = $1 WHERE essay_id IN (41, 42)", assignment.ID)
+	require.NoError(t, err)
+
+	_, err = testRepo.Add(context.Background(), models.ReviewRequest{EssayId: 41, Rank: 3, Content: "Strong", Author: "grade-reviewer"})
+	require.NoError(t, err)
+
+	grading, err := testGradeRepo.GetGradingContext(context.Background(), 41)
+	require.NoError(t, err)
+	assert.Equal(t, "graded-author", grading.EssayAuthor)
+	assert.Equal(t, assignment.ID, grading.AssignmentID)
+	assert.Equal(t, "teacher", grading.AssignmentCreator)
+	assert.Equal(t, 1, grading.PeerReviewCount)
+	assert.Nil(t, grading.PeerScore, "a bare rank does not seed the peer score")
+
+	rubric, err := testRubricRepo.Create(context.Background(), models.RubricRequest{
+		Title:     "Grading",
+		CreatedBy: "teacher",
+		Criteria:  []models.Criterion{{Name: "Overall", Weight: 1, MinScore: 0, MaxScore: 10}},
+	})
+	require.NoError(t, err)
+	_, err = testRepo.Add(context.Background(), models.ReviewRequest{
+		EssayId:    41,
+		Rank:       2,
+		Content:    "Scored",
+		Author:     "teacher",
+		RubricID:   rubric.ID,
+		TotalScore: 70,
+		Scores:     []models.CriterionScore{{CriterionID: rubric.Criteria[0].ID, Score: 7}},
+	})
+	require.NoError(t, err)
+
+	grading, err = testGradeRepo.GetGradingContext(context.Background(), 41)
+	require.NoError(t, err)
+	assert.Equal(t, 2, grading.PeerReviewCount)
+	require.NotNil(t, grading.PeerScore)
+	assert.InDelta(t, 70.0, *grading.PeerScore, 1e-9)
+
+	_, err = testGradeRepo.GetByEssayID(context.Background(), 41)
+	assert.ErrorIs(t, err, repository.GradeNotFoundErr)
+
+	_, err = testGradeRepo.Upsert(context.Background(), models.GradeRequest{EssayID: 41, Grade: 80, Feedback: "Draft", GradedBy: "teacher"})
+	require.NoError(t, err)
+	grade, err := testGradeRepo.Upsert(context.Background(), models.GradeRequest{EssayID: 41, Grade: 88.5, Feedback: "Final", GradedBy: "teacher"})
+	require.NoError(t, err)
+	assert.False(t, grade.GradedAt.IsZero())
+
+	grade, err = testGradeRepo.GetByEssayID(context.Background(), 41)
+	require.NoError(t, err)
+	assert.Equal(t, 88.5, grade.Grade)
+	assert.Equal(t, "Final", grade.Feedback)
+
+	gradebook, err := testGradeRepo.GetGradebook(context.Background(), assignment.ID)
+	require.NoError(t, err)
+	require.Len(t, gradebook, 2)
+	assert.Equal(t, "graded-author", gradebook[0].EssayAuthor)
+	require.NotNil(t, gradebook[0].Grade)
+	assert.Equal(t, 88.5, gradebook[0].Grade.Grade)
+	assert.Equal(t, 2, gradebook[0].PeerReviewCount)
+	require.NotNil(t, gradebook[0].PeerScore)
+	assert.InDelta(t, 70.0, *gradebook[0].PeerScore, 1e-9)
+	assert.Equal(t, "ungraded-author", gradebook[1].EssayAuthor)
+	assert.Nil(t, gradebook[1].Grade)
+	assert.Nil(t, gradebook[1].PeerScore)
+
+	released, err := testAssignmentRepo.SetGradesReleased(context.Background(), assignment.ID, true)
+	require.NoError(t, err)
+	assert.True(t, released.GradesReleased)
+
+	_, err = testGradeRepo.GetGradingContext(context.Background(), 999999)
+	assert.ErrorIs(t, err, repository.EssayNotFoundErr)
+}
+
+func TestIntegrationReliabilityRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	cleanupTables(t)
+	insertTestUser(t, "teacher")
+	insertTestUser(t, "calibration-author")
+	for _, reviewer := range []string{"generous", "agreeing", "harsh"} {
+		insertTestUser(t, reviewer)
+	}
+	insertTestEssay(t, 51, "calibration-author")
+	insertTestEssay(t, 52, "calibration-author")
+
+	for _, review := range []models.ReviewRequest{
+		{EssayId: 51, Rank: 3, Content: "Great", Author: "generous"},
+		{EssayId: 51, Rank: 3, Content: "Good", Author: "agreeing"},
+		{EssayId: 51, Rank: 1, Content: "Weak", Author: "harsh"},
+		{EssayId: 52, Rank: 3, Content: "Great", Author: "generous"},
+	} {
+		_, err := testRepo.Add(context.Background(), review)
+		require.NoError(t, err)
+	}
+
+	calibration, err := testReliabilityRepo.SetCalibration(context.Background(), models.CalibrationRequest{EssayID: 52, ReferenceRank: 2, CreatedBy: "teacher"})
+	require.NoError(t, err)
+	assert.False(t, calibration.CreatedAt.IsZero())
+	calibration, err = testReliabilityRepo.SetCalibration(context.Background(), models.CalibrationRequest{EssayID: 52, ReferenceRank: 1, CreatedBy: "teacher"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, calibration.ReferenceRank)
+
+	reliability, err := testReliabilityRepo.Recompute(context.Background())
+	require.NoError(t, err)
+	require.Len(t, reliability, 3)
+
+	// harsh deviates by 2 from the consensus of 3
+	assert.Equal(t, "harsh", reliability[0].Reviewer)
+	assert.InDelta(t, 0.0, reliability[0].Score, 1e-9)
+	// generous deviates by 1 from the consensus and by 2 from the reference rank
+	assert.Equal(t, "generous", reliability[1].Reviewer)
+	assert.InDelta(t, 1.0/6, reliability[1].Score, 1e-9)
+	assert.Equal(t, 1, reliability[1].ConsensusReviews)
+	assert.Equal(t, 1, reliability[1].CalibrationReviews)
+	assert.Equal(t, "agreeing", reliability[2].Reviewer)
+	assert.InDelta(t, 0.5, reliability[2].Score, 1e-9)
+
+	stats, err := testRepo.GetEssayStats(context.Background(), []int{51})
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	assert.InDelta(t, 7.0/3, stats[0].Stats.MeanRank, 1e-9)
+	assert.InDelta(t, (3.0/6+3*0.5+0.1)/(1.0/6+0.5+0.1), stats[0].Stats.WeightedMeanRank, 1e-9)
+
+	stored, err := testReliabilityRepo.GetAll(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, stored, 3)
+
+	removed, err := testReliabilityRepo.RemoveCalibration(context.Background(), 52)
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed.ReferenceRank)
+	_, err = testReliabilityRepo.RemoveCalibration(context.Background(), 52)
+	assert.ErrorIs(t, err, repository.CalibrationNotFoundErr)
+}
+
+func TestIntegrationReviewRepository_Drafts(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	cleanupTables(t)
+	insertTestUser(t, "draft-author")
+	insertTestUser(t, "drafting-reviewer")
+	insertTestEssay(t, 61, "draft-author")
+
+	_, err := testRepo.GetDraft(context.Background(), 61, "drafting-reviewer")
+	assert.ErrorIs(t, err, repository.DraftNotFoundErr)
+
+	_, err = testRepo.SaveDraft(context.Background(), models.ReviewDraft{EssayID: 61, Author: "drafting-reviewer", Content: "First"})
+	require.NoError(t, err)
+	saved, err := testRepo.SaveDraft(context.Background(), models.ReviewDraft{
+		EssayID:       61,
+		Author:        "drafting-reviewer",
+		Rank:          2,
+		Content:       "Second",
+		EssayRevision: 1,
+		Comments:      []models.InlineComment{{StartOffset: 0, EndOffset: 4, Quote: "Test", Content: "Hm", EssayRevision: 1}},
+	})
+	require.NoError(t, err)
+	assert.False(t, saved.UpdatedAt.IsZero())
+
+	draft, err := testRepo.GetDraft(context.Background(), 61, "drafting-reviewer")
+	require.NoError(t, err)
+	assert.Equal(t, 2, draft.Rank)
+	assert.Equal(t, "Second", draft.Content)
+	assert.Equal(t, []models.InlineComment{{StartOffset: 0, EndOffset: 4, Quote: "Test", Content: "Hm", EssayRevision: 1}}, draft.Comments)
+	assert.Empty(t, draft.Scores)
+
+	_, err = testRepo.AddFromDraft(context.Background(), models.ReviewRequest{EssayId: 61, Rank: 2, Content: "First", Author: "drafting-reviewer"}, draft.UpdatedAt.Add(-time.Second))
+	assert.ErrorIs(t, err, repository.DraftChangedErr)
+	_, err = testRepo.GetDraft(context.Background(), 61, "drafting-reviewer")
+	require.NoError(t, err)
+
+	review, err := testRepo.AddFromDraft(context.Background(), models.ReviewRequest{EssayId: 61, Rank: 2, Content: "Second", Author: "drafting-reviewer"}, draft.UpdatedAt)
+	require.NoError(t, err)
+	assert.NotZero(t, review.ID)
+
+	_, err = testRepo.GetDraft(context.Background(), 61, "drafting-reviewer")
+	assert.ErrorIs(t, err, repository.DraftNotFoundErr)
+	_, err = testRepo.AddFromDraft(context.Background(), models.ReviewRequest{EssayId: 61, Rank: 2, Content: "Second", Author: "drafting-reviewer"}, draft.UpdatedAt)
+	assert.ErrorIs(t, err, repository.DraftNotFoundErr)
+
+	reviews, err := testRepo.GetByEssayId(context.Background(), 61)
+	require.NoError(t, err)
+	assert.Len(t, reviews, 1)
+}
@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/pg_util"
+	"go.uber.org/zap"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Largest possible distance between two ranks
+const maxRankDeviation = 2
+
+// Deviations from calibration references count twice as much as deviations
+// from the other reviewers of an essay
+const calibrationWeight = 2
+
+// Essays need this many other reviews before they form a consensus
+const minConsensusReviews = 2
+
+type ReliabilityPgRepository struct {
+	db     *pgxpool.Pool
+	logger *logging.Logger
+}
+
+func NewReliabilityPgRepository(db *pgxpool.Pool, logger *logging.Logger) ReliabilityRepository {
+	return &ReliabilityPgRepository{db: db, logger: logger}
+}
+
+func (repository *ReliabilityPgRepository) SetCalibration(ctx context.Context, request models.CalibrationRequest) (models.CalibrationEssay, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "set_calibration"),
+		zap.Int("essay_id", request.EssayID),
+		zap.String("created_by", request.CreatedBy),
+	)
+
+	logger.Debug("Saving calibration reference")
+
+	calibration := models.CalibrationEssay{
+		EssayID:       request.EssayID,
+		ReferenceRank: request.ReferenceRank,
+		CreatedBy:     request.CreatedBy,
+	}
+	err := repository.db.QueryRow(ctx,
+		`INSERT INTO calibration_essays (essay_id, reference_rank, created_by)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (essay_id) DO UPDATE
+		SET reference_rank = EXCLUDED.reference_rank,
+			created_by = EXCLUDED.created_by,
+			created_at = CURRENT_TIMESTAMP
+		RETURNING created_at;`,
+		request.EssayID,
+		request.ReferenceRank,
+		request.CreatedBy,
+	).Scan(&calibration.CreatedAt)
+	if err != nil {
+		logger.Error("Failed to save calibration reference in database", zap.Error(err))
+		return models.CalibrationEssay{}, fmt.Errorf("failed to save calibration reference: %w", err)
+	}
+
+	logger.Info("Calibration reference saved successfully")
+	return calibration, nil
+}
+
+func (repository *ReliabilityPgRepository) RemoveCalibration(ctx context.Context, essayID int) (models.CalibrationEssay, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "remove_calibration"),
+		zap.Int("essay_id", essayID),
+	)
+
+	var calibration models.CalibrationEssay
+	err := repository.db.QueryRow(ctx,
+		`DELETE FROM calibration_essays
+		WHERE essay_id = $1
+		RETURNING essay_id, reference_rank, created_by, created_at;`,
+		essayID,
+	).Scan(&calibration.EssayID, &calibration.ReferenceRank, &calibration.CreatedBy, &calibration.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Debug("Calibration reference not found")
+			return models.CalibrationEssay{}, CalibrationNotFoundErr
+		}
+		logger.Error("Failed to remove calibration reference from database", zap.Error(err))
+		return models.CalibrationEssay{}, fmt.Errorf("failed to remove calibration reference: %w", err)
+	}
+
+	logger.Info("Calibration reference removed successfully")
+	return calibration, nil
+}
+
+// Replaces the stored scores of every reviewer with comparable reviews
+func (repository *ReliabilityPgRepository) Recompute(ctx context.Context) ([]models.ReviewerReliability, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(zap.String("operation", "recompute_reliability"))
+
+	logger.Debug("Recomputing reviewer reliability")
+
+	tx, err := repository.db.Begin(ctx)
+	if err != nil {
+		logger.Error("Failed to begin transaction", zap.Error(err))
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM reviewer_reliability;`); err != nil {
+		logger.Error("Failed to clear reviewer reliability", zap.Error(err))
+		return nil, fmt.Errorf("failed to clear reviewer reliability: %w", err)
+	}
+
+	_, err = tx.Exec(ctx,
+		`WITH consensus AS (
+			SELECT r.author,
+				ABS(r.rank - AVG(o.rank))::DOUBLE PRECISION AS deviation
+			FROM reviews r
+			JOIN reviews o ON o.essay_id = r.essay_id AND o.review_id <> r.review_id
+			WHERE r.essay_id NOT IN (SELECT essay_id FROM calibration_essays)
+			GROUP BY r.review_id, r.author, r.rank
+			HAVING COUNT(o.review_id) >= $3
+		),
+		calibration AS (
+			SELECT r.author,
+				ABS(r.rank - c.reference_rank)::DOUBLE PRECISION AS deviation
+			FROM reviews r
+			JOIN calibration_essays c ON c.essay_id = r.essay_id
+		),
+		deviations AS (
+			SELECT author, deviation, 1 AS weight, 1 AS consensus, 0 AS calibration FROM consensus
+			UNION ALL
+			SELECT author, deviation, $2, 0, 1 FROM calibration
+		)
+		INSERT INTO reviewer_reliability (username, score, consensus_reviews, calibration_reviews)
+		SELECT author,
+			1 - SUM(deviation * weight) / SUM(weight) / $1,
+			SUM(consensus),
+			SUM(calibration)
+		FROM deviations
+		GROUP BY author;`,
+		maxRankDeviation,
+		calibrationWeight,
+		minConsensusReviews,
+	)
+	if err != nil {
+		logger.Error("Failed to compute reviewer reliability", zap.Error(err))
+		return nil, fmt.Errorf("failed to compute reviewer reliability: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Error("Failed to commit transaction", zap.Error(err))
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	reliability, err := repository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Reviewer reliability recomputed", zap.Int("count", len(reliability)))
+	return reliability, nil
+}
+
+func (repository *ReliabilityPgRepository) GetAll(ctx context.Context) ([]models.ReviewerReliability, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(zap.String("operation", "get_reviewer_reliability"))
+
+	rows, err := repository.db.Query(ctx,
+		`SELECT username, score, consensus_reviews, calibration_reviews, computed_at
+		FROM reviewer_reliability
+		ORDER BY score, username;`,
+	)
+	if err != nil {
+		logger.Error("Failed to query reviewer reliability", zap.Error(err))
+		return nil, fmt.Errorf("failed to get reviewer reliability: %w", err)
+	}
+	defer rows.Close()
+
+	var result []models.ReviewerReliability
+	for rows.Next() {
+		var reliability models.ReviewerReliability
+		err := rows.Scan(
+			&reliability.Reviewer,
+			&reliability.Score,
+			&reliability.ConsensusReviews,
+			&reliability.CalibrationReviews,
+			&reliability.ComputedAt,
+		)
+		if err != nil {
+			logger.Error("Failed to scan reviewer reliability row", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan reviewer reliability: %w", err)
+		}
+		result = append(result, reliability)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("Error during rows iteration", zap.Error(err))
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,209 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/pg_util"
+	"go.uber.org/zap"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ReplyPgRepository struct {
+	db     *pgxpool.Pool
+	logger *logging.Logger
+}
+
+func NewReplyPgRepository(db *pgxpool.Pool, logger *logging.Logger) ReplyRepository {
+	return &ReplyPgRepository{db: db, logger: logger}
+}
+
+func (repository *ReplyPgRepository) Create(ctx context.Context, request models.ReplyRequest) (models.Reply, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "create_reply"),
+		zap.Int("review_id", request.ReviewID),
+		zap.String("author", request.Author),
+	)
+
+	logger.Debug("Creating new reply")
+
+	reply := models.Reply{
+		ReviewID: request.ReviewID,
+		ParentID: request.ParentID,
+		Author:   request.Author,
+		Content:  request.Content,
+	}
+	err := repository.db.QueryRow(ctx,
+		`INSERT INTO review_replies (review_id, parent_id, author, content)
+		VALUES ($1, NULLIF($2, 0), $3, $4)
+		RETURNING reply_id, created_at;`,
+		request.ReviewID,
+		request.ParentID,
+		request.Author,
+		request.Content,
+	).Scan(&reply.ID, &reply.CreatedAt)
+	if err != nil {
+		logger.Error("Failed to create reply in database", zap.Error(err))
+		return models.Reply{}, fmt.Errorf("failed to create reply: %w", err)
+	}
+
+	logger.Info("Reply created successfully", zap.Int64("reply_id", reply.ID))
+	return reply, nil
+}
+
+func (repository *ReplyPgRepository) GetByID(ctx context.Context, id int64) (models.Reply, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "get_reply_by_id"),
+		zap.Int64("reply_id", id),
+	)
+
+	var reply models.Reply
+	err := repository.db.QueryRow(ctx,
+		`SELECT reply_id, review_id, COALESCE(parent_id, 0), author, content, created_at
+		FROM review_replies
+		WHERE reply_id = $1;`,
+		id,
+	).Scan(&reply.ID, &reply.ReviewID, &reply.ParentID, &reply.Author, &reply.Content, &reply.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Debug("Reply not found")
+			return models.Reply{}, ReplyNotFoundErr
+		}
+		logger.Error("Failed to get reply from database", zap.Error(err))
+		return models.Reply{}, fmt.Errorf("failed to get reply: %w", err)
+	}
+
+	return reply, nil
+}
+
+func (repository *ReplyPgRepository) GetByReviewID(ctx context.Context, reviewID int) ([]models.Reply, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "get_replies_by_review_id"),
+		zap.Int("review_id", reviewID),
+	)
+
+	logger.Debug("Getting replies by review ID")
+
+	rows, err := repository.db.Query(ctx,
+		`SELECT reply_id, review_id, COALESCE(parent_id, 0), author, content, created_at
+		FROM review_replies
+		WHERE review_id = $1
+		ORDER BY created_at, reply_id;`,
+		reviewID,
+	)
+	if err != nil {
+		logger.Error("Failed to query replies", zap.Error(err))
+		return nil, fmt.Errorf("failed to get replies: %w", err)
+	}
+	defer rows.Close()
+
+	var replies []models.Reply
+	for rows.Next() {
+		var reply models.Reply
+		err := rows.Scan(&reply.ID, &reply.ReviewID, &reply.ParentID, &reply.Author, &reply.Content, &reply.CreatedAt)
+		if err != nil {
+			logger.Error("Failed to scan reply row", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan reply: %w", err)
+		}
+		replies = append(replies, reply)
+	}
+
+	if err := rows.Err(); err != nil {
+		logger.Error("Error during rows iteration", zap.Error(err))
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	logger.Debug("Retrieved replies", zap.Int("count", len(replies)))
+	return replies, nil
+}
+
+func (repository *ReplyPgRepository) RemoveByID(ctx context.Context, id int64) (models.Reply, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "remove_reply_by_id"),
+		zap.Int64("reply_id", id),
+	)
+
+	logger.Debug("Removing reply by ID")
+
+	var reply models.Reply
+	err := repository.db.QueryRow(ctx,
+		`DELETE FROM review_replies
+		WHERE reply_id = $1
+		RETURNING reply_id, review_id, COALESCE(parent_id, 0), author, content, created_at;`,
+		id,
+	).Scan(&reply.ID, &reply.ReviewID, &reply.ParentID, &reply.Author, &reply.Content, &reply.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Debug("Reply not found for removal")
+			return models.Reply{}, ReplyNotFoundErr
+		}
+		logger.Error("Failed to delete reply from database", zap.Error(err))
+		return models.Reply{}, fmt.Errorf("failed to delete reply: %w", err)
+	}
+
+	logger.Info("Reply removed successfully")
+	return reply, nil
+}
+
+func (repository *ReplyPgRepository) GetParticipants(ctx context.Context, reviewID int) (models.ReviewParticipants, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "get_review_participants"),
+		zap.Int("review_id", reviewID),
+	)
+
+	participants := models.ReviewParticipants{ReviewID: reviewID}
+	err := repository.db.QueryRow(ctx,
+		`SELECT r.essay_id, r.author, ru.user_id, e.author, eu.user_id,
+			COALESCE(a.anonymous, FALSE), COALESCE(ral.alias, ''), COALESCE(eal.alias, '')
+		FROM reviews r
+		JOIN users ru ON ru.username = r.author
+		JOIN essays e ON e.essay_id = r.essay_id
+		JOIN users eu ON eu.username = e.author
+		LEFT JOIN assignments a ON a.assignment_id = e.assignment_id
+		LEFT JOIN assignment_aliases ral ON ral.assignment_id = e.assignment_id
+			AND ral.kind = 'reviewer' AND ral.username = r.author
+		LEFT JOIN assignment_aliases eal ON eal.assignment_id = e.assignment_id
+			AND eal.kind = 'author' AND eal.username = e.author
+		WHERE r.review_id = $1;`,
+		reviewID,
+	).Scan(
+		&participants.EssayID,
+		&participants.Reviewer,
+		&participants.ReviewerID,
+		&participants.EssayAuthor,
+		&participants.EssayAuthorID,
+		&participants.Anonymous,
+		&participants.ReviewerAlias,
+		&participants.EssayAuthorAlias,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Debug("Review not found")
+			return models.ReviewParticipants{}, ReviewNotFoundErr
+		}
+		logger.Error("Failed to get review participants", zap.Error(err))
+		return models.ReviewParticipants{}, fmt.Errorf("failed to get review participants: %w", err)
+	}
+
+	return participants, nil
+}
@@ -1,18 +1,76 @@
 package repository
 
 import (
+	"context"
 	"errors"
+	"time"
 
 	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
 )
 
 var (
-	ReviewNotFoundErr = errors.New("review not found")
+	ReviewNotFoundErr      = errors.New("review not found")
+	RubricNotFoundErr      = errors.New("rubric not found")
+	EssayNotFoundErr       = errors.New("essay not found")
+	ReplyNotFoundErr       = errors.New("reply not found")
+	AssignmentNotFoundErr  = errors.New("assignment not found")
+	GradeNotFoundErr       = errors.New("grade not found")
+	CalibrationNotFoundErr = errors.New("calibration essay not found")
+	DraftNotFoundErr       = errors.New("draft not found")
+	DraftChangedErr        = errors.New("draft changed since it was read")
 )
 
 type ReviewRepository interface {
-	Add(review models.ReviewRequest) (models.Review, error)
-	GetAllReviews() ([]models.Review, error)
-	GetByEssayId(id int) ([]models.Review, error)
-	RemoveById(id int) (models.Review, error)
+	Add(ctx context.Context, review models.ReviewRequest) (models.Review, error)
+	GetAllReviews(ctx context.Context) ([]models.Review, error)
+	GetByEssayId(ctx context.Context, id int) ([]models.Review, error)
+	GetByAuthor(ctx context.Context, filter models.ReviewFilter) ([]models.Review, error)
+	GetById(ctx context.Context, id int) (models.Review, error)
+	RemoveById(ctx context.Context, id int) (models.Review, error)
+	Update(ctx context.Context, id int, review models.ReviewRequest) (models.Review, error)
+	GetHistory(ctx context.Context, id int) ([]models.ReviewVersion, error)
+	GetEssayText(ctx context.Context, essayID int) (models.EssayText, error)
+	UpdateCommentAnchors(ctx context.Context, comments []models.InlineComment) error
+	GetEssayStats(ctx context.Context, essayIDs []int) ([]models.EssayStats, error)
+	GetAssignmentStats(ctx context.Context, assignmentID int64) (models.AssignmentStats, error)
+	SaveDraft(ctx context.Context, draft models.ReviewDraft) (models.ReviewDraft, error)
+	GetDraft(ctx context.Context, essayID int, author string) (models.ReviewDraft, error)
+	AddFromDraft(ctx context.Context, review models.ReviewRequest, draftUpdatedAt time.Time) (models.Review, error)
+}
+
+type RubricRepository interface {
+	Create(ctx context.Context, rubric models.RubricRequest) (models.Rubric, error)
+	GetByID(ctx context.Context, id int64) (models.Rubric, error)
+	GetAll(ctx context.Context) ([]models.Rubric, error)
+}
+
+type ReplyRepository interface {
+	Create(ctx context.Context, reply models.ReplyRequest) (models.Reply, error)
+	GetByID(ctx context.Context, id int64) (models.Reply, error)
+	GetByReviewID(ctx context.Context, reviewID int) ([]models.Reply, error)
+	RemoveByID(ctx context.Context, id int64) (models.Reply, error)
+	GetParticipants(ctx context.Context, reviewID int) (models.ReviewParticipants, error)
+}
+
+type AssignmentRepository interface {
+	Create(ctx context.Context, assignment models.AssignmentRequest) (models.Assignment, error)
+	GetByID(ctx context.Context, id int64) (models.Assignment, error)
+	GetByEssayID(ctx context.Context, essayID int) (models.Assignment, error)
+	GetAll(ctx context.Context) ([]models.Assignment, error)
+	Update(ctx context.Context, id int64, assignment models.AssignmentRequest) (models.Assignment, error)
+	SetGradesReleased(ctx context.Context, id int64, released bool) (models.Assignment, error)
+}
+
+type GradeRepository interface {
+	Upsert(ctx context.Context, grade models.GradeRequest) (models.Grade, error)
+	GetByEssayID(ctx context.Context, essayID int) (models.Grade, error)
+	GetGradingContext(ctx context.Context, essayID int) (models.GradingContext, error)
+	GetGradebook(ctx context.Context, assignmentID int64) ([]models.GradebookEntry, error)
+}
+
+type ReliabilityRepository interface {
+	SetCalibration(ctx context.Context, calibration models.CalibrationRequest) (models.CalibrationEssay, error)
+	RemoveCalibration(ctx context.Context, essayID int) (models.CalibrationEssay, error)
+	Recompute(ctx context.Context) ([]models.ReviewerReliability, error)
+	GetAll(ctx context.Context) ([]models.ReviewerReliability, error)
 }
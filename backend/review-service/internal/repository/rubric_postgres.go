@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/pg_util"
+	"go.uber.org/zap"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RubricPgRepository struct {
+	db     *pgxpool.Pool
+	logger *logging.Logger
+}
+
+func NewRubricPgRepository(db *pgxpool.Pool, logger *logging.Logger) RubricRepository {
+	return &RubricPgRepository{db: db, logger: logger}
+}
+
+func (repository *RubricPgRepository) Create(ctx context.Context, request models.RubricRequest) (models.Rubric, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "create_rubric"),
+		zap.String("created_by", request.CreatedBy),
+	)
+
+	logger.Debug("Creating new rubric")
+
+	tx, err := repository.db.Begin(ctx)
+	if err != nil {
+		logger.Error("Failed to begin transaction", zap.Error(err))
+		return models.Rubric{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	rubric := models.Rubric{
+		Title:     request.Title,
+		CreatedBy: request.CreatedBy,
+	}
+	err = tx.QueryRow(ctx,
+		`INSERT INTO rubrics (title, created_by)
+		VALUES ($1, $2)
+		RETURNING rubric_id, created_at;`,
+		request.Title,
+		request.CreatedBy,
+	).Scan(&rubric.ID, &rubric.CreatedAt)
+	if err != nil {
+		logger.Error("Failed to create rubric in database", zap.Error(err))
+		return models.Rubric{}, fmt.Errorf("failed to create rubric: %w", err)
+	}
+
+	for i, criterion := range request.Criteria {
+		err = tx.QueryRow(ctx,
+			`INSERT INTO rubric_criteria (rubric_id, position, name, description, weight, min_score, max_score)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			RETURNING criterion_id;`,
+			rubric.ID,
+			i,
+			criterion.Name,
+			criterion.Description,
+			criterion.Weight,
+			criterion.MinScore,
+			criterion.MaxScore,
+		).Scan(&criterion.ID)
+		if err != nil {
+			logger.Error("Failed to create rubric criterion", zap.Error(err))
+			return models.Rubric{}, fmt.Errorf("failed to create rubric criterion: %w", err)
+		}
+		rubric.Criteria = append(rubric.Criteria, criterion)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Error("Failed to commit transaction", zap.Error(err))
+		return models.Rubric{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	logger.Info("Rubric created successfully", zap.Int64("rubric_id", rubric.ID))
+	return rubric, nil
+}
+
+func (repository *RubricPgRepository) GetByID(ctx context.Context, id int64) (models.Rubric, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "get_rubric_by_id"),
+		zap.Int64("rubric_id", id),
+	)
+
+	logger.Debug("Getting rubric by ID")
+
+	var rubric models.Rubric
+	err := repository.db.QueryRow(ctx,
+		`SELECT rubric_id, title, created_by, created_at
+		FROM rubrics
+		WHERE rubric_id = $1;`,
+		id,
+	).Scan(&rubric.ID, &rubric.Title, &rubric.CreatedBy, &rubric.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			logger.Debug("Rubric not found")
+			return models.Rubric{}, RubricNotFoundErr
+		}
+		logger.Error("Failed to get rubric from database", zap.Error(err))
+		return models.Rubric{}, fmt.Errorf("failed to get rubric: %w", err)
+	}
+
+	rubrics := []models.Rubric{rubric}
+	if err := repository.attachCriteria(ctx, rubrics); err != nil {
+		logger.Error("Failed to load rubric criteria", zap.Error(err))
+		return models.Rubric{}, err
+	}
+
+	return rubrics[0], nil
+}
+
+func (repository *RubricPgRepository) GetAll(ctx context.Context) ([]models.Rubric, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(zap.String("operation", "get_all_rubrics"))
+
+	logger.Debug("Getting all rubrics")
+
+	rows, err := repository.db.Query(ctx,
+		`SELECT rubric_id, title, created_by, created_at
+		FROM rubrics
+		ORDER BY created_at DESC, rubric_id DESC;`,
+	)
+	if err != nil {
+		logger.Error("Failed to query rubrics", zap.Error(err))
+		return nil, fmt.Errorf("failed to get rubrics: %w", err)
+	}
+	defer rows.Close()
+
+	var rubrics []models.Rubric
+	for rows.Next() {
+		var rubric models.Rubric
+		if err := rows.Scan(&rubric.ID, &rubric.Title, &rubric.CreatedBy, &rubric.CreatedAt); err != nil {
+			logger.Error("Failed to scan rubric row", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan rubric: %w", err)
+		}
+		rubrics = append(rubrics, rubric)
+	}
+
+	if err := rows.Err(); err != nil {
+		logger.Error("Error during rows iteration", zap.Error(err))
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	if err := repository.attachCriteria(ctx, rubrics); err != nil {
+		logger.Error("Failed to load rubric criteria", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Debug("Retrieved rubrics", zap.Int("count", len(rubrics)))
+	return rubrics, nil
+}
+
+func (repository *RubricPgRepository) attachCriteria(ctx context.Context, rubrics []models.Rubric) error {
+	if len(rubrics) == 0 {
+		return nil
+	}
+
+	index := make(map[int64]int, len(rubrics))
+	ids := make([]int64, 0, len(rubrics))
+	for i, rubric := range rubrics {
+		index[rubric.ID] = i
+		ids = append(ids, rubric.ID)
+	}
+
+	rows, err := repository.db.Query(ctx,
+		`SELECT rubric_id, criterion_id, name, description, weight, min_score, max_score
+		FROM rubric_criteria
+		WHERE rubric_id = ANY($1)
+		ORDER BY rubric_id, position;`,
+		ids,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to load rubric criteria: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rubricID int64
+		var criterion models.Criterion
+		err = rows.Scan(
+			&rubricID,
+			&criterion.ID,
+			&criterion.Name,
+			&criterion.Description,
+			&criterion.Weight,
+			&criterion.MinScore,
+			&criterion.MaxScore,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to scan rubric criterion: %w", err)
+		}
+		i := index[rubricID]
+		rubrics[i].Criteria = append(rubrics[i].Criteria, criterion)
+	}
+
+	return rows.Err()
+}
+
+func (repository *RubricPgRepository) DB() *pgxpool.Pool {
+	return repository.db
+}
@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/pg_util"
+	"go.uber.org/zap"
+)
+
+// Reliability weight of the joined review r, reviewers without a computed score
+// count fully and nobody drops below a tenth
+const reliabilityWeightSQL = `GREATEST(COALESCE(rr.score, 1), 0.1)`
+
+const weightedMeanRankSQL = `COALESCE(SUM(r.rank * ` + reliabilityWeightSQL + `) /
+	SUM(` + reliabilityWeightSQL + `) FILTER (WHERE r.review_id IS NOT NULL), 0)::DOUBLE PRECISION`
+
+// Returns review aggregates for every requested essay, essays without reviews get zero stats
+func (repository *ReviewPgRepository) GetEssayStats(ctx context.Context, essayIDs []int) ([]models.EssayStats, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "get_essay_stats"),
+		zap.Int("count", len(essayIDs)),
+	)
+
+	if len(essayIDs) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]int64, 0, len(essayIDs))
+	for _, id := range essayIDs {
+		ids = append(ids, int64(id))
+	}
+
+	rows, err := repository.db.Query(ctx,
+		`SELECT ids.essay_id,
+			COUNT(r.review_id),
+			COALESCE(AVG(r.rank)::DOUBLE PRECISION, 0),
+			`+weightedMeanRankSQL+`,
+			COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY r.rank), 0),
+			MAX(r.created_at)
+		FROM unnest($1::BIGINT[]) AS ids(essay_id)
+		LEFT JOIN reviews r ON r.essay_id = ids.essay_id
+		LEFT JOIN reviewer_reliability rr ON rr.username = r.author
+		GROUP BY ids.essay_id
+		ORDER BY ids.essay_id;`,
+		ids,
+	)
+	if err != nil {
+		logger.Error("Failed to query essay stats", zap.Error(err))
+		return nil, fmt.Errorf("failed to get essay stats: %w", err)
+	}
+	defer rows.Close()
+
+	index := make(map[int]int, len(essayIDs))
+	var result []models.EssayStats
+	for rows.Next() {
+		stats := models.EssayStats{Stats: models.ReviewStats{RankDistribution: map[int]int{}}}
+		err := rows.Scan(
+			&stats.EssayID,
+			&stats.Stats.ReviewCount,
+			&stats.Stats.MeanRank,
+			&stats.Stats.WeightedMeanRank,
+			&stats.Stats.MedianRank,
+			&stats.Stats.LastReviewAt,
+		)
+		if err != nil {
+			logger.Error("Failed to scan essay stats row", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan essay stats: %w", err)
+		}
+		index[stats.EssayID] = len(result)
+		result = append(result, stats)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("Error during rows iteration", zap.Error(err))
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	rows, err = repository.db.Query(ctx,
+		`SELECT essay_id, rank, COUNT(*)
+		FROM reviews
+		WHERE essay_id = ANY($1)
+		GROUP BY essay_id, rank;`,
+		ids,
+	)
+	if err != nil {
+		logger.Error("Failed to query rank distribution", zap.Error(err))
+		return nil, fmt.Errorf("failed to get rank distribution: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var essayID, rank, count int
+		if err := rows.Scan(&essayID, &rank, &count); err != nil {
+			logger.Error("Failed to scan rank distribution row", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan rank distribution: %w", err)
+		}
+		result[index[essayID]].Stats.RankDistribution[rank] = count
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("Error during rows iteration", zap.Error(err))
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	logger.Debug("Retrieved essay stats")
+	return result, nil
+}
+
+func (repository *ReviewPgRepository) GetAssignmentStats(ctx context.Context, assignmentID int64) (models.AssignmentStats, error) {
+	ctx, cancel := pgutil.WithQueryTimeout(ctx)
+	defer cancel()
+
+	logger := repository.logger.With(
+		zap.String("operation", "get_assignment_stats"),
+		zap.Int64("assignment_id", assignmentID),
+	)
+
+	result := models.AssignmentStats{
+		AssignmentID: assignmentID,
+		Stats:        models.ReviewStats{RankDistribution: map[int]int{}},
+	}
+	err := repository.db.QueryRow(ctx,
+		`SELECT COUNT(DISTINCT e.essay_id),
+			COUNT(r.review_id),
+			COALESCE(AVG(r.rank)::DOUBLE PRECISION, 0),
+			`+weightedMeanRankSQL+`,
+			COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY r.rank), 0),
+			MAX(r.created_at)
+		FROM essays e
+		LEFT JOIN reviews r ON r.essay_id = e.essay_id
+		LEFT JOIN reviewer_reliability rr ON rr.username = r.author
+		WHERE e.assignment_id = $1;`,
+		assignmentID,
+	).Scan(
+		&result.EssayCount,
+		&result.Stats.ReviewCount,
+		&result.Stats.MeanRank,
+		&result.Stats.WeightedMeanRank,
+		&result.Stats.MedianRank,
+		&result.Stats.LastReviewAt,
+	)
+	if err != nil {
+		logger.Error("Failed to query assignment stats", zap.Error(err))
+		return models.AssignmentStats{}, fmt.Errorf("failed to get assignment stats: %w", err)
+	}
+
+	rows, err := repository.db.Query(ctx,
+		`SELECT r.rank, COUNT(*)
+		FROM reviews r
+		JOIN essays e ON e.essay_id = r.essay_id
+		WHERE e.assignment_id = $1
+		GROUP BY r.rank;`,
+		assignmentID,
+	)
+	if err != nil {
+		logger.Error("Failed to query rank distribution", zap.Error(err))
+		return models.AssignmentStats{}, fmt.Errorf("failed to get rank distribution: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rank, count int
+		if err := rows.Scan(&rank, &count); err != nil {
+			logger.Error("Failed to scan rank distribution row", zap.Error(err))
+			return models.AssignmentStats{}, fmt.Errorf("failed to scan rank distribution: %w", err)
+		}
+		result.Stats.RankDistribution[rank] = count
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("Error during rows iteration", zap.Error(err))
+		return models.AssignmentStats{}, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return result, nil
+}
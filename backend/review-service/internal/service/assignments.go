@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+)
+
+func (s *reviewService) CreateAssignment(ctx context.Context, in *pb.CreateAssignmentRequest) (*pb.AssignmentResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "create_assignment"),
+		zap.String("created_by", in.CreatedBy),
+	)
+
+	title := strings.TrimSpace(in.Title)
+	if title == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+
+	if err := s.checkRubricExists(ctx, in.RubricId); err != nil {
+		return nil, err
+	}
+
+	assignment, err := s.assignments.Create(ctx, models.AssignmentRequest{
+		Title:     title,
+		CreatedBy: in.CreatedBy,
+		Anonymous: in.Anonymous,
+		RubricID:  in.RubricId,
+	})
+	if err != nil {
+		logger.Error("Failed to create assignment", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info("Assignment created successfully",
+		zap.Int64("assignment_id", assignment.ID),
+		zap.Bool("anonymous", assignment.Anonymous))
+	return toProtoAssignmentResponse(assignment), nil
+}
+
+func (s *reviewService) GetAssignment(ctx context.Context, in *pb.GetAssignmentRequest) (*pb.AssignmentResponse, error) {
+	assignment, err := s.assignments.GetByID(ctx, in.Id)
+	if err != nil {
+		if errors.Is(err, repository.AssignmentNotFoundErr) {
+			return nil, statusError(err)
+		}
+		logging.FromContext(ctx, s.logger).Error("Failed to get assignment",
+			zap.Int64("assignment_id", in.Id),
+			zap.Error(err))
+		return nil, err
+	}
+
+	return toProtoAssignmentResponse(assignment), nil
+}
+
+func (s *reviewService) GetAllAssignments(in *pb.EmptyRequest, stream grpc.ServerStreamingServer[pb.AssignmentResponse]) error {
+	logger := logging.FromContext(stream.Context(), s.logger).With(zap.String("operation", "get_all_assignments"))
+
+	assignments, err := s.assignments.GetAll(stream.Context())
+	if err != nil {
+		logger.Error("Failed to get assignments", zap.Error(err))
+		return err
+	}
+
+	for _, assignment := range assignments {
+		if err := stream.Send(toProtoAssignmentResponse(assignment)); err != nil {
+			logger.Error("Failed to send assignment in stream",
+				zap.Int64("assignment_id", assignment.ID),
+				zap.Error(err))
+			return err
+		}
+	}
+
+	logger.Debug("Sent assignments in stream", zap.Int("count", len(assignments)))
+	return nil
+}
+
+func (s *reviewService) UpdateAssignment(ctx context.Context, in *pb.UpdateAssignmentRequest) (*pb.AssignmentResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "update_assignment"),
+		zap.Int64("assignment_id", in.Id),
+		zap.String("requested_by", in.RequestedBy),
+	)
+
+	title := strings.TrimSpace(in.Title)
+	if title == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+
+	assignment, err := s.assignments.GetByID(ctx, in.Id)
+	if err != nil {
+		if errors.Is(err, repository.AssignmentNotFoundErr) {
+			return nil, statusError(err)
+		}
+		logger.Error("Failed to get assignment", zap.Error(err))
+		return nil, err
+	}
+
+	if assignment.CreatedBy != in.RequestedBy {
+		logger.Warn("Forbidden assignment update attempt")
+		return nil, status.Error(codes.PermissionDenied, "only the assignment creator can change it")
+	}
+
+	if err := s.checkRubricExists(ctx, in.RubricId); err != nil {
+		return nil, err
+	}
+
+	assignment, err = s.assignments.Update(ctx, in.Id, models.AssignmentRequest{
+		Title:     title,
+		Anonymous: in.Anonymous,
+		RubricID:  in.RubricId,
+	})
+	if err != nil {
+		if errors.Is(err, repository.AssignmentNotFoundErr) {
+			return nil, statusError(err)
+		}
+		logger.Error("Failed to update assignment", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info("Assignment updated successfully", zap.Bool("anonymous", assignment.Anonymous))
+	return toProtoAssignmentResponse(assignment), nil
+}
+
+func (s *reviewService) checkRubricExists(ctx context.Context, rubricID int64) error {
+	if rubricID == 0 {
+		return nil
+	}
+	if _, err := s.rubrics.GetByID(ctx, rubricID); err != nil {
+		if errors.Is(err, repository.RubricNotFoundErr) {
+			return invalidReference(err)
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/kafka"
+	kafkaMocks "github.com/IAGrig/vt-csa-essays/backend/review-service/internal/kafka/mocks"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository"
+	repoMocks "github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReviewService_AddAnonymousReview(t *testing.T) {
+	mockRepo := new(repoMocks.MockReviewRepository)
+	mockProducer := new(kafkaMocks.MockProducer)
+
+	mockRepo.On("Add", mock.Anything, mock.Anything).Return(models.Review{
+		ID:          4,
+		EssayId:     1,
+		Rank:        2,
+		Content:     "Blind review",
+		Author:      "reviewer1",
+		Anonymous:   true,
+		AuthorAlias: "Reviewer C",
+	}, nil)
+	mockProducer.On("SendNotificationEvent", mock.Anything, kafka.NotificationEvent{
+		Type:     "new_review",
+		UserID:   10,
+		Content:  "Your essay has been reviewed by Reviewer C",
+		EssayID:  1,
+		ReviewID: 4,
+		Author:   "Reviewer C",
+	}).Return(nil)
+
+	service := newTestService(Repositories{Reviews: mockRepo}, mockProducer)
+	result, err := service.Add(context.Background(), &pb.ReviewAddRequest{
+		EssayId:       1,
+		EssayAuthorId: 10,
+		Rank:          2,
+		Content:       "Blind review",
+		Author:        "reviewer1",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "reviewer1", result.Author)
+	assert.True(t, result.Anonymous)
+	assert.Equal(t, "Reviewer C", result.AuthorAlias)
+
+	mockRepo.AssertExpectations(t)
+	mockProducer.AssertExpectations(t)
+}
+
+func TestReviewService_CreateAssignment(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        *pb.CreateAssignmentRequest
+		setupMock    func(*repoMocks.MockAssignmentRepository, *repoMocks.MockRubricRepository)
+		expectedCode codes.Code
+	}{
+		{
+			name:  "success",
+			input: &pb.CreateAssignmentRequest{Title: " Week 1 ", CreatedBy: "teacher", Anonymous: true},
+			setupMock: func(assignments *repoMocks.MockAssignmentRepository, _ *repoMocks.MockRubricRepository) {
+				assignments.On("Create", mock.Anything, models.AssignmentRequest{Title: "Week 1", CreatedBy: "teacher", Anonymous: true}).
+					Return(models.Assignment{ID: 5, Title: "Week 1", CreatedBy: "teacher", Anonymous: true}, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:  "with rubric",
+			input: &pb.CreateAssignmentRequest{Title: "Week 1", CreatedBy: "teacher", Anonymous: true, RubricId: 7},
+			setupMock: func(assignments *repoMocks.MockAssignmentRepository, rubrics *repoMocks.MockRubricRepository) {
+				rubrics.On("GetByID", mock.Anything, int64(7)).Return(testRubric, nil)
+				assignments.On("Create", mock.Anything, models.AssignmentRequest{Title: "Week 1", CreatedBy: "teacher", Anonymous: true, RubricID: 7}).
+					Return(models.Assignment{ID: 5, Title: "Week 1", CreatedBy: "teacher", Anonymous: true, RubricID: 7}, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:  "unknown rubric",
+			input: &pb.CreateAssignmentRequest{Title: "Week 1", CreatedBy: "teacher", RubricId: 8},
+			setupMock: func(_ *repoMocks.MockAssignmentRepository, rubrics *repoMocks.MockRubricRepository) {
+				rubrics.On("GetByID", mock.Anything, int64(8)).Return(models.Rubric{}, repository.RubricNotFoundErr)
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "empty title",
+			input:        &pb.CreateAssignmentRequest{Title: "  ", CreatedBy: "teacher"},
+			setupMock:    func(*repoMocks.MockAssignmentRepository, *repoMocks.MockRubricRepository) {},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAssignments := new(repoMocks.MockAssignmentRepository)
+			mockRubrics := new(repoMocks.MockRubricRepository)
+			tt.setupMock(mockAssignments, mockRubrics)
+
+			service := newTestService(Repositories{Assignments: mockAssignments, Rubrics: mockRubrics}, nil)
+			result, err := service.CreateAssignment(context.Background(), tt.input)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.Equal(t, int64(5), result.Id)
+				assert.Equal(t, tt.input.RubricId, result.RubricId)
+				assert.True(t, result.Anonymous)
+			}
+
+			mockAssignments.AssertExpectations(t)
+			mockRubrics.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReviewService_UpdateAssignment(t *testing.T) {
+	assignment := models.Assignment{ID: 5, Title: "Week 1", CreatedBy: "teacher"}
+
+	tests := []struct {
+		name         string
+		input        *pb.UpdateAssignmentRequest
+		setupMock    func(*repoMocks.MockAssignmentRepository)
+		expectedCode codes.Code
+	}{
+		{
+			name:  "creator turns on anonymity",
+			input: &pb.UpdateAssignmentRequest{Id: 5, Title: "Week 1", Anonymous: true, RequestedBy: "teacher"},
+			setupMock: func(assignments *repoMocks.MockAssignmentRepository) {
+				assignments.On("GetByID", mock.Anything, int64(5)).Return(assignment, nil)
+				assignments.On("Update", mock.Anything, int64(5), models.AssignmentRequest{Title: "Week 1", Anonymous: true}).
+					Return(models.Assignment{ID: 5, Title: "Week 1", CreatedBy: "teacher", Anonymous: true}, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:  "another teacher",
+			input: &pb.UpdateAssignmentRequest{Id: 5, Title: "Week 1", RequestedBy: "other"},
+			setupMock: func(assignments *repoMocks.MockAssignmentRepository) {
+				assignments.On("GetByID", mock.Anything, int64(5)).Return(assignment, nil)
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:  "unknown assignment",
+			input: &pb.UpdateAssignmentRequest{Id: 6, Title: "Week 1", RequestedBy: "teacher"},
+			setupMock: func(assignments *repoMocks.MockAssignmentRepository) {
+				assignments.On("GetByID", mock.Anything, int64(6)).Return(models.Assignment{}, repository.AssignmentNotFoundErr)
+			},
+			expectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAssignments := new(repoMocks.MockAssignmentRepository)
+			tt.setupMock(mockAssignments)
+
+			service := newTestService(Repositories{Assignments: mockAssignments}, nil)
+			result, err := service.UpdateAssignment(context.Background(), tt.input)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.True(t, result.Anonymous)
+			}
+
+			mockAssignments.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReviewService_GetAssignment(t *testing.T) {
+	mockAssignments := new(repoMocks.MockAssignmentRepository)
+	mockAssignments.On("GetByID", mock.Anything, int64(5)).Return(models.Assignment{ID: 5, Title: "Week 1"}, nil)
+	mockAssignments.On("GetByID", mock.Anything, int64(6)).Return(models.Assignment{}, repository.AssignmentNotFoundErr)
+
+	service := newTestService(Repositories{Assignments: mockAssignments}, nil)
+
+	result, err := service.GetAssignment(context.Background(), &pb.GetAssignmentRequest{Id: 5})
+	require.NoError(t, err)
+	assert.Equal(t, "Week 1", result.Title)
+
+	_, err = service.GetAssignment(context.Background(), &pb.GetAssignmentRequest{Id: 6})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxCommentsPerReview = 100
+
+// Validates comment ranges against the current essay text and pins them to its revision
+func (s *reviewService) applyComments(ctx context.Context, req *models.ReviewRequest, revision int) error {
+	if len(req.Comments) == 0 {
+		return nil
+	}
+	if len(req.Comments) > maxCommentsPerReview {
+		return status.Errorf(codes.InvalidArgument,
+			"a review can have at most %d inline comments", maxCommentsPerReview)
+	}
+
+	text, err := s.repository.GetEssayText(ctx, req.EssayId)
+	if err != nil {
+		if errors.Is(err, repository.EssayNotFoundErr) {
+			return invalidReference(err)
+		}
+		return err
+	}
+
+	if revision != 0 && revision != text.Revision {
+		return status.Errorf(codes.FailedPrecondition,
+			"essay has changed: comments refer to revision %d, current revision is %d", revision, text.Revision)
+	}
+
+	runes := []rune(text.Content)
+	for i := range req.Comments {
+		comment := &req.Comments[i]
+		if strings.TrimSpace(comment.Content) == "" {
+			return status.Errorf(codes.InvalidArgument, "comment %d: content is required", i+1)
+		}
+		if comment.StartOffset < 0 || comment.EndOffset <= comment.StartOffset || comment.EndOffset > len(runes) {
+			return status.Errorf(codes.InvalidArgument,
+				"comment %d: range [%d, %d) is outside the essay of %d characters",
+				i+1, comment.StartOffset, comment.EndOffset, len(runes))
+		}
+
+		quote := string(runes[comment.StartOffset:comment.EndOffset])
+		if comment.Quote != "" && comment.Quote != quote {
+			return status.Errorf(codes.InvalidArgument,
+				"comment %d: quote does not match the essay text at [%d, %d)",
+				i+1, comment.StartOffset, comment.EndOffset)
+		}
+
+		comment.Quote = quote
+		comment.EssayRevision = text.Revision
+	}
+
+	return nil
+}
+
+// Moves comments made on older revisions of the essay onto its current text,
+// called by the essay service once the text has changed so reads stay side-effect free
+func (s *reviewService) ReanchorComments(ctx context.Context, in *pb.ReanchorCommentsRequest) (*pb.ReanchorCommentsResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "reanchor_comments"),
+		zap.Int32("essay_id", in.EssayId),
+	)
+
+	text, err := s.repository.GetEssayText(ctx, int(in.EssayId))
+	if err != nil {
+		if errors.Is(err, repository.EssayNotFoundErr) {
+			logger.Debug("Essay not found")
+		} else {
+			logger.Error("Failed to load essay text", zap.Error(err))
+		}
+		return nil, statusError(err)
+	}
+
+	reviews, err := s.repository.GetByEssayId(ctx, int(in.EssayId))
+	if err != nil {
+		logger.Error("Failed to get reviews of essay", zap.Error(err))
+		return nil, statusError(err)
+	}
+
+	var changed []models.InlineComment
+	for i := range reviews {
+		changed = append(changed, reanchorComments(text, reviews[i].Comments)...)
+	}
+
+	response := &pb.ReanchorCommentsResponse{EssayRevision: int32(text.Revision)}
+	if len(changed) == 0 {
+		return response, nil
+	}
+
+	if err := s.repository.UpdateCommentAnchors(ctx, changed); err != nil {
+		logger.Error("Failed to store re-anchored comments", zap.Error(err))
+		return nil, statusError(err)
+	}
+
+	for _, comment := range changed {
+		if comment.Orphaned {
+			response.Orphaned++
+		} else {
+			response.Reanchored++
+		}
+	}
+
+	logger.Info("Comments re-anchored",
+		zap.Int("revision", text.Revision),
+		zap.Int32("reanchored", response.Reanchored),
+		zap.Int32("orphaned", response.Orphaned))
+	return response, nil
+}
+
+// Updates comments in place and returns the ones whose anchor changed
+func reanchorComments(text models.EssayText, comments []models.InlineComment) []models.InlineComment {
+	var changed []models.InlineComment
+	runes := []rune(text.Content)
+
+	for i := range comments {
+		comment := &comments[i]
+		if comment.Orphaned || comment.EssayRevision == text.Revision {
+			continue
+		}
+
+		if comment.EndOffset <= len(runes) && string(runes[comment.StartOffset:comment.EndOffset]) == comment.Quote {
+			comment.EssayRevision = text.Revision
+		} else if start, ok := nearestOccurrence(text.Content, comment.Quote, comment.StartOffset); ok {
+			comment.StartOffset = start
+			comment.EndOffset = start + utf8.RuneCountInString(comment.Quote)
+			comment.EssayRevision = text.Revision
+		} else {
+			comment.Orphaned = true
+		}
+
+		changed = append(changed, *comment)
+	}
+
+	return changed
+}
+
+// Finds the occurrence of quote whose character offset is closest to the old one
+func nearestOccurrence(content, quote string, offset int) (int, bool) {
+	if quote == "" {
+		return 0, false
+	}
+
+	best, found := 0, false
+	byteStart, runeStart := 0, 0
+	for {
+		i := strings.Index(content[byteStart:], quote)
+		if i < 0 {
+			break
+		}
+		runeStart += utf8.RuneCountInString(content[byteStart : byteStart+i])
+		byteStart += i
+
+		if !found || abs(runeStart-offset) < abs(best-offset) {
+			best, found = runeStart, true
+		}
+
+		_, size := utf8.DecodeRuneInString(content[byteStart:])
+		byteStart += size
+		runeStart++
+	}
+
+	return best, found
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,272 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+	kafkaMocks "github.com/IAGrig/vt-csa-essays/backend/review-service/internal/kafka/mocks"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository"
+	repoMocks "github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReanchorComments(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		comment       models.InlineComment
+		expected      models.InlineComment
+		expectChanged bool
+	}{
+		{
+			name:     "current revision is left alone",
+			content:  "Hello world",
+			comment:  models.InlineComment{StartOffset: 0, EndOffset: 5, Quote: "Hello", EssayRevision: 2},
+			expected: models.InlineComment{StartOffset: 0, EndOffset: 5, Quote: "Hello", EssayRevision: 2},
+		},
+		{
+			name:          "unchanged range moves to new revision",
+			content:       "Hello there world",
+			comment:       models.InlineComment{StartOffset: 0, EndOffset: 5, Quote: "Hello", EssayRevision: 1},
+			expected:      models.InlineComment{StartOffset: 0, EndOffset: 5, Quote: "Hello", EssayRevision: 2},
+			expectChanged: true,
+		},
+		{
+			name:          "shifted quote is found again",
+			content:       "Well. Hello world",
+			comment:       models.InlineComment{StartOffset: 6, EndOffset: 11, Quote: "world", EssayRevision: 1},
+			expected:      models.InlineComment{StartOffset: 12, EndOffset: 17, Quote: "world", EssayRevision: 2},
+			expectChanged: true,
+		},
+		{
+			name:          "nearest of several occurrences wins",
+			content:       "ab ab ab ab",
+			comment:       models.InlineComment{StartOffset: 7, EndOffset: 9, Quote: "ab", EssayRevision: 1},
+			expected:      models.InlineComment{StartOffset: 6, EndOffset: 8, Quote: "ab", EssayRevision: 2},
+			expectChanged: true,
+		},
+		{
+			name:          "offsets count characters not bytes",
+			content:       "Привет, мир",
+			comment:       models.InlineComment{StartOffset: 0, EndOffset: 3, Quote: "мир", EssayRevision: 1},
+			expected:      models.InlineComment{StartOffset: 8, EndOffset: 11, Quote: "мир", EssayRevision: 2},
+			expectChanged: true,
+		},
+		{
+			name:          "missing quote orphans the comment",
+			content:       "Completely rewritten",
+			comment:       models.InlineComment{StartOffset: 0, EndOffset: 5, Quote: "Hello", EssayRevision: 1},
+			expected:      models.InlineComment{StartOffset: 0, EndOffset: 5, Quote: "Hello", EssayRevision: 1, Orphaned: true},
+			expectChanged: true,
+		},
+		{
+			name:     "orphaned comments stay orphaned",
+			content:  "Hello again",
+			comment:  models.InlineComment{StartOffset: 0, EndOffset: 5, Quote: "Hello", EssayRevision: 1, Orphaned: true},
+			expected: models.InlineComment{StartOffset: 0, EndOffset: 5, Quote: "Hello", EssayRevision: 1, Orphaned: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comments := []models.InlineComment{tt.comment}
+			changed := reanchorComments(models.EssayText{Content: tt.content, Revision: 2}, comments)
+
+			assert.Equal(t, tt.expected, comments[0])
+			if tt.expectChanged {
+				assert.Equal(t, []models.InlineComment{tt.expected}, changed)
+			} else {
+				assert.Empty(t, changed)
+			}
+		})
+	}
+}
+
+func TestReviewService_AddWithComments(t *testing.T) {
+	essay := models.EssayText{EssayID: 1, Content: "The thesis is unclear here.", Revision: 3}
+
+	tests := []struct {
+		name         string
+		input        *pb.ReviewAddRequest
+		setupMock    func(*repoMocks.MockReviewRepository, *kafkaMocks.MockProducer)
+		expectedCode codes.Code
+	}{
+		{
+			name: "quote is filled from the essay",
+			input: &pb.ReviewAddRequest{
+				EssayId:  1,
+				Rank:     2,
+				Content:  "Review",
+				Author:   "reviewer",
+				Comments: []*pb.InlineComment{{StartOffset: 4, EndOffset: 10, Content: "Which thesis?"}},
+			},
+			setupMock: func(mockRepo *repoMocks.MockReviewRepository, mockProducer *kafkaMocks.MockProducer) {
+				mockRepo.On("GetEssayText", mock.Anything, 1).Return(essay, nil)
+				mockRepo.On("Add", mock.Anything, mock.MatchedBy(func(req models.ReviewRequest) bool {
+					return len(req.Comments) == 1 && req.Comments[0].Quote == "thesis" && req.Comments[0].EssayRevision == 3
+				})).Return(models.Review{ID: 1, EssayId: 1, Rank: 2, Comments: []models.InlineComment{
+					{ID: 5, StartOffset: 4, EndOffset: 10, Quote: "thesis", Content: "Which thesis?", EssayRevision: 3},
+				}}, nil)
+				mockProducer.On("SendNotificationEvent", mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "range outside the essay",
+			input: &pb.ReviewAddRequest{
+				EssayId:  1,
+				Rank:     2,
+				Author:   "reviewer",
+				Comments: []*pb.InlineComment{{StartOffset: 20, EndOffset: 40, Content: "?"}},
+			},
+			setupMock: func(mockRepo *repoMocks.MockReviewRepository, mockProducer *kafkaMocks.MockProducer) {
+				mockRepo.On("GetEssayText", mock.Anything, 1).Return(essay, nil)
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "quote mismatch",
+			input: &pb.ReviewAddRequest{
+				EssayId:  1,
+				Rank:     2,
+				Author:   "reviewer",
+				Comments: []*pb.InlineComment{{StartOffset: 4, EndOffset: 10, Quote: "thing", Content: "?"}},
+			},
+			setupMock: func(mockRepo *repoMocks.MockReviewRepository, mockProducer *kafkaMocks.MockProducer) {
+				mockRepo.On("GetEssayText", mock.Anything, 1).Return(essay, nil)
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "stale revision",
+			input: &pb.ReviewAddRequest{
+				EssayId:       1,
+				Rank:          2,
+				Author:        "reviewer",
+				EssayRevision: 2,
+				Comments:      []*pb.InlineComment{{StartOffset: 4, EndOffset: 10, Content: "?"}},
+			},
+			setupMock: func(mockRepo *repoMocks.MockReviewRepository, mockProducer *kafkaMocks.MockProducer) {
+				mockRepo.On("GetEssayText", mock.Anything, 1).Return(essay, nil)
+			},
+			expectedCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(repoMocks.MockReviewRepository)
+			mockProducer := new(kafkaMocks.MockProducer)
+			tt.setupMock(mockRepo, mockProducer)
+
+			service := newTestService(Repositories{Reviews: mockRepo}, mockProducer)
+			result, err := service.Add(context.Background(), tt.input)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				require.Len(t, result.Comments, 1)
+				assert.Equal(t, "thesis", result.Comments[0].Quote)
+				assert.Equal(t, int32(3), result.Comments[0].EssayRevision)
+			}
+
+			mockRepo.AssertExpectations(t)
+			mockProducer.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReviewService_ReanchorComments(t *testing.T) {
+	essay := models.EssayText{EssayID: 1, Content: "A new thesis", Revision: 2}
+
+	tests := []struct {
+		name         string
+		setupMock    func(*repoMocks.MockReviewRepository)
+		expectedCode codes.Code
+		expected     *pb.ReanchorCommentsResponse
+	}{
+		{
+			name: "moves and orphans stale comments",
+			setupMock: func(mockRepo *repoMocks.MockReviewRepository) {
+				mockRepo.On("GetEssayText", mock.Anything, 1).Return(essay, nil)
+				mockRepo.On("GetByEssayId", mock.Anything, 1).Return([]models.Review{
+					{ID: 1, EssayId: 1, Comments: []models.InlineComment{
+						{ID: 10, StartOffset: 0, EndOffset: 6, Quote: "thesis", EssayRevision: 1},
+						{ID: 11, StartOffset: 7, EndOffset: 12, Quote: "gone!", EssayRevision: 1},
+						{ID: 12, StartOffset: 0, EndOffset: 1, Quote: "A", EssayRevision: 2},
+					}},
+					{ID: 2, EssayId: 1},
+				}, nil)
+				mockRepo.On("UpdateCommentAnchors", mock.Anything, []models.InlineComment{
+					{ID: 10, StartOffset: 6, EndOffset: 12, Quote: "thesis", EssayRevision: 2},
+					{ID: 11, StartOffset: 7, EndOffset: 12, Quote: "gone!", EssayRevision: 1, Orphaned: true},
+				}).Return(nil)
+			},
+			expectedCode: codes.OK,
+			expected:     &pb.ReanchorCommentsResponse{EssayRevision: 2, Reanchored: 1, Orphaned: 1},
+		},
+		{
+			name: "comments already on the current revision",
+			setupMock: func(mockRepo *repoMocks.MockReviewRepository) {
+				mockRepo.On("GetEssayText", mock.Anything, 1).Return(essay, nil)
+				mockRepo.On("GetByEssayId", mock.Anything, 1).Return([]models.Review{
+					{ID: 1, EssayId: 1, Comments: []models.InlineComment{
+						{ID: 12, StartOffset: 0, EndOffset: 1, Quote: "A", EssayRevision: 2},
+					}},
+				}, nil)
+			},
+			expectedCode: codes.OK,
+			expected:     &pb.ReanchorCommentsResponse{EssayRevision: 2},
+		},
+		{
+			name: "unknown essay",
+			setupMock: func(mockRepo *repoMocks.MockReviewRepository) {
+				mockRepo.On("GetEssayText", mock.Anything, 1).Return(models.EssayText{}, repository.EssayNotFoundErr)
+			},
+			expectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(repoMocks.MockReviewRepository)
+			tt.setupMock(mockRepo)
+			service := newTestService(Repositories{Reviews: mockRepo}, nil)
+
+			result, err := service.ReanchorComments(context.Background(), &pb.ReanchorCommentsRequest{EssayId: 1})
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.Equal(t, tt.expected, result)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReviewService_GetByEssayIdLeavesAnchorsAlone(t *testing.T) {
+	mockRepo := new(repoMocks.MockReviewRepository)
+	mockRepo.On("GetByEssayId", mock.Anything, 1).Return([]models.Review{
+		{ID: 1, EssayId: 1, Comments: []models.InlineComment{
+			{ID: 10, StartOffset: 0, EndOffset: 6, Quote: "thesis", EssayRevision: 1},
+		}},
+	}, nil)
+
+	service := newTestService(Repositories{Reviews: mockRepo}, nil)
+	stream := &MinimalServerStream{ctx: context.Background()}
+
+	err := service.GetByEssayId(&pb.GetByEssayIdRequest{EssayId: 1}, stream)
+	require.NoError(t, err)
+	require.Len(t, stream.sentMessages, 1)
+	assert.Equal(t, int32(0), stream.sentMessages[0].Comments[0].StartOffset)
+	assert.Equal(t, int32(1), stream.sentMessages[0].Comments[0].EssayRevision)
+
+	mockRepo.AssertNotCalled(t, "GetEssayText", mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "UpdateCommentAnchors", mock.Anything, mock.Anything)
+	mockRepo.AssertExpectations(t)
+}
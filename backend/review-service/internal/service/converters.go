@@ -1,6 +1,8 @@
 package service
 
 import (
+	"strings"
+
 	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
 
 	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
@@ -11,13 +13,367 @@ func toProtoReviewResponse(r models.Review) *pb.ReviewResponse {
 	if !r.CreatedAt.IsZero() {
 		createdAt = r.CreatedAt.Unix()
 	}
+	var editedAt int64
+	if r.EditedAt != nil {
+		editedAt = r.EditedAt.Unix()
+	}
 
 	return &pb.ReviewResponse{
-		Id:        int32(r.ID),
-		EssayId:   int32(r.EssayId),
-		Rank:      int32(r.Rank),
-		Content:   r.Content,
-		Author:    r.Author,
+		Id:          int32(r.ID),
+		EssayId:     int32(r.EssayId),
+		Rank:        int32(r.Rank),
+		Content:     r.Content,
+		Author:      r.Author,
+		CreatedAt:   createdAt,
+		RubricId:    r.RubricID,
+		TotalScore:  r.TotalScore,
+		Scores:      toProtoCriterionScores(r.Scores),
+		Comments:    toProtoInlineComments(r.Comments),
+		Anonymous:   r.Anonymous,
+		AuthorAlias: r.AuthorAlias,
+		Edited:      r.EditedAt != nil,
+		EditedAt:    editedAt,
+	}
+}
+
+func toProtoInlineComments(comments []models.InlineComment) []*pb.InlineComment {
+	if len(comments) == 0 {
+		return nil
+	}
+
+	result := make([]*pb.InlineComment, 0, len(comments))
+	for _, comment := range comments {
+		var createdAt int64
+		if !comment.CreatedAt.IsZero() {
+			createdAt = comment.CreatedAt.Unix()
+		}
+
+		result = append(result, &pb.InlineComment{
+			Id:            comment.ID,
+			StartOffset:   int32(comment.StartOffset),
+			EndOffset:     int32(comment.EndOffset),
+			Quote:         comment.Quote,
+			Content:       comment.Content,
+			EssayRevision: int32(comment.EssayRevision),
+			Orphaned:      comment.Orphaned,
+			CreatedAt:     createdAt,
+		})
+	}
+	return result
+}
+
+func toProtoCriterionScores(scores []models.CriterionScore) []*pb.CriterionScore {
+	if len(scores) == 0 {
+		return nil
+	}
+
+	result := make([]*pb.CriterionScore, 0, len(scores))
+	for _, score := range scores {
+		result = append(result, &pb.CriterionScore{
+			CriterionId:   score.CriterionID,
+			Score:         int32(score.Score),
+			Comment:       score.Comment,
+			CriterionName: score.CriterionName,
+			Weight:        score.Weight,
+			MinScore:      int32(score.MinScore),
+			MaxScore:      int32(score.MaxScore),
+		})
+	}
+	return result
+}
+
+func fromProtoReviewAddRequest(in *pb.ReviewAddRequest) models.ReviewRequest {
+	req := models.ReviewRequest{
+		EssayId:  int(in.EssayId),
+		Rank:     int(in.Rank),
+		Content:  in.Content,
+		Author:   in.Author,
+		RubricID: in.RubricId,
+	}
+
+	for _, score := range in.Scores {
+		req.Scores = append(req.Scores, models.CriterionScore{
+			CriterionID: score.CriterionId,
+			Score:       int(score.Score),
+			Comment:     score.Comment,
+		})
+	}
+
+	for _, comment := range in.Comments {
+		req.Comments = append(req.Comments, models.InlineComment{
+			StartOffset: int(comment.StartOffset),
+			EndOffset:   int(comment.EndOffset),
+			Quote:       comment.Quote,
+			Content:     comment.Content,
+		})
+	}
+	return req
+}
+
+func fromProtoUpdateReviewRequest(in *pb.UpdateReviewRequest) models.ReviewRequest {
+	req := models.ReviewRequest{
+		Rank:    int(in.Rank),
+		Content: in.Content,
+		Author:  in.Author,
+	}
+
+	for _, score := range in.Scores {
+		req.Scores = append(req.Scores, models.CriterionScore{
+			CriterionID: score.CriterionId,
+			Score:       int(score.Score),
+			Comment:     score.Comment,
+		})
+	}
+	return req
+}
+
+func fromProtoSaveDraftRequest(in *pb.SaveDraftRequest) models.ReviewDraft {
+	draft := models.ReviewDraft{
+		EssayID:       int(in.EssayId),
+		Author:        in.Author,
+		Rank:          int(in.Rank),
+		Content:       in.Content,
+		RubricID:      in.RubricId,
+		EssayRevision: int(in.EssayRevision),
+	}
+
+	for _, score := range in.Scores {
+		draft.Scores = append(draft.Scores, models.CriterionScore{
+			CriterionID: score.CriterionId,
+			Score:       int(score.Score),
+			Comment:     score.Comment,
+		})
+	}
+
+	for _, comment := range in.Comments {
+		draft.Comments = append(draft.Comments, models.InlineComment{
+			StartOffset:   int(comment.StartOffset),
+			EndOffset:     int(comment.EndOffset),
+			Quote:         comment.Quote,
+			Content:       comment.Content,
+			EssayRevision: int(in.EssayRevision),
+		})
+	}
+	return draft
+}
+
+func toProtoDraftResponse(d models.ReviewDraft) *pb.DraftResponse {
+	var updatedAt int64
+	if !d.UpdatedAt.IsZero() {
+		updatedAt = d.UpdatedAt.Unix()
+	}
+
+	return &pb.DraftResponse{
+		EssayId:       int32(d.EssayID),
+		Author:        d.Author,
+		Rank:          int32(d.Rank),
+		Content:       d.Content,
+		RubricId:      d.RubricID,
+		Scores:        toProtoCriterionScores(d.Scores),
+		EssayRevision: int32(d.EssayRevision),
+		Comments:      toProtoInlineComments(d.Comments),
+		UpdatedAt:     updatedAt,
+	}
+}
+
+func fromProtoGetByAuthorRequest(in *pb.GetByAuthorRequest) models.ReviewFilter {
+	return models.ReviewFilter{
+		Author:  strings.TrimSpace(in.Author),
+		EssayID: int(in.EssayId),
+		MinRank: int(in.MinRank),
+		Limit:   int(in.Limit),
+		Offset:  int(in.Offset),
+
+		IncludeAnonymous: in.IncludeAnonymous,
+	}
+}
+
+func toProtoReviewVersionResponse(v models.ReviewVersion) *pb.ReviewVersionResponse {
+	return &pb.ReviewVersionResponse{
+		Id:         v.ID,
+		ReviewId:   int32(v.ReviewID),
+		Rank:       int32(v.Rank),
+		Content:    v.Content,
+		TotalScore: v.TotalScore,
+		WrittenAt:  v.WrittenAt.Unix(),
+		ReplacedAt: v.ReplacedAt.Unix(),
+	}
+}
+
+func toProtoRubricResponse(r models.Rubric) *pb.RubricResponse {
+	var createdAt int64
+	if !r.CreatedAt.IsZero() {
+		createdAt = r.CreatedAt.Unix()
+	}
+
+	criteria := make([]*pb.Criterion, 0, len(r.Criteria))
+	for _, criterion := range r.Criteria {
+		criteria = append(criteria, &pb.Criterion{
+			Id:          criterion.ID,
+			Name:        criterion.Name,
+			Description: criterion.Description,
+			Weight:      criterion.Weight,
+			MinScore:    int32(criterion.MinScore),
+			MaxScore:    int32(criterion.MaxScore),
+		})
+	}
+
+	return &pb.RubricResponse{
+		Id:        r.ID,
+		Title:     r.Title,
+		CreatedBy: r.CreatedBy,
+		Criteria:  criteria,
 		CreatedAt: createdAt,
 	}
 }
+
+func fromProtoCreateRubricRequest(in *pb.CreateRubricRequest) models.RubricRequest {
+	req := models.RubricRequest{
+		Title:     strings.TrimSpace(in.Title),
+		CreatedBy: in.CreatedBy,
+	}
+
+	for _, criterion := range in.Criteria {
+		req.Criteria = append(req.Criteria, models.Criterion{
+			Name:        strings.TrimSpace(criterion.Name),
+			Description: criterion.Description,
+			Weight:      criterion.Weight,
+			MinScore:    int(criterion.MinScore),
+			MaxScore:    int(criterion.MaxScore),
+		})
+	}
+	return req
+}
+
+func toProtoReplyResponse(r models.Reply) *pb.ReplyResponse {
+	var createdAt int64
+	if !r.CreatedAt.IsZero() {
+		createdAt = r.CreatedAt.Unix()
+	}
+
+	return &pb.ReplyResponse{
+		Id:          r.ID,
+		ReviewId:    int32(r.ReviewID),
+		ParentId:    r.ParentID,
+		Author:      r.Author,
+		Content:     r.Content,
+		CreatedAt:   createdAt,
+		Anonymous:   r.Anonymous,
+		AuthorAlias: r.AuthorAlias,
+	}
+}
+
+func toProtoAssignmentResponse(a models.Assignment) *pb.AssignmentResponse {
+	var createdAt int64
+	if !a.CreatedAt.IsZero() {
+		createdAt = a.CreatedAt.Unix()
+	}
+
+	return &pb.AssignmentResponse{
+		Id:             a.ID,
+		Title:          a.Title,
+		CreatedBy:      a.CreatedBy,
+		Anonymous:      a.Anonymous,
+		CreatedAt:      createdAt,
+		GradesReleased: a.GradesReleased,
+		RubricId:       a.RubricID,
+	}
+}
+
+func toProtoGradeResponse(g models.Grade, grading models.GradingContext) *pb.GradeResponse {
+	resp := &pb.GradeResponse{
+		EssayId:         int32(g.EssayID),
+		Grade:           g.Grade,
+		Feedback:        g.Feedback,
+		GradedBy:        g.GradedBy,
+		PeerReviewCount: int32(grading.PeerReviewCount),
+		Released:        grading.GradesReleased,
+	}
+	if !g.GradedAt.IsZero() {
+		resp.GradedAt = g.GradedAt.Unix()
+	}
+	if grading.PeerScore != nil {
+		resp.PeerScore = *grading.PeerScore
+	}
+	return resp
+}
+
+func toProtoGradebookEntry(e models.GradebookEntry) *pb.GradebookEntry {
+	entry := &pb.GradebookEntry{
+		EssayId:         int32(e.EssayID),
+		EssayAuthor:     e.EssayAuthor,
+		PeerReviewCount: int32(e.PeerReviewCount),
+	}
+	if !e.SubmittedAt.IsZero() {
+		entry.SubmittedAt = e.SubmittedAt.Unix()
+	}
+	if e.PeerScore != nil {
+		entry.PeerScore = *e.PeerScore
+	}
+	if e.Grade != nil {
+		entry.Graded = true
+		entry.Grade = e.Grade.Grade
+		entry.Feedback = e.Grade.Feedback
+		entry.GradedBy = e.Grade.GradedBy
+		entry.GradedAt = e.Grade.GradedAt.Unix()
+	}
+	return entry
+}
+
+func toProtoCalibrationResponse(c models.CalibrationEssay) *pb.CalibrationResponse {
+	return &pb.CalibrationResponse{
+		EssayId:       int32(c.EssayID),
+		ReferenceRank: int32(c.ReferenceRank),
+		CreatedBy:     c.CreatedBy,
+		CreatedAt:     c.CreatedAt.Unix(),
+	}
+}
+
+func toProtoReviewerReliabilityResponse(r models.ReviewerReliability) *pb.ReviewerReliabilityResponse {
+	return &pb.ReviewerReliabilityResponse{
+		Reviewer:           r.Reviewer,
+		Score:              r.Score,
+		ConsensusReviews:   int32(r.ConsensusReviews),
+		CalibrationReviews: int32(r.CalibrationReviews),
+		ComputedAt:         r.ComputedAt.Unix(),
+	}
+}
+
+func toProtoReviewStats(s models.ReviewStats) *pb.ReviewStats {
+	var lastReviewAt int64
+	if s.LastReviewAt != nil {
+		lastReviewAt = s.LastReviewAt.Unix()
+	}
+
+	var distribution map[int32]int32
+	if len(s.RankDistribution) > 0 {
+		distribution = make(map[int32]int32, len(s.RankDistribution))
+		for rank, count := range s.RankDistribution {
+			distribution[int32(rank)] = int32(count)
+		}
+	}
+
+	return &pb.ReviewStats{
+		ReviewCount:      int32(s.ReviewCount),
+		MeanRank:         s.MeanRank,
+		WeightedMeanRank: s.WeightedMeanRank,
+		MedianRank:       s.MedianRank,
+		RankDistribution: distribution,
+		LastReviewAt:     lastReviewAt,
+	}
+}
+
+func toProtoEssayStatsResponse(s models.EssayStats) *pb.EssayStatsResponse {
+	return &pb.EssayStatsResponse{
+		EssayId: int32(s.EssayID),
+		Stats:   toProtoReviewStats(s.Stats),
+	}
+}
+
+func toProtoAssignmentStatsResponse(s models.AssignmentStats) *pb.AssignmentStatsResponse {
+	return &pb.AssignmentStatsResponse{
+		AssignmentId: s.AssignmentID,
+		EssayCount:   int32(s.EssayCount),
+		Stats:        toProtoReviewStats(s.Stats),
+	}
+}
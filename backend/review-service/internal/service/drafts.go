@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/monitoring"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+)
+
+// Keeps autosaved drafts within a sane size, submitted reviews are not limited
+const maxDraftContentLength = 50000
+
+func (s *reviewService) SaveDraft(ctx context.Context, in *pb.SaveDraftRequest) (*pb.DraftResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "save_draft"),
+		zap.Int32("essay_id", in.EssayId),
+		zap.String("author", in.Author),
+	)
+
+	if in.Author == "" {
+		return nil, status.Error(codes.InvalidArgument, "author is required")
+	}
+	if len([]rune(in.Content)) > maxDraftContentLength {
+		return nil, status.Errorf(codes.InvalidArgument, "draft content is limited to %d characters", maxDraftContentLength)
+	}
+	if len(in.Comments) > maxCommentsPerReview {
+		return nil, status.Errorf(codes.InvalidArgument, "a review can have at most %d inline comments", maxCommentsPerReview)
+	}
+
+	if _, err := s.repository.GetEssayText(ctx, int(in.EssayId)); err != nil {
+		if errors.Is(err, repository.EssayNotFoundErr) {
+			return nil, statusError(err)
+		}
+		logger.Error("Failed to check essay", zap.Error(err))
+		return nil, err
+	}
+
+	draft, err := s.repository.SaveDraft(ctx, fromProtoSaveDraftRequest(in))
+	if err != nil {
+		logger.Error("Failed to save draft", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Debug("Draft saved successfully")
+	return toProtoDraftResponse(draft), nil
+}
+
+func (s *reviewService) GetDraft(ctx context.Context, in *pb.GetDraftRequest) (*pb.DraftResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "get_draft"),
+		zap.Int32("essay_id", in.EssayId),
+		zap.String("author", in.Author),
+	)
+
+	draft, err := s.repository.GetDraft(ctx, int(in.EssayId), in.Author)
+	if err != nil {
+		if errors.Is(err, repository.DraftNotFoundErr) {
+			return nil, statusError(err)
+		}
+		logger.Error("Failed to get draft", zap.Error(err))
+		return nil, err
+	}
+
+	return toProtoDraftResponse(draft), nil
+}
+
+// Validates the draft like a new review and turns it into one
+func (s *reviewService) SubmitDraft(ctx context.Context, in *pb.SubmitDraftRequest) (*pb.ReviewResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "submit_draft"),
+		zap.Int32("essay_id", in.EssayId),
+		zap.String("author", in.Author),
+	)
+
+	draft, err := s.repository.GetDraft(ctx, int(in.EssayId), in.Author)
+	if err != nil {
+		if errors.Is(err, repository.DraftNotFoundErr) {
+			return nil, statusError(err)
+		}
+		logger.Error("Failed to get draft", zap.Error(err))
+		return nil, err
+	}
+
+	req := models.ReviewRequest{
+		EssayId:  draft.EssayID,
+		Rank:     draft.Rank,
+		Content:  strings.TrimSpace(draft.Content),
+		Author:   draft.Author,
+		RubricID: draft.RubricID,
+		Scores:   draft.Scores,
+		Comments: draft.Comments,
+	}
+	if err := s.validateDraft(ctx, &req, draft.EssayRevision); err != nil {
+		logger.Debug("Rejected draft", zap.Error(err))
+		return nil, err
+	}
+
+	review, err := s.repository.AddFromDraft(ctx, req, draft.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, repository.DraftNotFoundErr) || errors.Is(err, repository.DraftChangedErr) {
+			return nil, statusError(err)
+		}
+		logger.Error("Failed to submit draft", zap.Error(err))
+		return nil, err
+	}
+
+	monitoring.ReviewsCreated.Inc()
+
+	logger.Info("Draft submitted successfully",
+		zap.Int("review_id", review.ID))
+
+	s.notifyNewReview(ctx, logger, review, in.EssayAuthorId)
+
+	return toProtoReviewResponse(review), nil
+}
+
+func (s *reviewService) validateDraft(ctx context.Context, req *models.ReviewRequest, revision int) error {
+	if req.Content == "" {
+		return status.Error(codes.InvalidArgument, "content is required")
+	}
+	if err := s.applyAssignmentRubric(ctx, req); err != nil {
+		return err
+	}
+	if err := s.applyRubric(ctx, req); err != nil {
+		return err
+	}
+	if req.Rank < 1 || req.Rank > maxRank {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("rank must be between 1 and %d", maxRank))
+	}
+	return s.applyComments(ctx, req, revision)
+}
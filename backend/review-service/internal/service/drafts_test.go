@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/kafka"
+	kafkaMocks "github.com/IAGrig/vt-csa-essays/backend/review-service/internal/kafka/mocks"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository"
+	repoMocks "github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReviewService_SaveDraft(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        *pb.SaveDraftRequest
+		setupMock    func(*repoMocks.MockReviewRepository)
+		expectedCode codes.Code
+	}{
+		{
+			name:  "incomplete draft is saved",
+			input: &pb.SaveDraftRequest{EssayId: 1, Author: "reviewer", Content: "Half a thou"},
+			setupMock: func(mockRepo *repoMocks.MockReviewRepository) {
+				mockRepo.On("GetEssayText", mock.Anything, 1).Return(models.EssayText{EssayID: 1}, nil)
+				mockRepo.On("SaveDraft", mock.Anything, models.ReviewDraft{EssayID: 1, Author: "reviewer", Content: "Half a thou"}).
+					Return(models.ReviewDraft{EssayID: 1, Author: "reviewer", Content: "Half a thou", UpdatedAt: time.Now()}, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:  "unknown essay",
+			input: &pb.SaveDraftRequest{EssayId: 9, Author: "reviewer"},
+			setupMock: func(mockRepo *repoMocks.MockReviewRepository) {
+				mockRepo.On("GetEssayText", mock.Anything, 9).Return(models.EssayText{}, repository.EssayNotFoundErr)
+			},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "content too long",
+			input:        &pb.SaveDraftRequest{EssayId: 1, Author: "reviewer", Content: strings.Repeat("a", maxDraftContentLength+1)},
+			setupMock:    func(*repoMocks.MockReviewRepository) {},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(repoMocks.MockReviewRepository)
+			tt.setupMock(mockRepo)
+
+			resp, err := newTestService(Repositories{Reviews: mockRepo}, nil).SaveDraft(context.Background(), tt.input)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				require.NotNil(t, resp)
+				assert.Equal(t, tt.input.Content, resp.Content)
+				assert.NotZero(t, resp.UpdatedAt)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReviewService_GetDraft(t *testing.T) {
+	mockRepo := new(repoMocks.MockReviewRepository)
+	mockRepo.On("GetDraft", mock.Anything, 1, "reviewer").Return(models.ReviewDraft{
+		EssayID:  1,
+		Author:   "reviewer",
+		Rank:     2,
+		Content:  "Draft",
+		Comments: []models.InlineComment{{StartOffset: 0, EndOffset: 3, Content: "Hm"}},
+	}, nil)
+	mockRepo.On("GetDraft", mock.Anything, 2, "reviewer").Return(models.ReviewDraft{}, repository.DraftNotFoundErr)
+
+	service := newTestService(Repositories{Reviews: mockRepo}, nil)
+
+	resp, err := service.GetDraft(context.Background(), &pb.GetDraftRequest{EssayId: 1, Author: "reviewer"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), resp.Rank)
+	require.Len(t, resp.Comments, 1)
+	assert.Equal(t, "Hm", resp.Comments[0].Content)
+
+	_, err = service.GetDraft(context.Background(), &pb.GetDraftRequest{EssayId: 2, Author: "reviewer"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestReviewService_SubmitDraft(t *testing.T) {
+	essay := models.EssayText{EssayID: 1, Content: "The thesis is unclear here.", Revision: 3}
+	savedAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		setupMock    func(*repoMocks.MockReviewRepository, *kafkaMocks.MockProducer)
+		expectedCode codes.Code
+	}{
+		{
+			name: "valid draft becomes a review",
+			setupMock: func(mockRepo *repoMocks.MockReviewRepository, mockProducer *kafkaMocks.MockProducer) {
+				mockRepo.On("GetDraft", mock.Anything, 1, "reviewer").Return(models.ReviewDraft{
+					EssayID:       1,
+					Author:        "reviewer",
+					Rank:          2,
+					Content:       " Finished review ",
+					EssayRevision: 3,
+					Comments:      []models.InlineComment{{StartOffset: 4, EndOffset: 10, Content: "Which thesis?", EssayRevision: 3}},
+					UpdatedAt:     savedAt,
+				}, nil)
+				mockRepo.On("GetEssayText", mock.Anything, 1).Return(essay, nil)
+				mockRepo.On("AddFromDraft", mock.Anything, mock.MatchedBy(func(req models.ReviewRequest) bool {
+					return req.Content == "Finished review" && req.Rank == 2 &&
+						len(req.Comments) == 1 && req.Comments[0].Quote == "thesis"
+				}), savedAt).Return(models.Review{ID: 7, EssayId: 1, Rank: 2, Content: "Finished review", Author: "reviewer"}, nil)
+				mockProducer.On("SendNotificationEvent", mock.Anything, mock.MatchedBy(func(event kafka.NotificationEvent) bool {
+					return event.Type == "new_review" && event.UserID == 5 && event.ReviewID == 7 && event.Author == "reviewer"
+				})).Return(nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "draft without rank",
+			setupMock: func(mockRepo *repoMocks.MockReviewRepository, _ *kafkaMocks.MockProducer) {
+				mockRepo.On("GetDraft", mock.Anything, 1, "reviewer").Return(models.ReviewDraft{EssayID: 1, Author: "reviewer", Content: "Text"}, nil)
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "draft without content",
+			setupMock: func(mockRepo *repoMocks.MockReviewRepository, _ *kafkaMocks.MockProducer) {
+				mockRepo.On("GetDraft", mock.Anything, 1, "reviewer").Return(models.ReviewDraft{EssayID: 1, Author: "reviewer", Rank: 2, Content: "  "}, nil)
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "comments on an outdated revision",
+			setupMock: func(mockRepo *repoMocks.MockReviewRepository, _ *kafkaMocks.MockProducer) {
+				mockRepo.On("GetDraft", mock.Anything, 1, "reviewer").Return(models.ReviewDraft{
+					EssayID:       1,
+					Author:        "reviewer",
+					Rank:          2,
+					Content:       "Text",
+					EssayRevision: 2,
+					Comments:      []models.InlineComment{{StartOffset: 4, EndOffset: 10, Content: "?", EssayRevision: 2}},
+				}, nil)
+				mockRepo.On("GetEssayText", mock.Anything, 1).Return(essay, nil)
+			},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name: "no draft",
+			setupMock: func(mockRepo *repoMocks.MockReviewRepository, _ *kafkaMocks.MockProducer) {
+				mockRepo.On("GetDraft", mock.Anything, 1, "reviewer").Return(models.ReviewDraft{}, repository.DraftNotFoundErr)
+			},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name: "draft submitted concurrently",
+			setupMock: func(mockRepo *repoMocks.MockReviewRepository, _ *kafkaMocks.MockProducer) {
+				mockRepo.On("GetDraft", mock.Anything, 1, "reviewer").Return(models.ReviewDraft{EssayID: 1, Author: "reviewer", Rank: 3, Content: "Text"}, nil)
+				mockRepo.On("AddFromDraft", mock.Anything, mock.Anything, mock.Anything).Return(models.Review{}, repository.DraftNotFoundErr)
+			},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name: "draft autosaved during submit",
+			setupMock: func(mockRepo *repoMocks.MockReviewRepository, _ *kafkaMocks.MockProducer) {
+				mockRepo.On("GetDraft", mock.Anything, 1, "reviewer").Return(models.ReviewDraft{EssayID: 1, Author: "reviewer", Rank: 3, Content: "Text", UpdatedAt: savedAt}, nil)
+				mockRepo.On("AddFromDraft", mock.Anything, mock.Anything, savedAt).Return(models.Review{}, repository.DraftChangedErr)
+			},
+			expectedCode: codes.Aborted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(repoMocks.MockReviewRepository)
+			mockProducer := new(kafkaMocks.MockProducer)
+			tt.setupMock(mockRepo, mockProducer)
+
+			resp, err := newTestService(Repositories{Reviews: mockRepo}, mockProducer).SubmitDraft(context.Background(), &pb.SubmitDraftRequest{
+				EssayId:       1,
+				EssayAuthorId: 5,
+				Author:        "reviewer",
+			})
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				require.NotNil(t, resp)
+				assert.Equal(t, int32(7), resp.Id)
+			}
+			mockRepo.AssertExpectations(t)
+			mockProducer.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/kafka"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+)
+
+func (s *reviewService) UpdateReview(ctx context.Context, in *pb.UpdateReviewRequest) (*pb.ReviewResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "update_review"),
+		zap.Int32("review_id", in.Id),
+		zap.String("author", in.Author),
+	)
+
+	logger.Debug("Processing update review request")
+
+	content := strings.TrimSpace(in.Content)
+	if content == "" {
+		return nil, status.Error(codes.InvalidArgument, "content is required")
+	}
+
+	review, err := s.repository.GetById(ctx, int(in.Id))
+	if err != nil {
+		if errors.Is(err, repository.ReviewNotFoundErr) {
+			return nil, statusError(err)
+		}
+		logger.Error("Failed to get review", zap.Error(err))
+		return nil, err
+	}
+
+	if review.Author != in.Author {
+		logger.Warn("Forbidden review edit attempt")
+		return nil, status.Error(codes.PermissionDenied, "only the review author can edit it")
+	}
+
+	req := fromProtoUpdateReviewRequest(in)
+	req.Content = content
+	req.RubricID = review.RubricID
+	if err := s.applyRubric(ctx, &req); err != nil {
+		logger.Debug("Rejected review scores", zap.Error(err))
+		return nil, err
+	}
+	if req.RubricID == 0 && (req.Rank < 1 || req.Rank > maxRank) {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("rank must be between 1 and %d", maxRank))
+	}
+
+	updated, err := s.repository.Update(ctx, int(in.Id), req)
+	if err != nil {
+		if errors.Is(err, repository.ReviewNotFoundErr) {
+			return nil, statusError(err)
+		}
+		logger.Error("Failed to update review", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info("Review updated successfully")
+
+	participants, err := s.replies.GetParticipants(ctx, updated.ID)
+	if err != nil {
+		// the edit is saved, only the notification is lost
+		logger.Warn("Failed to get review participants", zap.Error(err))
+	} else if participants.Reviewer != participants.EssayAuthor {
+		reviewer := in.Author
+		if updated.Anonymous {
+			reviewer = updated.AuthorAlias
+		}
+		s.sendNotification(ctx, logger, kafka.NotificationEvent{
+			Type:     "review_edited",
+			UserID:   participants.EssayAuthorID,
+			Content:  fmt.Sprintf("%s edited their review of your essay", reviewer),
+			EssayID:  int64(updated.EssayId),
+			ReviewID: int64(updated.ID),
+			Author:   reviewer,
+		})
+	}
+
+	return toProtoReviewResponse(updated), nil
+}
+
+func (s *reviewService) GetReviewHistory(in *pb.GetReviewHistoryRequest, stream grpc.ServerStreamingServer[pb.ReviewVersionResponse]) error {
+	logger := logging.FromContext(stream.Context(), s.logger).With(
+		zap.String("operation", "get_review_history"),
+		zap.Int32("review_id", in.ReviewId),
+	)
+
+	versions, err := s.repository.GetHistory(stream.Context(), int(in.ReviewId))
+	if err != nil {
+		if errors.Is(err, repository.ReviewNotFoundErr) {
+			return statusError(err)
+		}
+		logger.Error("Failed to get review history", zap.Error(err))
+		return err
+	}
+
+	for _, version := range versions {
+		if err := stream.Send(toProtoReviewVersionResponse(version)); err != nil {
+			logger.Error("Failed to send review version in stream",
+				zap.Int64("version_id", version.ID),
+				zap.Error(err))
+			return err
+		}
+	}
+
+	logger.Debug("Sent review history in stream", zap.Int("count", len(versions)))
+	return nil
+}
@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/kafka"
+	kafkaMocks "github.com/IAGrig/vt-csa-essays/backend/review-service/internal/kafka/mocks"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository"
+	repoMocks "github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type versionServerStream struct {
+	sentMessages []*pb.ReviewVersionResponse
+}
+
+func (m *versionServerStream) Send(msg *pb.ReviewVersionResponse) error {
+	m.sentMessages = append(m.sentMessages, msg)
+	return nil
+}
+
+func (m *versionServerStream) Context() context.Context        { return context.Background() }
+func (m *versionServerStream) SetHeader(md metadata.MD) error  { return nil }
+func (m *versionServerStream) SendHeader(md metadata.MD) error { return nil }
+func (m *versionServerStream) SetTrailer(md metadata.MD)       {}
+func (m *versionServerStream) SendMsg(interface{}) error       { return nil }
+func (m *versionServerStream) RecvMsg(interface{}) error       { return nil }
+
+func TestReviewService_UpdateReview(t *testing.T) {
+	editedAt := time.Unix(1700000000, 0)
+	existing := models.Review{ID: 3, EssayId: 1, Rank: 2, Content: "Good", Author: "reviewer"}
+	edited := models.Review{ID: 3, EssayId: 1, Rank: 3, Content: "Great", Author: "reviewer", EditedAt: &editedAt}
+
+	tests := []struct {
+		name         string
+		input        *pb.UpdateReviewRequest
+		setupMock    func(*repoMocks.MockReviewRepository, *repoMocks.MockRubricRepository, *repoMocks.MockReplyRepository, *kafkaMocks.MockProducer)
+		expectedCode codes.Code
+	}{
+		{
+			name:  "author edit notifies the essay author",
+			input: &pb.UpdateReviewRequest{Id: 3, Author: "reviewer", Rank: 3, Content: " Great "},
+			setupMock: func(reviews *repoMocks.MockReviewRepository, rubrics *repoMocks.MockRubricRepository, replies *repoMocks.MockReplyRepository, producer *kafkaMocks.MockProducer) {
+				reviews.On("GetById", mock.Anything, 3).Return(existing, nil)
+				reviews.On("Update", mock.Anything, 3, models.ReviewRequest{Rank: 3, Content: "Great", Author: "reviewer"}).Return(edited, nil)
+				replies.On("GetParticipants", mock.Anything, 3).Return(testParticipants, nil)
+				producer.On("SendNotificationEvent", mock.Anything, kafka.NotificationEvent{
+					Type:     "review_edited",
+					UserID:   10,
+					Content:  "reviewer edited their review of your essay",
+					EssayID:  1,
+					ReviewID: 3,
+					Author:   "reviewer",
+				}).Return(nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:  "anonymous review notification uses the alias",
+			input: &pb.UpdateReviewRequest{Id: 3, Author: "reviewer", Rank: 3, Content: "Great"},
+			setupMock: func(reviews *repoMocks.MockReviewRepository, rubrics *repoMocks.MockRubricRepository, replies *repoMocks.MockReplyRepository, producer *kafkaMocks.MockProducer) {
+				anonymous := edited
+				anonymous.Anonymous = true
+				anonymous.AuthorAlias = "Reviewer A"
+				reviews.On("GetById", mock.Anything, 3).Return(existing, nil)
+				reviews.On("Update", mock.Anything, 3, mock.Anything).Return(anonymous, nil)
+				replies.On("GetParticipants", mock.Anything, 3).Return(anonymousParticipants, nil)
+				producer.On("SendNotificationEvent", mock.Anything, mock.MatchedBy(func(event kafka.NotificationEvent) bool {
+					return event.Author == "Reviewer A" && event.Content == "Reviewer A edited their review of your essay"
+				})).Return(nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "rubric review is rescored",
+			input: &pb.UpdateReviewRequest{Id: 3, Author: "reviewer", Content: "Rescored", Scores: []*pb.CriterionScore{
+				{CriterionId: 1, Score: 4},
+				{CriterionId: 2, Score: 5},
+			}},
+			setupMock: func(reviews *repoMocks.MockReviewRepository, rubrics *repoMocks.MockRubricRepository, replies *repoMocks.MockReplyRepository, producer *kafkaMocks.MockProducer) {
+				rubricReview := existing
+				rubricReview.RubricID = 7
+				reviews.On("GetById", mock.Anything, 3).Return(rubricReview, nil)
+				rubrics.On("GetByID", mock.Anything, int64(7)).Return(testRubric, nil)
+				reviews.On("Update", mock.Anything, 3, mock.MatchedBy(func(req models.ReviewRequest) bool {
+					return req.RubricID == 7 && req.TotalScore == 100 && req.Rank == maxRank && len(req.Scores) == 2
+				})).Return(edited, nil)
+				replies.On("GetParticipants", mock.Anything, 3).Return(testParticipants, nil)
+				producer.On("SendNotificationEvent", mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:  "rubric review without scores",
+			input: &pb.UpdateReviewRequest{Id: 3, Author: "reviewer", Content: "Rescored"},
+			setupMock: func(reviews *repoMocks.MockReviewRepository, rubrics *repoMocks.MockRubricRepository, replies *repoMocks.MockReplyRepository, producer *kafkaMocks.MockProducer) {
+				rubricReview := existing
+				rubricReview.RubricID = 7
+				reviews.On("GetById", mock.Anything, 3).Return(rubricReview, nil)
+				rubrics.On("GetByID", mock.Anything, int64(7)).Return(testRubric, nil)
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:  "rank out of range",
+			input: &pb.UpdateReviewRequest{Id: 3, Author: "reviewer", Rank: 4, Content: "Great"},
+			setupMock: func(reviews *repoMocks.MockReviewRepository, rubrics *repoMocks.MockRubricRepository, replies *repoMocks.MockReplyRepository, producer *kafkaMocks.MockProducer) {
+				reviews.On("GetById", mock.Anything, 3).Return(existing, nil)
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:  "only the author can edit",
+			input: &pb.UpdateReviewRequest{Id: 3, Author: "author", Rank: 3, Content: "Great"},
+			setupMock: func(reviews *repoMocks.MockReviewRepository, rubrics *repoMocks.MockRubricRepository, replies *repoMocks.MockReplyRepository, producer *kafkaMocks.MockProducer) {
+				reviews.On("GetById", mock.Anything, 3).Return(existing, nil)
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:  "unknown review",
+			input: &pb.UpdateReviewRequest{Id: 5, Author: "reviewer", Rank: 3, Content: "Great"},
+			setupMock: func(reviews *repoMocks.MockReviewRepository, rubrics *repoMocks.MockRubricRepository, replies *repoMocks.MockReplyRepository, producer *kafkaMocks.MockProducer) {
+				reviews.On("GetById", mock.Anything, 5).Return(models.Review{}, repository.ReviewNotFoundErr)
+			},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:  "empty content",
+			input: &pb.UpdateReviewRequest{Id: 3, Author: "reviewer", Rank: 3, Content: " "},
+			setupMock: func(*repoMocks.MockReviewRepository, *repoMocks.MockRubricRepository, *repoMocks.MockReplyRepository, *kafkaMocks.MockProducer) {
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReviews := new(repoMocks.MockReviewRepository)
+			mockRubrics := new(repoMocks.MockRubricRepository)
+			mockReplies := new(repoMocks.MockReplyRepository)
+			mockProducer := new(kafkaMocks.MockProducer)
+			tt.setupMock(mockReviews, mockRubrics, mockReplies, mockProducer)
+
+			service := newTestService(Repositories{Reviews: mockReviews, Rubrics: mockRubrics, Replies: mockReplies}, mockProducer)
+			result, err := service.UpdateReview(context.Background(), tt.input)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.True(t, result.Edited)
+				assert.Equal(t, editedAt.Unix(), result.EditedAt)
+			}
+
+			mockReviews.AssertExpectations(t)
+			mockRubrics.AssertExpectations(t)
+			mockReplies.AssertExpectations(t)
+			mockProducer.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReviewService_GetReviewHistory(t *testing.T) {
+	mockReviews := new(repoMocks.MockReviewRepository)
+	mockReviews.On("GetHistory", mock.Anything, 3).Return([]models.ReviewVersion{
+		{ID: 1, ReviewID: 3, Rank: 1, Content: "First", WrittenAt: time.Unix(100, 0), ReplacedAt: time.Unix(200, 0)},
+		{ID: 2, ReviewID: 3, Rank: 2, Content: "Second", WrittenAt: time.Unix(200, 0), ReplacedAt: time.Unix(300, 0)},
+	}, nil)
+	mockReviews.On("GetHistory", mock.Anything, 5).Return([]models.ReviewVersion(nil), repository.ReviewNotFoundErr)
+
+	service := newTestService(Repositories{Reviews: mockReviews}, nil)
+
+	stream := &versionServerStream{}
+	err := service.GetReviewHistory(&pb.GetReviewHistoryRequest{ReviewId: 3}, stream)
+	require.NoError(t, err)
+	require.Len(t, stream.sentMessages, 2)
+	assert.Equal(t, "First", stream.sentMessages[0].Content)
+	assert.Equal(t, int64(200), stream.sentMessages[1].WrittenAt)
+	assert.Equal(t, int64(300), stream.sentMessages[1].ReplacedAt)
+
+	err = service.GetReviewHistory(&pb.GetReviewHistoryRequest{ReviewId: 5}, &versionServerStream{})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	mockReviews.AssertExpectations(t)
+}
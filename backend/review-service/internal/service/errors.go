@@ -0,0 +1,30 @@
+package service
+
+import (
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/grpcerr"
+	"google.golang.org/grpc/codes"
+)
+
+// Domain errors and the statuses clients see them as
+var ErrorMappings = grpcerr.Mappings{
+	{Err: repository.ReviewNotFoundErr, Code: codes.NotFound, Reason: "REVIEW_NOT_FOUND"},
+	{Err: repository.RubricNotFoundErr, Code: codes.NotFound, Reason: "RUBRIC_NOT_FOUND"},
+	{Err: repository.EssayNotFoundErr, Code: codes.NotFound, Reason: "ESSAY_NOT_FOUND"},
+	{Err: repository.ReplyNotFoundErr, Code: codes.NotFound, Reason: "REPLY_NOT_FOUND"},
+	{Err: repository.AssignmentNotFoundErr, Code: codes.NotFound, Reason: "ASSIGNMENT_NOT_FOUND"},
+	{Err: repository.GradeNotFoundErr, Code: codes.NotFound, Reason: "GRADE_NOT_FOUND"},
+	{Err: repository.CalibrationNotFoundErr, Code: codes.NotFound, Reason: "CALIBRATION_NOT_FOUND"},
+	{Err: repository.DraftNotFoundErr, Code: codes.NotFound, Reason: "DRAFT_NOT_FOUND"},
+	{Err: repository.DraftChangedErr, Code: codes.Aborted, Reason: "DRAFT_CHANGED"},
+}
+
+func statusError(err error) error {
+	return ErrorMappings.Status(err)
+}
+
+// A missing entity the request refers to is the caller's mistake
+// rather than a missing resource
+func invalidReference(err error) error {
+	return grpcerr.New(codes.InvalidArgument, grpcerr.Reason(statusError(err)), err.Error())
+}
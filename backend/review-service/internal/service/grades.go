@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+)
+
+const maxGrade = 100
+
+func (s *reviewService) SetGrade(ctx context.Context, in *pb.SetGradeRequest) (*pb.GradeResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "set_grade"),
+		zap.Int32("essay_id", in.EssayId),
+		zap.String("graded_by", in.GradedBy),
+	)
+
+	if !in.SeedFromPeers && (math.IsNaN(in.Grade) || in.Grade < 0 || in.Grade > maxGrade) {
+		return nil, status.Error(codes.InvalidArgument, "grade must be between 0 and 100")
+	}
+
+	grading, err := s.gradingContext(ctx, int(in.EssayId))
+	if err != nil {
+		logger.Debug("Failed to get grading context", zap.Error(err))
+		return nil, err
+	}
+	if grading.AssignmentID == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "only essays submitted to an assignment can be graded")
+	}
+	if grading.AssignmentCreator != in.GradedBy {
+		logger.Warn("Forbidden grading attempt")
+		return nil, status.Error(codes.PermissionDenied, "only the assignment creator can grade its essays")
+	}
+
+	value := in.Grade
+	if in.SeedFromPeers {
+		if grading.PeerScore == nil {
+			return nil, status.Error(codes.FailedPrecondition, "essay has no rubric scored peer reviews to seed the grade from")
+		}
+		value = math.Round(*grading.PeerScore*10) / 10
+	}
+
+	grade, err := s.grades.Upsert(ctx, models.GradeRequest{
+		EssayID:  int(in.EssayId),
+		Grade:    value,
+		Feedback: strings.TrimSpace(in.Feedback),
+		GradedBy: in.GradedBy,
+	})
+	if err != nil {
+		logger.Error("Failed to save grade", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info("Essay graded successfully",
+		zap.Float64("grade", grade.Grade),
+		zap.Bool("seeded", in.SeedFromPeers))
+	return toProtoGradeResponse(grade, grading), nil
+}
+
+// The assignment creator always sees the grade, the essay author only after release
+func (s *reviewService) GetGrade(ctx context.Context, in *pb.GetGradeRequest) (*pb.GradeResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "get_grade"),
+		zap.Int32("essay_id", in.EssayId),
+		zap.String("requested_by", in.RequestedBy),
+	)
+
+	grading, err := s.gradingContext(ctx, int(in.EssayId))
+	if err != nil {
+		logger.Debug("Failed to get grading context", zap.Error(err))
+		return nil, err
+	}
+
+	switch {
+	case grading.AssignmentID != 0 && in.RequestedBy == grading.AssignmentCreator:
+	case in.RequestedBy == grading.EssayAuthor:
+		// an unreleased grade looks the same as a missing one
+		if !grading.GradesReleased {
+			return nil, statusError(repository.GradeNotFoundErr)
+		}
+	default:
+		return nil, status.Error(codes.PermissionDenied, "only the essay author and the assignment creator can see the grade")
+	}
+
+	grade, err := s.grades.GetByEssayID(ctx, int(in.EssayId))
+	if err != nil {
+		if errors.Is(err, repository.GradeNotFoundErr) {
+			return nil, statusError(err)
+		}
+		logger.Error("Failed to get grade", zap.Error(err))
+		return nil, err
+	}
+
+	return toProtoGradeResponse(grade, grading), nil
+}
+
+func (s *reviewService) SetGradesReleased(ctx context.Context, in *pb.SetGradesReleasedRequest) (*pb.AssignmentResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "set_grades_released"),
+		zap.Int64("assignment_id", in.AssignmentId),
+		zap.String("requested_by", in.RequestedBy),
+	)
+
+	if err := s.checkAssignmentCreator(ctx, in.AssignmentId, in.RequestedBy); err != nil {
+		logger.Debug("Rejected grade release", zap.Error(err))
+		return nil, err
+	}
+
+	assignment, err := s.assignments.SetGradesReleased(ctx, in.AssignmentId, in.Released)
+	if err != nil {
+		if errors.Is(err, repository.AssignmentNotFoundErr) {
+			return nil, statusError(err)
+		}
+		logger.Error("Failed to change grade release", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info("Grade release changed successfully", zap.Bool("released", assignment.GradesReleased))
+	return toProtoAssignmentResponse(assignment), nil
+}
+
+func (s *reviewService) GetGradebook(in *pb.GetGradebookRequest, stream grpc.ServerStreamingServer[pb.GradebookEntry]) error {
+	logger := logging.FromContext(stream.Context(), s.logger).With(
+		zap.String("operation", "get_gradebook"),
+		zap.Int64("assignment_id", in.AssignmentId),
+		zap.String("requested_by", in.RequestedBy),
+	)
+
+	if err := s.checkAssignmentCreator(stream.Context(), in.AssignmentId, in.RequestedBy); err != nil {
+		logger.Debug("Rejected gradebook request", zap.Error(err))
+		return err
+	}
+
+	entries, err := s.grades.GetGradebook(stream.Context(), in.AssignmentId)
+	if err != nil {
+		logger.Error("Failed to get gradebook", zap.Error(err))
+		return err
+	}
+
+	for _, entry := range entries {
+		if err := stream.Send(toProtoGradebookEntry(entry)); err != nil {
+			logger.Error("Failed to send gradebook entry in stream",
+				zap.Int("essay_id", entry.EssayID),
+				zap.Error(err))
+			return err
+		}
+	}
+
+	logger.Debug("Sent gradebook in stream", zap.Int("count", len(entries)))
+	return nil
+}
+
+func (s *reviewService) gradingContext(ctx context.Context, essayID int) (models.GradingContext, error) {
+	grading, err := s.grades.GetGradingContext(ctx, essayID)
+	if err != nil {
+		if errors.Is(err, repository.EssayNotFoundErr) {
+			return models.GradingContext{}, statusError(err)
+		}
+		return models.GradingContext{}, err
+	}
+	return grading, nil
+}
+
+func (s *reviewService) checkAssignmentCreator(ctx context.Context, assignmentID int64, username string) error {
+	assignment, err := s.assignments.GetByID(ctx, assignmentID)
+	if err != nil {
+		if errors.Is(err, repository.AssignmentNotFoundErr) {
+			return statusError(err)
+		}
+		return err
+	}
+	if assignment.CreatedBy != username {
+		return status.Error(codes.PermissionDenied, "only the assignment creator can manage its grades")
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository"
+	repoMocks "github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository/mocks"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/grpcerr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type gradebookServerStream struct {
+	sentMessages []*pb.GradebookEntry
+}
+
+func (m *gradebookServerStream) Send(msg *pb.GradebookEntry) error {
+	m.sentMessages = append(m.sentMessages, msg)
+	return nil
+}
+
+func (m *gradebookServerStream) Context() context.Context        { return context.Background() }
+func (m *gradebookServerStream) SetHeader(md metadata.MD) error  { return nil }
+func (m *gradebookServerStream) SendHeader(md metadata.MD) error { return nil }
+func (m *gradebookServerStream) SetTrailer(md metadata.MD)       {}
+func (m *gradebookServerStream) SendMsg(interface{}) error       { return nil }
+func (m *gradebookServerStream) RecvMsg(interface{}) error       { return nil }
+
+func gradingContext(released bool, peerScore *float64) models.GradingContext {
+	return models.GradingContext{
+		EssayID:           1,
+		EssayAuthor:       "student",
+		AssignmentID:      4,
+		AssignmentCreator: "teacher",
+		GradesReleased:    released,
+		PeerReviewCount:   2,
+		PeerScore:         peerScore,
+	}
+}
+
+func TestReviewService_SetGrade(t *testing.T) {
+	peerScore := 72.345
+
+	tests := []struct {
+		name          string
+		input         *pb.SetGradeRequest
+		setupMock     func(*repoMocks.MockGradeRepository)
+		expectedCode  codes.Code
+		expectedGrade float64
+	}{
+		{
+			name:  "teacher grades the essay",
+			input: &pb.SetGradeRequest{EssayId: 1, Grade: 85, Feedback: " Well argued ", GradedBy: "teacher"},
+			setupMock: func(grades *repoMocks.MockGradeRepository) {
+				grades.On("GetGradingContext", mock.Anything, 1).Return(gradingContext(false, &peerScore), nil)
+				grades.On("Upsert", mock.Anything, models.GradeRequest{EssayID: 1, Grade: 85, Feedback: "Well argued", GradedBy: "teacher"}).
+					Return(models.Grade{EssayID: 1, Grade: 85, Feedback: "Well argued", GradedBy: "teacher", GradedAt: time.Now()}, nil)
+			},
+			expectedCode:  codes.OK,
+			expectedGrade: 85,
+		},
+		{
+			name:  "seeded from peer scores",
+			input: &pb.SetGradeRequest{EssayId: 1, Grade: 10, GradedBy: "teacher", SeedFromPeers: true},
+			setupMock: func(grades *repoMocks.MockGradeRepository) {
+				grades.On("GetGradingContext", mock.Anything, 1).Return(gradingContext(false, &peerScore), nil)
+				grades.On("Upsert", mock.Anything, models.GradeRequest{EssayID: 1, Grade: 72.3, GradedBy: "teacher"}).
+					Return(models.Grade{EssayID: 1, Grade: 72.3, GradedBy: "teacher"}, nil)
+			},
+			expectedCode:  codes.OK,
+			expectedGrade: 72.3,
+		},
+		{
+			name:  "seeding without peer reviews",
+			input: &pb.SetGradeRequest{EssayId: 1, GradedBy: "teacher", SeedFromPeers: true},
+			setupMock: func(grades *repoMocks.MockGradeRepository) {
+				grades.On("GetGradingContext", mock.Anything, 1).Return(gradingContext(false, nil), nil)
+			},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:  "essay outside of an assignment",
+			input: &pb.SetGradeRequest{EssayId: 1, Grade: 85, GradedBy: "teacher"},
+			setupMock: func(grades *repoMocks.MockGradeRepository) {
+				grades.On("GetGradingContext", mock.Anything, 1).Return(models.GradingContext{EssayID: 1, EssayAuthor: "student"}, nil)
+			},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:  "another teacher",
+			input: &pb.SetGradeRequest{EssayId: 1, Grade: 85, GradedBy: "other-teacher"},
+			setupMock: func(grades *repoMocks.MockGradeRepository) {
+				grades.On("GetGradingContext", mock.Anything, 1).Return(gradingContext(false, nil), nil)
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:  "unknown essay",
+			input: &pb.SetGradeRequest{EssayId: 9, Grade: 85, GradedBy: "teacher"},
+			setupMock: func(grades *repoMocks.MockGradeRepository) {
+				grades.On("GetGradingContext", mock.Anything, 9).Return(models.GradingContext{}, repository.EssayNotFoundErr)
+			},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "grade out of range",
+			input:        &pb.SetGradeRequest{EssayId: 1, Grade: 101, GradedBy: "teacher"},
+			setupMock:    func(*repoMocks.MockGradeRepository) {},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGrades := new(repoMocks.MockGradeRepository)
+			tt.setupMock(mockGrades)
+
+			service := newTestService(Repositories{Grades: mockGrades}, nil)
+			result, err := service.SetGrade(context.Background(), tt.input)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.Equal(t, tt.expectedGrade, result.Grade)
+				assert.Equal(t, peerScore, result.PeerScore)
+				assert.Equal(t, int32(2), result.PeerReviewCount)
+			}
+
+			mockGrades.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReviewService_GetGrade(t *testing.T) {
+	grade := models.Grade{EssayID: 1, Grade: 85, Feedback: "Well argued", GradedBy: "teacher"}
+
+	tests := []struct {
+		name         string
+		requestedBy  string
+		released     bool
+		expectedCode codes.Code
+	}{
+		{name: "teacher before release", requestedBy: "teacher", expectedCode: codes.OK},
+		{name: "author after release", requestedBy: "student", released: true, expectedCode: codes.OK},
+		{name: "author before release", requestedBy: "student", expectedCode: codes.NotFound},
+		{name: "other student", requestedBy: "classmate", released: true, expectedCode: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGrades := new(repoMocks.MockGradeRepository)
+			mockGrades.On("GetGradingContext", mock.Anything, 1).Return(gradingContext(tt.released, nil), nil)
+			if tt.expectedCode == codes.OK {
+				mockGrades.On("GetByEssayID", mock.Anything, 1).Return(grade, nil)
+			}
+
+			service := newTestService(Repositories{Grades: mockGrades}, nil)
+			result, err := service.GetGrade(context.Background(), &pb.GetGradeRequest{EssayId: 1, RequestedBy: tt.requestedBy})
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.Equal(t, float64(85), result.Grade)
+				assert.Equal(t, tt.released, result.Released)
+			}
+
+			mockGrades.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReviewService_SetGradesReleased(t *testing.T) {
+	assignment := models.Assignment{ID: 4, Title: "Argumentative essay", CreatedBy: "teacher"}
+
+	mockAssignments := new(repoMocks.MockAssignmentRepository)
+	mockAssignments.On("GetByID", mock.Anything, int64(4)).Return(assignment, nil)
+	mockAssignments.On("GetByID", mock.Anything, int64(5)).Return(models.Assignment{}, repository.AssignmentNotFoundErr)
+	released := assignment
+	released.GradesReleased = true
+	mockAssignments.On("SetGradesReleased", mock.Anything, int64(4), true).Return(released, nil)
+
+	service := newTestService(Repositories{Assignments: mockAssignments}, nil)
+
+	result, err := service.SetGradesReleased(context.Background(), &pb.SetGradesReleasedRequest{AssignmentId: 4, Released: true, RequestedBy: "teacher"})
+	require.NoError(t, err)
+	assert.True(t, result.GradesReleased)
+
+	_, err = service.SetGradesReleased(context.Background(), &pb.SetGradesReleasedRequest{AssignmentId: 4, Released: true, RequestedBy: "other-teacher"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = service.SetGradesReleased(context.Background(), &pb.SetGradesReleasedRequest{AssignmentId: 5, Released: true, RequestedBy: "teacher"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, "ASSIGNMENT_NOT_FOUND", grpcerr.Reason(err))
+
+	mockAssignments.AssertExpectations(t)
+}
+
+func TestReviewService_GetGradebook(t *testing.T) {
+	peerScore := 66.5
+	gradedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	mockAssignments := new(repoMocks.MockAssignmentRepository)
+	mockAssignments.On("GetByID", mock.Anything, int64(4)).Return(models.Assignment{ID: 4, CreatedBy: "teacher"}, nil)
+	mockGrades := new(repoMocks.MockGradeRepository)
+	mockGrades.On("GetGradebook", mock.Anything, int64(4)).Return([]models.GradebookEntry{
+		{EssayID: 1, EssayAuthor: "alice", PeerReviewCount: 2, PeerScore: &peerScore, Grade: &models.Grade{
+			EssayID: 1, Grade: 70, Feedback: "Good", GradedBy: "teacher", GradedAt: gradedAt,
+		}},
+		{EssayID: 2, EssayAuthor: "bob"},
+	}, nil)
+
+	service := newTestService(Repositories{Grades: mockGrades, Assignments: mockAssignments}, nil)
+	stream := &gradebookServerStream{}
+
+	err := service.GetGradebook(&pb.GetGradebookRequest{AssignmentId: 4, RequestedBy: "teacher"}, stream)
+	require.NoError(t, err)
+	require.Len(t, stream.sentMessages, 2)
+	assert.True(t, stream.sentMessages[0].Graded)
+	assert.Equal(t, float64(70), stream.sentMessages[0].Grade)
+	assert.Equal(t, peerScore, stream.sentMessages[0].PeerScore)
+	assert.Equal(t, gradedAt.Unix(), stream.sentMessages[0].GradedAt)
+	assert.False(t, stream.sentMessages[1].Graded)
+
+	err = service.GetGradebook(&pb.GetGradebookRequest{AssignmentId: 4, RequestedBy: "student"}, &gradebookServerStream{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	mockAssignments.AssertExpectations(t)
+	mockGrades.AssertExpectations(t)
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+)
+
+func (s *reviewService) SetCalibration(ctx context.Context, in *pb.SetCalibrationRequest) (*pb.CalibrationResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "set_calibration"),
+		zap.Int32("essay_id", in.EssayId),
+		zap.String("requested_by", in.RequestedBy),
+	)
+
+	if in.ReferenceRank < 1 || in.ReferenceRank > maxRank {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("reference rank must be between 1 and %d", maxRank))
+	}
+	if err := s.checkCalibrationAccess(ctx, int(in.EssayId), in.RequestedBy); err != nil {
+		logger.Debug("Rejected calibration reference", zap.Error(err))
+		return nil, err
+	}
+
+	calibration, err := s.reliability.SetCalibration(ctx, models.CalibrationRequest{
+		EssayID:       int(in.EssayId),
+		ReferenceRank: int(in.ReferenceRank),
+		CreatedBy:     in.RequestedBy,
+	})
+	if err != nil {
+		logger.Error("Failed to save calibration reference", zap.Error(err))
+		return nil, err
+	}
+
+	s.refreshReliability(ctx, logger)
+
+	logger.Info("Calibration reference saved successfully", zap.Int("reference_rank", calibration.ReferenceRank))
+	return toProtoCalibrationResponse(calibration), nil
+}
+
+func (s *reviewService) RemoveCalibration(ctx context.Context, in *pb.RemoveCalibrationRequest) (*pb.CalibrationResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "remove_calibration"),
+		zap.Int32("essay_id", in.EssayId),
+		zap.String("requested_by", in.RequestedBy),
+	)
+
+	if err := s.checkCalibrationAccess(ctx, int(in.EssayId), in.RequestedBy); err != nil {
+		logger.Debug("Rejected calibration removal", zap.Error(err))
+		return nil, err
+	}
+
+	calibration, err := s.reliability.RemoveCalibration(ctx, int(in.EssayId))
+	if err != nil {
+		if errors.Is(err, repository.CalibrationNotFoundErr) {
+			return nil, statusError(err)
+		}
+		logger.Error("Failed to remove calibration reference", zap.Error(err))
+		return nil, err
+	}
+
+	s.refreshReliability(ctx, logger)
+
+	logger.Info("Calibration reference removed successfully")
+	return toProtoCalibrationResponse(calibration), nil
+}
+
+func (s *reviewService) RecomputeReliability(in *pb.EmptyRequest, stream grpc.ServerStreamingServer[pb.ReviewerReliabilityResponse]) error {
+	logger := logging.FromContext(stream.Context(), s.logger).With(zap.String("operation", "recompute_reliability"))
+
+	reliability, err := s.reliability.Recompute(stream.Context())
+	if err != nil {
+		logger.Error("Failed to recompute reviewer reliability", zap.Error(err))
+		return err
+	}
+
+	return s.sendReliability(logger, reliability, stream)
+}
+
+func (s *reviewService) GetReviewerReliability(in *pb.EmptyRequest, stream grpc.ServerStreamingServer[pb.ReviewerReliabilityResponse]) error {
+	logger := logging.FromContext(stream.Context(), s.logger).With(zap.String("operation", "get_reviewer_reliability"))
+
+	reliability, err := s.reliability.GetAll(stream.Context())
+	if err != nil {
+		logger.Error("Failed to get reviewer reliability", zap.Error(err))
+		return err
+	}
+
+	return s.sendReliability(logger, reliability, stream)
+}
+
+func (s *reviewService) sendReliability(logger *zap.Logger, reliability []models.ReviewerReliability, stream grpc.ServerStreamingServer[pb.ReviewerReliabilityResponse]) error {
+	for _, r := range reliability {
+		if err := stream.Send(toProtoReviewerReliabilityResponse(r)); err != nil {
+			logger.Error("Failed to send reviewer reliability in stream",
+				zap.String("reviewer", r.Reviewer),
+				zap.Error(err))
+			return err
+		}
+	}
+
+	logger.Debug("Sent reviewer reliability in stream", zap.Int("count", len(reliability)))
+	return nil
+}
+
+// Essays of an assignment can only be calibrated by the assignment creator
+func (s *reviewService) checkCalibrationAccess(ctx context.Context, essayID int, username string) error {
+	grading, err := s.gradingContext(ctx, essayID)
+	if err != nil {
+		return err
+	}
+	if grading.AssignmentID != 0 && grading.AssignmentCreator != username {
+		return status.Error(codes.PermissionDenied, "only the assignment creator can calibrate its essays")
+	}
+	return nil
+}
+
+// Scores are refreshed periodically anyway, so a failure here is not reported to the caller
+func (s *reviewService) refreshReliability(ctx context.Context, logger *zap.Logger) {
+	if _, err := s.reliability.Recompute(ctx); err != nil {
+		logger.Error("Failed to recompute reviewer reliability", zap.Error(err))
+	}
+}
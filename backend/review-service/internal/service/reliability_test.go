@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository"
+	repoMocks "github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type reliabilityServerStream struct {
+	sentMessages []*pb.ReviewerReliabilityResponse
+}
+
+func (m *reliabilityServerStream) Send(msg *pb.ReviewerReliabilityResponse) error {
+	m.sentMessages = append(m.sentMessages, msg)
+	return nil
+}
+
+func (m *reliabilityServerStream) Context() context.Context        { return context.Background() }
+func (m *reliabilityServerStream) SetHeader(md metadata.MD) error  { return nil }
+func (m *reliabilityServerStream) SendHeader(md metadata.MD) error { return nil }
+func (m *reliabilityServerStream) SetTrailer(md metadata.MD)       {}
+func (m *reliabilityServerStream) SendMsg(interface{}) error       { return nil }
+func (m *reliabilityServerStream) RecvMsg(interface{}) error       { return nil }
+
+func TestReviewService_SetCalibration(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        *pb.SetCalibrationRequest
+		setupMock    func(*repoMocks.MockGradeRepository, *repoMocks.MockReliabilityRepository)
+		expectedCode codes.Code
+	}{
+		{
+			name:  "assignment creator calibrates an essay",
+			input: &pb.SetCalibrationRequest{EssayId: 1, ReferenceRank: 2, RequestedBy: "teacher"},
+			setupMock: func(grades *repoMocks.MockGradeRepository, reliability *repoMocks.MockReliabilityRepository) {
+				grades.On("GetGradingContext", mock.Anything, 1).Return(gradingContext(false, nil), nil)
+				reliability.On("SetCalibration", mock.Anything, models.CalibrationRequest{EssayID: 1, ReferenceRank: 2, CreatedBy: "teacher"}).
+					Return(models.CalibrationEssay{EssayID: 1, ReferenceRank: 2, CreatedBy: "teacher", CreatedAt: time.Now()}, nil)
+				reliability.On("Recompute", mock.Anything, mock.Anything).Return([]models.ReviewerReliability{}, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:  "recompute failure does not fail the request",
+			input: &pb.SetCalibrationRequest{EssayId: 2, ReferenceRank: 3, RequestedBy: "teacher"},
+			setupMock: func(grades *repoMocks.MockGradeRepository, reliability *repoMocks.MockReliabilityRepository) {
+				grades.On("GetGradingContext", mock.Anything, 2).Return(models.GradingContext{EssayID: 2, EssayAuthor: "student"}, nil)
+				reliability.On("SetCalibration", mock.Anything, models.CalibrationRequest{EssayID: 2, ReferenceRank: 3, CreatedBy: "teacher"}).
+					Return(models.CalibrationEssay{EssayID: 2, ReferenceRank: 3, CreatedBy: "teacher"}, nil)
+				reliability.On("Recompute", mock.Anything, mock.Anything).Return([]models.ReviewerReliability(nil), errors.New("connection refused"))
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:  "essay of another teacher",
+			input: &pb.SetCalibrationRequest{EssayId: 1, ReferenceRank: 2, RequestedBy: "other-teacher"},
+			setupMock: func(grades *repoMocks.MockGradeRepository, _ *repoMocks.MockReliabilityRepository) {
+				grades.On("GetGradingContext", mock.Anything, 1).Return(gradingContext(false, nil), nil)
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:  "unknown essay",
+			input: &pb.SetCalibrationRequest{EssayId: 9, ReferenceRank: 2, RequestedBy: "teacher"},
+			setupMock: func(grades *repoMocks.MockGradeRepository, _ *repoMocks.MockReliabilityRepository) {
+				grades.On("GetGradingContext", mock.Anything, 9).Return(models.GradingContext{}, repository.EssayNotFoundErr)
+			},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "reference rank out of range",
+			input:        &pb.SetCalibrationRequest{EssayId: 1, ReferenceRank: 4, RequestedBy: "teacher"},
+			setupMock:    func(*repoMocks.MockGradeRepository, *repoMocks.MockReliabilityRepository) {},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grades := new(repoMocks.MockGradeRepository)
+			reliability := new(repoMocks.MockReliabilityRepository)
+			tt.setupMock(grades, reliability)
+
+			resp, err := newTestService(Repositories{Grades: grades, Reliability: reliability}, nil).SetCalibration(context.Background(), tt.input)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				require.NotNil(t, resp)
+				assert.Equal(t, tt.input.ReferenceRank, resp.ReferenceRank)
+			}
+			grades.AssertExpectations(t)
+			reliability.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReviewService_RemoveCalibration(t *testing.T) {
+	grades := new(repoMocks.MockGradeRepository)
+	grades.On("GetGradingContext", mock.Anything, 1).Return(gradingContext(false, nil), nil)
+	reliability := new(repoMocks.MockReliabilityRepository)
+	reliability.On("RemoveCalibration", mock.Anything, 1).Return(models.CalibrationEssay{}, repository.CalibrationNotFoundErr).Once()
+	reliability.On("RemoveCalibration", mock.Anything, 1).Return(models.CalibrationEssay{EssayID: 1, ReferenceRank: 2, CreatedBy: "teacher"}, nil).Once()
+	reliability.On("Recompute", mock.Anything, mock.Anything).Return([]models.ReviewerReliability{}, nil).Once()
+
+	service := newTestService(Repositories{Grades: grades, Reliability: reliability}, nil)
+
+	_, err := service.RemoveCalibration(context.Background(), &pb.RemoveCalibrationRequest{EssayId: 1, RequestedBy: "teacher"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	resp, err := service.RemoveCalibration(context.Background(), &pb.RemoveCalibrationRequest{EssayId: 1, RequestedBy: "teacher"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), resp.ReferenceRank)
+
+	reliability.AssertExpectations(t)
+}
+
+func TestReviewService_ReviewerReliability(t *testing.T) {
+	computedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	scores := []models.ReviewerReliability{
+		{Reviewer: "harsh", Score: 0, ConsensusReviews: 4, ComputedAt: computedAt},
+		{Reviewer: "agreeing", Score: 0.9, ConsensusReviews: 5, CalibrationReviews: 2, ComputedAt: computedAt},
+	}
+
+	reliability := new(repoMocks.MockReliabilityRepository)
+	reliability.On("Recompute", mock.Anything, mock.Anything).Return(scores, nil)
+	reliability.On("GetAll", mock.Anything, mock.Anything).Return(scores[1:], nil)
+
+	service := newTestService(Repositories{Reliability: reliability}, nil)
+
+	stream := &reliabilityServerStream{}
+	require.NoError(t, service.RecomputeReliability(&pb.EmptyRequest{}, stream))
+	require.Len(t, stream.sentMessages, 2)
+	assert.Equal(t, "harsh", stream.sentMessages[0].Reviewer)
+	assert.Equal(t, computedAt.Unix(), stream.sentMessages[0].ComputedAt)
+
+	stream = &reliabilityServerStream{}
+	require.NoError(t, service.GetReviewerReliability(&pb.EmptyRequest{}, stream))
+	require.Len(t, stream.sentMessages, 1)
+	assert.Equal(t, &pb.ReviewerReliabilityResponse{
+		Reviewer:           "agreeing",
+		Score:              0.9,
+		ConsensusReviews:   5,
+		CalibrationReviews: 2,
+		ComputedAt:         computedAt.Unix(),
+	}, stream.sentMessages[0])
+
+	reliability.AssertExpectations(t)
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/kafka"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+)
+
+func (s *reviewService) AddReply(ctx context.Context, in *pb.AddReplyRequest) (*pb.ReplyResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "add_reply"),
+		zap.Int32("review_id", in.ReviewId),
+		zap.String("author", in.Author),
+	)
+
+	logger.Debug("Processing add reply request")
+
+	content := strings.TrimSpace(in.Content)
+	if content == "" {
+		return nil, status.Error(codes.InvalidArgument, "content is required")
+	}
+
+	participants, err := s.replies.GetParticipants(ctx, int(in.ReviewId))
+	if err != nil {
+		if errors.Is(err, repository.ReviewNotFoundErr) {
+			return nil, statusError(err)
+		}
+		logger.Error("Failed to get review participants", zap.Error(err))
+		return nil, err
+	}
+
+	if in.Author != participants.Reviewer && in.Author != participants.EssayAuthor {
+		logger.Warn("Reply from a user outside the discussion")
+		return nil, status.Error(codes.PermissionDenied, "only the essay author and the reviewer can reply")
+	}
+
+	if in.ParentId != 0 {
+		if err := s.checkReplyParent(ctx, in.ParentId, int(in.ReviewId)); err != nil {
+			logger.Debug("Invalid reply parent", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	reply, err := s.replies.Create(ctx, models.ReplyRequest{
+		ReviewID: int(in.ReviewId),
+		ParentID: in.ParentId,
+		Author:   in.Author,
+		Content:  content,
+	})
+	if err != nil {
+		logger.Error("Failed to add reply", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info("Reply added successfully", zap.Int64("reply_id", reply.ID))
+
+	applyReplyAlias(&reply, participants)
+	actor := in.Author
+	if reply.Anonymous {
+		actor = reply.AuthorAlias
+	}
+
+	// the other side of the discussion gets notified
+	recipientID := participants.EssayAuthorID
+	if in.Author == participants.EssayAuthor {
+		recipientID = participants.ReviewerID
+	}
+	// the reviewer of a double-blind essay must not learn its author from the
+	// notification, the link then points at the essay id instead
+	essayAuthor := participants.EssayAuthor
+	if participants.Anonymous {
+		essayAuthor = ""
+	}
+	if participants.Reviewer != participants.EssayAuthor {
+		s.sendNotification(ctx, logger, kafka.NotificationEvent{
+			Type:        "review_reply",
+			UserID:      recipientID,
+			Content:     fmt.Sprintf("%s replied to the discussion of a review", actor),
+			EssayID:     int64(participants.EssayID),
+			ReviewID:    int64(participants.ReviewID),
+			ReplyID:     reply.ID,
+			EssayAuthor: essayAuthor,
+			Author:      actor,
+		})
+	}
+
+	return toProtoReplyResponse(reply), nil
+}
+
+func (s *reviewService) GetReplies(in *pb.GetRepliesRequest, stream grpc.ServerStreamingServer[pb.ReplyResponse]) error {
+	logger := logging.FromContext(stream.Context(), s.logger).With(
+		zap.String("operation", "get_replies"),
+		zap.Int32("review_id", in.ReviewId),
+	)
+
+	participants, err := s.replies.GetParticipants(stream.Context(), int(in.ReviewId))
+	if err != nil {
+		if errors.Is(err, repository.ReviewNotFoundErr) {
+			return statusError(err)
+		}
+		logger.Error("Failed to get review participants", zap.Error(err))
+		return err
+	}
+
+	replies, err := s.replies.GetByReviewID(stream.Context(), int(in.ReviewId))
+	if err != nil {
+		logger.Error("Failed to get replies", zap.Error(err))
+		return err
+	}
+
+	for _, reply := range replies {
+		applyReplyAlias(&reply, participants)
+		if err := stream.Send(toProtoReplyResponse(reply)); err != nil {
+			logger.Error("Failed to send reply in stream",
+				zap.Int64("reply_id", reply.ID),
+				zap.Error(err))
+			return err
+		}
+	}
+
+	logger.Debug("Sent replies in stream", zap.Int("count", len(replies)))
+	return nil
+}
+
+func (s *reviewService) RemoveReply(ctx context.Context, in *pb.RemoveReplyRequest) (*pb.ReplyResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "remove_reply"),
+		zap.Int64("reply_id", in.Id),
+		zap.String("author", in.Author),
+	)
+
+	reply, err := s.replies.GetByID(ctx, in.Id)
+	if err != nil {
+		if errors.Is(err, repository.ReplyNotFoundErr) {
+			return nil, statusError(err)
+		}
+		logger.Error("Failed to get reply", zap.Error(err))
+		return nil, err
+	}
+
+	if reply.Author != in.Author {
+		logger.Warn("Forbidden reply removal attempt")
+		return nil, status.Error(codes.PermissionDenied, "you can delete only your own replies")
+	}
+
+	reply, err = s.replies.RemoveByID(ctx, in.Id)
+	if err != nil {
+		if errors.Is(err, repository.ReplyNotFoundErr) {
+			return nil, statusError(err)
+		}
+		logger.Error("Failed to remove reply", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info("Reply removed successfully")
+	return toProtoReplyResponse(reply), nil
+}
+
+// Replies can answer a thread starter of the same review, but not another answer
+func (s *reviewService) checkReplyParent(ctx context.Context, parentID int64, reviewID int) error {
+	parent, err := s.replies.GetByID(ctx, parentID)
+	if err != nil {
+		if errors.Is(err, repository.ReplyNotFoundErr) {
+			return status.Error(codes.InvalidArgument, "parent reply not found")
+		}
+		return err
+	}
+
+	if parent.ReviewID != reviewID {
+		return status.Error(codes.InvalidArgument, "parent reply belongs to another review")
+	}
+	if parent.ParentID != 0 {
+		return status.Error(codes.InvalidArgument, "replies can be nested only one level deep")
+	}
+	return nil
+}
+
+// Replies in anonymous assignments carry the pseudonym of their author's side
+func applyReplyAlias(reply *models.Reply, participants models.ReviewParticipants) {
+	if !participants.Anonymous {
+		return
+	}
+
+	reply.Anonymous = true
+	switch reply.Author {
+	case participants.Reviewer:
+		reply.AuthorAlias = participants.ReviewerAlias
+	case participants.EssayAuthor:
+		reply.AuthorAlias = participants.EssayAuthorAlias
+	}
+}
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/kafka"
+	kafkaMocks "github.com/IAGrig/vt-csa-essays/backend/review-service/internal/kafka/mocks"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository"
+	repoMocks "github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type replyServerStream struct {
+	sentMessages []*pb.ReplyResponse
+}
+
+func (m *replyServerStream) Send(msg *pb.ReplyResponse) error {
+	m.sentMessages = append(m.sentMessages, msg)
+	return nil
+}
+
+func (m *replyServerStream) Context() context.Context        { return context.Background() }
+func (m *replyServerStream) SetHeader(md metadata.MD) error  { return nil }
+func (m *replyServerStream) SendHeader(md metadata.MD) error { return nil }
+func (m *replyServerStream) SetTrailer(md metadata.MD)       {}
+func (m *replyServerStream) SendMsg(interface{}) error       { return nil }
+func (m *replyServerStream) RecvMsg(interface{}) error       { return nil }
+
+var testParticipants = models.ReviewParticipants{
+	ReviewID:      3,
+	EssayID:       1,
+	Reviewer:      "reviewer",
+	ReviewerID:    20,
+	EssayAuthor:   "author",
+	EssayAuthorID: 10,
+}
+
+var anonymousParticipants = models.ReviewParticipants{
+	ReviewID:         3,
+	EssayID:          1,
+	Reviewer:         "reviewer",
+	ReviewerID:       20,
+	EssayAuthor:      "author",
+	EssayAuthorID:    10,
+	Anonymous:        true,
+	ReviewerAlias:    "Reviewer A",
+	EssayAuthorAlias: "Author B",
+}
+
+func TestReviewService_AddReply(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        *pb.AddReplyRequest
+		setupMock    func(*repoMocks.MockReplyRepository, *kafkaMocks.MockProducer)
+		expectedCode codes.Code
+	}{
+		{
+			name:  "essay author reply notifies the reviewer",
+			input: &pb.AddReplyRequest{ReviewId: 3, Author: "author", Content: " Thanks! "},
+			setupMock: func(replies *repoMocks.MockReplyRepository, producer *kafkaMocks.MockProducer) {
+				replies.On("GetParticipants", mock.Anything, 3).Return(testParticipants, nil)
+				replies.On("Create", mock.Anything, models.ReplyRequest{ReviewID: 3, Author: "author", Content: "Thanks!"}).
+					Return(models.Reply{ID: 7, ReviewID: 3, Author: "author", Content: "Thanks!"}, nil)
+				producer.On("SendNotificationEvent", mock.Anything, kafka.NotificationEvent{
+					Type:        "review_reply",
+					UserID:      20,
+					Content:     "author replied to the discussion of a review",
+					EssayID:     1,
+					ReviewID:    3,
+					ReplyID:     7,
+					EssayAuthor: "author",
+					Author:      "author",
+				}).Return(nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:  "reviewer answer notifies the essay author",
+			input: &pb.AddReplyRequest{ReviewId: 3, ParentId: 7, Author: "reviewer", Content: "You're welcome"},
+			setupMock: func(replies *repoMocks.MockReplyRepository, producer *kafkaMocks.MockProducer) {
+				replies.On("GetParticipants", mock.Anything, 3).Return(testParticipants, nil)
+				replies.On("GetByID", mock.Anything, int64(7)).Return(models.Reply{ID: 7, ReviewID: 3, Author: "author"}, nil)
+				replies.On("Create", mock.Anything, models.ReplyRequest{ReviewID: 3, ParentID: 7, Author: "reviewer", Content: "You're welcome"}).
+					Return(models.Reply{ID: 8, ReviewID: 3, ParentID: 7, Author: "reviewer"}, nil)
+				producer.On("SendNotificationEvent", mock.Anything, mock.MatchedBy(func(event kafka.NotificationEvent) bool {
+					return event.UserID == 10 && event.ReplyID == 8
+				})).Return(nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:  "anonymous assignment hides the replier and the essay author",
+			input: &pb.AddReplyRequest{ReviewId: 3, Author: "author", Content: "Thanks!"},
+			setupMock: func(replies *repoMocks.MockReplyRepository, producer *kafkaMocks.MockProducer) {
+				replies.On("GetParticipants", mock.Anything, 3).Return(anonymousParticipants, nil)
+				replies.On("Create", mock.Anything, models.ReplyRequest{ReviewID: 3, Author: "author", Content: "Thanks!"}).
+					Return(models.Reply{ID: 7, ReviewID: 3, Author: "author", Content: "Thanks!"}, nil)
+				producer.On("SendNotificationEvent", mock.Anything, kafka.NotificationEvent{
+					Type:     "review_reply",
+					UserID:   20,
+					Content:  "Author B replied to the discussion of a review",
+					EssayID:  1,
+					ReviewID: 3,
+					ReplyID:  7,
+					Author:   "Author B",
+				}).Return(nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:  "outsider cannot reply",
+			input: &pb.AddReplyRequest{ReviewId: 3, Author: "someone", Content: "Hi"},
+			setupMock: func(replies *repoMocks.MockReplyRepository, producer *kafkaMocks.MockProducer) {
+				replies.On("GetParticipants", mock.Anything, 3).Return(testParticipants, nil)
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:  "nesting deeper than one level",
+			input: &pb.AddReplyRequest{ReviewId: 3, ParentId: 8, Author: "author", Content: "Hi"},
+			setupMock: func(replies *repoMocks.MockReplyRepository, producer *kafkaMocks.MockProducer) {
+				replies.On("GetParticipants", mock.Anything, 3).Return(testParticipants, nil)
+				replies.On("GetByID", mock.Anything, int64(8)).Return(models.Reply{ID: 8, ReviewID: 3, ParentID: 7}, nil)
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:  "parent from another review",
+			input: &pb.AddReplyRequest{ReviewId: 3, ParentId: 9, Author: "author", Content: "Hi"},
+			setupMock: func(replies *repoMocks.MockReplyRepository, producer *kafkaMocks.MockProducer) {
+				replies.On("GetParticipants", mock.Anything, 3).Return(testParticipants, nil)
+				replies.On("GetByID", mock.Anything, int64(9)).Return(models.Reply{ID: 9, ReviewID: 4}, nil)
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:  "unknown review",
+			input: &pb.AddReplyRequest{ReviewId: 5, Author: "author", Content: "Hi"},
+			setupMock: func(replies *repoMocks.MockReplyRepository, producer *kafkaMocks.MockProducer) {
+				replies.On("GetParticipants", mock.Anything, 5).Return(models.ReviewParticipants{}, repository.ReviewNotFoundErr)
+			},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "empty content",
+			input:        &pb.AddReplyRequest{ReviewId: 3, Author: "author", Content: "  "},
+			setupMock:    func(*repoMocks.MockReplyRepository, *kafkaMocks.MockProducer) {},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReplies := new(repoMocks.MockReplyRepository)
+			mockProducer := new(kafkaMocks.MockProducer)
+			tt.setupMock(mockReplies, mockProducer)
+
+			service := newTestService(Repositories{Replies: mockReplies}, mockProducer)
+			result, err := service.AddReply(context.Background(), tt.input)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.NotZero(t, result.Id)
+			}
+
+			mockReplies.AssertExpectations(t)
+			mockProducer.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReviewService_GetReplies(t *testing.T) {
+	mockReplies := new(repoMocks.MockReplyRepository)
+	mockReplies.On("GetParticipants", mock.Anything, 3).Return(anonymousParticipants, nil)
+	mockReplies.On("GetByReviewID", mock.Anything, 3).Return([]models.Reply{
+		{ID: 7, ReviewID: 3, Author: "author", Content: "Thanks!"},
+		{ID: 8, ReviewID: 3, ParentID: 7, Author: "reviewer", Content: "You're welcome"},
+	}, nil)
+
+	service := newTestService(Repositories{Replies: mockReplies}, nil)
+	stream := &replyServerStream{}
+
+	err := service.GetReplies(&pb.GetRepliesRequest{ReviewId: 3}, stream)
+	require.NoError(t, err)
+	require.Len(t, stream.sentMessages, 2)
+	assert.Equal(t, int64(7), stream.sentMessages[1].ParentId)
+	assert.True(t, stream.sentMessages[0].Anonymous)
+	assert.Equal(t, "Author B", stream.sentMessages[0].AuthorAlias)
+	assert.Equal(t, "Reviewer A", stream.sentMessages[1].AuthorAlias)
+
+	mockReplies.AssertExpectations(t)
+}
+
+func TestReviewService_RemoveReply(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        *pb.RemoveReplyRequest
+		setupMock    func(*repoMocks.MockReplyRepository)
+		expectedCode codes.Code
+	}{
+		{
+			name:  "author removes own reply",
+			input: &pb.RemoveReplyRequest{Id: 7, Author: "author"},
+			setupMock: func(replies *repoMocks.MockReplyRepository) {
+				replies.On("GetByID", mock.Anything, int64(7)).Return(models.Reply{ID: 7, Author: "author"}, nil)
+				replies.On("RemoveByID", mock.Anything, int64(7)).Return(models.Reply{ID: 7, Author: "author"}, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:  "someone else's reply",
+			input: &pb.RemoveReplyRequest{Id: 7, Author: "reviewer"},
+			setupMock: func(replies *repoMocks.MockReplyRepository) {
+				replies.On("GetByID", mock.Anything, int64(7)).Return(models.Reply{ID: 7, Author: "author"}, nil)
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:  "missing reply",
+			input: &pb.RemoveReplyRequest{Id: 9, Author: "author"},
+			setupMock: func(replies *repoMocks.MockReplyRepository) {
+				replies.On("GetByID", mock.Anything, int64(9)).Return(models.Reply{}, repository.ReplyNotFoundErr)
+			},
+			expectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReplies := new(repoMocks.MockReplyRepository)
+			tt.setupMock(mockReplies)
+
+			service := newTestService(Repositories{Replies: mockReplies}, nil)
+			_, err := service.RemoveReply(context.Background(), tt.input)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			mockReplies.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+)
+
+const maxRank = 3
+
+func (s *reviewService) CreateRubric(ctx context.Context, in *pb.CreateRubricRequest) (*pb.RubricResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "create_rubric"),
+		zap.String("created_by", in.CreatedBy),
+	)
+
+	logger.Debug("Processing create rubric request")
+
+	req := fromProtoCreateRubricRequest(in)
+	if err := validateRubric(req); err != nil {
+		logger.Debug("Invalid rubric", zap.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rubric, err := s.rubrics.Create(ctx, req)
+	if err != nil {
+		logger.Error("Failed to create rubric", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info("Rubric created successfully", zap.Int64("rubric_id", rubric.ID))
+	return toProtoRubricResponse(rubric), nil
+}
+
+func (s *reviewService) GetRubric(ctx context.Context, in *pb.GetRubricRequest) (*pb.RubricResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "get_rubric"),
+		zap.Int64("rubric_id", in.Id),
+	)
+
+	rubric, err := s.rubrics.GetByID(ctx, in.Id)
+	if err != nil {
+		if errors.Is(err, repository.RubricNotFoundErr) {
+			return nil, statusError(err)
+		}
+		logger.Error("Failed to get rubric", zap.Error(err))
+		return nil, err
+	}
+
+	return toProtoRubricResponse(rubric), nil
+}
+
+func (s *reviewService) GetAllRubrics(in *pb.EmptyRequest, stream grpc.ServerStreamingServer[pb.RubricResponse]) error {
+	logger := logging.FromContext(stream.Context(), s.logger).With(zap.String("operation", "get_all_rubrics"))
+
+	rubrics, err := s.rubrics.GetAll(stream.Context())
+	if err != nil {
+		logger.Error("Failed to get rubrics", zap.Error(err))
+		return err
+	}
+
+	for _, rubric := range rubrics {
+		if err := stream.Send(toProtoRubricResponse(rubric)); err != nil {
+			logger.Error("Failed to send rubric in stream",
+				zap.Int64("rubric_id", rubric.ID),
+				zap.Error(err))
+			return err
+		}
+	}
+
+	logger.Debug("Sent all rubrics in stream", zap.Int("count", len(rubrics)))
+	return nil
+}
+
+// Reviews of an essay in an assignment are scored with the assignment's
+// rubric: an empty rubric_id picks it and any other one is rejected
+func (s *reviewService) applyAssignmentRubric(ctx context.Context, req *models.ReviewRequest) error {
+	assignment, err := s.assignments.GetByEssayID(ctx, req.EssayId)
+	if errors.Is(err, repository.AssignmentNotFoundErr) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case req.RubricID == assignment.RubricID:
+	case req.RubricID == 0:
+		req.RubricID = assignment.RubricID
+	case assignment.RubricID == 0:
+		return status.Error(codes.InvalidArgument, "the assignment of the essay is reviewed without a rubric")
+	default:
+		return status.Error(codes.InvalidArgument,
+			fmt.Sprintf("the assignment of the essay is reviewed with rubric %d", assignment.RubricID))
+	}
+	return nil
+}
+
+// Fills rubric based fields of the request from the submitted criterion scores
+func (s *reviewService) applyRubric(ctx context.Context, req *models.ReviewRequest) error {
+	if req.RubricID == 0 {
+		if len(req.Scores) > 0 {
+			return status.Error(codes.InvalidArgument, "scores require a rubric_id")
+		}
+		return nil
+	}
+
+	rubric, err := s.rubrics.GetByID(ctx, req.RubricID)
+	if err != nil {
+		if errors.Is(err, repository.RubricNotFoundErr) {
+			return invalidReference(err)
+		}
+		return err
+	}
+
+	scores, total, err := scoreReview(rubric, req.Scores)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	req.Scores = scores
+	req.TotalScore = total
+	req.Rank = rankFromTotal(total)
+	return nil
+}
+
+func validateRubric(req models.RubricRequest) error {
+	if strings.TrimSpace(req.Title) == "" {
+		return errors.New("title is required")
+	}
+	if req.CreatedBy == "" {
+		return errors.New("created_by is required")
+	}
+	if len(req.Criteria) == 0 {
+		return errors.New("rubric must have at least one criterion")
+	}
+
+	for i, criterion := range req.Criteria {
+		if strings.TrimSpace(criterion.Name) == "" {
+			return fmt.Errorf("criterion %d: name is required", i+1)
+		}
+		if criterion.Weight <= 0 {
+			return fmt.Errorf("criterion %q: weight must be positive", criterion.Name)
+		}
+		if criterion.MaxScore <= criterion.MinScore {
+			return fmt.Errorf("criterion %q: max_score must be greater than min_score", criterion.Name)
+		}
+	}
+
+	return nil
+}
+
+// Checks that every criterion is scored exactly once within its scale and
+// returns the scores in rubric order together with a weighted total in [0, 100]
+func scoreReview(rubric models.Rubric, scores []models.CriterionScore) ([]models.CriterionScore, float64, error) {
+	byCriterion := make(map[int64]models.CriterionScore, len(scores))
+	for _, score := range scores {
+		if _, ok := byCriterion[score.CriterionID]; ok {
+			return nil, 0, fmt.Errorf("criterion %d is scored more than once", score.CriterionID)
+		}
+		byCriterion[score.CriterionID] = score
+	}
+
+	result := make([]models.CriterionScore, 0, len(rubric.Criteria))
+	var weighted, totalWeight float64
+	for _, criterion := range rubric.Criteria {
+		score, ok := byCriterion[criterion.ID]
+		if !ok {
+			return nil, 0, fmt.Errorf("criterion %q is not scored", criterion.Name)
+		}
+		if score.Score < criterion.MinScore || score.Score > criterion.MaxScore {
+			return nil, 0, fmt.Errorf("score for criterion %q must be between %d and %d",
+				criterion.Name, criterion.MinScore, criterion.MaxScore)
+		}
+		delete(byCriterion, criterion.ID)
+
+		score.CriterionName = criterion.Name
+		score.Weight = criterion.Weight
+		score.MinScore = criterion.MinScore
+		score.MaxScore = criterion.MaxScore
+		result = append(result, score)
+
+		normalized := float64(score.Score-criterion.MinScore) / float64(criterion.MaxScore-criterion.MinScore)
+		weighted += criterion.Weight * normalized
+		totalWeight += criterion.Weight
+	}
+
+	for id := range byCriterion {
+		return nil, 0, fmt.Errorf("criterion %d does not belong to rubric %d", id, rubric.ID)
+	}
+
+	if totalWeight == 0 {
+		return nil, 0, errors.New("rubric has no criteria")
+	}
+
+	return result, weighted / totalWeight * 100, nil
+}
+
+// Maps a total score onto the 1..3 rank scale used by plain reviews
+func rankFromTotal(total float64) int {
+	rank := 1 + int(total/100*maxRank)
+	if rank > maxRank {
+		return maxRank
+	}
+	return rank
+}
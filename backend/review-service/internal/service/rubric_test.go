@@ -0,0 +1,337 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+	kafkaMocks "github.com/IAGrig/vt-csa-essays/backend/review-service/internal/kafka/mocks"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository"
+	repoMocks "github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var testRubric = models.Rubric{
+	ID:        7,
+	Title:     "Essay rubric",
+	CreatedBy: "teacher",
+	Criteria: []models.Criterion{
+		{ID: 1, Name: "Thesis", Weight: 1, MinScore: 0, MaxScore: 4},
+		{ID: 2, Name: "Evidence", Weight: 3, MinScore: 1, MaxScore: 5},
+	},
+}
+
+func TestScoreReview(t *testing.T) {
+	tests := []struct {
+		name          string
+		scores        []models.CriterionScore
+		expectedTotal float64
+		expectedError string
+	}{
+		{
+			name: "weighted total over normalized scores",
+			scores: []models.CriterionScore{
+				{CriterionID: 2, Score: 3, Comment: "ok"},
+				{CriterionID: 1, Score: 4},
+			},
+			expectedTotal: 62.5,
+		},
+		{
+			name: "minimum scores give zero",
+			scores: []models.CriterionScore{
+				{CriterionID: 1, Score: 0},
+				{CriterionID: 2, Score: 1},
+			},
+			expectedTotal: 0,
+		},
+		{
+			name:          "missing criterion",
+			scores:        []models.CriterionScore{{CriterionID: 1, Score: 2}},
+			expectedError: `criterion "Evidence" is not scored`,
+		},
+		{
+			name: "duplicate criterion",
+			scores: []models.CriterionScore{
+				{CriterionID: 1, Score: 2},
+				{CriterionID: 1, Score: 3},
+				{CriterionID: 2, Score: 3},
+			},
+			expectedError: "criterion 1 is scored more than once",
+		},
+		{
+			name: "score outside scale",
+			scores: []models.CriterionScore{
+				{CriterionID: 1, Score: 2},
+				{CriterionID: 2, Score: 0},
+			},
+			expectedError: `score for criterion "Evidence" must be between 1 and 5`,
+		},
+		{
+			name: "unknown criterion",
+			scores: []models.CriterionScore{
+				{CriterionID: 1, Score: 2},
+				{CriterionID: 2, Score: 3},
+				{CriterionID: 99, Score: 3},
+			},
+			expectedError: "criterion 99 does not belong to rubric 7",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scores, total, err := scoreReview(testRubric, tt.scores)
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expectedTotal, total, 1e-9)
+			require.Len(t, scores, 2)
+			assert.Equal(t, "Thesis", scores[0].CriterionName)
+			assert.Equal(t, "Evidence", scores[1].CriterionName)
+			assert.Equal(t, 3.0, scores[1].Weight)
+		})
+	}
+}
+
+func TestRankFromTotal(t *testing.T) {
+	assert.Equal(t, 1, rankFromTotal(0))
+	assert.Equal(t, 1, rankFromTotal(33))
+	assert.Equal(t, 2, rankFromTotal(34))
+	assert.Equal(t, 3, rankFromTotal(70))
+	assert.Equal(t, 3, rankFromTotal(100))
+}
+
+func TestReviewService_AddWithRubric(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        *pb.ReviewAddRequest
+		setupMock    func(*repoMocks.MockReviewRepository, *repoMocks.MockRubricRepository, *kafkaMocks.MockProducer)
+		expectedCode codes.Code
+	}{
+		{
+			name: "scores are validated and total stored",
+			input: &pb.ReviewAddRequest{
+				EssayId:  1,
+				Content:  "Scored",
+				Author:   "reviewer",
+				RubricId: 7,
+				Scores: []*pb.CriterionScore{
+					{CriterionId: 1, Score: 4},
+					{CriterionId: 2, Score: 3, Comment: "ok"},
+				},
+			},
+			setupMock: func(reviews *repoMocks.MockReviewRepository, rubrics *repoMocks.MockRubricRepository, producer *kafkaMocks.MockProducer) {
+				rubrics.On("GetByID", mock.Anything, int64(7)).Return(testRubric, nil)
+				reviews.On("Add", mock.Anything, mock.MatchedBy(func(req models.ReviewRequest) bool {
+					return req.RubricID == 7 && req.TotalScore == 62.5 && req.Rank == 2 &&
+						len(req.Scores) == 2 && req.Scores[1].Comment == "ok"
+				})).Return(models.Review{ID: 1, EssayId: 1, Rank: 2, RubricID: 7, TotalScore: 62.5}, nil)
+				producer.On("SendNotificationEvent", mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "unknown rubric",
+			input: &pb.ReviewAddRequest{
+				EssayId:  1,
+				Author:   "reviewer",
+				RubricId: 8,
+			},
+			setupMock: func(reviews *repoMocks.MockReviewRepository, rubrics *repoMocks.MockRubricRepository, producer *kafkaMocks.MockProducer) {
+				rubrics.On("GetByID", mock.Anything, int64(8)).Return(models.Rubric{}, repository.RubricNotFoundErr)
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "invalid scores",
+			input: &pb.ReviewAddRequest{
+				EssayId:  1,
+				Author:   "reviewer",
+				RubricId: 7,
+				Scores:   []*pb.CriterionScore{{CriterionId: 1, Score: 9}},
+			},
+			setupMock: func(reviews *repoMocks.MockReviewRepository, rubrics *repoMocks.MockRubricRepository, producer *kafkaMocks.MockProducer) {
+				rubrics.On("GetByID", mock.Anything, int64(7)).Return(testRubric, nil)
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "scores without rubric",
+			input: &pb.ReviewAddRequest{
+				EssayId: 1,
+				Rank:    2,
+				Author:  "reviewer",
+				Scores:  []*pb.CriterionScore{{CriterionId: 1, Score: 2}},
+			},
+			setupMock:    func(*repoMocks.MockReviewRepository, *repoMocks.MockRubricRepository, *kafkaMocks.MockProducer) {},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(repoMocks.MockReviewRepository)
+			mockRubrics := new(repoMocks.MockRubricRepository)
+			mockProducer := new(kafkaMocks.MockProducer)
+			tt.setupMock(mockRepo, mockRubrics, mockProducer)
+
+			service := newTestService(Repositories{Reviews: mockRepo, Rubrics: mockRubrics}, mockProducer)
+			result, err := service.Add(context.Background(), tt.input)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.Equal(t, int64(7), result.RubricId)
+				assert.Equal(t, 62.5, result.TotalScore)
+			}
+
+			mockRepo.AssertExpectations(t)
+			mockRubrics.AssertExpectations(t)
+			mockProducer.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReviewService_AddWithAssignmentRubric(t *testing.T) {
+	scores := []*pb.CriterionScore{{CriterionId: 1, Score: 4}, {CriterionId: 2, Score: 3}}
+
+	tests := []struct {
+		name             string
+		assignmentRubric int64
+		rubricID         int64
+		expectedCode     codes.Code
+	}{
+		{name: "rubric taken from the assignment", assignmentRubric: 7, expectedCode: codes.OK},
+		{name: "rubric of the assignment", assignmentRubric: 7, rubricID: 7, expectedCode: codes.OK},
+		{name: "other rubric", assignmentRubric: 7, rubricID: 8, expectedCode: codes.InvalidArgument},
+		{name: "rubric for an assignment without one", rubricID: 7, expectedCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(repoMocks.MockReviewRepository)
+			mockRubrics := new(repoMocks.MockRubricRepository)
+			mockAssignments := new(repoMocks.MockAssignmentRepository)
+			mockProducer := new(kafkaMocks.MockProducer)
+
+			mockAssignments.On("GetByEssayID", mock.Anything, 1).Return(models.Assignment{ID: 3, RubricID: tt.assignmentRubric}, nil)
+			if tt.expectedCode == codes.OK {
+				mockRubrics.On("GetByID", mock.Anything, int64(7)).Return(testRubric, nil)
+				mockRepo.On("Add", mock.Anything, mock.MatchedBy(func(req models.ReviewRequest) bool {
+					return req.RubricID == 7 && req.TotalScore == 62.5
+				})).Return(models.Review{ID: 1, EssayId: 1, Rank: 2, RubricID: 7, TotalScore: 62.5}, nil)
+				mockProducer.On("SendNotificationEvent", mock.Anything, mock.Anything).Return(nil)
+			}
+
+			service := newTestService(Repositories{Reviews: mockRepo, Rubrics: mockRubrics, Assignments: mockAssignments}, mockProducer)
+			_, err := service.Add(context.Background(), &pb.ReviewAddRequest{
+				EssayId:  1,
+				Content:  "Scored",
+				Author:   "reviewer",
+				RubricId: tt.rubricID,
+				Scores:   scores,
+			})
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			mockRepo.AssertExpectations(t)
+			mockRubrics.AssertExpectations(t)
+			mockAssignments.AssertExpectations(t)
+			mockProducer.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReviewService_CreateRubric(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        *pb.CreateRubricRequest
+		setupMock    func(*repoMocks.MockRubricRepository)
+		expectedCode codes.Code
+	}{
+		{
+			name: "success",
+			input: &pb.CreateRubricRequest{
+				Title:     " Essay rubric ",
+				CreatedBy: "teacher",
+				Criteria: []*pb.Criterion{
+					{Name: "Thesis", Weight: 1, MinScore: 0, MaxScore: 4},
+				},
+			},
+			setupMock: func(rubrics *repoMocks.MockRubricRepository) {
+				rubrics.On("Create", mock.Anything, models.RubricRequest{
+					Title:     "Essay rubric",
+					CreatedBy: "teacher",
+					Criteria:  []models.Criterion{{Name: "Thesis", Weight: 1, MinScore: 0, MaxScore: 4}},
+				}).Return(testRubric, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "no criteria",
+			input:        &pb.CreateRubricRequest{Title: "Empty", CreatedBy: "teacher"},
+			setupMock:    func(*repoMocks.MockRubricRepository) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "non positive weight",
+			input: &pb.CreateRubricRequest{
+				Title:     "Bad",
+				CreatedBy: "teacher",
+				Criteria:  []*pb.Criterion{{Name: "Thesis", Weight: 0, MinScore: 0, MaxScore: 4}},
+			},
+			setupMock:    func(*repoMocks.MockRubricRepository) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "empty scale",
+			input: &pb.CreateRubricRequest{
+				Title:     "Bad",
+				CreatedBy: "teacher",
+				Criteria:  []*pb.Criterion{{Name: "Thesis", Weight: 1, MinScore: 3, MaxScore: 3}},
+			},
+			setupMock:    func(*repoMocks.MockRubricRepository) {},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRubrics := new(repoMocks.MockRubricRepository)
+			tt.setupMock(mockRubrics)
+
+			service := newTestService(Repositories{Rubrics: mockRubrics}, nil)
+			result, err := service.CreateRubric(context.Background(), tt.input)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.Equal(t, int64(7), result.Id)
+				assert.Len(t, result.Criteria, 2)
+			}
+
+			mockRubrics.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReviewService_GetRubric(t *testing.T) {
+	mockRubrics := new(repoMocks.MockRubricRepository)
+	mockRubrics.On("GetByID", mock.Anything, int64(7)).Return(testRubric, nil)
+	mockRubrics.On("GetByID", mock.Anything, int64(8)).Return(models.Rubric{}, repository.RubricNotFoundErr)
+
+	service := newTestService(Repositories{Rubrics: mockRubrics}, nil)
+
+	result, err := service.GetRubric(context.Background(), &pb.GetRubricRequest{Id: 7})
+	require.NoError(t, err)
+	assert.Equal(t, "Essay rubric", result.Title)
+	assert.Equal(t, "Evidence", result.Criteria[1].Name)
+
+	_, err = service.GetRubric(context.Background(), &pb.GetRubricRequest{Id: 8})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/kafka"
@@ -12,39 +13,67 @@ import (
 	"github.com/IAGrig/vt-csa-essays/backend/shared/monitoring"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
 )
 
+// The review server, Drain waits for the notifications still being sent
+type Server interface {
+	pb.ReviewServiceServer
+	Drain(ctx context.Context) error
+}
+
 type reviewService struct {
 	pb.UnimplementedReviewServiceServer
-	repository repository.ReviewRepository
-	producer   kafka.Producer
-	logger     *logging.Logger
-	testMode   bool
+	repository  repository.ReviewRepository
+	rubrics     repository.RubricRepository
+	replies     repository.ReplyRepository
+	assignments repository.AssignmentRepository
+	grades      repository.GradeRepository
+	reliability repository.ReliabilityRepository
+	producer    kafka.Producer
+	logger      *logging.Logger
+	testMode    bool
+	pending     sync.WaitGroup
 }
 
-func New(repository repository.ReviewRepository, producer kafka.Producer, logger *logging.Logger) pb.ReviewServiceServer {
-	return &reviewService{
-		repository: repository,
-		producer:   producer,
-		logger:     logger,
-		testMode:   false,
-	}
+// Storage the service works with, each feature reads only its own part
+type Repositories struct {
+	Reviews     repository.ReviewRepository
+	Rubrics     repository.RubricRepository
+	Replies     repository.ReplyRepository
+	Assignments repository.AssignmentRepository
+	Grades      repository.GradeRepository
+	Reliability repository.ReliabilityRepository
+}
+
+func New(repos Repositories, producer kafka.Producer, logger *logging.Logger) Server {
+	return newReviewService(repos, producer, logger, false)
+}
+
+func NewForTest(repos Repositories, producer kafka.Producer, logger *logging.Logger) pb.ReviewServiceServer {
+	return newReviewService(repos, producer, logger, true)
 }
 
-func NewForTest(repository repository.ReviewRepository, producer kafka.Producer, logger *logging.Logger) pb.ReviewServiceServer {
+func newReviewService(repos Repositories, producer kafka.Producer, logger *logging.Logger, testMode bool) *reviewService {
 	return &reviewService{
-		repository: repository,
-		producer:   producer,
-		logger:     logger,
-		testMode:   true,
+		repository:  repos.Reviews,
+		rubrics:     repos.Rubrics,
+		replies:     repos.Replies,
+		assignments: repos.Assignments,
+		grades:      repos.Grades,
+		reliability: repos.Reliability,
+		producer:    producer,
+		logger:      logger,
+		testMode:    testMode,
 	}
 }
 
 func (s *reviewService) Add(ctx context.Context, in *pb.ReviewAddRequest) (*pb.ReviewResponse, error) {
 	start := time.Now()
-	logger := s.logger.With(
+	logger := logging.FromContext(ctx, s.logger).With(
 		zap.String("operation", "add_review"),
 		zap.Int32("essay_id", in.EssayId),
 		zap.String("author", in.Author),
@@ -52,67 +81,59 @@ func (s *reviewService) Add(ctx context.Context, in *pb.ReviewAddRequest) (*pb.R
 
 	logger.Debug("Processing review add request")
 
-	req := models.ReviewRequest{
-		EssayId: int(in.EssayId),
-		Rank:    int(in.Rank),
-		Content: in.Content,
-		Author:  in.Author,
+	req := fromProtoReviewAddRequest(in)
+	if err := s.applyAssignmentRubric(ctx, &req); err != nil {
+		logger.Debug("Rejected review rubric", zap.Error(err))
+		return nil, err
+	}
+	if err := s.applyRubric(ctx, &req); err != nil {
+		logger.Debug("Rejected review scores", zap.Error(err))
+		return nil, err
+	}
+	if err := s.applyComments(ctx, &req, int(in.EssayRevision)); err != nil {
+		logger.Debug("Rejected inline comments", zap.Error(err))
+		return nil, err
 	}
 
-	review, err := s.repository.Add(req)
+	review, err := s.repository.Add(ctx, req)
 	if err != nil {
-		monitoring.GrpcRequestDuration.WithLabelValues("review", "add", "error").Observe(float64(time.Since(start).Milliseconds()))
-		monitoring.GrpcRequestsTotal.WithLabelValues("review", "add", "error").Inc()
 		logger.Error("Failed to add review", zap.Error(err))
 		return nil, err
 	}
 
-	duration := time.Since(start).Seconds()
-	monitoring.GrpcRequestDuration.WithLabelValues("review", "Add", "success").Observe(duration)
-	monitoring.GrpcRequestsTotal.WithLabelValues("review", "Add", "success").Inc()
 	monitoring.ReviewsCreated.Inc()
 
 	logger.Info("Review added successfully",
 		zap.Int("review_id", review.ID),
 		zap.Duration("processing_time", time.Since(start)))
 
-	kafkaStart := time.Now()
-	event := kafka.NotificationEvent{
-		Type:     "new_review",
-		UserID:   int64(in.EssayAuthorId),
-		Content:  fmt.Sprintf("Your essay has been reviewed by %s", in.Author),
-		EssayID:  int64(in.EssayId),
-		ReviewID: int64(review.ID),
-		Author:   in.Author,
-	}
-
-	if s.testMode {
-		// synchronous call for tests
-		if err := s.producer.SendNotificationEvent(ctx, event); err != nil {
-			monitoring.KafkaMessagesProcessed.WithLabelValues("notifications", "producer_error").Inc()
-			logger.Warn("Failed to send notification event", zap.Error(err))
-		}
-	} else {
-		// asynchronous call for production
-		go func() {
-			if err := s.producer.SendNotificationEvent(context.Background(), event); err != nil {
-				monitoring.KafkaMessagesProcessed.WithLabelValues("notifications", "producer_error").Inc()
-				logger.Warn("Failed to send notification event asynchronously", zap.Error(err))
-			}
-			kafkaDuration := time.Since(kafkaStart).Seconds()
-			monitoring.DbQueryDuration.WithLabelValues("kafka_produce", "notifications").Observe(kafkaDuration)
-		}()
-	}
+	s.notifyNewReview(ctx, logger, review, in.EssayAuthorId)
 
 	return toProtoReviewResponse(review), nil
 }
 
+func (s *reviewService) notifyNewReview(ctx context.Context, logger *zap.Logger, review models.Review, essayAuthorID int32) {
+	// double-blind assignments keep the reviewer hidden from the essay author
+	reviewer := review.Author
+	if review.Anonymous {
+		reviewer = review.AuthorAlias
+	}
+	s.sendNotification(ctx, logger, kafka.NotificationEvent{
+		Type:     "new_review",
+		UserID:   int64(essayAuthorID),
+		Content:  fmt.Sprintf("Your essay has been reviewed by %s", reviewer),
+		EssayID:  int64(review.EssayId),
+		ReviewID: int64(review.ID),
+		Author:   reviewer,
+	})
+}
+
 func (s *reviewService) GetAllReviews(in *pb.EmptyRequest, stream grpc.ServerStreamingServer[pb.ReviewResponse]) error {
-	logger := s.logger.With(zap.String("operation", "get_all_reviews"))
+	logger := logging.FromContext(stream.Context(), s.logger).With(zap.String("operation", "get_all_reviews"))
 
 	logger.Debug("Getting all reviews")
 
-	reviews, err := s.repository.GetAllReviews()
+	reviews, err := s.repository.GetAllReviews(stream.Context())
 	if err != nil {
 		logger.Error("Failed to get all reviews", zap.Error(err))
 		return err
@@ -132,14 +153,14 @@ func (s *reviewService) GetAllReviews(in *pb.EmptyRequest, stream grpc.ServerStr
 }
 
 func (s *reviewService) GetByEssayId(in *pb.GetByEssayIdRequest, stream grpc.ServerStreamingServer[pb.ReviewResponse]) error {
-	logger := s.logger.With(
+	logger := logging.FromContext(stream.Context(), s.logger).With(
 		zap.String("operation", "get_reviews_by_essay_id"),
 		zap.Int32("essay_id", in.EssayId),
 	)
 
 	logger.Debug("Getting reviews by essay ID")
 
-	reviews, err := s.repository.GetByEssayId(int(in.EssayId))
+	reviews, err := s.repository.GetByEssayId(stream.Context(), int(in.EssayId))
 	if err != nil {
 		logger.Error("Failed to get reviews by essay ID", zap.Error(err))
 		return err
@@ -158,15 +179,68 @@ func (s *reviewService) GetByEssayId(in *pb.GetByEssayIdRequest, stream grpc.Ser
 	return nil
 }
 
+func (s *reviewService) GetByAuthor(in *pb.GetByAuthorRequest, stream grpc.ServerStreamingServer[pb.ReviewResponse]) error {
+	logger := logging.FromContext(stream.Context(), s.logger).With(
+		zap.String("operation", "get_reviews_by_author"),
+		zap.String("author", in.Author),
+	)
+
+	logger.Debug("Getting reviews by author")
+
+	filter := fromProtoGetByAuthorRequest(in)
+	if err := normalizeReviewFilter(&filter); err != nil {
+		logger.Debug("Rejected review filter", zap.Error(err))
+		return err
+	}
+
+	reviews, err := s.repository.GetByAuthor(stream.Context(), filter)
+	if err != nil {
+		logger.Error("Failed to get reviews by author", zap.Error(err))
+		return err
+	}
+
+	for _, review := range reviews {
+		if err := stream.Send(toProtoReviewResponse(review)); err != nil {
+			logger.Error("Failed to send review in stream",
+				zap.Int("review_id", review.ID),
+				zap.Error(err))
+			return err
+		}
+	}
+
+	logger.Debug("Sent reviews of author in stream", zap.Int("count", len(reviews)))
+	return nil
+}
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 100
+)
+
+func normalizeReviewFilter(filter *models.ReviewFilter) error {
+	switch {
+	case filter.Author == "":
+		return status.Error(codes.InvalidArgument, "author is required")
+	case filter.Limit < 0 || filter.Offset < 0:
+		return status.Error(codes.InvalidArgument, "limit and offset must not be negative")
+	case filter.Limit > maxPageSize:
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("limit must not exceed %d", maxPageSize))
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultPageSize
+	}
+	return nil
+}
+
 func (s *reviewService) RemoveById(ctx context.Context, in *pb.RemoveByIdRequest) (*pb.ReviewResponse, error) {
-	logger := s.logger.With(
+	logger := logging.FromContext(ctx, s.logger).With(
 		zap.String("operation", "remove_review_by_id"),
 		zap.Int32("review_id", in.Id),
 	)
 
 	logger.Debug("Removing review by ID")
 
-	review, err := s.repository.RemoveById(int(in.Id))
+	review, err := s.repository.RemoveById(ctx, int(in.Id))
 	if err != nil {
 		logger.Error("Failed to remove review", zap.Error(err))
 		return nil, err
@@ -175,3 +249,45 @@ func (s *reviewService) RemoveById(ctx context.Context, in *pb.RemoveByIdRequest
 	logger.Info("Review removed successfully")
 	return toProtoReviewResponse(review), nil
 }
+
+func (s *reviewService) sendNotification(ctx context.Context, logger *zap.Logger, event kafka.NotificationEvent) {
+	kafkaStart := time.Now()
+
+	if s.testMode {
+		// synchronous call for tests
+		if err := s.producer.SendNotificationEvent(ctx, event); err != nil {
+			monitoring.KafkaMessagesProcessed.WithLabelValues("notifications", "producer_error").Inc()
+			logger.Warn("Failed to send notification event", zap.Error(err))
+		}
+		return
+	}
+
+	// asynchronous call for production, the event outlives the request but
+	// keeps its trace and request id
+	sendCtx := context.WithoutCancel(ctx)
+	s.pending.Add(1)
+	go func() {
+		defer s.pending.Done()
+		if err := s.producer.SendNotificationEvent(sendCtx, event); err != nil {
+			monitoring.KafkaMessagesProcessed.WithLabelValues("notifications", "producer_error").Inc()
+			logger.Warn("Failed to send notification event asynchronously", zap.Error(err))
+		}
+		kafkaDuration := time.Since(kafkaStart).Seconds()
+		monitoring.DbQueryDuration.WithLabelValues("kafka_produce", "notifications").Observe(kafkaDuration)
+	}()
+}
+
+func (s *reviewService) Drain(ctx context.Context) error {
+	drained := make(chan struct{})
+	go func() {
+		s.pending.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to drain notifications: %w", ctx.Err())
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository"
 	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/service"
 	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	pgutil "github.com/IAGrig/vt-csa-essays/backend/shared/pg_util"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -75,16 +76,28 @@ func TestMain(m *testing.M) {
 	}()
 
 	logger := logging.NewEmptyLogger()
-	var repoErr error
-	testRepo, repoErr = repository.NewReviewPgRepository(logger)
-	if repoErr != nil {
-		fmt.Printf("Failed to create repository: %v\n", repoErr)
+	pool, err := pgutil.GetPgxPool()
+	if err != nil {
+		fmt.Printf("Failed to connect to database: %v\n", err)
 		os.Exit(1)
 	}
+	testRepo = repository.NewReviewPgRepository(pool, logger)
+	rubricRepo := repository.NewRubricPgRepository(pool, logger)
+	replyRepo := repository.NewReplyPgRepository(pool, logger)
+	assignmentRepo := repository.NewAssignmentPgRepository(pool, logger)
+	gradeRepo := repository.NewGradePgRepository(pool, logger)
+	reliabilityRepo := repository.NewReliabilityPgRepository(pool, logger)
 
 	mockProducer = kafkaMocks.MockProducer{}
 
-	testService = service.New(testRepo, &mockProducer, logger)
+	testService = service.New(service.Repositories{
+		Reviews:     testRepo,
+		Rubrics:     rubricRepo,
+		Replies:     replyRepo,
+		Assignments: assignmentRepo,
+		Grades:      gradeRepo,
+		Reliability: reliabilityRepo,
+	}, &mockProducer, logger)
 
 	code := m.Run()
 	os.Exit(code)
@@ -236,11 +249,11 @@ func TestIntegrationReviewService_GetByEssayId(t *testing.T) {
 	insertTestEssay(t, 1, "test-author")
 	insertTestEssay(t, 2, "test-author")
 
-	_, err := testRepo.Add(models.ReviewRequest{EssayId: 1, Rank: 2, Content: "Review 1", Author: "author1"})
+	_, err := testRepo.Add(context.Background(), models.ReviewRequest{EssayId: 1, Rank: 2, Content: "Review 1", Author: "author1"})
 	require.NoError(t, err)
-	_, err = testRepo.Add(models.ReviewRequest{EssayId: 1, Rank: 1, Content: "Review 2", Author: "author2"})
+	_, err = testRepo.Add(context.Background(), models.ReviewRequest{EssayId: 1, Rank: 1, Content: "Review 2", Author: "author2"})
 	require.NoError(t, err)
-	_, err = testRepo.Add(models.ReviewRequest{EssayId: 2, Rank: 3, Content: "Review 3", Author: "author1"})
+	_, err = testRepo.Add(context.Background(), models.ReviewRequest{EssayId: 2, Rank: 3, Content: "Review 3", Author: "author1"})
 	require.NoError(t, err)
 
 	req := &pb.GetByEssayIdRequest{EssayId: 1}
@@ -267,9 +280,9 @@ func TestIntegrationReviewService_GetAllReviews(t *testing.T) {
 	insertTestEssay(t, 1, "test-author")
 	insertTestEssay(t, 2, "test-author")
 
-	_, err := testRepo.Add(models.ReviewRequest{EssayId: 1, Rank: 2, Content: "Review 1", Author: "author1"})
+	_, err := testRepo.Add(context.Background(), models.ReviewRequest{EssayId: 1, Rank: 2, Content: "Review 1", Author: "author1"})
 	require.NoError(t, err)
-	_, err = testRepo.Add(models.ReviewRequest{EssayId: 2, Rank: 1, Content: "Review 2", Author: "author2"})
+	_, err = testRepo.Add(context.Background(), models.ReviewRequest{EssayId: 2, Rank: 1, Content: "Review 2", Author: "author2"})
 	require.NoError(t, err)
 
 	req := &pb.EmptyRequest{}
@@ -289,7 +302,7 @@ func TestIntegrationReviewService_RemoveById(t *testing.T) {
 	insertTestUser(t, "test-author")
 	insertTestEssay(t, 1, "test-author")
 
-	addedReview, err := testRepo.Add(models.ReviewRequest{
+	addedReview, err := testRepo.Add(context.Background(), models.ReviewRequest{
 		EssayId: 1,
 		Rank:    2,
 		Content: "To be removed",
@@ -313,13 +326,13 @@ func TestIntegrationReviewService_RemoveById(t *testing.T) {
 func cleanupTables(t *testing.T) {
 	t.Helper()
 
-	reviews, err := testRepo.GetAllReviews()
+	reviews, err := testRepo.GetAllReviews(context.Background())
 	if err != nil {
 		return
 	}
 
 	for _, review := range reviews {
-		_, _ = testRepo.RemoveById(review.ID)
+		_, _ = testRepo.RemoveById(context.Background(), review.ID)
 	}
 }
 
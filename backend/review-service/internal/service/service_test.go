@@ -3,8 +3,10 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
 	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/kafka"
 	kafkaMocks "github.com/IAGrig/vt-csa-essays/backend/review-service/internal/kafka/mocks"
 	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
 	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository"
@@ -12,7 +14,12 @@ import (
 	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 type MinimalServerStream struct {
@@ -39,6 +46,25 @@ func (m *MinimalServerStream) SetTrailer(md metadata.MD)       {}
 func (m *MinimalServerStream) SendMsg(interface{}) error       { return nil }
 func (m *MinimalServerStream) RecvMsg(interface{}) error       { return nil }
 
+// Service under test, repositories a test leaves out stay nil and
+// notifications are sent synchronously
+func newTestService(repos Repositories, producer *kafkaMocks.MockProducer) pb.ReviewServiceServer {
+	if producer == nil {
+		producer = new(kafkaMocks.MockProducer)
+	}
+	if repos.Assignments == nil {
+		repos.Assignments = noAssignments()
+	}
+	return NewForTest(repos, producer, logging.NewEmptyLogger())
+}
+
+// Essays of the tests belong to no assignment unless a test says otherwise
+func noAssignments() *repoMocks.MockAssignmentRepository {
+	assignments := new(repoMocks.MockAssignmentRepository)
+	assignments.On("GetByEssayID", mock.Anything, mock.Anything).Return(models.Assignment{}, repository.AssignmentNotFoundErr).Maybe()
+	return assignments
+}
+
 func TestReviewService_Add(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -70,7 +96,7 @@ func TestReviewService_Add(t *testing.T) {
 					Content: "Excellent essay",
 					Author:  "reviewer1",
 				}
-				mockRepo.On("Add", expectedRequest).Return(expectedReview, nil)
+				mockRepo.On("Add", mock.Anything, expectedRequest).Return(expectedReview, nil)
 				mockProducer.On("SendNotificationEvent", mock.Anything, mock.Anything).Return(nil)
 			},
 			expectedResult: &pb.ReviewResponse{
@@ -97,7 +123,7 @@ func TestReviewService_Add(t *testing.T) {
 					Content: "Excellent essay",
 					Author:  "reviewer1",
 				}
-				mockRepo.On("Add", expectedRequest).Return(models.Review{}, assert.AnError)
+				mockRepo.On("Add", mock.Anything, expectedRequest).Return(models.Review{}, assert.AnError)
 			},
 			expectedResult: nil,
 			expectedError:  true,
@@ -110,8 +136,7 @@ func TestReviewService_Add(t *testing.T) {
 			mockProducer := new(kafkaMocks.MockProducer)
 			tt.setupMock(mockRepo, mockProducer)
 
-			logger := logging.NewEmptyLogger()
-			service := NewForTest(mockRepo, mockProducer, logger)
+			service := newTestService(Repositories{Reviews: mockRepo}, mockProducer)
 			result, err := service.Add(context.Background(), tt.input)
 
 			if tt.expectedError {
@@ -147,7 +172,7 @@ func TestReviewService_GetAllReviews(t *testing.T) {
 					{ID: 1, EssayId: 1, Rank: 1, Content: "Great essay", Author: "reviewer1"},
 					{ID: 2, EssayId: 2, Rank: 2, Content: "Good essay", Author: "reviewer2"},
 				}
-				mockRepo.On("GetAllReviews").Return(reviews, nil)
+				mockRepo.On("GetAllReviews", mock.Anything, mock.Anything).Return(reviews, nil)
 			},
 			expectedCount: 2,
 			expectedError: false,
@@ -155,7 +180,7 @@ func TestReviewService_GetAllReviews(t *testing.T) {
 		{
 			name: "success - streams empty list when no reviews",
 			setupMock: func(mockRepo *repoMocks.MockReviewRepository, mockProducer *kafkaMocks.MockProducer) {
-				mockRepo.On("GetAllReviews").Return([]models.Review{}, nil)
+				mockRepo.On("GetAllReviews", mock.Anything, mock.Anything).Return([]models.Review{}, nil)
 			},
 			expectedCount: 0,
 			expectedError: false,
@@ -163,7 +188,7 @@ func TestReviewService_GetAllReviews(t *testing.T) {
 		{
 			name: "error - repository returns error",
 			setupMock: func(mockRepo *repoMocks.MockReviewRepository, mockProducer *kafkaMocks.MockProducer) {
-				mockRepo.On("GetAllReviews").Return([]models.Review{}, assert.AnError)
+				mockRepo.On("GetAllReviews", mock.Anything, mock.Anything).Return([]models.Review{}, assert.AnError)
 			},
 			expectedCount: 0,
 			expectedError: true,
@@ -174,7 +199,7 @@ func TestReviewService_GetAllReviews(t *testing.T) {
 				reviews := []models.Review{
 					{ID: 1, EssayId: 1, Rank: 1, Content: "Great essay", Author: "reviewer1"},
 				}
-				mockRepo.On("GetAllReviews").Return(reviews, nil)
+				mockRepo.On("GetAllReviews", mock.Anything, mock.Anything).Return(reviews, nil)
 			},
 			sendError:     assert.AnError,
 			expectedCount: 0,
@@ -194,7 +219,7 @@ func TestReviewService_GetAllReviews(t *testing.T) {
 			}
 
 			logger := logging.NewEmptyLogger()
-			service := New(mockRepo, mockProducer, logger)
+			service := New(Repositories{Reviews: mockRepo}, mockProducer, logger)
 			err := service.GetAllReviews(&pb.EmptyRequest{}, stream)
 
 			if tt.expectedError {
@@ -220,6 +245,72 @@ func TestReviewService_GetAllReviews(t *testing.T) {
 	}
 }
 
+func TestReviewService_GetByAuthor(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         *pb.GetByAuthorRequest
+		setupMock     func(*repoMocks.MockReviewRepository)
+		expectedCode  codes.Code
+		expectedCount int
+	}{
+		{
+			name:  "default page size",
+			input: &pb.GetByAuthorRequest{Author: " reviewer1 ", MinRank: 2},
+			setupMock: func(mockRepo *repoMocks.MockReviewRepository) {
+				mockRepo.On("GetByAuthor", mock.Anything, models.ReviewFilter{Author: "reviewer1", MinRank: 2, Limit: 50}).
+					Return([]models.Review{
+						{ID: 2, EssayId: 2, Rank: 3, Author: "reviewer1"},
+						{ID: 1, EssayId: 1, Rank: 2, Author: "reviewer1"},
+					}, nil)
+			},
+			expectedCode:  codes.OK,
+			expectedCount: 2,
+		},
+		{
+			name:  "explicit page",
+			input: &pb.GetByAuthorRequest{Author: "reviewer1", EssayId: 1, Limit: 10, Offset: 20, IncludeAnonymous: true},
+			setupMock: func(mockRepo *repoMocks.MockReviewRepository) {
+				mockRepo.On("GetByAuthor", mock.Anything, models.ReviewFilter{Author: "reviewer1", EssayID: 1, Limit: 10, Offset: 20, IncludeAnonymous: true}).
+					Return([]models.Review{}, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "missing author",
+			input:        &pb.GetByAuthorRequest{Author: "  "},
+			setupMock:    func(*repoMocks.MockReviewRepository) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "page too large",
+			input:        &pb.GetByAuthorRequest{Author: "reviewer1", Limit: 101},
+			setupMock:    func(*repoMocks.MockReviewRepository) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "negative offset",
+			input:        &pb.GetByAuthorRequest{Author: "reviewer1", Offset: -1},
+			setupMock:    func(*repoMocks.MockReviewRepository) {},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(repoMocks.MockReviewRepository)
+			tt.setupMock(mockRepo)
+
+			stream := &MinimalServerStream{ctx: context.Background()}
+			service := newTestService(Repositories{Reviews: mockRepo}, nil)
+			err := service.GetByAuthor(tt.input, stream)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			assert.Len(t, stream.sentMessages, tt.expectedCount)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
 func TestReviewService_GetByEssayId(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -237,7 +328,7 @@ func TestReviewService_GetByEssayId(t *testing.T) {
 					{ID: 1, EssayId: 1, Rank: 1, Content: "Review 1", Author: "reviewer1"},
 					{ID: 2, EssayId: 1, Rank: 2, Content: "Review 2", Author: "reviewer2"},
 				}
-				mockRepo.On("GetByEssayId", 1).Return(reviews, nil)
+				mockRepo.On("GetByEssayId", mock.Anything, 1).Return(reviews, nil)
 			},
 			expectedCount: 2,
 			expectedError: false,
@@ -246,7 +337,7 @@ func TestReviewService_GetByEssayId(t *testing.T) {
 			name:  "success - no reviews for essay",
 			input: &pb.GetByEssayIdRequest{EssayId: 999},
 			setupMock: func(mockRepo *repoMocks.MockReviewRepository, mockProducer *kafkaMocks.MockProducer) {
-				mockRepo.On("GetByEssayId", 999).Return([]models.Review{}, nil)
+				mockRepo.On("GetByEssayId", mock.Anything, 999).Return([]models.Review{}, nil)
 			},
 			expectedCount: 0,
 			expectedError: false,
@@ -255,7 +346,7 @@ func TestReviewService_GetByEssayId(t *testing.T) {
 			name:  "error - repository returns error",
 			input: &pb.GetByEssayIdRequest{EssayId: 1},
 			setupMock: func(mockRepo *repoMocks.MockReviewRepository, mockProducer *kafkaMocks.MockProducer) {
-				mockRepo.On("GetByEssayId", 1).Return([]models.Review{}, assert.AnError)
+				mockRepo.On("GetByEssayId", mock.Anything, 1).Return([]models.Review{}, assert.AnError)
 			},
 			expectedCount: 0,
 			expectedError: true,
@@ -267,7 +358,7 @@ func TestReviewService_GetByEssayId(t *testing.T) {
 				reviews := []models.Review{
 					{ID: 1, EssayId: 1, Rank: 1, Content: "Review 1", Author: "reviewer1"},
 				}
-				mockRepo.On("GetByEssayId", 1).Return(reviews, nil)
+				mockRepo.On("GetByEssayId", mock.Anything, 1).Return(reviews, nil)
 			},
 			sendError:     assert.AnError,
 			expectedCount: 0,
@@ -287,7 +378,7 @@ func TestReviewService_GetByEssayId(t *testing.T) {
 			}
 
 			logger := logging.NewEmptyLogger()
-			service := New(mockRepo, mockProducer, logger)
+			service := New(Repositories{Reviews: mockRepo}, mockProducer, logger)
 			err := service.GetByEssayId(tt.input, stream)
 
 			if tt.expectedError {
@@ -327,7 +418,7 @@ func TestReviewService_RemoveById(t *testing.T) {
 					Content: "Deleted review",
 					Author:  "reviewer1",
 				}
-				mockRepo.On("RemoveById", 1).Return(review, nil)
+				mockRepo.On("RemoveById", mock.Anything, 1).Return(review, nil)
 			},
 			expectedResult: &pb.ReviewResponse{
 				Id:      1,
@@ -342,7 +433,7 @@ func TestReviewService_RemoveById(t *testing.T) {
 			name:  "error - review not found",
 			input: &pb.RemoveByIdRequest{Id: 999},
 			setupMock: func(mockRepo *repoMocks.MockReviewRepository, mockProducer *kafkaMocks.MockProducer) {
-				mockRepo.On("RemoveById", 999).Return(models.Review{}, repository.ReviewNotFoundErr)
+				mockRepo.On("RemoveById", mock.Anything, 999).Return(models.Review{}, repository.ReviewNotFoundErr)
 			},
 			expectedResult: nil,
 			expectedError:  true,
@@ -351,7 +442,7 @@ func TestReviewService_RemoveById(t *testing.T) {
 			name:  "error - repository returns error",
 			input: &pb.RemoveByIdRequest{Id: 1},
 			setupMock: func(mockRepo *repoMocks.MockReviewRepository, mockProducer *kafkaMocks.MockProducer) {
-				mockRepo.On("RemoveById", 1).Return(models.Review{}, assert.AnError)
+				mockRepo.On("RemoveById", mock.Anything, 1).Return(models.Review{}, assert.AnError)
 			},
 			expectedResult: nil,
 			expectedError:  true,
@@ -365,7 +456,7 @@ func TestReviewService_RemoveById(t *testing.T) {
 			tt.setupMock(mockRepo, mockProducer)
 
 			logger := logging.NewEmptyLogger()
-			service := New(mockRepo, mockProducer, logger)
+			service := New(Repositories{Reviews: mockRepo}, mockProducer, logger)
 			result, err := service.RemoveById(context.Background(), tt.input)
 
 			if tt.expectedError {
@@ -435,3 +526,67 @@ func TestToProtoReviewResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestReviewService_Drain(t *testing.T) {
+	release := make(chan struct{})
+	mockProducer := new(kafkaMocks.MockProducer)
+	mockProducer.On("SendNotificationEvent", mock.Anything, mock.Anything).Return(nil).Run(func(mock.Arguments) {
+		<-release
+	})
+
+	s := New(Repositories{}, mockProducer, logging.NewEmptyLogger())
+	s.(*reviewService).sendNotification(context.Background(), zap.NewNop(), kafka.NotificationEvent{Type: "review", EssayID: 1})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, s.Drain(ctx), context.DeadlineExceeded)
+
+	close(release)
+	assert.NoError(t, s.Drain(context.Background()))
+	mockProducer.AssertExpectations(t)
+}
+
+func TestReviewService_SendNotificationKeepsTrace(t *testing.T) {
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx, cancel := context.WithCancel(trace.ContextWithSpanContext(context.Background(), spanContext))
+
+	sent := make(chan context.Context, 1)
+	mockProducer := new(kafkaMocks.MockProducer)
+	mockProducer.On("SendNotificationEvent", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		sent <- args.Get(0).(context.Context)
+	})
+
+	s := New(Repositories{}, mockProducer, logging.NewEmptyLogger())
+	s.(*reviewService).sendNotification(ctx, zap.NewNop(), kafka.NotificationEvent{Type: "new_review", EssayID: 1})
+	// the request is over by the time the event is sent
+	cancel()
+	require.NoError(t, s.Drain(context.Background()))
+
+	sendCtx := <-sent
+	assert.NoError(t, sendCtx.Err())
+	assert.Equal(t, spanContext, trace.SpanContextFromContext(sendCtx))
+}
+
+func TestReviewService_Add_NotificationKeepsRequestID(t *testing.T) {
+	mockRepo := new(repoMocks.MockReviewRepository)
+	mockRepo.On("Add", mock.Anything, mock.Anything).Return(models.Review{ID: 1, EssayId: 1, Rank: 1, Author: "reviewer1"}, nil)
+
+	sent := make(chan context.Context, 1)
+	mockProducer := new(kafkaMocks.MockProducer)
+	mockProducer.On("SendNotificationEvent", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		sent <- args.Get(0).(context.Context)
+	})
+
+	s := New(Repositories{Reviews: mockRepo, Assignments: noAssignments()}, mockProducer, logging.NewEmptyLogger())
+	ctx, cancel := context.WithCancel(logging.WithRequestID(context.Background(), "req-42"))
+	_, err := s.Add(ctx, &pb.ReviewAddRequest{EssayId: 1, EssayAuthorId: 2, Rank: 1, Content: "Fine", Author: "reviewer1"})
+	require.NoError(t, err)
+	cancel()
+	require.NoError(t, s.Drain(context.Background()))
+
+	assert.Equal(t, "req-42", logging.RequestID(<-sent))
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+)
+
+const maxStatsBatch = 1000
+
+func (s *reviewService) GetEssayStats(ctx context.Context, in *pb.GetEssayStatsRequest) (*pb.EssayStatsResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "get_essay_stats"),
+		zap.Int32("essay_id", in.EssayId),
+	)
+
+	if _, err := s.repository.GetEssayText(ctx, int(in.EssayId)); err != nil {
+		if errors.Is(err, repository.EssayNotFoundErr) {
+			return nil, statusError(err)
+		}
+		logger.Error("Failed to check essay", zap.Error(err))
+		return nil, err
+	}
+
+	stats, err := s.repository.GetEssayStats(ctx, []int{int(in.EssayId)})
+	if err != nil {
+		logger.Error("Failed to get essay stats", zap.Error(err))
+		return nil, err
+	}
+	if len(stats) == 0 {
+		return nil, statusError(repository.EssayNotFoundErr)
+	}
+
+	return toProtoEssayStatsResponse(stats[0]), nil
+}
+
+func (s *reviewService) GetEssayStatsBatch(in *pb.GetEssayStatsBatchRequest, stream grpc.ServerStreamingServer[pb.EssayStatsResponse]) error {
+	logger := logging.FromContext(stream.Context(), s.logger).With(
+		zap.String("operation", "get_essay_stats_batch"),
+		zap.Int("count", len(in.EssayIds)),
+	)
+
+	if len(in.EssayIds) > maxStatsBatch {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("at most %d essay ids can be requested at once", maxStatsBatch))
+	}
+
+	ids := make([]int, 0, len(in.EssayIds))
+	for _, id := range in.EssayIds {
+		ids = append(ids, int(id))
+	}
+
+	stats, err := s.repository.GetEssayStats(stream.Context(), ids)
+	if err != nil {
+		logger.Error("Failed to get essay stats", zap.Error(err))
+		return err
+	}
+
+	for _, essayStats := range stats {
+		if err := stream.Send(toProtoEssayStatsResponse(essayStats)); err != nil {
+			logger.Error("Failed to send essay stats in stream",
+				zap.Int("essay_id", essayStats.EssayID),
+				zap.Error(err))
+			return err
+		}
+	}
+
+	logger.Debug("Sent essay stats in stream")
+	return nil
+}
+
+func (s *reviewService) GetAssignmentStats(ctx context.Context, in *pb.GetAssignmentStatsRequest) (*pb.AssignmentStatsResponse, error) {
+	logger := logging.FromContext(ctx, s.logger).With(
+		zap.String("operation", "get_assignment_stats"),
+		zap.Int64("assignment_id", in.AssignmentId),
+	)
+
+	if _, err := s.assignments.GetByID(ctx, in.AssignmentId); err != nil {
+		if errors.Is(err, repository.AssignmentNotFoundErr) {
+			return nil, statusError(err)
+		}
+		logger.Error("Failed to get assignment", zap.Error(err))
+		return nil, err
+	}
+
+	stats, err := s.repository.GetAssignmentStats(ctx, in.AssignmentId)
+	if err != nil {
+		logger.Error("Failed to get assignment stats", zap.Error(err))
+		return nil, err
+	}
+
+	return toProtoAssignmentStatsResponse(stats), nil
+}
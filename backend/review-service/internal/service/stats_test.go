@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/IAGrig/vt-csa-essays/backend/proto/review"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/models"
+	"github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository"
+	repoMocks "github.com/IAGrig/vt-csa-essays/backend/review-service/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type essayStatsServerStream struct {
+	sentMessages []*pb.EssayStatsResponse
+}
+
+func (m *essayStatsServerStream) Send(msg *pb.EssayStatsResponse) error {
+	m.sentMessages = append(m.sentMessages, msg)
+	return nil
+}
+
+func (m *essayStatsServerStream) Context() context.Context        { return context.Background() }
+func (m *essayStatsServerStream) SetHeader(md metadata.MD) error  { return nil }
+func (m *essayStatsServerStream) SendHeader(md metadata.MD) error { return nil }
+func (m *essayStatsServerStream) SetTrailer(md metadata.MD)       {}
+func (m *essayStatsServerStream) SendMsg(interface{}) error       { return nil }
+func (m *essayStatsServerStream) RecvMsg(interface{}) error       { return nil }
+
+func TestReviewService_GetEssayStats(t *testing.T) {
+	lastReviewAt := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name         string
+		essayId      int32
+		setupMock    func(*repoMocks.MockReviewRepository)
+		expectedCode codes.Code
+		expected     *pb.EssayStatsResponse
+	}{
+		{
+			name:    "essay with reviews",
+			essayId: 1,
+			setupMock: func(reviews *repoMocks.MockReviewRepository) {
+				reviews.On("GetEssayText", mock.Anything, 1).Return(models.EssayText{EssayID: 1}, nil)
+				reviews.On("GetEssayStats", mock.Anything, []int{1}).Return([]models.EssayStats{{
+					EssayID: 1,
+					Stats: models.ReviewStats{
+						ReviewCount:      3,
+						MeanRank:         2,
+						WeightedMeanRank: 2.4,
+						MedianRank:       2,
+						RankDistribution: map[int]int{1: 1, 3: 2},
+						LastReviewAt:     &lastReviewAt,
+					},
+				}}, nil)
+			},
+			expectedCode: codes.OK,
+			expected: &pb.EssayStatsResponse{
+				EssayId: 1,
+				Stats: &pb.ReviewStats{
+					ReviewCount:      3,
+					MeanRank:         2,
+					WeightedMeanRank: 2.4,
+					MedianRank:       2,
+					RankDistribution: map[int32]int32{1: 1, 3: 2},
+					LastReviewAt:     1700000000,
+				},
+			},
+		},
+		{
+			name:    "essay without reviews",
+			essayId: 2,
+			setupMock: func(reviews *repoMocks.MockReviewRepository) {
+				reviews.On("GetEssayText", mock.Anything, 2).Return(models.EssayText{EssayID: 2}, nil)
+				reviews.On("GetEssayStats", mock.Anything, []int{2}).Return([]models.EssayStats{{EssayID: 2}}, nil)
+			},
+			expectedCode: codes.OK,
+			expected:     &pb.EssayStatsResponse{EssayId: 2, Stats: &pb.ReviewStats{}},
+		},
+		{
+			name:    "unknown essay",
+			essayId: 9,
+			setupMock: func(reviews *repoMocks.MockReviewRepository) {
+				reviews.On("GetEssayText", mock.Anything, 9).Return(models.EssayText{}, repository.EssayNotFoundErr)
+			},
+			expectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReviews := new(repoMocks.MockReviewRepository)
+			tt.setupMock(mockReviews)
+
+			service := newTestService(Repositories{Reviews: mockReviews}, nil)
+			result, err := service.GetEssayStats(context.Background(), &pb.GetEssayStatsRequest{EssayId: tt.essayId})
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expected != nil {
+				assert.Equal(t, tt.expected, result)
+			}
+
+			mockReviews.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReviewService_GetEssayStatsBatch(t *testing.T) {
+	mockReviews := new(repoMocks.MockReviewRepository)
+	mockReviews.On("GetEssayStats", mock.Anything, []int{1, 2}).Return([]models.EssayStats{
+		{EssayID: 1, Stats: models.ReviewStats{ReviewCount: 2, MeanRank: 1.5, MedianRank: 1.5}},
+		{EssayID: 2},
+	}, nil)
+
+	service := newTestService(Repositories{Reviews: mockReviews}, nil)
+
+	stream := &essayStatsServerStream{}
+	err := service.GetEssayStatsBatch(&pb.GetEssayStatsBatchRequest{EssayIds: []int32{1, 2}}, stream)
+	require.NoError(t, err)
+	require.Len(t, stream.sentMessages, 2)
+	assert.Equal(t, int32(2), stream.sentMessages[0].Stats.ReviewCount)
+	assert.Equal(t, int32(0), stream.sentMessages[1].Stats.ReviewCount)
+
+	tooMany := make([]int32, maxStatsBatch+1)
+	err = service.GetEssayStatsBatch(&pb.GetEssayStatsBatchRequest{EssayIds: tooMany}, &essayStatsServerStream{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	mockReviews.AssertExpectations(t)
+}
+
+func TestReviewService_GetAssignmentStats(t *testing.T) {
+	mockReviews := new(repoMocks.MockReviewRepository)
+	mockAssignments := new(repoMocks.MockAssignmentRepository)
+	mockAssignments.On("GetByID", mock.Anything, int64(4)).Return(models.Assignment{ID: 4}, nil)
+	mockAssignments.On("GetByID", mock.Anything, int64(5)).Return(models.Assignment{}, repository.AssignmentNotFoundErr)
+	mockReviews.On("GetAssignmentStats", mock.Anything, int64(4)).Return(models.AssignmentStats{
+		AssignmentID: 4,
+		EssayCount:   2,
+		Stats:        models.ReviewStats{ReviewCount: 3, MeanRank: 2, MedianRank: 2, RankDistribution: map[int]int{2: 3}},
+	}, nil)
+
+	service := newTestService(Repositories{Reviews: mockReviews, Assignments: mockAssignments}, nil)
+
+	result, err := service.GetAssignmentStats(context.Background(), &pb.GetAssignmentStatsRequest{AssignmentId: 4})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), result.EssayCount)
+	assert.Equal(t, map[int32]int32{2: 3}, result.Stats.RankDistribution)
+
+	_, err = service.GetAssignmentStats(context.Background(), &pb.GetAssignmentStatsRequest{AssignmentId: 5})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	mockReviews.AssertExpectations(t)
+	mockAssignments.AssertExpectations(t)
+}
@@ -0,0 +1,28 @@
+// Generates a local CA and certificates of the services for mTLS in docker-compose:
+//
+//	go run ./cmd/gencerts -out ../../certs api-gateway auth-service essay-service review-service notification-service
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/mtls"
+)
+
+func main() {
+	out := flag.String("out", "certs", "directory the CA and certificates are written to")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: gencerts [-out dir] name...")
+		os.Exit(2)
+	}
+
+	if err := mtls.Generate(*out, flag.Args()...); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Printf("Certificates written to %s\n", *out)
+}
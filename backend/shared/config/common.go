@@ -0,0 +1,54 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Connection settings of the services that keep their data in PostgreSQL
+type Postgres struct {
+	Host     string `env:"POSTGRES_HOST" yaml:"host" required:"true"`
+	Port     string `env:"POSTGRES_PORT" yaml:"port" default:"5432"`
+	User     string `env:"POSTGRES_USER" yaml:"user" required:"true"`
+	Password string `env:"POSTGRES_PASSWORD" yaml:"password" secret:"true"`
+	Database string `env:"POSTGRES_DB_NAME" yaml:"database" required:"true"`
+	SSLMode  string `env:"POSTGRES_SSL_MODE" yaml:"ssl_mode" default:"disable"`
+	// Upper bound of a single repository call
+	QueryTimeout time.Duration `env:"POSTGRES_QUERY_TIMEOUT" yaml:"query_timeout" default:"5s"`
+}
+
+func (p Postgres) Validate() error {
+	if p.QueryTimeout <= 0 {
+		return errors.New("config: POSTGRES_QUERY_TIMEOUT must be positive")
+	}
+	return nil
+}
+
+func (p Postgres) ConnString() string {
+	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
+		p.Host, p.Port, p.User, p.Password, p.Database, p.SSLMode)
+}
+
+// Settings every backend process shares
+type Server struct {
+	MonitoringPort      string        `env:"MONITORING_PORT" yaml:"monitoring_port" default:"9090"`
+	ShutdownTimeout     time.Duration `env:"SHUTDOWN_TIMEOUT" yaml:"shutdown_timeout" default:"10s"`
+	HealthCheckInterval time.Duration `env:"HEALTH_CHECK_INTERVAL" yaml:"health_check_interval" default:"10s"`
+}
+
+// Brokers and topic the notification events travel through
+type Kafka struct {
+	Brokers []string `env:"KAFKA_BROKERS" yaml:"brokers" required:"true"`
+	Topic   string   `env:"KAFKA_TOPIC" yaml:"topic" default:"notifications"`
+}
+
+// Certificates of the gRPC connections, see the mtls package. Connections
+// stay plaintext while none of the files is set
+type TLS struct {
+	CertFile       string        `env:"TLS_CERT_FILE" yaml:"cert_file"`
+	KeyFile        string        `env:"TLS_KEY_FILE" yaml:"key_file"`
+	CAFile         string        `env:"TLS_CA_FILE" yaml:"ca_file"`
+	AllowedClients []string      `env:"TLS_ALLOWED_CLIENTS" yaml:"allowed_clients"`
+	ReloadInterval time.Duration `env:"TLS_RELOAD_INTERVAL" yaml:"reload_interval" default:"30s"`
+}
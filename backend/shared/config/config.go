@@ -0,0 +1,215 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+)
+
+// Path of the optional YAML file, values set in the environment override it
+const FileEnv = "CONFIG_FILE"
+
+const redacted = "[REDACTED]"
+
+// Implemented by structs with constraints the tags can't express
+type Validator interface {
+	Validate() error
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// Fills cfg, a pointer to a struct, from its tags:
+//
+//	env:"NAME"       variable the value is read from
+//	yaml:"name"      key of the value in the file named by CONFIG_FILE
+//	default:"value"  used when neither sets it
+//	required:"true"  fails the load when the value ends up empty
+//	secret:"true"    hidden by Log
+//
+// Strings, bools, ints, durations, comma separated string lists and nested
+// structs are supported, structs implementing Validator are checked once
+// loaded. Every problem is reported, not only the first one
+func Load(cfg any) error {
+	return load(cfg, "")
+}
+
+// Same as Load for a part of the configuration loaded on its own, its
+// values are read from the given top-level key of the file
+func LoadSection(section string, cfg any) error {
+	return load(cfg, section)
+}
+
+func load(cfg any, section string) error {
+	root := reflect.ValueOf(cfg)
+	if root.Kind() != reflect.Pointer || root.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("config: expected a pointer to a struct, got %T", cfg)
+	}
+
+	var errs []error
+	walk(root.Elem(), func(field reflect.StructField, value reflect.Value) {
+		if def, ok := field.Tag.Lookup("default"); ok {
+			if err := set(value, def); err != nil {
+				errs = append(errs, fmt.Errorf("config: invalid default of %s: %w", name(field), err))
+			}
+		}
+	})
+
+	if path := os.Getenv(FileEnv); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("config: failed to read %s: %w", path, err)
+		}
+		if err := decodeFile(data, section, cfg); err != nil {
+			return fmt.Errorf("config: failed to parse %s: %w", path, err)
+		}
+	}
+
+	walk(root.Elem(), func(field reflect.StructField, value reflect.Value) {
+		env := field.Tag.Get("env")
+		if raw, ok := os.LookupEnv(env); ok && env != "" {
+			if err := set(value, raw); err != nil {
+				errs = append(errs, fmt.Errorf("config: invalid %s %q: %w", env, raw, err))
+				return
+			}
+		}
+		if field.Tag.Get("required") == "true" && value.IsZero() {
+			errs = append(errs, fmt.Errorf("config: %s is required", name(field)))
+		}
+	})
+
+	return errors.Join(append(errs, validate(root.Elem())...)...)
+}
+
+func validate(v reflect.Value) []error {
+	var errs []error
+	if validator, ok := v.Addr().Interface().(Validator); ok {
+		if err := validator.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for i := range v.NumField() {
+		field := v.Field(i)
+		if v.Type().Field(i).IsExported() && field.Kind() == reflect.Struct && field.Type() != durationType {
+			errs = append(errs, validate(field)...)
+		}
+	}
+	return errs
+}
+
+func decodeFile(data []byte, section string, cfg any) error {
+	if section == "" {
+		return yaml.Unmarshal(data, cfg)
+	}
+
+	var sections map[string]yaml.Node
+	if err := yaml.Unmarshal(data, &sections); err != nil {
+		return err
+	}
+	node, ok := sections[section]
+	if !ok {
+		return nil
+	}
+	return node.Decode(cfg)
+}
+
+// Logs the effective configuration, secrets only show whether they are set
+func Log(logger *logging.Logger, cfg any) {
+	var fields []zap.Field
+	walk(reflect.Indirect(reflect.ValueOf(cfg)), func(field reflect.StructField, value reflect.Value) {
+		fields = append(fields, zap.String(name(field), format(field, value)))
+	})
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Key < fields[j].Key })
+
+	logger.Info("Effective configuration", fields...)
+}
+
+// Visits every leaf field, nested structs other than durations are descended into
+func walk(v reflect.Value, visit func(reflect.StructField, reflect.Value)) {
+	t := v.Type()
+	for i := range t.NumField() {
+		field, value := t.Field(i), v.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if value.Kind() == reflect.Struct {
+			walk(value, visit)
+			continue
+		}
+		visit(field, value)
+	}
+}
+
+func set(value reflect.Value, raw string) error {
+	if value.Type() == durationType {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+		if d < 0 {
+			return errors.New("must not be negative")
+		}
+		value.SetInt(int64(d))
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		value.SetBool(b)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, value.Type().Bits())
+		if err != nil {
+			return err
+		}
+		value.SetInt(n)
+	case reflect.Slice:
+		if value.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported type %s", value.Type())
+		}
+		var items []string
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		value.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("unsupported type %s", value.Type())
+	}
+	return nil
+}
+
+// Fields are named after their variable, the Go name is the fallback
+func name(field reflect.StructField) string {
+	if env := field.Tag.Get("env"); env != "" {
+		return env
+	}
+	return field.Name
+}
+
+func format(field reflect.StructField, value reflect.Value) string {
+	if field.Tag.Get("secret") == "true" {
+		if value.IsZero() {
+			return ""
+		}
+		return redacted
+	}
+	if value.Kind() == reflect.Slice {
+		return strings.Join(value.Interface().([]string), ",")
+	}
+	return fmt.Sprint(value.Interface())
+}
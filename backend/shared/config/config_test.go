@@ -0,0 +1,134 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+type testConfig struct {
+	Port        string        `env:"TEST_GRPC_PORT" yaml:"grpc_port" required:"true"`
+	ReviewAddr  string        `env:"TEST_REVIEW_SERVICE_ADDR" yaml:"review_service_addr" default:"review-service:50053"`
+	Secret      string        `env:"TEST_JWT_SECRET" yaml:"jwt_secret" required:"true" secret:"true"`
+	Brokers     []string      `env:"TEST_KAFKA_BROKERS" yaml:"kafka_brokers" default:"kafka:9092"`
+	Interval    time.Duration `env:"TEST_POLL_INTERVAL" yaml:"poll_interval" default:"5s"`
+	Secure      bool          `env:"TEST_COOKIE_IS_SECURE" yaml:"cookie_is_secure"`
+	MaxAttempts int           `env:"TEST_MAX_ATTEMPTS" yaml:"max_attempts" default:"3"`
+	Postgres    Postgres      `yaml:"postgres"`
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("defaults and environment", func(t *testing.T) {
+		setPostgres(t)
+		t.Setenv("TEST_GRPC_PORT", "50052")
+		t.Setenv("TEST_JWT_SECRET", "secret")
+		t.Setenv("TEST_KAFKA_BROKERS", "kafka-1:9092, kafka-2:9092")
+		t.Setenv("TEST_COOKIE_IS_SECURE", "true")
+
+		var cfg testConfig
+		require.NoError(t, Load(&cfg))
+
+		assert.Equal(t, "50052", cfg.Port)
+		assert.Equal(t, "review-service:50053", cfg.ReviewAddr)
+		assert.Equal(t, []string{"kafka-1:9092", "kafka-2:9092"}, cfg.Brokers)
+		assert.Equal(t, 5*time.Second, cfg.Interval)
+		assert.True(t, cfg.Secure)
+		assert.Equal(t, 3, cfg.MaxAttempts)
+		assert.Equal(t, "5432", cfg.Postgres.Port)
+		assert.Equal(t, "disable", cfg.Postgres.SSLMode)
+		assert.Equal(t, 5*time.Second, cfg.Postgres.QueryTimeout)
+	})
+
+	t.Run("file is overridden by the environment", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(`
+grpc_port: "50052"
+jwt_secret: from-file
+poll_interval: 1m
+review_service_addr: review.internal:443
+postgres:
+  host: db.internal
+  user: essays
+  database: essays
+`), 0o600))
+		t.Setenv(FileEnv, path)
+		t.Setenv("TEST_JWT_SECRET", "from-env")
+
+		var cfg testConfig
+		require.NoError(t, Load(&cfg))
+
+		assert.Equal(t, "50052", cfg.Port)
+		assert.Equal(t, "from-env", cfg.Secret)
+		assert.Equal(t, time.Minute, cfg.Interval)
+		assert.Equal(t, "review.internal:443", cfg.ReviewAddr)
+		assert.Equal(t, "db.internal", cfg.Postgres.Host)
+
+		var pg Postgres
+		require.NoError(t, LoadSection("postgres", &pg))
+		assert.Equal(t, "db.internal", pg.Host)
+		assert.Equal(t, "host=db.internal port=5432 user=essays password= dbname=essays sslmode=disable", pg.ConnString())
+	})
+
+	t.Run("every problem is reported", func(t *testing.T) {
+		t.Setenv("TEST_POLL_INTERVAL", "often")
+		t.Setenv("TEST_MAX_ATTEMPTS", "three")
+		t.Setenv("POSTGRES_QUERY_TIMEOUT", "0s")
+
+		var cfg testConfig
+		err := Load(&cfg)
+
+		require.Error(t, err)
+		for _, message := range []string{
+			"TEST_GRPC_PORT is required",
+			"TEST_JWT_SECRET is required",
+			"POSTGRES_HOST is required",
+			`invalid TEST_POLL_INTERVAL "often"`,
+			`invalid TEST_MAX_ATTEMPTS "three"`,
+			"POSTGRES_QUERY_TIMEOUT must be positive",
+		} {
+			assert.ErrorContains(t, err, message)
+		}
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		t.Setenv(FileEnv, filepath.Join(t.TempDir(), "missing.yaml"))
+		assert.ErrorContains(t, Load(&testConfig{}), "failed to read")
+	})
+
+	t.Run("not a struct pointer", func(t *testing.T) {
+		assert.Error(t, Load(testConfig{}))
+	})
+}
+
+func TestLog(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	logger := &logging.Logger{Logger: zap.New(core)}
+
+	Log(logger, testConfig{
+		Port:     "50052",
+		Secret:   "secret",
+		Brokers:  []string{"kafka-1:9092", "kafka-2:9092"},
+		Postgres: Postgres{Host: "postgres", Password: "hunter2"},
+	})
+
+	require.Equal(t, 1, logs.Len())
+	fields := logs.All()[0].ContextMap()
+	assert.Equal(t, "50052", fields["TEST_GRPC_PORT"])
+	assert.Equal(t, redacted, fields["TEST_JWT_SECRET"])
+	assert.Equal(t, "kafka-1:9092,kafka-2:9092", fields["TEST_KAFKA_BROKERS"])
+	assert.Equal(t, "postgres", fields["POSTGRES_HOST"])
+	assert.Equal(t, redacted, fields["POSTGRES_PASSWORD"])
+}
+
+func setPostgres(t *testing.T) {
+	t.Setenv("POSTGRES_HOST", "postgres")
+	t.Setenv("POSTGRES_USER", "essays")
+	t.Setenv("POSTGRES_DB_NAME", "essays")
+}
@@ -0,0 +1,173 @@
+package grpcclient
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type breakerState int
+
+const (
+	closed breakerState = iota
+	open
+	halfOpen
+)
+
+func (s breakerState) String() string {
+	switch s {
+	case open:
+		return "open"
+	case halfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// Fails calls fast while the server keeps failing, one probe call is let
+// through once the pause is over and closes the circuit again if it succeeds
+type breaker struct {
+	target   string
+	failures int
+	pause    time.Duration
+	logger   *logging.Logger
+	now      func() time.Time
+
+	mu          sync.Mutex
+	state       breakerState
+	consecutive int
+	openedAt    time.Time
+}
+
+func newBreaker(target string, failures int, pause time.Duration, logger *logging.Logger) *breaker {
+	return &breaker{
+		target:   target,
+		failures: failures,
+		pause:    pause,
+		logger:   logger,
+		now:      time.Now,
+	}
+}
+
+func (b *breaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == closed {
+		return nil
+	}
+	// a probe that never reported back doesn't keep the circuit half-open for good
+	if b.now().Sub(b.openedAt) < b.pause {
+		return status.Errorf(codes.Unavailable, "circuit breaker for %s is %s", b.target, b.state)
+	}
+
+	b.openedAt = b.now()
+	if b.state != halfOpen {
+		b.setState(halfOpen)
+	}
+	return nil
+}
+
+func (b *breaker) record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !serverFailure(err) {
+		b.consecutive = 0
+		if b.state != closed {
+			b.setState(closed)
+		}
+		return
+	}
+
+	b.consecutive++
+	if b.state == halfOpen || b.consecutive >= b.failures {
+		b.openedAt = b.now()
+		if b.state != open {
+			b.setState(open)
+		}
+	}
+}
+
+func (b *breaker) setState(state breakerState) {
+	b.logger.Warn("Circuit breaker state changed",
+		zap.String("target", b.target),
+		zap.Stringer("from", b.state),
+		zap.Stringer("to", state))
+	b.state = state
+}
+
+// Errors that say nothing about the server, such as a missing essay or a
+// caller that gave up, don't count
+func serverFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Internal, codes.Unknown, codes.DataLoss:
+		return true
+	default:
+		return false
+	}
+}
+
+func (b *breaker) unaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if err := b.allow(); err != nil {
+			return err
+		}
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		b.record(callError(ctx, err))
+		return err
+	}
+}
+
+// A stream is accounted once it ends, servers that are down usually fail
+// it before the first message
+func (b *breaker) streamInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if err := b.allow(); err != nil {
+			return nil, err
+		}
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			b.record(callError(ctx, err))
+			return nil, err
+		}
+		return &clientStream{ClientStream: stream, ctx: ctx, breaker: b}, nil
+	}
+}
+
+type clientStream struct {
+	grpc.ClientStream
+	ctx     context.Context
+	breaker *breaker
+	once    sync.Once
+}
+
+func (s *clientStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.once.Do(func() {
+			if err == io.EOF {
+				s.breaker.record(nil)
+				return
+			}
+			s.breaker.record(callError(s.ctx, err))
+		})
+	}
+	return err
+}
+
+// A deadline set by the caller tells nothing about the server either
+func callError(ctx context.Context, err error) error {
+	if err != nil && ctx.Err() != nil && status.Code(err) != codes.Unavailable {
+		return nil
+	}
+	return err
+}
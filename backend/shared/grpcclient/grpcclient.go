@@ -0,0 +1,161 @@
+package grpcclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/grpcmw"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/tracing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	defaultTimeout      = 5 * time.Second
+	defaultMaxAttempts  = 3
+	defaultFailures     = 5
+	defaultBreakerPause = 10 * time.Second
+)
+
+type config struct {
+	timeout        time.Duration
+	methodTimeouts map[string]time.Duration
+	retryMethods   []string
+	maxAttempts    int
+	failures       int
+	breakerPause   time.Duration
+	creds          credentials.TransportCredentials
+}
+
+type Option func(*config)
+
+// Deadline of every call that has no timeout of its own
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *config) {
+		c.timeout = timeout
+	}
+}
+
+// Deadline of one method, such as a stream that takes longer than the rest
+func WithMethodTimeout(method string, timeout time.Duration) Option {
+	return func(c *config) {
+		c.methodTimeouts[method] = timeout
+	}
+}
+
+// Methods that are safe to repeat, they are retried while the server is UNAVAILABLE
+func WithRetries(methods ...string) Option {
+	return func(c *config) {
+		c.retryMethods = append(c.retryMethods, methods...)
+	}
+}
+
+// Opens the circuit after failures consecutive server failures and keeps it
+// open for pause before letting a probe call through
+func WithBreaker(failures int, pause time.Duration) Option {
+	return func(c *config) {
+		c.failures, c.breakerPause = failures, pause
+	}
+}
+
+// Transport security of the connection, plaintext by default
+func WithCredentials(creds credentials.TransportCredentials) Option {
+	return func(c *config) {
+		c.creds = creds
+	}
+}
+
+// Connects to target, service is the full name of the gRPC service the
+// options refer to, such as review.ReviewService
+func NewClient(target, service string, logger *logging.Logger, opts ...Option) (*grpc.ClientConn, error) {
+	cfg := config{
+		timeout:        defaultTimeout,
+		methodTimeouts: make(map[string]time.Duration),
+		maxAttempts:    defaultMaxAttempts,
+		failures:       defaultFailures,
+		breakerPause:   defaultBreakerPause,
+		creds:          insecure.NewCredentials(),
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	serviceConfig, err := cfg.serviceConfig(service)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build service config: %w", err)
+	}
+
+	breaker := newBreaker(target, cfg.failures, cfg.breakerPause, logger)
+	return grpc.NewClient(target,
+		grpc.WithTransportCredentials(cfg.creds),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+		tracing.DialOption(),
+		grpc.WithChainUnaryInterceptor(grpcmw.UnaryClientInterceptor(), breaker.unaryInterceptor()),
+		grpc.WithChainStreamInterceptor(grpcmw.StreamClientInterceptor(), breaker.streamInterceptor()),
+	)
+}
+
+type methodName struct {
+	Service string `json:"service"`
+	Method  string `json:"method,omitempty"`
+}
+
+type retryPolicy struct {
+	MaxAttempts          int      `json:"maxAttempts"`
+	InitialBackoff       string   `json:"initialBackoff"`
+	MaxBackoff           string   `json:"maxBackoff"`
+	BackoffMultiplier    float64  `json:"backoffMultiplier"`
+	RetryableStatusCodes []string `json:"retryableStatusCodes"`
+}
+
+type methodConfig struct {
+	Name        []methodName `json:"name"`
+	Timeout     string       `json:"timeout,omitempty"`
+	RetryPolicy *retryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// Timeouts and retries are left to grpc, the most specific name wins so every
+// method with its own timeout or retry policy gets an entry
+func (c config) serviceConfig(service string) (string, error) {
+	methods := make(map[string]*methodConfig)
+	entry := func(method string) *methodConfig {
+		if mc, ok := methods[method]; ok {
+			return mc
+		}
+		mc := &methodConfig{
+			Name:    []methodName{{Service: service, Method: method}},
+			Timeout: seconds(c.timeout),
+		}
+		methods[method] = mc
+		return mc
+	}
+
+	entry("")
+	for method, timeout := range c.methodTimeouts {
+		entry(path.Base(method)).Timeout = seconds(timeout)
+	}
+	for _, method := range c.retryMethods {
+		entry(path.Base(method)).RetryPolicy = &retryPolicy{
+			MaxAttempts:          c.maxAttempts,
+			InitialBackoff:       "0.1s",
+			MaxBackoff:           "1s",
+			BackoffMultiplier:    2,
+			RetryableStatusCodes: []string{"UNAVAILABLE"},
+		}
+	}
+
+	configs := make([]*methodConfig, 0, len(methods))
+	for _, mc := range methods {
+		configs = append(configs, mc)
+	}
+	serviceConfig, err := json.Marshal(map[string]any{"methodConfig": configs})
+	return string(serviceConfig), err
+}
+
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%gs", d.Seconds())
+}
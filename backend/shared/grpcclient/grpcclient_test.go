@@ -0,0 +1,151 @@
+package grpcclient
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+const healthService = "grpc.health.v1.Health"
+
+type flakyHealthServer struct {
+	healthpb.UnimplementedHealthServer
+	calls atomic.Int32
+	err   atomic.Value
+	delay time.Duration
+}
+
+func (s *flakyHealthServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	s.calls.Add(1)
+	if s.delay > 0 {
+		select {
+		case <-time.After(s.delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if err, ok := s.err.Load().(error); ok && err != nil {
+		return nil, err
+	}
+	return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}, nil
+}
+
+func TestNewClient_Retries(t *testing.T) {
+	server := &flakyHealthServer{}
+	server.err.Store(status.Error(codes.Unavailable, "restarting"))
+	client := dial(t, server, WithRetries("/grpc.health.v1.Health/Check"), WithBreaker(100, time.Minute))
+
+	_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, int32(defaultMaxAttempts), server.calls.Load())
+
+	server.calls.Store(0)
+	server.err.Store(status.Error(codes.NotFound, "unknown service"))
+	_, err = client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, int32(1), server.calls.Load())
+}
+
+func TestNewClient_NoRetriesByDefault(t *testing.T) {
+	server := &flakyHealthServer{}
+	server.err.Store(status.Error(codes.Unavailable, "restarting"))
+	client := dial(t, server)
+
+	_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, int32(1), server.calls.Load())
+}
+
+func TestNewClient_MethodTimeout(t *testing.T) {
+	server := &flakyHealthServer{delay: time.Second}
+	client := dial(t, server, WithMethodTimeout("Check", 50*time.Millisecond))
+
+	start := time.Now()
+	_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+}
+
+func TestNewClient_Breaker(t *testing.T) {
+	server := &flakyHealthServer{}
+	server.err.Store(status.Error(codes.Internal, "database is down"))
+	client := dial(t, server, WithBreaker(2, time.Minute))
+
+	for range 2 {
+		_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	}
+
+	_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, int32(2), server.calls.Load())
+}
+
+func TestBreaker(t *testing.T) {
+	now := time.Now()
+	b := newBreaker("review-service:50053", 2, 10*time.Second, logging.NewEmptyLogger())
+	b.now = func() time.Time { return now }
+	failure := status.Error(codes.Unavailable, "connection refused")
+
+	t.Run("client errors keep it closed", func(t *testing.T) {
+		for range 5 {
+			require.NoError(t, b.allow())
+			b.record(status.Error(codes.NotFound, "essay not found"))
+		}
+		assert.Equal(t, closed, b.state)
+	})
+
+	t.Run("opens after consecutive failures", func(t *testing.T) {
+		b.record(failure)
+		assert.Equal(t, closed, b.state)
+		b.record(failure)
+		assert.Equal(t, open, b.state)
+		assert.Equal(t, codes.Unavailable, status.Code(b.allow()))
+	})
+
+	t.Run("lets one probe through after the pause", func(t *testing.T) {
+		now = now.Add(10 * time.Second)
+		require.NoError(t, b.allow())
+		assert.Equal(t, halfOpen, b.state)
+		assert.Error(t, b.allow())
+	})
+
+	t.Run("failed probe opens it again", func(t *testing.T) {
+		b.record(failure)
+		assert.Equal(t, open, b.state)
+		assert.Error(t, b.allow())
+	})
+
+	t.Run("successful probe closes it", func(t *testing.T) {
+		now = now.Add(10 * time.Second)
+		require.NoError(t, b.allow())
+		b.record(nil)
+		assert.Equal(t, closed, b.state)
+		assert.NoError(t, b.allow())
+	})
+}
+
+func dial(t *testing.T, server healthpb.HealthServer, opts ...Option) healthpb.HealthClient {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, server)
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := NewClient(lis.Addr().String(), healthService, logging.NewEmptyLogger(), opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return healthpb.NewHealthClient(conn)
+}
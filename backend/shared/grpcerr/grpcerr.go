@@ -0,0 +1,115 @@
+package grpcerr
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Domain of the ErrorInfo details attached by the services
+const Domain = "essays.vt-csa"
+
+const (
+	ReasonInternal = "INTERNAL"
+
+	internalMessage = "internal error"
+)
+
+// Binds a domain error to the status code and reason clients see
+type Mapping struct {
+	Err    error
+	Code   codes.Code
+	Reason string
+}
+
+type Mappings []Mapping
+
+// Builds a status error carrying a machine-readable reason
+func New(code codes.Code, reason, message string) error {
+	return withInfo(status.New(code, message), reason)
+}
+
+// Hides the cause from clients, the caller is expected to log it
+func Internal() error {
+	return New(codes.Internal, ReasonInternal, internalMessage)
+}
+
+// Converts err into a status error with a reason, errors nobody
+// expected become a bare Internal error
+func (m Mappings) Status(err error) error {
+	converted, _ := m.convert(err)
+	return converted
+}
+
+// Reports false when err had to be hidden behind Internal
+func (m Mappings) convert(err error) (error, bool) {
+	if err == nil {
+		return nil, true
+	}
+
+	for _, mapping := range m {
+		if errors.Is(err, mapping.Err) {
+			return New(mapping.Code, mapping.Reason, mapping.Err.Error()), true
+		}
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return New(codes.DeadlineExceeded, CodeReason(codes.DeadlineExceeded), context.DeadlineExceeded.Error()), true
+	}
+	if errors.Is(err, context.Canceled) {
+		return New(codes.Canceled, CodeReason(codes.Canceled), context.Canceled.Error()), true
+	}
+
+	if st, ok := status.FromError(err); ok && st.Code() != codes.Unknown {
+		if errorInfo(st) != nil {
+			return err, true
+		}
+		return withInfo(st, CodeReason(st.Code())), true
+	}
+
+	return Internal(), false
+}
+
+// Extracts the reason of a status error, falling back to the code name
+func Reason(err error) string {
+	st := status.Convert(err)
+	if info := errorInfo(st); info != nil {
+		return info.Reason
+	}
+	return CodeReason(st.Code())
+}
+
+// Spells the code the way reasons are spelled, InvalidArgument becomes INVALID_ARGUMENT
+func CodeReason(code codes.Code) string {
+	var reason strings.Builder
+	name := code.String()
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) && unicode.IsLower(rune(name[i-1])) {
+			reason.WriteByte('_')
+		}
+		reason.WriteRune(unicode.ToUpper(r))
+	}
+	return reason.String()
+}
+
+func withInfo(st *status.Status, reason string) error {
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: Domain})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func errorInfo(st *status.Status) *errdetails.ErrorInfo {
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package grpcerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var essayNotFoundErr = errors.New("essay not found")
+
+var testMappings = Mappings{
+	{Err: essayNotFoundErr, Code: codes.NotFound, Reason: "ESSAY_NOT_FOUND"},
+}
+
+func TestMappings_Status(t *testing.T) {
+	tests := []struct {
+		name            string
+		err             error
+		expectedCode    codes.Code
+		expectedReason  string
+		expectedMessage string
+	}{
+		{
+			name:            "mapped domain error",
+			err:             fmt.Errorf("failed to get essay: %w", essayNotFoundErr),
+			expectedCode:    codes.NotFound,
+			expectedReason:  "ESSAY_NOT_FOUND",
+			expectedMessage: "essay not found",
+		},
+		{
+			name:            "status without details",
+			err:             status.Error(codes.FailedPrecondition, "essay has changed"),
+			expectedCode:    codes.FailedPrecondition,
+			expectedReason:  "FAILED_PRECONDITION",
+			expectedMessage: "essay has changed",
+		},
+		{
+			name:            "status with a reason",
+			err:             New(codes.InvalidArgument, "RUBRIC_NOT_FOUND", "rubric not found"),
+			expectedCode:    codes.InvalidArgument,
+			expectedReason:  "RUBRIC_NOT_FOUND",
+			expectedMessage: "rubric not found",
+		},
+		{
+			name:            "context deadline",
+			err:             fmt.Errorf("failed to query: %w", context.DeadlineExceeded),
+			expectedCode:    codes.DeadlineExceeded,
+			expectedReason:  "DEADLINE_EXCEEDED",
+			expectedMessage: context.DeadlineExceeded.Error(),
+		},
+		{
+			name:            "unexpected error is hidden",
+			err:             errors.New("failed to connect to `host=postgres user=essays`"),
+			expectedCode:    codes.Internal,
+			expectedReason:  ReasonInternal,
+			expectedMessage: internalMessage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := testMappings.Status(tt.err)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			assert.Equal(t, tt.expectedReason, Reason(err))
+			assert.Equal(t, tt.expectedMessage, status.Convert(err).Message())
+		})
+	}
+
+	assert.NoError(t, testMappings.Status(nil))
+}
+
+func TestCodeReason(t *testing.T) {
+	assert.Equal(t, "OK", CodeReason(codes.OK))
+	assert.Equal(t, "NOT_FOUND", CodeReason(codes.NotFound))
+	assert.Equal(t, "INVALID_ARGUMENT", CodeReason(codes.InvalidArgument))
+	assert.Equal(t, "UNAVAILABLE", CodeReason(codes.Unavailable))
+	assert.Equal(t, "UNKNOWN", Reason(errors.New("plain error")))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor(testMappings, logging.NewEmptyLogger())
+	info := &grpc.UnaryServerInfo{FullMethod: "/essay.EssayService/GetEssay"}
+
+	_, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+		return nil, essayNotFoundErr
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, "ESSAY_NOT_FOUND", Reason(err))
+
+	resp, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+		return "essay", nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "essay", resp)
+}
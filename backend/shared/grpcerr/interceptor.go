@@ -0,0 +1,36 @@
+package grpcerr
+
+import (
+	"context"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// Translates the errors returned by unary handlers, unexpected ones are
+// logged and hidden behind Internal
+func UnaryServerInterceptor(mappings Mappings, logger *logging.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		return resp, mappings.translate(err, info.FullMethod, logger)
+	}
+}
+
+// Translates the errors returned by streaming handlers, unexpected ones are
+// logged and hidden behind Internal
+func StreamServerInterceptor(mappings Mappings, logger *logging.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return mappings.translate(handler(srv, stream), info.FullMethod, logger)
+	}
+}
+
+func (m Mappings) translate(err error, method string, logger *logging.Logger) error {
+	converted, expected := m.convert(err)
+	if !expected {
+		logger.Error("Unexpected error in gRPC handler",
+			zap.String("method", method),
+			zap.Error(err))
+	}
+	return converted
+}
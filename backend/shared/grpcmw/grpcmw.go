@@ -0,0 +1,104 @@
+package grpcmw
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"runtime/debug"
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/grpcerr"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/monitoring"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Logs every unary call along with the request id sent by the caller,
+// records its metrics under the service label and turns panics into
+// Internal errors. It should be the outermost interceptor so that it sees
+// the status the client receives
+func UnaryServerInterceptor(service string, logger *logging.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		ctx = incomingRequestID(ctx)
+		callLogger := logger.WithContext(ctx).WithFields(zap.String("grpc_method", info.FullMethod))
+		start := time.Now()
+
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(callLogger, r)
+			}
+			observe(service, info.FullMethod, callLogger, start, err)
+		}()
+
+		return handler(logging.NewContext(ctx, callLogger), req)
+	}
+}
+
+// Streaming counterpart of UnaryServerInterceptor
+func StreamServerInterceptor(service string, logger *logging.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		ctx := incomingRequestID(stream.Context())
+		callLogger := logger.WithContext(ctx).WithFields(zap.String("grpc_method", info.FullMethod))
+		start := time.Now()
+
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(callLogger, r)
+			}
+			observe(service, info.FullMethod, callLogger, start, err)
+		}()
+
+		return handler(srv, &serverStream{
+			ServerStream: stream,
+			ctx:          logging.NewContext(ctx, callLogger),
+		})
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func recovered(logger *logging.Logger, r any) error {
+	logger.Error("Panic in gRPC handler",
+		zap.String("panic", fmt.Sprint(r)),
+		zap.ByteString("stack", debug.Stack()))
+	return grpcerr.Internal()
+}
+
+func observe(service, fullMethod string, logger *logging.Logger, start time.Time, err error) {
+	duration := time.Since(start)
+	code := status.Code(err)
+	method := path.Base(fullMethod)
+
+	monitoring.GrpcRequestDuration.WithLabelValues(service, method, code.String()).Observe(duration.Seconds())
+	monitoring.GrpcRequestsTotal.WithLabelValues(service, method, code.String()).Inc()
+
+	if ce := logger.Check(level(code), "gRPC call finished"); ce != nil {
+		ce.Write(
+			zap.String("grpc_code", code.String()),
+			zap.Duration("duration", duration))
+	}
+}
+
+// Failures of the service itself are errors, client mistakes are not
+func level(code codes.Code) zapcore.Level {
+	switch code {
+	case codes.OK, codes.Canceled, codes.InvalidArgument, codes.NotFound, codes.AlreadyExists,
+		codes.PermissionDenied, codes.Unauthenticated, codes.FailedPrecondition, codes.OutOfRange:
+		return zap.InfoLevel
+	case codes.ResourceExhausted, codes.Aborted, codes.DeadlineExceeded:
+		return zap.WarnLevel
+	default:
+		return zap.ErrorLevel
+	}
+}
@@ -0,0 +1,103 @@
+package grpcmw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/grpcerr"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/monitoring"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	core, logs := observer.New(zap.DebugLevel)
+	interceptor := UnaryServerInterceptor("essay", &logging.Logger{Logger: zap.New(core)})
+	info := &grpc.UnaryServerInfo{FullMethod: "/essay.EssayService/GetEssay"}
+
+	t.Run("success", func(t *testing.T) {
+		before := testutil.ToFloat64(monitoring.GrpcRequestsTotal.WithLabelValues("essay", "GetEssay", "OK"))
+
+		resp, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+			logging.FromContext(ctx, nil).Info("handling")
+			return "essay", nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "essay", resp)
+		assert.Equal(t, before+1, testutil.ToFloat64(monitoring.GrpcRequestsTotal.WithLabelValues("essay", "GetEssay", "OK")))
+		assert.Equal(t, 1, logs.FilterMessage("handling").FilterField(zap.String("grpc_method", info.FullMethod)).Len())
+		assert.Equal(t, 1, logs.FilterMessage("gRPC call finished").FilterField(zap.String("grpc_code", "OK")).Len())
+	})
+
+	t.Run("error status is recorded", func(t *testing.T) {
+		before := testutil.ToFloat64(monitoring.GrpcRequestsTotal.WithLabelValues("essay", "GetEssay", "NotFound"))
+
+		_, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+			return nil, status.Error(codes.NotFound, "essay not found")
+		})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Equal(t, before+1, testutil.ToFloat64(monitoring.GrpcRequestsTotal.WithLabelValues("essay", "GetEssay", "NotFound")))
+	})
+
+	t.Run("panic becomes internal", func(t *testing.T) {
+		before := testutil.ToFloat64(monitoring.GrpcRequestsTotal.WithLabelValues("essay", "GetEssay", "Internal"))
+
+		_, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+			panic("nil map")
+		})
+
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Equal(t, grpcerr.ReasonInternal, grpcerr.Reason(err))
+		assert.Equal(t, before+1, testutil.ToFloat64(monitoring.GrpcRequestsTotal.WithLabelValues("essay", "GetEssay", "Internal")))
+		assert.Equal(t, 1, logs.FilterMessage("Panic in gRPC handler").Len())
+	})
+}
+
+type testStream struct {
+	grpc.ServerStream
+}
+
+func (testStream) Context() context.Context {
+	return context.Background()
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	interceptor := StreamServerInterceptor("review", logging.NewEmptyLogger())
+	info := &grpc.StreamServerInfo{FullMethod: "/review.ReviewService/GetByEssayId"}
+
+	err := interceptor(nil, testStream{}, info, func(srv any, stream grpc.ServerStream) error {
+		assert.NotNil(t, logging.FromContext(stream.Context(), nil))
+		panic("closed channel")
+	})
+
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestRequestIDPropagation(t *testing.T) {
+	var forwarded metadata.MD
+	client := UnaryClientInterceptor()
+	err := client(logging.WithRequestID(context.Background(), "3f0c9a52"), "/review.ReviewService/GetByEssayId", nil, nil, nil,
+		func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			forwarded, _ = metadata.FromOutgoingContext(ctx)
+			return nil
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"3f0c9a52"}, forwarded.Get("x-request-id"))
+
+	server := UnaryServerInterceptor("review", logging.NewEmptyLogger())
+	info := &grpc.UnaryServerInfo{FullMethod: "/review.ReviewService/GetByEssayId"}
+	_, err = server(metadata.NewIncomingContext(context.Background(), forwarded), nil, info, func(ctx context.Context, req any) (any, error) {
+		assert.Equal(t, "3f0c9a52", logging.RequestID(ctx))
+		return nil, nil
+	})
+	assert.NoError(t, err)
+}
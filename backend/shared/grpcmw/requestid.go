@@ -0,0 +1,42 @@
+package grpcmw
+
+import (
+	"context"
+	"strings"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+var requestIDMetadataKey = strings.ToLower(logging.RequestIDHeader)
+
+// Forwards the request id of ctx to the called service
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(outgoingRequestID(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// Streaming counterpart of UnaryClientInterceptor
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(outgoingRequestID(ctx), desc, cc, method, opts...)
+	}
+}
+
+func outgoingRequestID(ctx context.Context) context.Context {
+	requestID := logging.RequestID(ctx)
+	if requestID == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, requestIDMetadataKey, requestID)
+}
+
+func incomingRequestID(ctx context.Context) context.Context {
+	values := metadata.ValueFromIncomingContext(ctx, requestIDMetadataKey)
+	if len(values) == 0 || values[0] == "" {
+		return ctx
+	}
+	return logging.WithRequestID(ctx, values[0])
+}
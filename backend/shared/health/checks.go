@@ -0,0 +1,67 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Anything that can reach its server, such as *pgxpool.Pool
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+func PingCheck(pinger Pinger) Check {
+	return pinger.Ping
+}
+
+// Succeeds when one of the brokers answers and knows the topic
+func KafkaCheck(brokers []string, topic string) Check {
+	return func(ctx context.Context) error {
+		var errs []error
+		for _, broker := range brokers {
+			err := kafkaTopicExists(ctx, broker, topic)
+			if err == nil {
+				return nil
+			}
+			errs = append(errs, err)
+		}
+		return errors.Join(errs...)
+	}
+}
+
+func kafkaTopicExists(ctx context.Context, broker, topic string) error {
+	var dialer kafka.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", broker)
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", broker, err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	if _, err := conn.ReadPartitions(topic); err != nil {
+		return fmt.Errorf("failed to read partitions of %s: %w", topic, err)
+	}
+	return nil
+}
+
+// Asks a downstream service for its overall status
+func GrpcCheck(conn grpc.ClientConnInterface) Check {
+	client := healthpb.NewHealthClient(conn)
+	return func(ctx context.Context) error {
+		resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+		if err != nil {
+			return err
+		}
+		if resp.Status != healthpb.HealthCheckResponse_SERVING {
+			return fmt.Errorf("service is %s", resp.Status)
+		}
+		return nil
+	}
+}
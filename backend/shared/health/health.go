@@ -0,0 +1,194 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const checkTimeout = 2 * time.Second
+
+// Reports why a dependency can't be used, nil when it is fine
+type Check func(ctx context.Context) error
+
+// Runs the dependency checks of a service and publishes the result through
+// grpc.health.v1 and the /readyz HTTP endpoint
+type Checker struct {
+	service string
+	server  *grpchealth.Server
+	logger  *logging.Logger
+
+	mu      sync.RWMutex
+	checks  map[string]Check
+	results map[string]error
+	ready   bool
+}
+
+// The service is reported as not serving until the first round of checks passes
+func New(service string, logger *logging.Logger) *Checker {
+	c := &Checker{
+		service: service,
+		server:  grpchealth.NewServer(),
+		logger:  logger,
+		checks:  make(map[string]Check),
+		results: make(map[string]error),
+	}
+	c.setServing(false)
+	return c
+}
+
+func (c *Checker) Add(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks[name] = check
+}
+
+func (c *Checker) Register(server *grpc.Server) {
+	healthpb.RegisterHealthServer(server, c.server)
+}
+
+// Runs every check once and updates the published status
+func (c *Checker) Run(ctx context.Context) bool {
+	c.mu.RLock()
+	checks := make(map[string]Check, len(c.checks))
+	for name, check := range c.checks {
+		checks[name] = check
+	}
+	c.mu.RUnlock()
+
+	results := make(map[string]error, len(checks))
+	var resultsMu sync.Mutex
+	var wg sync.WaitGroup
+	for name, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+			defer cancel()
+
+			err := check(checkCtx)
+			resultsMu.Lock()
+			results[name] = err
+			resultsMu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	ready := true
+	for name, err := range results {
+		if err != nil {
+			ready = false
+			c.logger.Warn("Health check failed", zap.String("check", name), zap.Error(err))
+		}
+	}
+
+	c.mu.Lock()
+	changed := c.ready != ready
+	c.results, c.ready = results, ready
+	c.mu.Unlock()
+
+	if changed {
+		c.logger.Info("Health status changed", zap.Bool("ready", ready))
+	}
+	c.setServing(ready)
+	return ready
+}
+
+// Repeats the checks until ctx is done, then reports the service as not serving
+func (c *Checker) Start(ctx context.Context, interval time.Duration) {
+	c.Run(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.Shutdown()
+			return
+		case <-ticker.C:
+			c.Run(ctx)
+		}
+	}
+}
+
+// Reports the service as not serving for good, used while shutting down
+func (c *Checker) Shutdown() {
+	c.mu.Lock()
+	c.ready = false
+	c.mu.Unlock()
+	c.server.Shutdown()
+}
+
+func (c *Checker) Ready() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.ready
+}
+
+// Serves the result of the last round of checks
+func (c *Checker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mu.RLock()
+	ready := c.ready
+	names := make([]string, 0, len(c.results))
+	for name := range c.results {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	checks := make(map[string]string, len(names))
+	for _, name := range names {
+		checks[name] = "ok"
+		if err := c.results[name]; err != nil {
+			checks[name] = err.Error()
+		}
+	}
+	c.mu.RUnlock()
+
+	WriteJSON(w, ready, checks)
+}
+
+// Exposes /healthz and /readyz next to the other endpoints of the mux
+func (c *Checker) RegisterHTTP(mux *http.ServeMux) {
+	mux.HandleFunc("/healthz", Live)
+	mux.Handle("/readyz", c)
+}
+
+// Answers liveness probes, the process is alive as long as it can answer
+func Live(w http.ResponseWriter, r *http.Request) {
+	WriteJSON(w, true, nil)
+}
+
+// Writes the body shared by the readiness endpoints of every service
+func WriteJSON(w http.ResponseWriter, ready bool, checks map[string]string) {
+	body := map[string]any{"status": "ok"}
+	httpStatus := http.StatusOK
+	if !ready {
+		body["status"] = "unavailable"
+		httpStatus = http.StatusServiceUnavailable
+	}
+	if checks != nil {
+		body["checks"] = checks
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	json.NewEncoder(w).Encode(body)
+}
+
+func (c *Checker) setServing(serving bool) {
+	status := healthpb.HealthCheckResponse_NOT_SERVING
+	if serving {
+		status = healthpb.HealthCheckResponse_SERVING
+	}
+	c.server.SetServingStatus("", status)
+	c.server.SetServingStatus(c.service, status)
+}
@@ -0,0 +1,106 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type pingerFunc func(ctx context.Context) error
+
+func (f pingerFunc) Ping(ctx context.Context) error {
+	return f(ctx)
+}
+
+func TestChecker(t *testing.T) {
+	dbErr := errors.New("connection refused")
+	var pingErr error
+
+	checker := New("essay.EssayService", logging.NewEmptyLogger())
+	checker.Add("postgres", PingCheck(pingerFunc(func(ctx context.Context) error {
+		return pingErr
+	})))
+
+	conn := serve(t, checker)
+	client := healthpb.NewHealthClient(conn)
+	check := GrpcCheck(conn)
+
+	t.Run("not serving before the first run", func(t *testing.T) {
+		assert.False(t, checker.Ready())
+		assert.Error(t, check(context.Background()))
+	})
+
+	t.Run("serving when the checks pass", func(t *testing.T) {
+		assert.True(t, checker.Run(context.Background()))
+
+		resp, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "essay.EssayService"})
+		require.NoError(t, err)
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+		assert.NoError(t, check(context.Background()))
+
+		w := httptest.NewRecorder()
+		checker.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"status": "ok", "checks": {"postgres": "ok"}}`, w.Body.String())
+	})
+
+	t.Run("not serving when a check fails", func(t *testing.T) {
+		pingErr = dbErr
+		assert.False(t, checker.Run(context.Background()))
+
+		resp, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, resp.Status)
+		assert.Error(t, check(context.Background()))
+
+		w := httptest.NewRecorder()
+		checker.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.JSONEq(t, `{"status": "unavailable", "checks": {"postgres": "connection refused"}}`, w.Body.String())
+	})
+
+	t.Run("not serving after shutdown", func(t *testing.T) {
+		pingErr = nil
+		checker.Run(context.Background())
+		checker.Shutdown()
+
+		assert.False(t, checker.Ready())
+		assert.Error(t, check(context.Background()))
+	})
+}
+
+func TestLive(t *testing.T) {
+	w := httptest.NewRecorder()
+	Live(w, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"status": "ok"}`, w.Body.String())
+}
+
+func serve(t *testing.T, checker *Checker) *grpc.ClientConn {
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	checker.Register(server)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
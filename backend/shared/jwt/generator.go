@@ -13,9 +13,16 @@ var (
 	ErrInvalidUsername = errors.New("Invalid username")
 )
 
+// User roles carried in access tokens
+const (
+	RoleStudent = "student"
+	RoleTeacher = "teacher"
+)
+
 type UserInfo struct {
 	UserId   int
 	Username string
+	Role     string
 }
 
 type TokenGenerator interface {
@@ -45,6 +52,7 @@ func (generator *jwtGenerator) GenerateAccessToken(userInfo UserInfo) (string, e
 		"jti":    uuid.New().String(),
 		"type":   "access",
 		"userId": userInfo.UserId,
+		"role":   roleOrDefault(userInfo.Role),
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS512, claims)
@@ -69,3 +77,10 @@ func (generator *jwtGenerator) GenerateRefreshToken(userInfo UserInfo) (string,
 	token := jwt.NewWithClaims(jwt.SigningMethodHS384, claims)
 	return token.SignedString(generator.refreshSecret)
 }
+
+func roleOrDefault(role string) string {
+	if role == "" {
+		return RoleStudent
+	}
+	return role
+}
@@ -64,6 +64,7 @@ func TestJWTGenerator(t *testing.T) {
 					assert.Equal(t, tt.username, claims["sub"])
 					assert.Equal(t, "vt-csa-essays", claims["iss"])
 					assert.Equal(t, "access", claims["type"])
+					assert.Equal(t, RoleStudent, claims["role"])
 					assert.NotEmpty(t, claims["jti"])
 					assert.NotZero(t, claims["iat"])
 					assert.NotZero(t, claims["exp"])
@@ -77,6 +78,20 @@ func TestJWTGenerator(t *testing.T) {
 		}
 	})
 
+	t.Run("GenerateAccessToken keeps teacher role", func(t *testing.T) {
+		token, err := generator.GenerateAccessToken(UserInfo{UserId: 2, Username: "teacher", Role: RoleTeacher})
+		require.NoError(t, err)
+
+		parsedToken, err := jwt.Parse(token, func(token *jwt.Token) (interface{}, error) {
+			return accessSecret, nil
+		})
+		require.NoError(t, err)
+
+		claims, ok := parsedToken.Claims.(jwt.MapClaims)
+		require.True(t, ok)
+		assert.Equal(t, RoleTeacher, claims["role"])
+	})
+
 	t.Run("GenerateRefreshToken", func(t *testing.T) {
 		tests := []struct {
 			name     string
@@ -0,0 +1,185 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// Releases a resource on shutdown, ctx carries the shutdown deadline
+type Hook func(ctx context.Context) error
+
+type namedHook struct {
+	name string
+	hook Hook
+}
+
+// Owns the servers and background loops of a service and stops them in order
+// once SIGINT or SIGTERM arrives:
+//  1. Context is canceled and the loops started with Go are awaited, so health
+//     checkers report NOT_SERVING before the servers stop
+//  2. hooks run in the order they were added, servers drain their requests
+//  3. logs are flushed
+type Lifecycle struct {
+	logger  *logging.Logger
+	timeout time.Duration
+
+	ctx     context.Context
+	cancel  context.CancelFunc
+	signals chan os.Signal
+	failed  chan error
+	workers sync.WaitGroup
+
+	mu    sync.Mutex
+	hooks []namedHook
+}
+
+// timeout bounds the whole shutdown
+func New(logger *logging.Logger, timeout time.Duration) *Lifecycle {
+	ctx, cancel := context.WithCancel(context.Background())
+	l := &Lifecycle{
+		logger:  logger,
+		timeout: timeout,
+		ctx:     ctx,
+		cancel:  cancel,
+		signals: make(chan os.Signal, 1),
+		failed:  make(chan error, 1),
+	}
+	signal.Notify(l.signals, syscall.SIGINT, syscall.SIGTERM)
+	return l
+}
+
+// Canceled as soon as the shutdown begins
+func (l *Lifecycle) Context() context.Context {
+	return l.ctx
+}
+
+func (l *Lifecycle) OnShutdown(name string, hook Hook) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.hooks = append(l.hooks, namedHook{name: name, hook: hook})
+}
+
+// Runs a background loop that has to return once Context is canceled
+func (l *Lifecycle) Go(run func(ctx context.Context)) {
+	l.workers.Add(1)
+	go func() {
+		defer l.workers.Done()
+		run(l.ctx)
+	}()
+}
+
+// Serves until shutdown, in-flight calls get until the deadline to finish
+// and are cut off after it
+func (l *Lifecycle) ServeGRPC(server *grpc.Server, lis net.Listener) {
+	go func() {
+		if err := server.Serve(lis); err != nil {
+			l.fail(err)
+		}
+	}()
+
+	l.OnShutdown("grpc server", func(ctx context.Context) error {
+		stopped := make(chan struct{})
+		go func() {
+			server.GracefulStop()
+			close(stopped)
+		}()
+
+		select {
+		case <-stopped:
+			return nil
+		case <-ctx.Done():
+			server.Stop()
+			<-stopped
+			return ctx.Err()
+		}
+	})
+}
+
+// Serves until shutdown, in-flight requests get until the deadline to finish
+// and are cut off after it
+func (l *Lifecycle) ServeHTTP(server *http.Server) {
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			l.fail(err)
+		}
+	}()
+
+	l.OnShutdown("http server", func(ctx context.Context) error {
+		if err := server.Shutdown(ctx); err != nil {
+			server.Close()
+			return err
+		}
+		return nil
+	})
+}
+
+// Blocks until a signal arrives or a server fails, then shuts everything down
+func (l *Lifecycle) Wait() error {
+	var cause error
+	select {
+	case sig := <-l.signals:
+		l.logger.Info("Shutting down", zap.String("signal", sig.String()))
+	case cause = <-l.failed:
+		l.logger.Error("Server failed, shutting down", zap.Error(cause))
+	}
+	signal.Stop(l.signals)
+
+	ctx, cancel := context.WithTimeout(context.Background(), l.timeout)
+	defer cancel()
+
+	l.cancel()
+	if err := waitGroup(ctx, &l.workers); err != nil {
+		l.logger.Warn("Background loops did not stop in time", zap.Error(err))
+	}
+
+	l.mu.Lock()
+	hooks := l.hooks
+	l.mu.Unlock()
+
+	errs := []error{cause}
+	for _, h := range hooks {
+		if err := h.hook(ctx); err != nil {
+			l.logger.Error("Shutdown step failed", zap.String("step", h.name), zap.Error(err))
+			errs = append(errs, err)
+			continue
+		}
+		l.logger.Debug("Shutdown step finished", zap.String("step", h.name))
+	}
+
+	l.logger.Info("Shutdown complete")
+	l.logger.Sync()
+	return errors.Join(errs...)
+}
+
+func (l *Lifecycle) fail(err error) {
+	select {
+	case l.failed <- err:
+	default:
+	}
+}
+
+func waitGroup(ctx context.Context, wg *sync.WaitGroup) error {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
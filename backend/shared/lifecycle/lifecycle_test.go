@@ -0,0 +1,158 @@
+package lifecycle
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// Blocks every Check until release is closed
+type slowHealthServer struct {
+	healthpb.UnimplementedHealthServer
+	started chan struct{}
+	release chan struct{}
+}
+
+func (s *slowHealthServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	close(s.started)
+	select {
+	case <-s.release:
+		return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func TestLifecycle_SignalMidRequest(t *testing.T) {
+	lc, client, slow := startGRPC(t, time.Minute)
+
+	var steps []string
+	lc.Go(func(ctx context.Context) {
+		<-ctx.Done()
+		steps = append(steps, "worker")
+	})
+	lc.OnShutdown("producer", func(ctx context.Context) error {
+		steps = append(steps, "producer")
+		return nil
+	})
+
+	call := make(chan error, 1)
+	go func() {
+		_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+		call <- err
+	}()
+	<-slow.started
+
+	waited := make(chan error, 1)
+	go func() { waited <- lc.Wait() }()
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGTERM))
+
+	select {
+	case <-lc.Context().Done():
+	case <-time.After(time.Second):
+		t.Fatal("context was not canceled by the signal")
+	}
+	select {
+	case <-waited:
+		t.Fatal("shutdown finished before the request")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(slow.release)
+	assert.NoError(t, <-call)
+	assert.NoError(t, <-waited)
+	assert.Equal(t, []string{"worker", "producer"}, steps)
+}
+
+func TestLifecycle_DeadlineCutsOffRequests(t *testing.T) {
+	lc, client, slow := startGRPC(t, 100*time.Millisecond)
+
+	call := make(chan error, 1)
+	go func() {
+		_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+		call <- err
+	}()
+	<-slow.started
+
+	waited := make(chan error, 1)
+	go func() { waited <- lc.Wait() }()
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGTERM))
+
+	assert.ErrorIs(t, <-waited, context.DeadlineExceeded)
+	assert.Equal(t, codes.Unavailable, status.Code(<-call))
+}
+
+func TestLifecycle_ServeHTTP(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := lis.Addr().String()
+	lis.Close()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	lc := New(logging.NewEmptyLogger(), time.Minute)
+	lc.ServeHTTP(&http.Server{Addr: addr, Handler: mux})
+
+	var resp *http.Response
+	call := make(chan error, 1)
+	go func() {
+		var err error
+		for range 50 {
+			if resp, err = http.Get("http://" + addr); err == nil {
+				break
+			}
+			time.Sleep(20 * time.Millisecond)
+		}
+		call <- err
+	}()
+	<-started
+
+	waited := make(chan error, 1)
+	go func() { waited <- lc.Wait() }()
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGTERM))
+	<-lc.Context().Done()
+
+	close(release)
+	require.NoError(t, <-call)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	resp.Body.Close()
+	assert.NoError(t, <-waited)
+}
+
+func startGRPC(t *testing.T, timeout time.Duration) (*Lifecycle, healthpb.HealthClient, *slowHealthServer) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	slow := &slowHealthServer{started: make(chan struct{}), release: make(chan struct{})}
+	server := grpc.NewServer()
+	healthpb.RegisterHealthServer(server, slow)
+
+	lc := New(logging.NewEmptyLogger(), timeout)
+	lc.ServeGRPC(server, lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return lc, healthpb.NewHealthClient(conn), slow
+}
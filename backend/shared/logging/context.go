@@ -0,0 +1,58 @@
+package logging
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Header carrying the id of the user action a request belongs to, gRPC
+// metadata and Kafka messages use the same name
+const RequestIDHeader = "X-Request-ID"
+
+type contextKey struct{}
+
+type requestIDKey struct{}
+
+// Stores the request-scoped logger so handlers can pick it up
+func NewContext(ctx context.Context, logger *Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, logger)
+}
+
+// Returns the logger stored in ctx, or fallback scoped to ctx when there is none
+func FromContext(ctx context.Context, fallback *Logger) *Logger {
+	if logger, ok := ctx.Value(contextKey{}).(*Logger); ok {
+		return logger
+	}
+	return fallback.WithContext(ctx)
+}
+
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// Returns the request id stored in ctx, empty when there is none
+func RequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// Adds the request id and the ids of the span in ctx so log lines can be
+// joined across services and with traces
+func (l *Logger) WithContext(ctx context.Context) *Logger {
+	var fields []zap.Field
+	if requestID := RequestID(ctx); requestID != "" {
+		fields = append(fields, zap.String("request_id", requestID))
+	}
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		fields = append(fields,
+			zap.String("trace_id", spanContext.TraceID().String()),
+			zap.String("span_id", spanContext.SpanID().String()))
+	}
+
+	if len(fields) == 0 {
+		return l
+	}
+	return l.WithFields(fields...)
+}
@@ -0,0 +1,31 @@
+package logging
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestWithContext(t *testing.T) {
+	core, logs := observer.New(zap.DebugLevel)
+	logger := &Logger{Logger: zap.New(core)}
+
+	ctx := WithRequestID(context.Background(), "3f0c9a52")
+	logger.WithContext(ctx).Info("scoped")
+	logger.WithContext(context.Background()).Info("plain")
+
+	assert.Equal(t, "3f0c9a52", logs.FilterMessage("scoped").All()[0].ContextMap()["request_id"])
+	assert.Empty(t, logs.FilterMessage("plain").All()[0].Context)
+}
+
+func TestFromContext(t *testing.T) {
+	stored := NewEmptyLogger()
+	fallback := NewEmptyLogger()
+
+	assert.Same(t, stored, FromContext(NewContext(context.Background(), stored), fallback))
+	assert.Same(t, fallback, FromContext(context.Background(), fallback))
+	assert.NotSame(t, fallback, FromContext(WithRequestID(context.Background(), "3f0c9a52"), fallback))
+}
@@ -0,0 +1,145 @@
+package mtls
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	CAName = "ca"
+
+	caValidity   = 10 * 365 * 24 * time.Hour
+	certValidity = 365 * 24 * time.Hour
+)
+
+// Writes a key pair signed by a local CA for every name to dir, as
+// <name>.crt and <name>.key. The CA is created on the first run and reused
+// afterwards, so certificates can be added or renewed one at a time.
+// Meant for docker-compose and tests, the certificates are valid for the
+// name itself and for localhost
+func Generate(dir string, names ...string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("mtls: %w", err)
+	}
+
+	ca, err := loadCA(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		ca, err = createCA(dir)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		if err := createCert(dir, name, ca); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Paths Generate writes the key pair of name to
+func Files(dir, name string) (certFile, keyFile string) {
+	return filepath.Join(dir, name+".crt"), filepath.Join(dir, name+".key")
+}
+
+func loadCA(dir string) (*tls.Certificate, error) {
+	certFile, keyFile := Files(dir, CAName)
+	ca, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("mtls: failed to load CA: %w", err)
+	}
+	return &ca, nil
+}
+
+func createCA(dir string) (*tls.Certificate, error) {
+	template := &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "vt-csa-essays local CA"},
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	if err := create(dir, CAName, template, nil); err != nil {
+		return nil, err
+	}
+	return loadCA(dir)
+}
+
+func createCert(dir, name string, ca *tls.Certificate) error {
+	template := &x509.Certificate{
+		Subject:     pkix.Name{CommonName: name},
+		DNSNames:    []string{name, "localhost"},
+		IPAddresses: []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	return create(dir, name, template, ca)
+}
+
+// Signs template with ca, or with its own key when ca is nil
+func create(dir, name string, template *x509.Certificate, ca *tls.Certificate) error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return fmt.Errorf("mtls: failed to generate key: %w", err)
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return fmt.Errorf("mtls: failed to generate serial number: %w", err)
+	}
+	template.SerialNumber = serial
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(certValidity)
+
+	parent, signer := template, crypto.Signer(key)
+	if ca == nil {
+		template.NotAfter = time.Now().Add(caValidity)
+	} else {
+		if parent, err = x509.ParseCertificate(ca.Certificate[0]); err != nil {
+			return fmt.Errorf("mtls: failed to parse CA: %w", err)
+		}
+		signer = ca.PrivateKey.(crypto.Signer)
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), signer)
+	if err != nil {
+		return fmt.Errorf("mtls: failed to create certificate of %s: %w", name, err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return fmt.Errorf("mtls: failed to encode key of %s: %w", name, err)
+	}
+
+	certFile, keyFile := Files(dir, name)
+	if err := writePEM(keyFile, "PRIVATE KEY", keyDER, 0o600); err != nil {
+		return err
+	}
+	return writePEM(certFile, "CERTIFICATE", der, 0o644)
+}
+
+// Written next to the target and renamed over it, a service watching the
+// file never reads half of it
+func writePEM(path, blockType string, der []byte, perm os.FileMode) error {
+	tmp := path + ".tmp"
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	if err := os.WriteFile(tmp, data, perm); err != nil {
+		return fmt.Errorf("mtls: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("mtls: %w", err)
+	}
+	return nil
+}
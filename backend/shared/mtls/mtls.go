@@ -0,0 +1,221 @@
+package mtls
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/config"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Key pair and CA of a service, both sides of every connection present a
+// certificate signed by the CA. The files are read again when they change
+// so certificates can be rotated without a restart
+type Certificates struct {
+	cfg    config.TLS
+	logger *logging.Logger
+
+	mu    sync.RWMutex
+	cert  *tls.Certificate
+	pool  *x509.CertPool
+	stamp string
+}
+
+// Loads the files named by cfg, mTLS is disabled when none of them is set
+func New(cfg config.TLS, logger *logging.Logger) (*Certificates, error) {
+	c := &Certificates{cfg: cfg, logger: logger}
+
+	set := 0
+	for _, file := range c.files() {
+		if file != "" {
+			set++
+		}
+	}
+	switch set {
+	case 0:
+		return c, nil
+	case len(c.files()):
+	default:
+		return nil, errors.New("mtls: TLS_CERT_FILE, TLS_KEY_FILE and TLS_CA_FILE must be set together")
+	}
+
+	if err := c.reload(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Certificates) Enabled() bool {
+	return c.cfg.CertFile != ""
+}
+
+// Credentials of a gRPC server, clients without a certificate signed by the
+// CA are turned away, and so are the ones not in TLS_ALLOWED_CLIENTS if it is set
+func (c *Certificates) ServerOption() grpc.ServerOption {
+	if !c.Enabled() {
+		return grpc.Creds(insecure.NewCredentials())
+	}
+
+	return grpc.Creds(credentials.NewTLS(&tls.Config{
+		MinVersion: tls.VersionTLS13,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, pool := c.current()
+			return &tls.Config{
+				MinVersion:       tls.VersionTLS13,
+				Certificates:     []tls.Certificate{*cert},
+				ClientAuth:       tls.RequireAndVerifyClientCert,
+				ClientCAs:        pool,
+				VerifyConnection: c.verifyClient,
+			}, nil
+		},
+	}))
+}
+
+// Credentials of a gRPC client, the server name is taken from the target
+func (c *Certificates) ClientCredentials() credentials.TransportCredentials {
+	if !c.Enabled() {
+		return insecure.NewCredentials()
+	}
+
+	// RootCAs can't be swapped on a live config, so the default verification
+	// is replaced by one against the CA loaded last
+	return credentials.NewTLS(&tls.Config{
+		MinVersion:         tls.VersionTLS13,
+		InsecureSkipVerify: true,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := c.current()
+			return cert, nil
+		},
+		VerifyConnection: c.verifyServer,
+	})
+}
+
+// Checks the files every TLS_RELOAD_INTERVAL until ctx is done, a pair that
+// fails to load is logged and the previous one stays in use
+func (c *Certificates) Watch(ctx context.Context) {
+	if !c.Enabled() || c.cfg.ReloadInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(c.cfg.ReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			stamp, err := c.filesStamp()
+			if err != nil {
+				c.logger.Error("Failed to check TLS certificates", zap.Error(err))
+				continue
+			}
+			c.mu.RLock()
+			changed := stamp != c.stamp
+			c.mu.RUnlock()
+			if !changed {
+				continue
+			}
+
+			if err := c.reload(); err != nil {
+				c.logger.Error("Failed to reload TLS certificates", zap.Error(err))
+				continue
+			}
+			c.logger.Info("TLS certificates reloaded", zap.String("cert_file", c.cfg.CertFile))
+		}
+	}
+}
+
+func (c *Certificates) reload() error {
+	// taken first so a change made while reading is picked up on the next check
+	stamp, err := c.filesStamp()
+	if err != nil {
+		return err
+	}
+
+	cert, err := tls.LoadX509KeyPair(c.cfg.CertFile, c.cfg.KeyFile)
+	if err != nil {
+		return fmt.Errorf("mtls: failed to load key pair: %w", err)
+	}
+
+	caPEM, err := os.ReadFile(c.cfg.CAFile)
+	if err != nil {
+		return fmt.Errorf("mtls: failed to read CA: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return fmt.Errorf("mtls: no certificates in %s", c.cfg.CAFile)
+	}
+
+	c.mu.Lock()
+	c.cert, c.pool, c.stamp = &cert, pool, stamp
+	c.mu.Unlock()
+	return nil
+}
+
+func (c *Certificates) current() (*tls.Certificate, *x509.CertPool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.cert, c.pool
+}
+
+func (c *Certificates) verifyServer(cs tls.ConnectionState) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("mtls: server sent no certificate")
+	}
+
+	_, pool := c.current()
+	intermediates := x509.NewCertPool()
+	for _, cert := range cs.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := cs.PeerCertificates[0].Verify(x509.VerifyOptions{
+		DNSName:       cs.ServerName,
+		Roots:         pool,
+		Intermediates: intermediates,
+	})
+	return err
+}
+
+// Runs after the chain was verified against ClientCAs
+func (c *Certificates) verifyClient(cs tls.ConnectionState) error {
+	if len(c.cfg.AllowedClients) == 0 {
+		return nil
+	}
+
+	leaf := cs.PeerCertificates[0]
+	for _, name := range c.cfg.AllowedClients {
+		if leaf.Subject.CommonName == name || slices.Contains(leaf.DNSNames, name) {
+			return nil
+		}
+	}
+	return fmt.Errorf("mtls: client %q is not allowed", leaf.Subject.CommonName)
+}
+
+func (c *Certificates) files() []string {
+	return []string{c.cfg.CertFile, c.cfg.KeyFile, c.cfg.CAFile}
+}
+
+// Size and modification time of the files, enough to notice a rotation
+func (c *Certificates) filesStamp() (string, error) {
+	var stamp string
+	for _, file := range c.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return "", fmt.Errorf("mtls: %w", err)
+		}
+		stamp += fmt.Sprintf("%s:%d:%d;", file, info.Size(), info.ModTime().UnixNano())
+	}
+	return stamp, nil
+}
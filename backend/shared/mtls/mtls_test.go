@@ -0,0 +1,159 @@
+package mtls
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/IAGrig/vt-csa-essays/backend/shared/config"
+	"github.com/IAGrig/vt-csa-essays/backend/shared/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("disabled without files", func(t *testing.T) {
+		certs, err := New(config.TLS{}, logging.NewEmptyLogger())
+		require.NoError(t, err)
+		assert.False(t, certs.Enabled())
+		assert.Equal(t, "insecure", certs.ClientCredentials().Info().SecurityProtocol)
+	})
+
+	t.Run("files are set together", func(t *testing.T) {
+		_, err := New(config.TLS{CertFile: "service.crt"}, logging.NewEmptyLogger())
+		assert.ErrorContains(t, err, "must be set together")
+	})
+
+	t.Run("missing files", func(t *testing.T) {
+		dir := t.TempDir()
+		_, err := New(tlsConfig(dir, "essay-service"), logging.NewEmptyLogger())
+		assert.Error(t, err)
+	})
+}
+
+func TestCertificates(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, Generate(dir, "review-service", "essay-service", "api-gateway", "intruder"))
+
+	serverCfg := tlsConfig(dir, "review-service")
+	serverCfg.AllowedClients = []string{"essay-service", "api-gateway"}
+	server, err := New(serverCfg, logging.NewEmptyLogger())
+	require.NoError(t, err)
+	addr := serve(t, server)
+
+	t.Run("allowed client", func(t *testing.T) {
+		assert.NoError(t, check(t, addr, client(t, dir, "essay-service")))
+	})
+
+	t.Run("client not in the allowed list", func(t *testing.T) {
+		assert.Error(t, check(t, addr, client(t, dir, "intruder")))
+	})
+
+	t.Run("plaintext client", func(t *testing.T) {
+		assert.Error(t, check(t, addr, insecure.NewCredentials()))
+	})
+
+	t.Run("certificate of another CA", func(t *testing.T) {
+		otherDir := t.TempDir()
+		require.NoError(t, Generate(otherDir, "api-gateway"))
+		assert.Error(t, check(t, addr, client(t, otherDir, "api-gateway")))
+	})
+
+	t.Run("server name must match", func(t *testing.T) {
+		conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(client(t, dir, "essay-service")),
+			grpc.WithAuthority("essay-service"))
+		require.NoError(t, err)
+		defer conn.Close()
+		_, err = healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+		assert.Error(t, err)
+	})
+}
+
+func TestCertificates_Watch(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, Generate(dir, "review-service", "essay-service"))
+
+	serverCfg := tlsConfig(dir, "review-service")
+	serverCfg.ReloadInterval = 10 * time.Millisecond
+	server, err := New(serverCfg, logging.NewEmptyLogger())
+	require.NoError(t, err)
+	addr := serve(t, server)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go server.Watch(ctx)
+
+	oldClient := client(t, dir, "essay-service")
+	require.NoError(t, check(t, addr, oldClient))
+
+	// a new CA replaces the old one, clients of the old CA are no longer trusted
+	caCert, caKey := Files(dir, CAName)
+	require.NoError(t, os.Remove(caCert))
+	require.NoError(t, os.Remove(caKey))
+	require.NoError(t, Generate(dir, "review-service", "essay-service"))
+
+	newClient := client(t, dir, "essay-service")
+	assert.Eventually(t, func() bool {
+		return check(t, addr, newClient) == nil
+	}, 5*time.Second, 20*time.Millisecond)
+	assert.Error(t, check(t, addr, oldClient))
+
+	// a broken file keeps the pair loaded last
+	certFile, _ := Files(dir, "review-service")
+	require.NoError(t, os.WriteFile(certFile, []byte("not a certificate"), 0o644))
+	time.Sleep(50 * time.Millisecond)
+	assert.NoError(t, check(t, addr, newClient))
+}
+
+func tlsConfig(dir, name string) config.TLS {
+	certFile, keyFile := Files(dir, name)
+	return config.TLS{
+		CertFile: certFile,
+		KeyFile:  keyFile,
+		CAFile:   filepath.Join(dir, CAName+".crt"),
+	}
+}
+
+// Credentials of name, loaded once so later changes of the files don't affect them
+func client(t *testing.T, dir, name string) credentials.TransportCredentials {
+	t.Helper()
+	certs, err := New(tlsConfig(dir, name), logging.NewEmptyLogger())
+	require.NoError(t, err)
+	return certs.ClientCredentials()
+}
+
+func serve(t *testing.T, certs *Certificates) string {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer(certs.ServerOption())
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	return "localhost:" + strconv.Itoa(lis.Addr().(*net.TCPAddr).Port)
+}
+
+func check(t *testing.T, addr string, creds credentials.TransportCredentials) error {
+	t.Helper()
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	return err
+}
@@ -3,32 +3,42 @@ package pgutil
 import (
 	"context"
 	"fmt"
-	"os"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/IAGrig/vt-csa-essays/backend/shared/config"
+	"github.com/exaring/otelpgx"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// Upper bound of a single repository call, POSTGRES_QUERY_TIMEOUT as loaded by GetPgxPool
+var queryTimeout atomic.Int64
+
+// Pools handed out by GetPgxPool, closed together on shutdown
+var (
+	poolsMu sync.Mutex
+	pools   []*pgxpool.Pool
+)
+
 func GetPgxPool() (*pgxpool.Pool, error) {
-	host := os.Getenv("POSTGRES_HOST")
-	port := os.Getenv("POSTGRES_PORT")
-	user := os.Getenv("POSTGRES_USER")
-	password := os.Getenv("POSTGRES_PASSWORD")
-	dbname := os.Getenv("POSTGRES_DB_NAME")
-	sslMode := os.Getenv("POSTGRES_SSL_MODE")
+	var pgConfig config.Postgres
+	if err := config.LoadSection("postgres", &pgConfig); err != nil {
+		return nil, err
+	}
 
-	connStr := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
-		host, port, user, password, dbname, sslMode)
+	queryTimeout.Store(int64(pgConfig.QueryTimeout))
 
-	config, err := pgxpool.ParseConfig(connStr)
+	poolConfig, err := pgxpool.ParseConfig(pgConfig.ConnString())
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse connection config: %w", err)
 	}
+	poolConfig.ConnConfig.Tracer = otelpgx.NewTracer()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	pool, err := pgxpool.NewWithConfig(ctx, config)
+	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create connection pool: %w", err)
 	}
@@ -37,5 +47,39 @@ func GetPgxPool() (*pgxpool.Pool, error) {
 		return nil, fmt.Errorf("database ping failed: %w", err)
 	}
 
+	poolsMu.Lock()
+	pools = append(pools, pool)
+	poolsMu.Unlock()
+
 	return pool, nil
 }
+
+// Closes every pool created by GetPgxPool, waiting for the connections
+// still in use to be released until ctx is done
+func ClosePools(ctx context.Context) error {
+	poolsMu.Lock()
+	closing := pools
+	pools = nil
+	poolsMu.Unlock()
+
+	closed := make(chan struct{})
+	go func() {
+		for _, pool := range closing {
+			pool.Close()
+		}
+		close(closed)
+	}()
+
+	select {
+	case <-closed:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to close connection pools: %w", ctx.Err())
+	}
+}
+
+// Bounds a repository call so a stuck query can't outlive the request,
+// deadlines already set by the caller still apply when they are shorter
+func WithQueryTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, time.Duration(queryTimeout.Load()))
+}
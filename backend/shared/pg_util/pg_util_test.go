@@ -0,0 +1,41 @@
+package pgutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithQueryTimeout(t *testing.T) {
+	queryTimeout.Store(int64(2 * time.Second))
+
+	ctx, cancel := WithQueryTimeout(context.Background())
+	defer cancel()
+
+	deadline, ok := ctx.Deadline()
+	assert.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(2*time.Second), deadline, time.Second)
+
+	parent, cancelParent := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancelParent()
+	ctx, cancel = WithQueryTimeout(parent)
+	defer cancel()
+
+	parentDeadline, _ := parent.Deadline()
+	deadline, _ = ctx.Deadline()
+	assert.Equal(t, parentDeadline, deadline)
+}
+
+func TestClosePools(t *testing.T) {
+	pool, err := pgxpool.New(context.Background(), "host=localhost dbname=essays")
+	require.NoError(t, err)
+	pools = append(pools, pool)
+
+	assert.NoError(t, ClosePools(context.Background()))
+	assert.Empty(t, pools)
+	assert.Error(t, pool.Ping(context.Background()))
+}
@@ -0,0 +1,50 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+)
+
+// Adapts kafka message headers to the propagation.TextMapCarrier interface
+type kafkaHeaders struct {
+	headers *[]kafka.Header
+}
+
+func (h kafkaHeaders) Get(key string) string {
+	for _, header := range *h.headers {
+		if header.Key == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+func (h kafkaHeaders) Set(key, value string) {
+	for i, header := range *h.headers {
+		if header.Key == key {
+			(*h.headers)[i].Value = []byte(value)
+			return
+		}
+	}
+	*h.headers = append(*h.headers, kafka.Header{Key: key, Value: []byte(value)})
+}
+
+func (h kafkaHeaders) Keys() []string {
+	keys := make([]string, 0, len(*h.headers))
+	for _, header := range *h.headers {
+		keys = append(keys, header.Key)
+	}
+	return keys
+}
+
+// Writes the trace context of ctx into the message headers
+func InjectKafka(ctx context.Context, msg *kafka.Message) {
+	otel.GetTextMapPropagator().Inject(ctx, kafkaHeaders{headers: &msg.Headers})
+}
+
+// Restores the trace context the producer wrote into the message headers
+func ExtractKafka(ctx context.Context, msg kafka.Message) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, kafkaHeaders{headers: &msg.Headers})
+}
@@ -0,0 +1,100 @@
+package tracing
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newTestProvider(t *testing.T) *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := NewProvider("test", sdktrace.WithSyncer(exporter))
+	t.Cleanup(func() { provider.Shutdown(context.Background()) })
+	return exporter
+}
+
+func TestGrpcPropagation(t *testing.T) {
+	exporter := newTestProvider(t)
+
+	lis := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(ServerOption())
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	go server.Serve(lis)
+	defer server.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		DialOption())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "GET /api/essays/:authorname")
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	parent.End()
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 3)
+	for _, span := range spans {
+		assert.Equal(t, parent.SpanContext().TraceID(), span.SpanContext.TraceID())
+	}
+
+	kinds := map[trace.SpanKind]bool{}
+	for _, span := range spans {
+		kinds[span.SpanKind] = true
+	}
+	assert.True(t, kinds[trace.SpanKindClient])
+	assert.True(t, kinds[trace.SpanKindServer])
+}
+
+func TestKafkaPropagation(t *testing.T) {
+	newTestProvider(t)
+
+	ctx, span := otel.Tracer("test").Start(context.Background(), "send")
+	defer span.End()
+
+	msg := kafka.Message{Headers: []kafka.Header{{Key: "source", Value: []byte("review-service")}}}
+	InjectKafka(ctx, &msg)
+	InjectKafka(ctx, &msg)
+
+	assert.Len(t, msg.Headers, 2)
+	restored := trace.SpanContextFromContext(ExtractKafka(context.Background(), msg))
+	assert.Equal(t, span.SpanContext().TraceID(), restored.TraceID())
+	assert.Equal(t, span.SpanContext().SpanID(), restored.SpanID())
+	assert.True(t, restored.IsRemote())
+}
+
+func TestGinMiddleware(t *testing.T) {
+	exporter := newTestProvider(t)
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(GinMiddleware("api-gateway"))
+	router.GET("/api/essays/:authorname", func(c *gin.Context) {
+		assert.True(t, trace.SpanContextFromContext(c.Request.Context()).IsValid())
+		c.Status(http.StatusOK)
+	})
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/essays/alice", nil))
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 1)
+	assert.Equal(t, trace.SpanKindServer, spans[0].SpanKind)
+}
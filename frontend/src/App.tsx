@@ -21,6 +21,7 @@ const App: React.FC = () => (
         <Route path="/" element={<Home />} />
         <Route path="/essays" element={<Essays />} />
         <Route path="/essay/:author" element={<EssayDetail />} />
+        <Route path="/essay/by-id/:id" element={<EssayDetail />} />
         <Route path="/reviews" element={<Reviews />} />
         <Route path="/login" element={<Login />} />
         <Route path="/register" element={<Register />} />
@@ -29,6 +29,10 @@ interface EssayWithReviews {
 const fetchEssay = (author: string) =>
   api.get<EssayWithReviews>(`/essay/${author}`).then(r => r.data);
 
+// essays of anonymous assignments are linked by id so the author stays hidden
+const fetchEssayById = (id: string) =>
+  api.get<EssayWithReviews>(`/essays/by-id/${id}`).then(r => r.data);
+
 const submitReview = (req: {
   essayId: number;
   rank: number;
@@ -37,13 +41,14 @@ const submitReview = (req: {
 }) => api.post('/review', req).then(r => r.data);
 
 const EssayDetail: React.FC = () => {
-  const { author } = useParams<{ author: string }>();
+  const { author, id } = useParams<{ author?: string; id?: string }>();
+  const essayKey = id ?? author;
   const qc = useQueryClient();
   const token = getAccessToken();
 
   const { data, isLoading, error } = useQuery<EssayWithReviews, Error>({
-    queryKey: ['essay', author],
-    queryFn: () => fetchEssay(author!),
+    queryKey: ['essay', essayKey],
+    queryFn: () => (id ? fetchEssayById(id) : fetchEssay(author!)),
     retry: false,
   });
   // Determine status message
@@ -77,7 +82,7 @@ const EssayDetail: React.FC = () => {
         author: localStorage.getItem('username')!,
       }),
     onSuccess: () => {
-	    qc.invalidateQueries({ queryKey: ['essay', author] });
+	    qc.invalidateQueries({ queryKey: ['essay', essayKey] });
       setContent('');
       setRank('1');
     },